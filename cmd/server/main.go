@@ -17,6 +17,7 @@ import (
 	"github.com/dwarvesf/fortress-api/pkg/service"
 	"github.com/dwarvesf/fortress-api/pkg/service/vault"
 	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/cryptoutils"
 	"github.com/dwarvesf/fortress-api/pkg/worker"
 )
 
@@ -49,6 +50,15 @@ func main() {
 		cfg = config.Generate(v)
 	}
 
+	keyring, err := cryptoutils.NewKeyringFromConfig(cfg.Encryption)
+	if err != nil {
+		log.Fatal(err, "failed to init encryption keyring")
+	}
+	if keyring == nil {
+		log.Warn("field encryption is disabled, employee PII will be stored in plain text")
+	}
+	cryptoutils.SetDefaultKeyring(keyring)
+
 	s := store.New()
 	repo := store.NewPostgresStore(cfg)
 
@@ -0,0 +1,44 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS "audit_logs" (
+    id                 UUID PRIMARY KEY DEFAULT (UUID()),
+    created_at         TIMESTAMP(6)     DEFAULT (now()),
+
+    actor_type         TEXT NOT NULL,
+    actor_id           TEXT,
+    action             TEXT NOT NULL,
+    resource_type      TEXT NOT NULL,
+    resource_id        TEXT,
+    method             TEXT NOT NULL,
+    path               TEXT NOT NULL,
+    status_code        INTEGER,
+    before             JSONB,
+    after              JSONB,
+    diff               JSONB,
+    request_id         TEXT,
+    ip_address         TEXT
+);
+
+CREATE INDEX IF NOT EXISTS audit_logs_resource_idx ON audit_logs (resource_type, resource_id);
+CREATE INDEX IF NOT EXISTS audit_logs_actor_idx ON audit_logs (actor_type, actor_id);
+CREATE INDEX IF NOT EXISTS audit_logs_created_at_idx ON audit_logs (created_at);
+
+-- +migrate StatementBegin
+CREATE OR REPLACE FUNCTION public.fn_prevent_audit_logs_modification ()
+	RETURNS TRIGGER
+	LANGUAGE plpgsql
+	AS $function$
+BEGIN
+	RAISE EXCEPTION 'audit_logs is append-only';
+END;
+$function$;
+-- +migrate StatementEnd
+
+CREATE TRIGGER trg_prevent_audit_logs_modification
+	BEFORE UPDATE OR DELETE ON audit_logs
+	FOR EACH ROW
+	EXECUTE FUNCTION fn_prevent_audit_logs_modification ();
+
+-- +migrate Down
+DROP TRIGGER IF EXISTS trg_prevent_audit_logs_modification ON audit_logs;
+DROP FUNCTION IF EXISTS fn_prevent_audit_logs_modification;
+DROP TABLE IF EXISTS audit_logs;
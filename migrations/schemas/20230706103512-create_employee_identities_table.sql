@@ -0,0 +1,39 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS "employee_identities" (
+    id                 UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at         TIMESTAMP(6),
+    created_at         TIMESTAMP(6)     DEFAULT (now()),
+    updated_at         TIMESTAMP(6)     DEFAULT (now()),
+
+    employee_id        UUID NOT NULL,
+    provider           TEXT NOT NULL,
+    subject            TEXT NOT NULL,
+    email              TEXT,
+    last_login_at      TIMESTAMP(6)
+);
+
+ALTER TABLE employee_identities
+    ADD CONSTRAINT employee_identities_employee_id_fkey FOREIGN KEY (employee_id) REFERENCES employees (id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS employee_identities_provider_subject_uidx
+    ON employee_identities (provider, subject) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS "magic_link_tokens" (
+    id                 UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at         TIMESTAMP(6),
+    created_at         TIMESTAMP(6)     DEFAULT (now()),
+    updated_at         TIMESTAMP(6)     DEFAULT (now()),
+
+    employee_id        UUID NOT NULL,
+    email              TEXT NOT NULL,
+    token_hash         TEXT NOT NULL UNIQUE,
+    expired_at         TIMESTAMP(6) NOT NULL,
+    used_at            TIMESTAMP(6)
+);
+
+ALTER TABLE magic_link_tokens
+    ADD CONSTRAINT magic_link_tokens_employee_id_fkey FOREIGN KEY (employee_id) REFERENCES employees (id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS magic_link_tokens;
+DROP TABLE IF EXISTS employee_identities;
@@ -0,0 +1,31 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS "approval_requests" (
+    id                 UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at         TIMESTAMP(6),
+    created_at         TIMESTAMP(6)     DEFAULT (now()),
+    updated_at         TIMESTAMP(6)     DEFAULT (now()),
+
+    action             TEXT NOT NULL,
+    status             TEXT NOT NULL DEFAULT 'pending',
+    permission         TEXT NOT NULL,
+    summary            TEXT,
+    payload            JSONB NOT NULL,
+    requester_id       UUID NOT NULL,
+    approver_id        UUID,
+    expired_at         TIMESTAMP(6) NOT NULL,
+    reviewed_at        TIMESTAMP(6),
+    executed_at        TIMESTAMP(6),
+    reject_reason      TEXT,
+    error_message      TEXT
+);
+
+ALTER TABLE approval_requests
+    ADD CONSTRAINT approval_requests_requester_id_fkey FOREIGN KEY (requester_id) REFERENCES employees (id);
+
+ALTER TABLE approval_requests
+    ADD CONSTRAINT approval_requests_approver_id_fkey FOREIGN KEY (approver_id) REFERENCES employees (id);
+
+CREATE INDEX IF NOT EXISTS approval_requests_status_idx ON approval_requests (status, expired_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS approval_requests;
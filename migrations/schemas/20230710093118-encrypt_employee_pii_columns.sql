@@ -0,0 +1,51 @@
+-- +migrate Up
+-- date of birth is stored as ciphertext once field encryption is enabled
+ALTER TABLE employees ALTER COLUMN date_of_birth TYPE TEXT USING to_char(date_of_birth, 'YYYY-MM-DD');
+
+-- encrypted values start with their blind index, so exact lookups are prefix matches
+CREATE INDEX IF NOT EXISTS employees_personal_email_prefix_idx ON employees (personal_email text_pattern_ops);
+CREATE INDEX IF NOT EXISTS employees_phone_number_prefix_idx ON employees (phone_number text_pattern_ops);
+
+-- personal email is encrypted, the dashboard only exposes the team email
+DROP VIEW IF EXISTS "vw_icy_employee_dashboard";
+CREATE VIEW "vw_icy_employee_dashboard" AS
+SELECT
+    t.dest_employee_id as employee_id,
+    e.full_name,
+    e.team_email,
+    SUM(t.amount) AS "total_earned"
+FROM
+    icy_transactions t
+        JOIN employees e ON e.id = t.dest_employee_id
+GROUP BY
+    t.dest_employee_id,
+    e.full_name,
+    e.team_email
+ORDER BY
+    SUM(t.amount) DESC;
+
+-- +migrate Down
+DROP VIEW IF EXISTS "vw_icy_employee_dashboard";
+CREATE VIEW "vw_icy_employee_dashboard" AS
+SELECT
+    t.dest_employee_id as employee_id,
+    e.full_name,
+    e.team_email,
+    e.personal_email,
+    SUM(t.amount) AS "total_earned"
+FROM
+    icy_transactions t
+        JOIN employees e ON e.id = t.dest_employee_id
+GROUP BY
+    t.dest_employee_id,
+    e.full_name,
+    e.team_email,
+    e.personal_email
+ORDER BY
+    SUM(t.amount) DESC;
+
+DROP INDEX IF EXISTS employees_phone_number_prefix_idx;
+DROP INDEX IF EXISTS employees_personal_email_prefix_idx;
+
+-- encrypted rows must be decrypted before rolling back
+ALTER TABLE employees ALTER COLUMN date_of_birth TYPE DATE USING NULLIF(LEFT(date_of_birth, 10), '')::DATE;
@@ -0,0 +1,13 @@
+-- +migrate Up
+-- buckets are cheap to lose, so the table skips the write-ahead log
+CREATE UNLOGGED TABLE IF NOT EXISTS "rate_limit_buckets" (
+    key        TEXT PRIMARY KEY,
+    tokens     DOUBLE PRECISION NOT NULL,
+    allowed    BOOLEAN          NOT NULL DEFAULT TRUE,
+    updated_at TIMESTAMP(6)     NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX IF NOT EXISTS rate_limit_buckets_updated_at_idx ON rate_limit_buckets (updated_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS rate_limit_buckets;
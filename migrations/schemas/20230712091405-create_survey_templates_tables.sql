@@ -0,0 +1,81 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS "survey_templates" (
+    id                 UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at         TIMESTAMP(6),
+    created_at         TIMESTAMP(6)     DEFAULT (now()),
+    updated_at         TIMESTAMP(6)     DEFAULT (now()),
+
+    name               TEXT NOT NULL,
+    description        TEXT,
+    subtype            TEXT,
+    latest_version     INT NOT NULL DEFAULT 0,
+    created_by         UUID NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS "survey_template_versions" (
+    id                 UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at         TIMESTAMP(6),
+    created_at         TIMESTAMP(6)     DEFAULT (now()),
+    updated_at         TIMESTAMP(6)     DEFAULT (now()),
+
+    template_id        UUID NOT NULL,
+    version            INT NOT NULL,
+    created_by         UUID NOT NULL,
+
+    CONSTRAINT survey_template_versions_template_id_version_key UNIQUE (template_id, version)
+);
+
+CREATE TABLE IF NOT EXISTS "survey_template_questions" (
+    id                  UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at          TIMESTAMP(6),
+    created_at          TIMESTAMP(6)     DEFAULT (now()),
+    updated_at          TIMESTAMP(6)     DEFAULT (now()),
+
+    template_version_id UUID NOT NULL,
+    key                 TEXT NOT NULL,
+    type                TEXT NOT NULL,
+    content             TEXT NOT NULL,
+    "order"             INT,
+    domain              TEXT,
+    required            BOOLEAN NOT NULL DEFAULT FALSE,
+    options             JSONB,
+    min_value           INT,
+    max_value           INT,
+    parent_key          TEXT,
+    show_if             JSONB,
+
+    CONSTRAINT survey_template_questions_template_version_id_key_key UNIQUE (template_version_id, key)
+);
+
+ALTER TABLE survey_templates
+    ADD CONSTRAINT survey_templates_created_by_fkey FOREIGN KEY (created_by) REFERENCES employees (id);
+
+ALTER TABLE survey_template_versions
+    ADD CONSTRAINT survey_template_versions_template_id_fkey FOREIGN KEY (template_id) REFERENCES survey_templates (id);
+
+ALTER TABLE survey_template_versions
+    ADD CONSTRAINT survey_template_versions_created_by_fkey FOREIGN KEY (created_by) REFERENCES employees (id);
+
+ALTER TABLE survey_template_questions
+    ADD CONSTRAINT survey_template_questions_template_version_id_fkey FOREIGN KEY (template_version_id) REFERENCES survey_template_versions (id);
+
+ALTER TABLE feedback_events ADD COLUMN template_version_id UUID;
+
+ALTER TABLE feedback_events
+    ADD CONSTRAINT feedback_events_template_version_id_fkey FOREIGN KEY (template_version_id) REFERENCES survey_template_versions (id);
+
+ALTER TABLE employee_event_questions ADD COLUMN template_question_id UUID;
+
+ALTER TABLE employee_event_questions
+    ADD CONSTRAINT employee_event_questions_template_question_id_fkey FOREIGN KEY (template_question_id) REFERENCES survey_template_questions (id);
+
+-- +migrate Down
+ALTER TABLE employee_event_questions DROP CONSTRAINT IF EXISTS employee_event_questions_template_question_id_fkey;
+ALTER TABLE employee_event_questions DROP COLUMN IF EXISTS template_question_id;
+
+ALTER TABLE feedback_events DROP CONSTRAINT IF EXISTS feedback_events_template_version_id_fkey;
+ALTER TABLE feedback_events DROP COLUMN IF EXISTS template_version_id;
+
+DROP TABLE IF EXISTS survey_template_questions;
+DROP TABLE IF EXISTS survey_template_versions;
+DROP TABLE IF EXISTS survey_templates;
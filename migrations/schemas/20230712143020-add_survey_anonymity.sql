@@ -0,0 +1,36 @@
+-- +migrate Up
+ALTER TABLE feedback_events ADD COLUMN anonymity TEXT NOT NULL DEFAULT 'identified';
+ALTER TABLE feedback_events ADD COLUMN anonymity_threshold INT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS "anonymous_event_answers" (
+    id                      UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at              TIMESTAMP(6),
+    created_at              TIMESTAMP(6)     DEFAULT (now()),
+    updated_at              TIMESTAMP(6)     DEFAULT (now()),
+
+    event_id                UUID NOT NULL,
+    employee_event_topic_id UUID,
+    response_key            TEXT NOT NULL,
+    question_id             UUID,
+    template_question_id    UUID,
+    content                 TEXT,
+    type                    TEXT,
+    answer                  TEXT,
+    note                    TEXT,
+    "order"                 INT,
+    domain                  TEXT,
+    seniority               TEXT,
+    chapters                JSONB,
+    projects                JSONB
+);
+
+ALTER TABLE anonymous_event_answers
+    ADD CONSTRAINT anonymous_event_answers_event_id_fkey FOREIGN KEY (event_id) REFERENCES feedback_events (id);
+
+CREATE INDEX IF NOT EXISTS anonymous_event_answers_event_id_idx ON anonymous_event_answers (event_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS anonymous_event_answers;
+
+ALTER TABLE feedback_events DROP COLUMN IF EXISTS anonymity_threshold;
+ALTER TABLE feedback_events DROP COLUMN IF EXISTS anonymity;
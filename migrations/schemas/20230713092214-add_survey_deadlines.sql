@@ -0,0 +1,41 @@
+-- +migrate Up
+ALTER TABLE feedback_events ADD COLUMN deadline TIMESTAMP(6);
+ALTER TABLE feedback_events ADD COLUMN reminder_days JSONB;
+ALTER TABLE feedback_events ADD COLUMN auto_close BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE feedback_events ADD COLUMN closed_at TIMESTAMP(6);
+
+ALTER TABLE employee_event_reviewers ADD COLUMN is_non_response BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS "survey_reminders" (
+    id                         UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at                 TIMESTAMP(6),
+    created_at                 TIMESTAMP(6)     DEFAULT (now()),
+    updated_at                 TIMESTAMP(6)     DEFAULT (now()),
+
+    event_id                   UUID NOT NULL,
+    employee_event_reviewer_id UUID NOT NULL,
+    reviewer_id                UUID NOT NULL,
+    days_before                INT  NOT NULL,
+    channel                    TEXT NOT NULL,
+    sent_at                    TIMESTAMP(6)
+);
+
+ALTER TABLE survey_reminders
+    ADD CONSTRAINT survey_reminders_event_id_fkey FOREIGN KEY (event_id) REFERENCES feedback_events (id);
+ALTER TABLE survey_reminders
+    ADD CONSTRAINT survey_reminders_employee_event_reviewer_id_fkey FOREIGN KEY (employee_event_reviewer_id) REFERENCES employee_event_reviewers (id);
+ALTER TABLE survey_reminders
+    ADD CONSTRAINT survey_reminders_reviewer_id_fkey FOREIGN KEY (reviewer_id) REFERENCES employees (id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS survey_reminders_reviewer_days_before_uidx
+    ON survey_reminders (employee_event_reviewer_id, days_before) WHERE deleted_at IS NULL;
+
+-- +migrate Down
+DROP TABLE IF EXISTS survey_reminders;
+
+ALTER TABLE employee_event_reviewers DROP COLUMN IF EXISTS is_non_response;
+
+ALTER TABLE feedback_events DROP COLUMN IF EXISTS closed_at;
+ALTER TABLE feedback_events DROP COLUMN IF EXISTS auto_close;
+ALTER TABLE feedback_events DROP COLUMN IF EXISTS reminder_days;
+ALTER TABLE feedback_events DROP COLUMN IF EXISTS deadline;
@@ -0,0 +1,53 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS "survey_campaigns" (
+    id                  UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at          TIMESTAMP(6),
+    created_at          TIMESTAMP(6)     DEFAULT (now()),
+    updated_at          TIMESTAMP(6)     DEFAULT (now()),
+
+    name                TEXT    NOT NULL,
+    subtype             TEXT    NOT NULL,
+    recurrence          TEXT    NOT NULL,
+    start_date          DATE    NOT NULL,
+    lead_days           INT     NOT NULL DEFAULT 0,
+    deadline_days       INT     NOT NULL DEFAULT 0,
+    reminder_days       JSONB,
+    auto_close          BOOLEAN NOT NULL DEFAULT FALSE,
+    template_id         UUID,
+    anonymity           TEXT    NOT NULL DEFAULT 'identified',
+    anonymity_threshold INT     NOT NULL DEFAULT 0,
+    participant_rule    JSONB,
+    is_active           BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by          UUID
+);
+
+ALTER TABLE survey_campaigns
+    ADD CONSTRAINT survey_campaigns_template_id_fkey FOREIGN KEY (template_id) REFERENCES survey_templates (id);
+ALTER TABLE survey_campaigns
+    ADD CONSTRAINT survey_campaigns_created_by_fkey FOREIGN KEY (created_by) REFERENCES employees (id);
+
+CREATE TABLE IF NOT EXISTS "survey_campaign_runs" (
+    id           UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at   TIMESTAMP(6),
+    created_at   TIMESTAMP(6)     DEFAULT (now()),
+    updated_at   TIMESTAMP(6)     DEFAULT (now()),
+
+    campaign_id  UUID NOT NULL,
+    event_id     UUID,
+    period_start DATE NOT NULL,
+    period_end   DATE NOT NULL,
+    status       TEXT NOT NULL,
+    error        TEXT
+);
+
+ALTER TABLE survey_campaign_runs
+    ADD CONSTRAINT survey_campaign_runs_campaign_id_fkey FOREIGN KEY (campaign_id) REFERENCES survey_campaigns (id);
+ALTER TABLE survey_campaign_runs
+    ADD CONSTRAINT survey_campaign_runs_event_id_fkey FOREIGN KEY (event_id) REFERENCES feedback_events (id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS survey_campaign_runs_campaign_period_uidx
+    ON survey_campaign_runs (campaign_id, period_start) WHERE deleted_at IS NULL;
+
+-- +migrate Down
+DROP TABLE IF EXISTS survey_campaign_runs;
+DROP TABLE IF EXISTS survey_campaigns;
@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE feedback_events ADD COLUMN visibility TEXT NOT NULL DEFAULT 'private';
+
+-- +migrate Down
+ALTER TABLE feedback_events DROP COLUMN IF EXISTS visibility;
@@ -0,0 +1,52 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS "performance_review_cycles" (
+    id                   UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at           TIMESTAMP(6),
+    created_at           TIMESTAMP(6)     DEFAULT (now()),
+    updated_at           TIMESTAMP(6)     DEFAULT (now()),
+
+    name                 TEXT NOT NULL,
+    start_date           DATE NOT NULL,
+    end_date             DATE NOT NULL,
+    peer_review_event_id UUID,
+    status               TEXT NOT NULL DEFAULT 'assessment',
+    created_by           UUID
+);
+
+ALTER TABLE performance_review_cycles
+    ADD CONSTRAINT performance_review_cycles_peer_review_event_id_fkey FOREIGN KEY (peer_review_event_id) REFERENCES feedback_events (id);
+ALTER TABLE performance_review_cycles
+    ADD CONSTRAINT performance_review_cycles_created_by_fkey FOREIGN KEY (created_by) REFERENCES employees (id);
+
+CREATE TABLE IF NOT EXISTS "performance_reviews" (
+    id                UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at        TIMESTAMP(6),
+    created_at        TIMESTAMP(6)     DEFAULT (now()),
+    updated_at        TIMESTAMP(6)     DEFAULT (now()),
+
+    cycle_id          UUID NOT NULL,
+    employee_id       UUID NOT NULL,
+    reviewer_id       UUID,
+    status            TEXT NOT NULL DEFAULT 'pending',
+    assessment        JSONB,
+    proposed_rating   TEXT,
+    calibrated_rating TEXT,
+    calibration_note  TEXT,
+    final_rating      TEXT,
+    finalized_at      TIMESTAMP(6)
+);
+
+ALTER TABLE performance_reviews
+    ADD CONSTRAINT performance_reviews_cycle_id_fkey FOREIGN KEY (cycle_id) REFERENCES performance_review_cycles (id);
+ALTER TABLE performance_reviews
+    ADD CONSTRAINT performance_reviews_employee_id_fkey FOREIGN KEY (employee_id) REFERENCES employees (id);
+ALTER TABLE performance_reviews
+    ADD CONSTRAINT performance_reviews_reviewer_id_fkey FOREIGN KEY (reviewer_id) REFERENCES employees (id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS performance_reviews_cycle_employee_uidx
+    ON performance_reviews (cycle_id, employee_id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS performance_reviews_employee_id_idx ON performance_reviews (employee_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS performance_reviews;
+DROP TABLE IF EXISTS performance_review_cycles;
@@ -0,0 +1,85 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS "objectives" (
+    id                     UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at             TIMESTAMP(6),
+    created_at             TIMESTAMP(6)     DEFAULT (now()),
+    updated_at             TIMESTAMP(6)     DEFAULT (now()),
+
+    title                  TEXT NOT NULL,
+    description            TEXT,
+    level                  TEXT NOT NULL,
+    status                 TEXT NOT NULL DEFAULT 'active',
+    parent_id              UUID,
+    owner_id               UUID NOT NULL,
+    project_id             UUID,
+    chapter_id             UUID,
+    employee_id            UUID,
+    start_date             DATE NOT NULL,
+    end_date               DATE NOT NULL,
+    check_in_interval_days INT NOT NULL DEFAULT 7,
+    created_by             UUID
+);
+
+ALTER TABLE objectives
+    ADD CONSTRAINT objectives_parent_id_fkey FOREIGN KEY (parent_id) REFERENCES objectives (id);
+ALTER TABLE objectives
+    ADD CONSTRAINT objectives_owner_id_fkey FOREIGN KEY (owner_id) REFERENCES employees (id);
+ALTER TABLE objectives
+    ADD CONSTRAINT objectives_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id);
+ALTER TABLE objectives
+    ADD CONSTRAINT objectives_chapter_id_fkey FOREIGN KEY (chapter_id) REFERENCES chapters (id);
+ALTER TABLE objectives
+    ADD CONSTRAINT objectives_employee_id_fkey FOREIGN KEY (employee_id) REFERENCES employees (id);
+ALTER TABLE objectives
+    ADD CONSTRAINT objectives_created_by_fkey FOREIGN KEY (created_by) REFERENCES employees (id);
+
+CREATE INDEX IF NOT EXISTS objectives_parent_id_idx ON objectives (parent_id);
+CREATE INDEX IF NOT EXISTS objectives_employee_id_idx ON objectives (employee_id);
+
+CREATE TABLE IF NOT EXISTS "key_results" (
+    id                UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at        TIMESTAMP(6),
+    created_at        TIMESTAMP(6)     DEFAULT (now()),
+    updated_at        TIMESTAMP(6)     DEFAULT (now()),
+
+    objective_id      UUID NOT NULL,
+    title             TEXT NOT NULL,
+    owner_id          UUID NOT NULL,
+    unit              TEXT,
+    start_value       DOUBLE PRECISION NOT NULL DEFAULT 0,
+    target_value      DOUBLE PRECISION NOT NULL,
+    current_value     DOUBLE PRECISION NOT NULL DEFAULT 0,
+    last_check_in_at  TIMESTAMP(6),
+    last_reminded_at  TIMESTAMP(6)
+);
+
+ALTER TABLE key_results
+    ADD CONSTRAINT key_results_objective_id_fkey FOREIGN KEY (objective_id) REFERENCES objectives (id);
+ALTER TABLE key_results
+    ADD CONSTRAINT key_results_owner_id_fkey FOREIGN KEY (owner_id) REFERENCES employees (id);
+
+CREATE TABLE IF NOT EXISTS "key_result_check_ins" (
+    id            UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at    TIMESTAMP(6),
+    created_at    TIMESTAMP(6)     DEFAULT (now()),
+    updated_at    TIMESTAMP(6)     DEFAULT (now()),
+
+    key_result_id UUID NOT NULL,
+    employee_id   UUID NOT NULL,
+    value         DOUBLE PRECISION NOT NULL,
+    progress      DOUBLE PRECISION NOT NULL,
+    confidence    INT,
+    note          TEXT
+);
+
+ALTER TABLE key_result_check_ins
+    ADD CONSTRAINT key_result_check_ins_key_result_id_fkey FOREIGN KEY (key_result_id) REFERENCES key_results (id);
+ALTER TABLE key_result_check_ins
+    ADD CONSTRAINT key_result_check_ins_employee_id_fkey FOREIGN KEY (employee_id) REFERENCES employees (id);
+
+CREATE INDEX IF NOT EXISTS key_result_check_ins_key_result_id_idx ON key_result_check_ins (key_result_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS key_result_check_ins;
+DROP TABLE IF EXISTS key_results;
+DROP TABLE IF EXISTS objectives;
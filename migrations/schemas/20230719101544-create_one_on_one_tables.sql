@@ -0,0 +1,63 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS "one_on_one_meetings" (
+    id           UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at   TIMESTAMP(6),
+    created_at   TIMESTAMP(6)     DEFAULT (now()),
+    updated_at   TIMESTAMP(6)     DEFAULT (now()),
+
+    manager_id   UUID NOT NULL,
+    report_id    UUID NOT NULL,
+    schedule_id  UUID NOT NULL,
+    status       TEXT NOT NULL DEFAULT 'scheduled',
+    manager_note TEXT
+);
+
+ALTER TABLE one_on_one_meetings
+    ADD CONSTRAINT one_on_one_meetings_manager_id_fkey FOREIGN KEY (manager_id) REFERENCES employees (id);
+ALTER TABLE one_on_one_meetings
+    ADD CONSTRAINT one_on_one_meetings_report_id_fkey FOREIGN KEY (report_id) REFERENCES employees (id);
+ALTER TABLE one_on_one_meetings
+    ADD CONSTRAINT one_on_one_meetings_schedule_id_fkey FOREIGN KEY (schedule_id) REFERENCES schedules (id);
+
+CREATE INDEX IF NOT EXISTS one_on_one_meetings_manager_id_report_id_idx ON one_on_one_meetings (manager_id, report_id);
+
+CREATE TABLE IF NOT EXISTS "one_on_one_agenda_items" (
+    id           UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at   TIMESTAMP(6),
+    created_at   TIMESTAMP(6)     DEFAULT (now()),
+    updated_at   TIMESTAMP(6)     DEFAULT (now()),
+
+    meeting_id   UUID NOT NULL,
+    author_id    UUID NOT NULL,
+    content      TEXT NOT NULL,
+    is_discussed BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+ALTER TABLE one_on_one_agenda_items
+    ADD CONSTRAINT one_on_one_agenda_items_meeting_id_fkey FOREIGN KEY (meeting_id) REFERENCES one_on_one_meetings (id);
+ALTER TABLE one_on_one_agenda_items
+    ADD CONSTRAINT one_on_one_agenda_items_author_id_fkey FOREIGN KEY (author_id) REFERENCES employees (id);
+
+CREATE TABLE IF NOT EXISTS "one_on_one_action_items" (
+    id           UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at   TIMESTAMP(6),
+    created_at   TIMESTAMP(6)     DEFAULT (now()),
+    updated_at   TIMESTAMP(6)     DEFAULT (now()),
+
+    meeting_id   UUID NOT NULL,
+    owner_id     UUID NOT NULL,
+    content      TEXT NOT NULL,
+    due_date     DATE,
+    status       TEXT NOT NULL DEFAULT 'open',
+    completed_at TIMESTAMP(6)
+);
+
+ALTER TABLE one_on_one_action_items
+    ADD CONSTRAINT one_on_one_action_items_meeting_id_fkey FOREIGN KEY (meeting_id) REFERENCES one_on_one_meetings (id);
+ALTER TABLE one_on_one_action_items
+    ADD CONSTRAINT one_on_one_action_items_owner_id_fkey FOREIGN KEY (owner_id) REFERENCES employees (id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS one_on_one_action_items;
+DROP TABLE IF EXISTS one_on_one_agenda_items;
+DROP TABLE IF EXISTS one_on_one_meetings;
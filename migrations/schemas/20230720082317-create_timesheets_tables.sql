@@ -0,0 +1,51 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS "timesheets" (
+    id           UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at   TIMESTAMP(6),
+    created_at   TIMESTAMP(6)     DEFAULT (now()),
+    updated_at   TIMESTAMP(6)     DEFAULT (now()),
+
+    employee_id  UUID NOT NULL,
+    project_id   UUID NOT NULL,
+    week_start   DATE NOT NULL,
+    status       TEXT NOT NULL DEFAULT 'draft',
+    submitted_at TIMESTAMP(6),
+    reviewed_by  UUID,
+    reviewed_at  TIMESTAMP(6),
+    review_note  TEXT
+);
+
+ALTER TABLE timesheets
+    ADD CONSTRAINT timesheets_employee_id_fkey FOREIGN KEY (employee_id) REFERENCES employees (id);
+ALTER TABLE timesheets
+    ADD CONSTRAINT timesheets_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id);
+ALTER TABLE timesheets
+    ADD CONSTRAINT timesheets_reviewed_by_fkey FOREIGN KEY (reviewed_by) REFERENCES employees (id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS timesheets_employee_id_project_id_week_start_idx
+    ON timesheets (employee_id, project_id, week_start) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS timesheets_project_id_week_start_idx ON timesheets (project_id, week_start);
+
+CREATE TABLE IF NOT EXISTS "timesheet_entries" (
+    id           UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at   TIMESTAMP(6),
+    created_at   TIMESTAMP(6)     DEFAULT (now()),
+    updated_at   TIMESTAMP(6)     DEFAULT (now()),
+
+    timesheet_id UUID NOT NULL,
+    work_unit_id UUID,
+    date         DATE NOT NULL,
+    hours        DOUBLE PRECISION NOT NULL,
+    note         TEXT
+);
+
+ALTER TABLE timesheet_entries
+    ADD CONSTRAINT timesheet_entries_timesheet_id_fkey FOREIGN KEY (timesheet_id) REFERENCES timesheets (id);
+ALTER TABLE timesheet_entries
+    ADD CONSTRAINT timesheet_entries_work_unit_id_fkey FOREIGN KEY (work_unit_id) REFERENCES work_units (id);
+
+CREATE INDEX IF NOT EXISTS timesheet_entries_timesheet_id_idx ON timesheet_entries (timesheet_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS timesheet_entries;
+DROP TABLE IF EXISTS timesheets;
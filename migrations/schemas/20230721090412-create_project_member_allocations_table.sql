@@ -0,0 +1,21 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS "project_member_allocations" (
+    id                UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at        TIMESTAMP(6),
+    created_at        TIMESTAMP(6)     DEFAULT (now()),
+    updated_at        TIMESTAMP(6)     DEFAULT (now()),
+
+    project_member_id UUID             NOT NULL,
+    percentage        DOUBLE PRECISION NOT NULL,
+    start_date        DATE             NOT NULL,
+    end_date          DATE,
+    note              TEXT
+);
+
+ALTER TABLE project_member_allocations
+    ADD CONSTRAINT project_member_allocations_project_member_id_fkey FOREIGN KEY (project_member_id) REFERENCES project_members (id);
+
+CREATE INDEX IF NOT EXISTS project_member_allocations_project_member_id_idx ON project_member_allocations (project_member_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS project_member_allocations;
@@ -0,0 +1,75 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS "staffing_requests" (
+    id                    UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at            TIMESTAMP(6),
+    created_at            TIMESTAMP(6)     DEFAULT (now()),
+    updated_at            TIMESTAMP(6)     DEFAULT (now()),
+
+    project_id            UUID             NOT NULL,
+    project_slot_id       UUID             NOT NULL,
+    seniority_id          UUID             NOT NULL,
+    start_date            DATE             NOT NULL,
+    allocation_percentage DOUBLE PRECISION NOT NULL DEFAULT 100,
+    status                TEXT             NOT NULL DEFAULT 'open',
+    note                  TEXT,
+    requested_by          UUID,
+    project_member_id     UUID,
+    filled_at             TIMESTAMP(6)
+);
+
+ALTER TABLE staffing_requests
+    ADD CONSTRAINT staffing_requests_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id);
+ALTER TABLE staffing_requests
+    ADD CONSTRAINT staffing_requests_project_slot_id_fkey FOREIGN KEY (project_slot_id) REFERENCES project_slots (id);
+ALTER TABLE staffing_requests
+    ADD CONSTRAINT staffing_requests_seniority_id_fkey FOREIGN KEY (seniority_id) REFERENCES seniorities (id);
+ALTER TABLE staffing_requests
+    ADD CONSTRAINT staffing_requests_requested_by_fkey FOREIGN KEY (requested_by) REFERENCES employees (id);
+ALTER TABLE staffing_requests
+    ADD CONSTRAINT staffing_requests_project_member_id_fkey FOREIGN KEY (project_member_id) REFERENCES project_members (id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS staffing_requests_project_slot_id_idx
+    ON staffing_requests (project_slot_id) WHERE deleted_at IS NULL AND status NOT IN ('filled', 'cancelled');
+
+CREATE TABLE IF NOT EXISTS "staffing_request_stacks" (
+    id                  UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at          TIMESTAMP(6),
+    created_at          TIMESTAMP(6)     DEFAULT (now()),
+    updated_at          TIMESTAMP(6)     DEFAULT (now()),
+
+    staffing_request_id UUID NOT NULL,
+    stack_id            UUID NOT NULL
+);
+
+ALTER TABLE staffing_request_stacks
+    ADD CONSTRAINT staffing_request_stacks_staffing_request_id_fkey FOREIGN KEY (staffing_request_id) REFERENCES staffing_requests (id);
+ALTER TABLE staffing_request_stacks
+    ADD CONSTRAINT staffing_request_stacks_stack_id_fkey FOREIGN KEY (stack_id) REFERENCES stacks (id);
+
+CREATE TABLE IF NOT EXISTS "staffing_candidates" (
+    id                  UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at          TIMESTAMP(6),
+    created_at          TIMESTAMP(6)     DEFAULT (now()),
+    updated_at          TIMESTAMP(6)     DEFAULT (now()),
+
+    staffing_request_id UUID             NOT NULL,
+    employee_id         UUID             NOT NULL,
+    score               DOUBLE PRECISION NOT NULL DEFAULT 0,
+    allocation          DOUBLE PRECISION NOT NULL DEFAULT 0,
+    available_from      DATE             NOT NULL,
+    status              TEXT             NOT NULL DEFAULT 'proposed',
+    note                TEXT
+);
+
+ALTER TABLE staffing_candidates
+    ADD CONSTRAINT staffing_candidates_staffing_request_id_fkey FOREIGN KEY (staffing_request_id) REFERENCES staffing_requests (id);
+ALTER TABLE staffing_candidates
+    ADD CONSTRAINT staffing_candidates_employee_id_fkey FOREIGN KEY (employee_id) REFERENCES employees (id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS staffing_candidates_staffing_request_id_employee_id_idx
+    ON staffing_candidates (staffing_request_id, employee_id) WHERE deleted_at IS NULL;
+
+-- +migrate Down
+DROP TABLE IF EXISTS staffing_candidates;
+DROP TABLE IF EXISTS staffing_request_stacks;
+DROP TABLE IF EXISTS staffing_requests;
@@ -0,0 +1,11 @@
+-- +migrate Up
+ALTER TABLE expenses ADD COLUMN "project_id" UUID;
+ALTER TABLE expenses
+    ADD CONSTRAINT expenses_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id);
+
+CREATE INDEX IF NOT EXISTS expenses_project_id_idx ON expenses (project_id);
+
+-- +migrate Down
+DROP INDEX IF EXISTS expenses_project_id_idx;
+ALTER TABLE expenses DROP CONSTRAINT IF EXISTS expenses_project_id_fkey;
+ALTER TABLE expenses DROP COLUMN IF EXISTS "project_id";
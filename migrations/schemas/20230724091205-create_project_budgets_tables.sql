@@ -0,0 +1,49 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS "project_budgets" (
+    id                UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at        TIMESTAMP(6),
+    created_at        TIMESTAMP(6)     DEFAULT (now()),
+    updated_at        TIMESTAMP(6)     DEFAULT (now()),
+
+    project_id        UUID             NOT NULL,
+    total_amount      DOUBLE PRECISION NOT NULL,
+    start_date        DATE             NOT NULL,
+    end_date          DATE,
+    alert_thresholds  JSON             DEFAULT '[50, 75, 90, 100]',
+    alerted_threshold DOUBLE PRECISION NOT NULL DEFAULT 0,
+    note              TEXT
+);
+
+ALTER TABLE project_budgets
+    ADD CONSTRAINT project_budgets_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS project_budgets_project_id_idx ON project_budgets (project_id) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS "project_budget_phases" (
+    id                UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at        TIMESTAMP(6),
+    created_at        TIMESTAMP(6)     DEFAULT (now()),
+    updated_at        TIMESTAMP(6)     DEFAULT (now()),
+
+    project_budget_id UUID             NOT NULL,
+    name              TEXT             NOT NULL,
+    amount            DOUBLE PRECISION NOT NULL,
+    start_date        DATE             NOT NULL,
+    end_date          DATE,
+    note              TEXT
+);
+
+ALTER TABLE project_budget_phases
+    ADD CONSTRAINT project_budget_phases_project_budget_id_fkey FOREIGN KEY (project_budget_id) REFERENCES project_budgets (id);
+
+CREATE INDEX IF NOT EXISTS project_budget_phases_project_budget_id_idx ON project_budget_phases (project_budget_id);
+
+ALTER TABLE invoices ADD COLUMN "budget_phase_id" UUID;
+ALTER TABLE invoices
+    ADD CONSTRAINT invoices_budget_phase_id_fkey FOREIGN KEY (budget_phase_id) REFERENCES project_budget_phases (id);
+
+-- +migrate Down
+ALTER TABLE invoices DROP CONSTRAINT IF EXISTS invoices_budget_phase_id_fkey;
+ALTER TABLE invoices DROP COLUMN IF EXISTS "budget_phase_id";
+DROP TABLE IF EXISTS project_budget_phases;
+DROP TABLE IF EXISTS project_budgets;
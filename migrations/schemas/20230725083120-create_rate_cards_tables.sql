@@ -0,0 +1,81 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS "rate_cards" (
+    id           UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at   TIMESTAMP(6),
+    created_at   TIMESTAMP(6) DEFAULT (now()),
+    updated_at   TIMESTAMP(6) DEFAULT (now()),
+
+    client_id    UUID,
+    project_id   UUID,
+    name         TEXT         NOT NULL,
+    start_date   DATE         NOT NULL,
+    end_date     DATE,
+    note         TEXT,
+
+    CONSTRAINT rate_cards_owner_check CHECK (client_id IS NOT NULL OR project_id IS NOT NULL)
+);
+
+ALTER TABLE rate_cards
+    ADD CONSTRAINT rate_cards_client_id_fkey FOREIGN KEY (client_id) REFERENCES clients (id);
+ALTER TABLE rate_cards
+    ADD CONSTRAINT rate_cards_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id);
+
+CREATE INDEX IF NOT EXISTS rate_cards_client_id_idx ON rate_cards (client_id);
+CREATE INDEX IF NOT EXISTS rate_cards_project_id_idx ON rate_cards (project_id);
+
+CREATE TABLE IF NOT EXISTS "rate_card_rates" (
+    id           UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at   TIMESTAMP(6),
+    created_at   TIMESTAMP(6) DEFAULT (now()),
+    updated_at   TIMESTAMP(6) DEFAULT (now()),
+
+    rate_card_id UUID         NOT NULL,
+    seniority_id UUID         NOT NULL,
+    position_id  UUID,
+    rate         DECIMAL      NOT NULL,
+    discount     DECIMAL      NOT NULL DEFAULT 0
+);
+
+ALTER TABLE rate_card_rates
+    ADD CONSTRAINT rate_card_rates_rate_card_id_fkey FOREIGN KEY (rate_card_id) REFERENCES rate_cards (id);
+ALTER TABLE rate_card_rates
+    ADD CONSTRAINT rate_card_rates_seniority_id_fkey FOREIGN KEY (seniority_id) REFERENCES seniorities (id);
+ALTER TABLE rate_card_rates
+    ADD CONSTRAINT rate_card_rates_position_id_fkey FOREIGN KEY (position_id) REFERENCES positions (id);
+
+CREATE INDEX IF NOT EXISTS rate_card_rates_rate_card_id_idx ON rate_card_rates (rate_card_id);
+
+CREATE TABLE IF NOT EXISTS "project_member_rates" (
+    id                UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at        TIMESTAMP(6),
+    created_at        TIMESTAMP(6) DEFAULT (now()),
+    updated_at        TIMESTAMP(6) DEFAULT (now()),
+
+    project_member_id UUID         NOT NULL,
+    rate_card_id      UUID,
+    rate              DECIMAL      NOT NULL,
+    discount          DECIMAL      NOT NULL DEFAULT 0,
+    effective_from    DATE         NOT NULL,
+    created_by        UUID,
+    note              TEXT
+);
+
+ALTER TABLE project_member_rates
+    ADD CONSTRAINT project_member_rates_project_member_id_fkey FOREIGN KEY (project_member_id) REFERENCES project_members (id);
+ALTER TABLE project_member_rates
+    ADD CONSTRAINT project_member_rates_rate_card_id_fkey FOREIGN KEY (rate_card_id) REFERENCES rate_cards (id);
+ALTER TABLE project_member_rates
+    ADD CONSTRAINT project_member_rates_created_by_fkey FOREIGN KEY (created_by) REFERENCES employees (id);
+
+CREATE INDEX IF NOT EXISTS project_member_rates_project_member_id_idx ON project_member_rates (project_member_id);
+
+-- the current rates of the members start the history
+INSERT INTO project_member_rates (project_member_id, rate, discount, effective_from)
+SELECT id, COALESCE(rate, 0), COALESCE(discount, 0), COALESCE(start_date, created_at)::DATE
+FROM project_members
+WHERE deleted_at IS NULL;
+
+-- +migrate Down
+DROP TABLE IF EXISTS project_member_rates;
+DROP TABLE IF EXISTS rate_card_rates;
+DROP TABLE IF EXISTS rate_cards;
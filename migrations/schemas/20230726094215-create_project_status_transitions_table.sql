@@ -0,0 +1,23 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS "project_status_transitions" (
+    id          UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at  TIMESTAMP(6),
+    created_at  TIMESTAMP(6) DEFAULT (now()),
+    updated_at  TIMESTAMP(6) DEFAULT (now()),
+
+    project_id  UUID         NOT NULL,
+    from_status project_statuses,
+    to_status   project_statuses NOT NULL,
+    actor_id    UUID,
+    reason      TEXT
+);
+
+ALTER TABLE project_status_transitions
+    ADD CONSTRAINT project_status_transitions_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id);
+ALTER TABLE project_status_transitions
+    ADD CONSTRAINT project_status_transitions_actor_id_fkey FOREIGN KEY (actor_id) REFERENCES employees (id);
+
+CREATE INDEX IF NOT EXISTS project_status_transitions_project_id_idx ON project_status_transitions (project_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS project_status_transitions;
@@ -0,0 +1,47 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS "project_health_weights" (
+    id         UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at TIMESTAMP(6),
+    created_at TIMESTAMP(6)     DEFAULT (now()),
+    updated_at TIMESTAMP(6)     DEFAULT (now()),
+
+    signal     TEXT             NOT NULL,
+    weight     DOUBLE PRECISION NOT NULL DEFAULT 0
+);
+
+ALTER TABLE project_health_weights
+    ADD CONSTRAINT project_health_weights_signal_key UNIQUE (signal);
+
+INSERT INTO project_health_weights (signal, weight) VALUES
+    ('account-rating', 15),
+    ('delivery-rating', 15),
+    ('lead-rating', 10),
+    ('audit', 15),
+    ('engineering-health', 15),
+    ('action-items', 10),
+    ('workload', 10),
+    ('overdue-invoices', 10);
+
+CREATE TABLE IF NOT EXISTS "project_health_scores" (
+    id         UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at TIMESTAMP(6),
+    created_at TIMESTAMP(6)     DEFAULT (now()),
+    updated_at TIMESTAMP(6)     DEFAULT (now()),
+
+    project_id UUID             NOT NULL,
+    week       DATE             NOT NULL,
+    score      DOUBLE PRECISION NOT NULL,
+    status     TEXT             NOT NULL,
+    signals    JSON
+);
+
+ALTER TABLE project_health_scores
+    ADD CONSTRAINT project_health_scores_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id);
+ALTER TABLE project_health_scores
+    ADD CONSTRAINT project_health_scores_project_id_week_key UNIQUE (project_id, week);
+
+CREATE INDEX IF NOT EXISTS project_health_scores_week_idx ON project_health_scores (week);
+
+-- +migrate Down
+DROP TABLE IF EXISTS project_health_scores;
+DROP TABLE IF EXISTS project_health_weights;
@@ -0,0 +1,51 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS "client_portal_accesses" (
+    id                UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at        TIMESTAMP(6),
+    created_at        TIMESTAMP(6) DEFAULT (now()),
+    updated_at        TIMESTAMP(6) DEFAULT (now()),
+
+    client_id         UUID         NOT NULL,
+    client_contact_id UUID         NOT NULL,
+    email             TEXT         NOT NULL,
+    status            TEXT         NOT NULL DEFAULT 'active',
+    issued_by         UUID,
+    token_hash        TEXT,
+    token_expired_at  TIMESTAMP(6),
+    last_login_at     TIMESTAMP(6),
+    revoked_at        TIMESTAMP(6)
+);
+
+ALTER TABLE client_portal_accesses
+    ADD CONSTRAINT client_portal_accesses_client_id_fkey FOREIGN KEY (client_id) REFERENCES clients (id);
+ALTER TABLE client_portal_accesses
+    ADD CONSTRAINT client_portal_accesses_client_contact_id_fkey FOREIGN KEY (client_contact_id) REFERENCES client_contacts (id);
+ALTER TABLE client_portal_accesses
+    ADD CONSTRAINT client_portal_accesses_issued_by_fkey FOREIGN KEY (issued_by) REFERENCES employees (id);
+ALTER TABLE client_portal_accesses
+    ADD CONSTRAINT client_portal_accesses_client_contact_id_key UNIQUE (client_contact_id);
+
+CREATE INDEX IF NOT EXISTS client_portal_accesses_email_idx ON client_portal_accesses (email);
+CREATE INDEX IF NOT EXISTS client_portal_accesses_token_hash_idx ON client_portal_accesses (token_hash);
+
+CREATE TABLE IF NOT EXISTS "project_changelogs" (
+    id             UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at     TIMESTAMP(6),
+    created_at     TIMESTAMP(6) DEFAULT (now()),
+    updated_at     TIMESTAMP(6) DEFAULT (now()),
+
+    project_id     UUID         NOT NULL,
+    notion_page_id TEXT,
+    title          TEXT         NOT NULL,
+    content        TEXT,
+    sent_at        TIMESTAMP(6) NOT NULL
+);
+
+ALTER TABLE project_changelogs
+    ADD CONSTRAINT project_changelogs_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id);
+
+CREATE INDEX IF NOT EXISTS project_changelogs_project_id_idx ON project_changelogs (project_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS project_changelogs;
+DROP TABLE IF EXISTS client_portal_accesses;
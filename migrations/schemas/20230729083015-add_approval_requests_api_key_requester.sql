@@ -0,0 +1,15 @@
+-- +migrate Up
+ALTER TABLE approval_requests ADD COLUMN requester_api_key_id UUID;
+ALTER TABLE approval_requests ALTER COLUMN requester_id DROP NOT NULL;
+
+ALTER TABLE approval_requests
+    ADD CONSTRAINT approval_requests_requester_api_key_id_fkey FOREIGN KEY (requester_api_key_id) REFERENCES api_keys (id);
+ALTER TABLE approval_requests
+    ADD CONSTRAINT approval_requests_requester_check CHECK (requester_id IS NOT NULL OR requester_api_key_id IS NOT NULL);
+
+-- +migrate Down
+ALTER TABLE approval_requests DROP CONSTRAINT IF EXISTS approval_requests_requester_check;
+ALTER TABLE approval_requests DROP CONSTRAINT IF EXISTS approval_requests_requester_api_key_id_fkey;
+DELETE FROM approval_requests WHERE requester_id IS NULL;
+ALTER TABLE approval_requests ALTER COLUMN requester_id SET NOT NULL;
+ALTER TABLE approval_requests DROP COLUMN IF EXISTS requester_api_key_id;
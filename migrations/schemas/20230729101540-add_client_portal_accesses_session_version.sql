@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE client_portal_accesses ADD COLUMN session_version INT NOT NULL DEFAULT 0;
+
+-- +migrate Down
+ALTER TABLE client_portal_accesses DROP COLUMN IF EXISTS session_version;
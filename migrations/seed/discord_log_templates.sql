@@ -9,4 +9,11 @@ INSERT INTO public.discord_log_templates (id, deleted_at, created_at, updated_at
 ('a427c903-2932-408c-8f55-f7e0213a1432', null, '2023-05-09 06:26:16.634402', '2023-05-09 06:26:16.634402', 'project_member_update_charge_rate', '{{ employee_id }} update {{ updated_employee_id }} charge rate in {{ project_name }} to {{ rate }}.'),
 ('4a0c264a-0b90-48d7-b217-19d9a5d66bc7', null, '2023-05-09 06:26:16.634402', '2023-05-09 06:26:16.634402', 'payroll_commit', 'payroll batch.{{ batch_number }}-{{ month }}-{{ year }} is committed.'),
 ('b15fd037-ffac-407d-b878-10809236868f', null, '2023-05-09 06:26:16.634402', '2023-05-09 06:26:16.634402', 'employee_submit_onboarding_form', '{{ employee_id }} submit new employee onboarding form.  '),
-('cb2610ac-5c7d-45f7-8d71-50c815526a75', null, '2023-05-09 06:26:16.634402', '2023-05-09 06:26:16.634402', 'project_member_update_end_date', '{{ employee_id }} update {{ updated_employee_id }} end date in {{ project_name }} to {{ end_date }}.');
+('cb2610ac-5c7d-45f7-8d71-50c815526a75', null, '2023-05-09 06:26:16.634402', '2023-05-09 06:26:16.634402', 'project_member_update_end_date', '{{ employee_id }} update {{ updated_employee_id }} end date in {{ project_name }} to {{ end_date }}.'),
+('3f0b3f7e-5a0f-4c52-9d0a-1f6c2b8e7a01', null, '2023-07-07 08:10:44.000000', '2023-07-07 08:10:44.000000', 'approval_request_created', '{{ employee_id }} requested approval for {{ action }}: {{ summary }} (request {{ id }}, expires at {{ expired_at }}).'),
+('8b6d2c1a-2e4f-4a7b-8c3d-5e9f0a1b2c02', null, '2023-07-07 08:10:44.000000', '2023-07-07 08:10:44.000000', 'approval_request_approved', '{{ employee_id }} approved {{ action }} requested by {{ updated_employee_id }}: {{ summary }} (request {{ id }}).'),
+('c4e7a9b2-7d1f-4e3a-9b5c-6d8e0f1a2b03', null, '2023-07-07 08:10:44.000000', '2023-07-07 08:10:44.000000', 'approval_request_rejected', '{{ employee_id }} rejected {{ action }} requested by {{ updated_employee_id }}: {{ summary }} (request {{ id }}). Reason: {{ reason }}'),
+('e1a3c5d7-9f2b-4d6e-8a0c-2b4d6f8a0c04', null, '2023-07-07 08:10:44.000000', '2023-07-07 08:10:44.000000', 'approval_request_failed', '{{ action }} approved by {{ employee_id }} failed to execute: {{ summary }} (request {{ id }}). Error: {{ error }}'),
+('5d9e1f3a-4b6c-4e8d-9f0a-3c5e7a9b1d05', null, '2023-07-29 08:30:15.000000', '2023-07-29 08:30:15.000000', 'approval_request_created_by_api_key', 'API key {{ api_key_client_id }} requested approval for {{ action }}: {{ summary }} (request {{ id }}, expires at {{ expired_at }}).'),
+('7f1a3b5c-6d8e-4f0a-8b2c-4d6f8a0b2c06', null, '2023-07-29 08:30:15.000000', '2023-07-29 08:30:15.000000', 'approval_request_approved_by_api_key', '{{ employee_id }} approved {{ action }} requested by API key {{ api_key_client_id }}: {{ summary }} (request {{ id }}).'),
+('9a2c4e6f-8b0d-4a1c-9d3e-5f7a9c1e3d07', null, '2023-07-29 08:30:15.000000', '2023-07-29 08:30:15.000000', 'approval_request_rejected_by_api_key', '{{ employee_id }} rejected {{ action }} requested by API key {{ api_key_client_id }}: {{ summary }} (request {{ id }}). Reason: {{ reason }}');
@@ -91,4 +91,25 @@ INSERT INTO public.permissions (id, deleted_at, created_at, updated_at, name, co
 ('38a36881-7fb0-4003-9a9f-cbcc7eaa3deb', null, '2023-06-04 11:18:47.869309', '2023-06-04 11:18:47.869309', 'Engagement Metrics Read', 'engagementMetrics.read'),
 ('70109d50-6ec3-4475-9e45-757514781daf', null, '2023-06-04 11:19:08.204248', '2023-06-04 11:19:08.204248', 'Engagement Metrics Write', 'engagementMetrics.write'),
 ('8dd29797-e5fb-45ce-b9ef-8fbcfc3961bd', null, '2023-06-20 16:35:12.475872', '2023-06-20 16:35:12.475872', 'Brainery Logs Read', 'braineryLogs.read'),
-('fa85ee6a-c335-4edb-8100-6aa840a0e520', null, '2023-06-20 16:35:12.475872', '2023-06-20 16:35:12.475872', 'Brainery Logs Write', 'braineryLogs.write');
+('fa85ee6a-c335-4edb-8100-6aa840a0e520', null, '2023-06-20 16:35:12.475872', '2023-06-20 16:35:12.475872', 'Brainery Logs Write', 'braineryLogs.write'),
+('9b6c182a-9b67-4b18-8bee-f542f55acb97', null, '2023-07-05 09:12:31.120938', '2023-07-05 09:12:31.120938', 'Audit Logs Read', 'auditLogs.read'),
+('51536089-dad1-4eb6-b60c-1962c9706369', null, '2023-07-07 08:10:44.512783', '2023-07-07 08:10:44.512783', 'Approval Requests Read', 'approvalRequests.read'),
+('47e65026-1105-4e51-8a4e-d3b4334b59fd', null, '2023-07-15 09:24:51.306218', '2023-07-15 09:24:51.306218', 'Dashboards Surveys Read', 'dashboards.surveys.read'),
+('7c31ae1e-eb85-4d9e-83a2-78e86b229f8a', null, '2023-07-17 08:41:12.540127', '2023-07-17 08:41:12.540127', 'Performance Reviews Read', 'performanceReviews.read'),
+('9fa3add9-8dd8-4f0e-814d-de50410be804', null, '2023-07-17 08:41:12.540127', '2023-07-17 08:41:12.540127', 'Performance Reviews Create', 'performanceReviews.create'),
+('4f8139b8-8c0c-4be2-acf2-7c1172250b83', null, '2023-07-17 08:41:12.540127', '2023-07-17 08:41:12.540127', 'Performance Reviews Edit', 'performanceReviews.edit'),
+('a64d0caa-8424-427c-8c41-ecf754162a26', null, '2023-07-18 09:30:27.418263', '2023-07-18 09:30:27.418263', 'Objectives Create', 'objectives.create'),
+('85720650-1895-4dbb-a9d3-d4f007a7d016', null, '2023-07-18 09:30:27.418263', '2023-07-18 09:30:27.418263', 'Objectives Edit', 'objectives.edit'),
+('d3b16ef1-7117-4a1a-a119-bfafded551a3', null, '2023-07-20 08:23:17.275361', '2023-07-20 08:23:17.275361', 'Timesheets Read', 'timesheets.read'),
+('7f6103bf-7ed1-4be9-9166-96cded10802f', null, '2023-07-20 08:23:17.275361', '2023-07-20 08:23:17.275361', 'Timesheets Edit', 'timesheets.edit'),
+('0630ffe4-7125-40a9-a911-87387456e5e1', null, '2023-07-20 08:23:17.275361', '2023-07-20 08:23:17.275361', 'Timesheets Approve', 'timesheets.approve'),
+('2fa3a2cb-5d75-4713-a452-43f9f225765c', null, '2023-07-22 08:30:15.275361', '2023-07-22 08:30:15.275361', 'Staffing Requests Read', 'staffingRequests.read'),
+('fa9b4f9b-0d7c-4a76-afa4-a963dc497812', null, '2023-07-22 08:30:15.275361', '2023-07-22 08:30:15.275361', 'Staffing Requests Edit', 'staffingRequests.edit'),
+('508b10c7-cb17-4921-bae2-59499547faa5', null, '2023-07-23 07:45:30.318462', '2023-07-23 07:45:30.318462', 'Projects Profit Loss Read', 'projects.profitLoss.read'),
+('666014d2-c342-457c-a42b-083ee3a25d55', null, '2023-07-23 07:45:30.318462', '2023-07-23 07:45:30.318462', 'Dashboards Profit Loss Read', 'dashboards.profitLoss.read'),
+('d286d27f-25e4-44a3-ac3b-0a46cc57be78', null, '2023-07-24 09:12:05.519274', '2023-07-24 09:12:05.519274', 'Projects Budgets Read', 'projects.budgets.read'),
+('6fd501ce-02c1-412a-b6b5-3cb2e8a541f6', null, '2023-07-24 09:12:05.519274', '2023-07-24 09:12:05.519274', 'Projects Budgets Edit', 'projects.budgets.edit'),
+('2b388b27-a34c-4412-a8e7-3b975d36ed3f', null, '2023-07-25 08:31:20.406152', '2023-07-25 08:31:20.406152', 'Rate Cards Read', 'rateCards.read'),
+('1b443bfc-c1d6-4cfe-a7aa-a4f6b3ee3f83', null, '2023-07-25 08:31:20.406152', '2023-07-25 08:31:20.406152', 'Rate Cards Edit', 'rateCards.edit'),
+('fa4cff13-88fc-4dba-9377-2c20228014bf', null, '2023-07-27 08:15:30.218417', '2023-07-27 08:15:30.218417', 'Project Health Read', 'projectHealth.read'),
+('1ce37636-d1f1-4dce-b480-1dd383bd9e7d', null, '2023-07-27 08:15:30.218417', '2023-07-27 08:15:30.218417', 'Project Health Edit', 'projectHealth.edit');
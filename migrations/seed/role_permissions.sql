@@ -266,4 +266,25 @@ INSERT INTO public.role_permissions (id, deleted_at, created_at, updated_at, rol
 ('2ea832a2-7eaa-4db6-9b11-1d1027b5713d', null, '2023-06-04 11:15:07.549995', '2023-06-04 11:15:07.549995', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '38a36881-7fb0-4003-9a9f-cbcc7eaa3deb'), -- engagementMetrics.read
 ('2bf409ad-46e3-47b9-b746-571735b69c92', null, '2023-06-04 11:15:12.249251', '2023-06-04 11:15:12.249251', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '70109d50-6ec3-4475-9e45-757514781daf'), -- engagementMetrics.write
 ('b03375c3-328b-4af7-98f8-bf901a106b0b', NULL, '2023-06-20 16:35:12.475872', '2023-06-20 16:35:12.475872', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '8dd29797-e5fb-45ce-b9ef-8fbcfc3961bd'), -- braineryLogs.read
-('10b6dedf-b939-4a5a-9f02-b1b0db917058', NULL, '2023-06-20 16:35:12.475872', '2023-06-20 16:35:12.475872', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', 'fa85ee6a-c335-4edb-8100-6aa840a0e520'); -- braineryLogs.write
+('10b6dedf-b939-4a5a-9f02-b1b0db917058', NULL, '2023-06-20 16:35:12.475872', '2023-06-20 16:35:12.475872', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', 'fa85ee6a-c335-4edb-8100-6aa840a0e520'), -- braineryLogs.write
+('32a524e1-f373-4a02-bc68-fda00662e8d0', NULL, '2023-07-05 09:12:31.120938', '2023-07-05 09:12:31.120938', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '9b6c182a-9b67-4b18-8bee-f542f55acb97'), -- auditLogs.read
+('80d72515-7b45-4203-96e1-cb43f0641600', NULL, '2023-07-07 08:10:44.512783', '2023-07-07 08:10:44.512783', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '51536089-dad1-4eb6-b60c-1962c9706369'), -- approvalRequests.read
+('f200f042-2b3e-4c0b-bf6a-4177bf746cb9', NULL, '2023-07-15 09:24:51.306218', '2023-07-15 09:24:51.306218', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '47e65026-1105-4e51-8a4e-d3b4334b59fd'), -- dashboards.surveys.read
+('38bde154-dbd8-4f30-addf-e44f4df0f0f7', NULL, '2023-07-17 08:41:12.540127', '2023-07-17 08:41:12.540127', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '7c31ae1e-eb85-4d9e-83a2-78e86b229f8a'), -- performanceReviews.read
+('c8c8e2bf-8c49-4ea6-8098-1a690fa64449', NULL, '2023-07-17 08:41:12.540127', '2023-07-17 08:41:12.540127', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '9fa3add9-8dd8-4f0e-814d-de50410be804'), -- performanceReviews.create
+('415d2ea0-9c6d-4772-921f-c7646c45ad66', NULL, '2023-07-17 08:41:12.540127', '2023-07-17 08:41:12.540127', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '4f8139b8-8c0c-4be2-acf2-7c1172250b83'), -- performanceReviews.edit
+('a61f49d5-bebf-42c5-93f6-8d6919850b40', NULL, '2023-07-18 09:30:27.418263', '2023-07-18 09:30:27.418263', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', 'a64d0caa-8424-427c-8c41-ecf754162a26'), -- objectives.create
+('f968a67a-48c8-4b25-90ea-9706b702fefa', NULL, '2023-07-18 09:30:27.418263', '2023-07-18 09:30:27.418263', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '85720650-1895-4dbb-a9d3-d4f007a7d016'), -- objectives.edit
+('2e815e5e-138f-46a1-bb81-6dec760faa2b', NULL, '2023-07-20 08:23:17.275361', '2023-07-20 08:23:17.275361', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', 'd3b16ef1-7117-4a1a-a119-bfafded551a3'), -- timesheets.read
+('dc374a61-dc17-49a0-bc2e-72167bdbdcf3', NULL, '2023-07-20 08:23:17.275361', '2023-07-20 08:23:17.275361', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '7f6103bf-7ed1-4be9-9166-96cded10802f'), -- timesheets.edit
+('b50b97e9-3269-4bd4-a004-1a520300102c', NULL, '2023-07-20 08:23:17.275361', '2023-07-20 08:23:17.275361', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '0630ffe4-7125-40a9-a911-87387456e5e1'), -- timesheets.approve
+('f14e2537-6727-4743-a66d-bf9a9b8c0e48', NULL, '2023-07-22 08:30:15.275361', '2023-07-22 08:30:15.275361', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '2fa3a2cb-5d75-4713-a452-43f9f225765c'), -- staffingRequests.read
+('8e42086f-f7d6-44ba-9c04-18877c1489b4', NULL, '2023-07-22 08:30:15.275361', '2023-07-22 08:30:15.275361', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', 'fa9b4f9b-0d7c-4a76-afa4-a963dc497812'), -- staffingRequests.edit
+('3646c917-f38b-435f-b3b9-71c48a7c0698', NULL, '2023-07-23 07:45:30.318462', '2023-07-23 07:45:30.318462', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '508b10c7-cb17-4921-bae2-59499547faa5'), -- projects.profitLoss.read
+('9d9cea36-0ee9-4d95-a66a-5a2bb6b0c6a1', NULL, '2023-07-23 07:45:30.318462', '2023-07-23 07:45:30.318462', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '666014d2-c342-457c-a42b-083ee3a25d55'), -- dashboards.profitLoss.read
+('7a697a72-0b9d-447c-ab9a-9371e0a37304', NULL, '2023-07-24 09:12:05.519274', '2023-07-24 09:12:05.519274', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', 'd286d27f-25e4-44a3-ac3b-0a46cc57be78'), -- projects.budgets.read
+('32f83580-3e2b-4496-af16-dd53f02bcb67', NULL, '2023-07-24 09:12:05.519274', '2023-07-24 09:12:05.519274', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '6fd501ce-02c1-412a-b6b5-3cb2e8a541f6'), -- projects.budgets.edit
+('69aac0ac-65a7-4c5f-8db2-e9045b593b2e', NULL, '2023-07-25 08:31:20.406152', '2023-07-25 08:31:20.406152', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '2b388b27-a34c-4412-a8e7-3b975d36ed3f'), -- rateCards.read
+('5c8c5ee1-bd79-4a37-97b2-1cc57f9048b9', NULL, '2023-07-25 08:31:20.406152', '2023-07-25 08:31:20.406152', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '1b443bfc-c1d6-4cfe-a7aa-a4f6b3ee3f83'), -- rateCards.edit
+('2a1ee631-728f-4e9f-89e2-756c54e2764b', NULL, '2023-07-27 08:15:30.218417', '2023-07-27 08:15:30.218417', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', 'fa4cff13-88fc-4dba-9377-2c20228014bf'), -- projectHealth.read
+('4cf3fdf4-99a5-4e52-a0a6-aa6d9bedb3f5', NULL, '2023-07-27 08:15:30.218417', '2023-07-27 08:15:30.218417', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '1ce37636-d1f1-4dce-b480-1dd383bd9e7d'); -- projectHealth.edit
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"strconv"
+
 	"github.com/spf13/viper"
 )
 
@@ -17,8 +19,14 @@ type Config struct {
 	ApiServer ApiServer
 
 	// service
+	Auth          Auth
 	Google        Google
 	Vault         Vault
+	Encryption    Encryption
+	RateLimit     RateLimit
+	Webhook       Webhook
+	Survey        Survey
+	ClientPortal  ClientPortal
 	Notion        Notion
 	Wise          Wise
 	Discord       Discord
@@ -51,6 +59,64 @@ type DBConnection struct {
 type ApiServer struct {
 	Port           string
 	AllowedOrigins string
+	// TrustedProxies are the proxies allowed to set the client ip with X-Forwarded-For, none by default
+	TrustedProxies string
+}
+
+type Auth struct {
+	// GoogleLegacyUserInfo makes the Google provider read the email from the legacy Google+ API, true on prod by default
+	GoogleLegacyUserInfo bool
+	// StubEnabled allows logging in as any employee by email, it only works on local
+	StubEnabled bool
+	OIDC        OIDC
+}
+
+// Encryption holds the keys for field-level encryption of employee PII,
+// Keys is a comma separated list of `id:base64key` and KeyFile is only used for local development
+type Encryption struct {
+	KeyFile       string
+	Keys          string
+	CurrentKeyID  string
+	BlindIndexKey string
+}
+
+// RateLimit rules are in the format `<limit>/<period>`, e.g. 60/1m, `off` disables a group.
+// Backend is memory or postgres, postgres shares the limits between instances
+type RateLimit struct {
+	Backend string
+	Public  string
+	Auth    string
+	Webhook string
+}
+
+// Webhook holds the shared secrets used to verify incoming webhooks. Webhooks of a sender without a secret
+// are rejected, unless the sender is in report only mode while its secret is being rolled out
+type Webhook struct {
+	N8nSecret          string
+	BasecampSecret     string
+	N8nReportOnly      bool
+	BasecampReportOnly bool
+}
+
+// Survey configures anonymous surveys, AnonymityThreshold is the default minimum group size
+// and PseudonymSecret derives the pseudonyms of pseudonymous surveys
+type Survey struct {
+	AnonymityThreshold int
+	PseudonymSecret    string
+}
+
+// ClientPortal configures the portal of the client contacts, its tokens are signed with JWTSecretKey
+// which must differ from the employee one so a token is only valid in its own realm
+type ClientPortal struct {
+	URL          string
+	JWTSecretKey string
+}
+
+type OIDC struct {
+	Name         string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
 }
 
 type Google struct {
@@ -125,8 +191,9 @@ type Discord struct {
 }
 
 type DiscordWebhook struct {
-	Campfire string
-	AuditLog string
+	Campfire      string
+	AuditLog      string
+	ProjectHealth string
 }
 
 type DiscordID struct {
@@ -155,6 +222,21 @@ type ENV interface {
 	GetString(string) string
 }
 
+// getGoogleLegacyUserInfo keeps prod on the legacy Google user info endpoint
+// unless AUTH_GOOGLE_LEGACY_USER_INFO is set
+func getGoogleLegacyUserInfo(v ENV) bool {
+	if v.GetString("AUTH_GOOGLE_LEGACY_USER_INFO") == "" {
+		return v.GetString("ENV") == "prod"
+	}
+	return v.GetBool("AUTH_GOOGLE_LEGACY_USER_INFO")
+}
+
+// getInt returns zero when the value is empty or not a number
+func getInt(v ENV, key string) int {
+	i, _ := strconv.Atoi(v.GetString(key))
+	return i
+}
+
 func Generate(v ENV) *Config {
 	return &Config{
 		Debug:        v.GetBool("DEBUG"),
@@ -166,6 +248,7 @@ func Generate(v ENV) *Config {
 		ApiServer: ApiServer{
 			Port:           v.GetString("PORT"),
 			AllowedOrigins: v.GetString("ALLOWED_ORIGINS"),
+			TrustedProxies: v.GetString("TRUSTED_PROXIES"),
 		},
 
 		Postgres: DBConnection{
@@ -179,6 +262,16 @@ func Generate(v ENV) *Config {
 		Github: Github{
 			Token: v.GetString("GITHUB_ACCESS_TOKEN"),
 		},
+		Auth: Auth{
+			GoogleLegacyUserInfo: getGoogleLegacyUserInfo(v),
+			StubEnabled:          v.GetBool("AUTH_STUB_ENABLED"),
+			OIDC: OIDC{
+				Name:         v.GetString("AUTH_OIDC_NAME"),
+				Issuer:       v.GetString("AUTH_OIDC_ISSUER"),
+				ClientID:     v.GetString("AUTH_OIDC_CLIENT_ID"),
+				ClientSecret: v.GetString("AUTH_OIDC_CLIENT_SECRET"),
+			},
+		},
 		Google: Google{
 			AccountingEmailID:            v.GetString("ACCOUNTING_EMAIL_ID"),
 			AccountingGoogleRefreshToken: v.GetString("ACCOUNTING_GOOGLE_REFRESH_TOKEN"),
@@ -207,6 +300,32 @@ func Generate(v ENV) *Config {
 			Token:   v.GetString("VAULT_TOKEN"),
 			Path:    v.GetString("VAULT_PATH"),
 		},
+		RateLimit: RateLimit{
+			Backend: v.GetString("RATE_LIMIT_BACKEND"),
+			Public:  v.GetString("RATE_LIMIT_PUBLIC"),
+			Auth:    v.GetString("RATE_LIMIT_AUTH"),
+			Webhook: v.GetString("RATE_LIMIT_WEBHOOK"),
+		},
+		Webhook: Webhook{
+			N8nSecret:          v.GetString("WEBHOOK_N8N_SECRET"),
+			BasecampSecret:     v.GetString("WEBHOOK_BASECAMP_SECRET"),
+			N8nReportOnly:      v.GetBool("WEBHOOK_N8N_REPORT_ONLY"),
+			BasecampReportOnly: v.GetBool("WEBHOOK_BASECAMP_REPORT_ONLY"),
+		},
+		Survey: Survey{
+			AnonymityThreshold: getInt(v, "SURVEY_ANONYMITY_THRESHOLD"),
+			PseudonymSecret:    v.GetString("SURVEY_PSEUDONYM_SECRET"),
+		},
+		ClientPortal: ClientPortal{
+			URL:          v.GetString("CLIENT_PORTAL_URL"),
+			JWTSecretKey: v.GetString("CLIENT_PORTAL_JWT_SECRET_KEY"),
+		},
+		Encryption: Encryption{
+			KeyFile:       v.GetString("ENCRYPTION_KEY_FILE"),
+			Keys:          v.GetString("ENCRYPTION_KEYS"),
+			CurrentKeyID:  v.GetString("ENCRYPTION_CURRENT_KEY_ID"),
+			BlindIndexKey: v.GetString("ENCRYPTION_BLIND_INDEX_KEY"),
+		},
 		Notion: Notion{
 			Secret: v.GetString("NOTION_SECRET"),
 			Databases: NotionDatabase{
@@ -228,8 +347,9 @@ func Generate(v ENV) *Config {
 		},
 		Discord: Discord{
 			Webhooks: DiscordWebhook{
-				Campfire: v.GetString("DISCORD_WEBHOOK_CAMPFIRE"),
-				AuditLog: v.GetString("DISCORD_WEBHOOK_AUDIT"),
+				Campfire:      v.GetString("DISCORD_WEBHOOK_CAMPFIRE"),
+				AuditLog:      v.GetString("DISCORD_WEBHOOK_AUDIT"),
+				ProjectHealth: v.GetString("DISCORD_WEBHOOK_PROJECT_HEALTH"),
 			},
 			SecretToken: v.GetString("DISCORD_SECRET_TOKEN"),
 			IDs: DiscordID{
@@ -0,0 +1,33 @@
+package config
+
+import "testing"
+
+type mapENV map[string]string
+
+func (m mapENV) GetBool(key string) bool {
+	return m[key] == "true"
+}
+
+func (m mapENV) GetString(key string) string {
+	return m[key]
+}
+
+func TestGetGoogleLegacyUserInfo(t *testing.T) {
+	tests := []struct {
+		name string
+		env  mapENV
+		want bool
+	}{
+		{name: "prod by default", env: mapENV{"ENV": "prod"}, want: true},
+		{name: "not prod by default", env: mapENV{"ENV": "dev"}, want: false},
+		{name: "disabled on prod", env: mapENV{"ENV": "prod", "AUTH_GOOGLE_LEGACY_USER_INFO": "false"}, want: false},
+		{name: "enabled on dev", env: mapENV{"ENV": "dev", "AUTH_GOOGLE_LEGACY_USER_INFO": "true"}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getGoogleLegacyUserInfo(tt.env); got != tt.want {
+				t.Errorf("getGoogleLegacyUserInfo() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
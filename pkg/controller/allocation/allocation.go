@@ -0,0 +1,113 @@
+package allocation
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type CreateInput struct {
+	ProjectID  string
+	MemberID   string
+	Percentage float64
+	StartDate  time.Time
+	EndDate    *time.Time
+	Note       string
+}
+
+// List returns the allocations of a project member ordered by start date
+func (r *controller) List(projectID string, memberID string) ([]*model.ProjectMemberAllocation, error) {
+	if _, err := r.getMember(projectID, memberID); err != nil {
+		return nil, err
+	}
+
+	return r.store.ProjectMemberAllocation.GetByProjectMemberID(r.repo.DB(), memberID)
+}
+
+// Create allocates a share of the time of a project member in a date range within the membership
+func (r *controller) Create(input CreateInput) (*model.ProjectMemberAllocation, error) {
+	if input.Percentage <= 0 || input.Percentage > model.MaxAllocationPercentage {
+		return nil, ErrInvalidPercentage
+	}
+	if input.EndDate != nil && input.EndDate.Before(input.StartDate) {
+		return nil, ErrInvalidPeriod
+	}
+
+	member, err := r.getMember(input.ProjectID, input.MemberID)
+	if err != nil {
+		return nil, err
+	}
+	if member.EmployeeID.IsZero() {
+		return nil, ErrMemberNotAssigned
+	}
+
+	allocation := &model.ProjectMemberAllocation{
+		ProjectMemberID: member.ID,
+		Percentage:      input.Percentage,
+		StartDate:       input.StartDate,
+		EndDate:         input.EndDate,
+		Note:            input.Note,
+	}
+
+	if member.StartDate != nil && allocation.StartDate.Before(*member.StartDate) {
+		return nil, ErrAllocationOutsideMembership
+	}
+	if member.EndDate != nil && (allocation.EndDate == nil || allocation.EndDate.After(*member.EndDate)) {
+		return nil, ErrAllocationOutsideMembership
+	}
+
+	db := r.repo.DB()
+
+	allocations, err := r.store.ProjectMemberAllocation.GetByProjectMemberID(db, input.MemberID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, a := range allocations {
+		if a.OverlapsAllocation(allocation) {
+			return nil, ErrAllocationOverlapped
+		}
+	}
+
+	return r.store.ProjectMemberAllocation.Create(db, allocation)
+}
+
+// Delete removes an allocation of a project member
+func (r *controller) Delete(projectID string, memberID string, id string) error {
+	if _, err := r.getMember(projectID, memberID); err != nil {
+		return err
+	}
+
+	db := r.repo.DB()
+
+	allocation, err := r.store.ProjectMemberAllocation.One(db, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrAllocationNotFound
+		}
+		return err
+	}
+	if allocation.ProjectMemberID.String() != memberID {
+		return ErrAllocationNotFound
+	}
+
+	return r.store.ProjectMemberAllocation.Delete(db, id)
+}
+
+func (r *controller) getMember(projectID string, memberID string) (*model.ProjectMember, error) {
+	member, err := r.store.ProjectMember.OneByID(r.repo.DB(), memberID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrProjectMemberNotFound
+		}
+		return nil, err
+	}
+	if member.ProjectID.String() != projectID {
+		return nil, ErrProjectMemberNotFound
+	}
+
+	return member, nil
+}
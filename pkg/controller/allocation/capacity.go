@@ -0,0 +1,128 @@
+package allocation
+
+import (
+	"sort"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/employee"
+)
+
+// DefaultCapacityPlanWeeks is the number of weeks planned when none is given
+const DefaultCapacityPlanWeeks = 8
+
+type CapacityPlanInput struct {
+	model.Pagination
+
+	Weeks int
+	Now   time.Time
+}
+
+type CapacityPlan struct {
+	Weeks     []time.Time
+	Employees []*model.EmployeeCapacity
+	// FreeingUp are the memberships ending in the planned weeks, soonest first
+	FreeingUp []*model.FreeingUpMember
+	Total     int64
+}
+
+// GetCapacityPlan returns the weekly allocation of the employees from the current week
+// and the memberships ending in the planned weeks
+func (r *controller) GetCapacityPlan(input CapacityPlanInput) (*CapacityPlan, error) {
+	if input.Weeks <= 0 {
+		input.Weeks = DefaultCapacityPlanWeeks
+	}
+
+	db := r.repo.DB()
+
+	employees, total, err := r.store.Employee.All(db, employee.EmployeeFilter{
+		WorkingStatuses: []string{
+			model.WorkingStatusOnBoarding.String(),
+			model.WorkingStatusProbation.String(),
+			model.WorkingStatusFullTime.String(),
+		},
+		Organizations: []string{model.OrganizationCodeDwarves},
+	}, input.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	start := model.TimesheetWeekStart(input.Now)
+	end := start.AddDate(0, 0, 7*input.Weeks-1)
+
+	plan := &CapacityPlan{
+		Weeks:     make([]time.Time, 0, input.Weeks),
+		Employees: make([]*model.EmployeeCapacity, 0, len(employees)),
+		FreeingUp: make([]*model.FreeingUpMember, 0),
+		Total:     total,
+	}
+	for i := 0; i < input.Weeks; i++ {
+		plan.Weeks = append(plan.Weeks, start.AddDate(0, 0, 7*i))
+	}
+
+	if len(employees) == 0 {
+		return plan, nil
+	}
+
+	ids := make([]string, 0, len(employees))
+	for _, e := range employees {
+		ids = append(ids, e.ID.String())
+	}
+
+	// load a week more to know what is left after a membership ending in the last planned week
+	members, err := r.store.ProjectMember.GetAllocatedByEmployeeIDs(db, ids, start, end.AddDate(0, 0, 7))
+	if err != nil {
+		return nil, err
+	}
+
+	membersByEmployee := map[model.UUID][]*model.ProjectMember{}
+	for _, m := range members {
+		membersByEmployee[m.EmployeeID] = append(membersByEmployee[m.EmployeeID], m)
+	}
+
+	for _, e := range employees {
+		c := &model.EmployeeCapacity{
+			Employee: e,
+			Members:  membersByEmployee[e.ID],
+			Weeks:    make([]model.CapacityWeek, 0, input.Weeks),
+		}
+
+		for _, weekStart := range plan.Weeks {
+			c.Weeks = append(c.Weeks, model.CapacityWeek{
+				WeekStart:  weekStart,
+				Allocation: allocationInPeriod(c.Members, weekStart, weekStart.AddDate(0, 0, 6)),
+			})
+		}
+
+		for _, m := range c.Members {
+			if m.EndDate == nil || m.EndDate.Before(start) || m.EndDate.After(end) {
+				continue
+			}
+
+			lastWeek := model.TimesheetWeekStart(*m.EndDate)
+			weekAfter := lastWeek.AddDate(0, 0, 7)
+			plan.FreeingUp = append(plan.FreeingUp, &model.FreeingUpMember{
+				Member:     m,
+				Employee:   e,
+				Allocation: m.AllocationInPeriod(lastWeek, *m.EndDate),
+				Remaining:  allocationInPeriod(c.Members, weekAfter, weekAfter.AddDate(0, 0, 6)),
+			})
+		}
+
+		plan.Employees = append(plan.Employees, c)
+	}
+
+	sort.SliceStable(plan.FreeingUp, func(i, j int) bool {
+		return plan.FreeingUp[i].Member.EndDate.Before(*plan.FreeingUp[j].Member.EndDate)
+	})
+
+	return plan, nil
+}
+
+func allocationInPeriod(members []*model.ProjectMember, from, to time.Time) float64 {
+	var rs float64
+	for _, m := range members {
+		rs += m.AllocationInPeriod(from, to)
+	}
+	return rs
+}
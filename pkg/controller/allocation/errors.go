@@ -0,0 +1,13 @@
+package allocation
+
+import "errors"
+
+var (
+	ErrProjectMemberNotFound       = errors.New("project member not found")
+	ErrAllocationNotFound          = errors.New("allocation not found")
+	ErrMemberNotAssigned           = errors.New("project member has no employee assigned")
+	ErrInvalidPercentage           = errors.New("allocation percentage must be more than 0 and at most 100")
+	ErrInvalidPeriod               = errors.New("allocation end date must be after its start date")
+	ErrAllocationOutsideMembership = errors.New("allocation must be within the start and end dates of the project member")
+	ErrAllocationOverlapped        = errors.New("allocation overlaps another allocation of the project member")
+)
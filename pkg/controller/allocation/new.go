@@ -0,0 +1,35 @@
+package allocation
+
+import (
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type controller struct {
+	store   *store.Store
+	service *service.Service
+	logger  logger.Logger
+	repo    store.DBRepo
+	config  *config.Config
+}
+
+func New(store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:   store,
+		repo:    repo,
+		service: service,
+		logger:  logger,
+		config:  cfg,
+	}
+}
+
+type IController interface {
+	List(projectID string, memberID string) (allocations []*model.ProjectMemberAllocation, err error)
+	Create(input CreateInput) (allocation *model.ProjectMemberAllocation, err error)
+	Delete(projectID string, memberID string, id string) error
+
+	GetCapacityPlan(input CapacityPlanInput) (plan *CapacityPlan, err error)
+}
@@ -0,0 +1,106 @@
+package approval
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+)
+
+// defaultExpiry is how long a request waits for the second person
+const defaultExpiry = 72 * time.Hour
+
+// Requester is the employee or the api key asking for an approval
+type Requester struct {
+	EmployeeID     string
+	APIKeyClientID string
+}
+
+// GetRequester returns the caller of the request, api keys go through approval the same as employees
+func GetRequester(c *gin.Context, cfg *config.Config) (Requester, error) {
+	if authutils.IsAPIKey(c) {
+		token, err := authutils.GetTokenFromRequest(c)
+		if err != nil {
+			return Requester{}, ErrRequesterRequired
+		}
+
+		clientID, _, err := authutils.ExtractAPIKey(token)
+		if err != nil {
+			return Requester{}, ErrRequesterRequired
+		}
+
+		return Requester{APIKeyClientID: clientID}, nil
+	}
+
+	userID, err := authutils.GetUserIDFromContext(c, cfg)
+	if err != nil || userID == "" {
+		return Requester{}, ErrRequesterRequired
+	}
+
+	return Requester{EmployeeID: userID}, nil
+}
+
+type CreateInput struct {
+	Action     model.ApprovalAction
+	Permission string
+	Summary    string
+	Payload    interface{}
+	Requester  Requester
+}
+
+func (r *controller) Create(input CreateInput) (*model.ApprovalRequest, error) {
+	if !input.Action.IsValid() {
+		return nil, ErrInvalidAction
+	}
+
+	payload, err := json.Marshal(input.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &model.ApprovalRequest{
+		Action:     input.Action,
+		Status:     model.ApprovalRequestStatusPending,
+		Permission: input.Permission,
+		Summary:    input.Summary,
+		Payload:    payload,
+		ExpiredAt:  time.Now().Add(defaultExpiry),
+	}
+
+	if input.Requester.APIKeyClientID != "" {
+		apiKey, err := r.store.APIKey.GetByClientID(r.repo.DB(), input.Requester.APIKeyClientID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRequesterRequired
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		request.RequesterAPIKeyID = &apiKey.ID
+		request.RequesterAPIKey = apiKey
+	} else {
+		requesterID, err := model.UUIDFromString(input.Requester.EmployeeID)
+		if err != nil {
+			return nil, ErrRequesterRequired
+		}
+
+		request.RequesterID = requesterID
+	}
+
+	request, err = r.store.ApprovalRequest.Create(r.repo.DB(), request)
+	if err != nil {
+		return nil, err
+	}
+
+	r.notify("approval_request_created", request, withRequester(request, "employee_id", map[string]interface{}{
+		"expired_at": request.ExpiredAt.Format("2006-01-02 15:04"),
+	}))
+
+	return request, nil
+}
@@ -0,0 +1,108 @@
+package approval
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/store/apikey"
+)
+
+type fakeAPIKeyStore struct {
+	apikey.IStore
+	keys map[string]*model.APIKey
+}
+
+func (s *fakeAPIKeyStore) GetByClientID(db *gorm.DB, id string) (*model.APIKey, error) {
+	key, ok := s.keys[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return key, nil
+}
+
+type fakeCreateApprovalRequestStore struct {
+	fakeApprovalRequestStore
+}
+
+func (s *fakeCreateApprovalRequestStore) Create(db *gorm.DB, request *model.ApprovalRequest) (*model.ApprovalRequest, error) {
+	request.ID = model.NewUUID()
+	s.request = request
+	return request, nil
+}
+
+func TestController_Create(t *testing.T) {
+	var (
+		employeeID = "2655832e-f009-4b73-a535-64c3a22e558f"
+		apiKeyID   = model.MustGetUUIDFromString("7a3c2e1f-5b4d-4c6e-8f0a-1b2c3d4e5f60")
+	)
+
+	tcs := map[string]struct {
+		requester       Requester
+		expectedErr     error
+		expectedLogType string
+		expectedLogData map[string]interface{}
+	}{
+		"requested by an employee": {
+			requester:       Requester{EmployeeID: employeeID},
+			expectedLogType: "approval_request_created",
+			expectedLogData: map[string]interface{}{"employee_id": employeeID},
+		},
+		"requested by an api key": {
+			requester:       Requester{APIKeyClientID: "scheduler"},
+			expectedLogType: "approval_request_created_by_api_key",
+			expectedLogData: map[string]interface{}{"api_key_client_id": "scheduler"},
+		},
+		"unknown api key": {
+			requester:   Requester{APIKeyClientID: "made-up"},
+			expectedErr: ErrRequesterRequired,
+		},
+		"no requester": {
+			expectedErr: ErrRequesterRequired,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			requestStore := &fakeCreateApprovalRequestStore{}
+			s := &store.Store{
+				ApprovalRequest: requestStore,
+				APIKey: &fakeAPIKeyStore{keys: map[string]*model.APIKey{
+					"scheduler": {BaseModel: model.BaseModel{ID: apiKeyID}, ClientID: "scheduler", Status: model.ApikeyStatusValid},
+				}},
+			}
+			cfg := config.LoadTestConfig()
+			discord := &fakeDiscord{}
+			ctrl := New(s, &fakeRepo{}, nil, discord, logger.NewLogrusLogger(), &cfg)
+
+			got, err := ctrl.Create(CreateInput{
+				Action:     model.ApprovalActionPayrollCommit,
+				Permission: model.PermissionPayrollsCreate.String(),
+				Summary:    "Commit payroll batch 1 of July 2023",
+				Payload:    map[string]int{"batch": 1},
+				Requester:  tc.requester,
+			})
+			require.ErrorIs(t, err, tc.expectedErr)
+			if tc.expectedErr != nil {
+				require.Nil(t, requestStore.request)
+				require.Empty(t, discord.logs)
+				return
+			}
+
+			// the request always waits for a second person, whoever asked for it
+			require.Equal(t, model.ApprovalRequestStatusPending, got.Status)
+			require.Equal(t, tc.requester.APIKeyClientID != "", got.IsRequestedByAPIKey())
+
+			require.Len(t, discord.logs, 1)
+			require.Equal(t, tc.expectedLogType, discord.logs[0].Type)
+			data := discord.logs[0].Data.(map[string]interface{})
+			for k, v := range tc.expectedLogData {
+				require.Equal(t, v, data[k])
+			}
+		})
+	}
+}
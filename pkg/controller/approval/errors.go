@@ -0,0 +1,15 @@
+package approval
+
+import "errors"
+
+var (
+	ErrApprovalRequestNotFound = errors.New("approval request not found")
+	ErrInvalidAction           = errors.New("invalid approval action")
+	ErrRequesterRequired       = errors.New("approval request must be created by an employee or an api key")
+	ErrNotPending              = errors.New("approval request is not pending")
+	ErrExpired                 = errors.New("approval request is expired")
+	ErrSelfApproval            = errors.New("requester can not review their own request")
+	ErrMissingPermission       = errors.New("reviewer does not have the required permission")
+	ErrExecutorNotFound        = errors.New("no executor registered for the approval action")
+	ErrExecutionFailed         = errors.New("approved action failed to execute")
+)
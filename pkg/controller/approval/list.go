@@ -0,0 +1,45 @@
+package approval
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/approvalrequest"
+)
+
+type ListInput struct {
+	model.Pagination
+
+	Statuses    []string
+	Actions     []string
+	RequesterID string
+}
+
+func (r *controller) List(input ListInput) ([]*model.ApprovalRequest, int64, error) {
+	filter := approvalrequest.Filter{
+		Statuses:    input.Statuses,
+		Actions:     input.Actions,
+		RequesterID: input.RequesterID,
+	}
+
+	requests, total, err := r.store.ApprovalRequest.All(r.repo.DB(), filter, input.Pagination)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return requests, total, nil
+}
+
+func (r *controller) Detail(id string) (*model.ApprovalRequest, error) {
+	request, err := r.store.ApprovalRequest.One(r.repo.DB(), id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrApprovalRequestNotFound
+		}
+		return nil, err
+	}
+
+	return request, nil
+}
@@ -0,0 +1,112 @@
+package approval
+
+import (
+	"sync"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller/discord"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+const (
+	apiKeyClientIDField = "api_key_client_id"
+	apiKeyLogTypeSuffix = "_by_api_key"
+)
+
+// Executor runs the guarded action once its approval request is approved
+type Executor func(request *model.ApprovalRequest) error
+
+type controller struct {
+	store   *store.Store
+	service *service.Service
+	logger  logger.Logger
+	repo    store.DBRepo
+	config  *config.Config
+	discord discord.IController
+
+	mu        sync.RWMutex
+	executors map[model.ApprovalAction]Executor
+}
+
+func New(store *store.Store, repo store.DBRepo, service *service.Service, discord discord.IController, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:     store,
+		repo:      repo,
+		service:   service,
+		logger:    logger,
+		config:    cfg,
+		discord:   discord,
+		executors: map[model.ApprovalAction]Executor{},
+	}
+}
+
+type IController interface {
+	RegisterExecutor(action model.ApprovalAction, executor Executor)
+	Create(input CreateInput) (request *model.ApprovalRequest, err error)
+	List(input ListInput) (requests []*model.ApprovalRequest, total int64, err error)
+	Detail(id string) (request *model.ApprovalRequest, err error)
+	Approve(id string, approverID string) (request *model.ApprovalRequest, err error)
+	Reject(id string, approverID string, reason string) (request *model.ApprovalRequest, err error)
+	ExpireOverdue() (requests []*model.ApprovalRequest, err error)
+}
+
+// RegisterExecutor binds an action to the function executing it after approval
+func (r *controller) RegisterExecutor(action model.ApprovalAction, executor Executor) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.executors[action] = executor
+}
+
+func (r *controller) getExecutor(action model.ApprovalAction) (Executor, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	executor, ok := r.executors[action]
+	return executor, ok
+}
+
+// withRequester adds the requester to the discord log data, employees are mentioned under the key
+// while api keys are named by their client id and logged with the api key variant of the template
+func withRequester(request *model.ApprovalRequest, key string, data map[string]interface{}) map[string]interface{} {
+	if !request.IsRequestedByAPIKey() {
+		data[key] = request.RequesterID.String()
+		return data
+	}
+
+	clientID := ""
+	if request.RequesterAPIKey != nil {
+		clientID = request.RequesterAPIKey.ClientID
+	}
+	data[apiKeyClientIDField] = clientID
+
+	return data
+}
+
+// notify announces the approval request state on discord,
+// a failed notification does not fail the approval flow
+func (r *controller) notify(logType string, request *model.ApprovalRequest, extra map[string]interface{}) {
+	if _, ok := extra[apiKeyClientIDField]; ok {
+		logType += apiKeyLogTypeSuffix
+	}
+
+	data := map[string]interface{}{
+		"id":      request.ID.String(),
+		"action":  request.Action.String(),
+		"summary": request.Summary,
+	}
+	for k, v := range extra {
+		data[k] = v
+	}
+
+	if err := r.discord.Log(model.LogDiscordInput{Type: logType, Data: data}); err != nil {
+		r.logger.Fields(logger.Fields{
+			"controller": "approval",
+			"type":       logType,
+			"id":         request.ID.String(),
+		}).Error(err, "failed to logs to discord")
+	}
+}
@@ -0,0 +1,160 @@
+package approval
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// Approve marks the request as approved by a second person and executes the guarded action.
+// When the action fails the request is marked as failed and returned together with ErrExecutionFailed
+func (r *controller) Approve(id string, approverID string) (*model.ApprovalRequest, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "approval",
+		"method":     "Approve",
+		"id":         id,
+	})
+
+	request, err := r.getReviewable(id, approverID)
+	if err != nil {
+		return nil, err
+	}
+
+	executor, ok := r.getExecutor(request.Action)
+	if !ok {
+		return nil, ErrExecutorNotFound
+	}
+
+	approver, err := model.UUIDFromString(approverID)
+	if err != nil {
+		return nil, ErrMissingPermission
+	}
+
+	now := time.Now()
+	ok, err = r.store.ApprovalRequest.UpdatePendingByID(r.repo.DB(), id, model.ApprovalRequest{
+		Status:     model.ApprovalRequestStatusApproved,
+		ApproverID: &approver,
+		ReviewedAt: &now,
+	}, "status", "approver_id", "reviewed_at")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNotPending
+	}
+
+	request.Status = model.ApprovalRequestStatusApproved
+	request.ApproverID = &approver
+	request.ReviewedAt = &now
+
+	if err := executor(request); err != nil {
+		l.Error(err, "failed to execute approved request")
+
+		request.Status = model.ApprovalRequestStatusFailed
+		request.ErrorMessage = err.Error()
+		if _, err := r.store.ApprovalRequest.UpdateSelectedFieldsByID(r.repo.DB(), id, *request, "status", "error_message"); err != nil {
+			return nil, err
+		}
+
+		r.notify("approval_request_failed", request, map[string]interface{}{
+			"employee_id": approverID,
+			"error":       request.ErrorMessage,
+		})
+
+		return request, fmt.Errorf("%w: %v", ErrExecutionFailed, err)
+	}
+
+	executedAt := time.Now()
+	request.ExecutedAt = &executedAt
+	if _, err := r.store.ApprovalRequest.UpdateSelectedFieldsByID(r.repo.DB(), id, *request, "executed_at"); err != nil {
+		return nil, err
+	}
+
+	r.notify("approval_request_approved", request, withRequester(request, "updated_employee_id", map[string]interface{}{
+		"employee_id": approverID,
+	}))
+
+	return request, nil
+}
+
+// Reject closes the request without executing it
+func (r *controller) Reject(id string, approverID string, reason string) (*model.ApprovalRequest, error) {
+	request, err := r.getReviewable(id, approverID)
+	if err != nil {
+		return nil, err
+	}
+
+	approver, err := model.UUIDFromString(approverID)
+	if err != nil {
+		return nil, ErrMissingPermission
+	}
+
+	now := time.Now()
+	ok, err := r.store.ApprovalRequest.UpdatePendingByID(r.repo.DB(), id, model.ApprovalRequest{
+		Status:       model.ApprovalRequestStatusRejected,
+		ApproverID:   &approver,
+		ReviewedAt:   &now,
+		RejectReason: reason,
+	}, "status", "approver_id", "reviewed_at", "reject_reason")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNotPending
+	}
+
+	request.Status = model.ApprovalRequestStatusRejected
+	request.ApproverID = &approver
+	request.ReviewedAt = &now
+	request.RejectReason = reason
+
+	r.notify("approval_request_rejected", request, withRequester(request, "updated_employee_id", map[string]interface{}{
+		"employee_id": approverID,
+		"reason":      reason,
+	}))
+
+	return request, nil
+}
+
+// ExpireOverdue expires all pending requests which passed their deadline
+func (r *controller) ExpireOverdue() ([]*model.ApprovalRequest, error) {
+	return r.store.ApprovalRequest.ExpirePending(r.repo.DB(), time.Now())
+}
+
+// getReviewable loads a pending request and checks the reviewer is allowed to review it
+func (r *controller) getReviewable(id string, approverID string) (*model.ApprovalRequest, error) {
+	request, err := r.Detail(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Status != model.ApprovalRequestStatusPending {
+		return nil, ErrNotPending
+	}
+
+	if request.IsExpired(time.Now()) {
+		_, err := r.store.ApprovalRequest.UpdatePendingByID(r.repo.DB(), id, model.ApprovalRequest{
+			Status: model.ApprovalRequestStatusExpired,
+		}, "status")
+		if err != nil {
+			return nil, err
+		}
+		return nil, ErrExpired
+	}
+
+	if request.RequesterID.String() == approverID {
+		return nil, ErrSelfApproval
+	}
+
+	hasPerm, err := r.store.Permission.HasPermission(r.repo.DB(), approverID, request.Permission)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPerm {
+		return nil, ErrMissingPermission
+	}
+
+	return request, nil
+}
@@ -0,0 +1,172 @@
+package approval
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/store/approvalrequest"
+	"github.com/dwarvesf/fortress-api/pkg/store/permission"
+)
+
+type fakeRepo struct {
+	store.DBRepo
+}
+
+func (r *fakeRepo) DB() *gorm.DB {
+	return nil
+}
+
+type fakeApprovalRequestStore struct {
+	approvalrequest.IStore
+	request *model.ApprovalRequest
+}
+
+func (s *fakeApprovalRequestStore) One(db *gorm.DB, id string) (*model.ApprovalRequest, error) {
+	if s.request == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	r := *s.request
+	return &r, nil
+}
+
+func (s *fakeApprovalRequestStore) UpdatePendingByID(db *gorm.DB, id string, updateModel model.ApprovalRequest, updatedFields ...string) (bool, error) {
+	if s.request.Status != model.ApprovalRequestStatusPending {
+		return false, nil
+	}
+	s.request.Status = updateModel.Status
+	return true, nil
+}
+
+func (s *fakeApprovalRequestStore) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.ApprovalRequest, updatedFields ...string) (*model.ApprovalRequest, error) {
+	s.request.Status = updateModel.Status
+	s.request.ErrorMessage = updateModel.ErrorMessage
+	return s.request, nil
+}
+
+type fakePermissionStore struct {
+	permission.IStore
+	granted map[string]bool
+}
+
+func (s *fakePermissionStore) HasPermission(db *gorm.DB, employeeID string, perm string) (bool, error) {
+	return s.granted[employeeID+":"+perm], nil
+}
+
+type fakeDiscord struct {
+	logs []model.LogDiscordInput
+}
+
+func (d *fakeDiscord) Log(in model.LogDiscordInput) error {
+	d.logs = append(d.logs, in)
+	return nil
+}
+
+func TestController_Approve(t *testing.T) {
+	var (
+		requestID   = "0b3d4a4c-2f34-4e2a-9c86-9d0e4c1e6a01"
+		requesterID = model.MustGetUUIDFromString("2655832e-f009-4b73-a535-64c3a22e558f")
+		approverID  = "ecea9d15-05ba-4a4e-9787-54210e3b98ce"
+		perm        = model.PermissionPayrollsEdit.String()
+	)
+
+	tcs := map[string]struct {
+		expiredAt     time.Time
+		status        model.ApprovalRequestStatus
+		approverID    string
+		granted       bool
+		executorErr   error
+		expectedErr   error
+		expectedState model.ApprovalRequestStatus
+		executed      bool
+	}{
+		"approved by a second person with the permission": {
+			approverID:    approverID,
+			granted:       true,
+			expectedState: model.ApprovalRequestStatusApproved,
+			executed:      true,
+		},
+		"requester can not approve their own request": {
+			approverID:    requesterID.String(),
+			granted:       true,
+			expectedErr:   ErrSelfApproval,
+			expectedState: model.ApprovalRequestStatusPending,
+		},
+		"approver without the permission": {
+			approverID:    approverID,
+			expectedErr:   ErrMissingPermission,
+			expectedState: model.ApprovalRequestStatusPending,
+		},
+		"expired request": {
+			expiredAt:     time.Now().Add(-time.Minute),
+			approverID:    approverID,
+			granted:       true,
+			expectedErr:   ErrExpired,
+			expectedState: model.ApprovalRequestStatusExpired,
+		},
+		"request which is already reviewed": {
+			status:        model.ApprovalRequestStatusRejected,
+			approverID:    approverID,
+			granted:       true,
+			expectedErr:   ErrNotPending,
+			expectedState: model.ApprovalRequestStatusRejected,
+		},
+		"action which fails after approval": {
+			approverID:    approverID,
+			granted:       true,
+			executorErr:   errors.New("payroll not found"),
+			expectedErr:   ErrExecutionFailed,
+			expectedState: model.ApprovalRequestStatusFailed,
+			executed:      true,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			request := &model.ApprovalRequest{
+				BaseModel:   model.BaseModel{ID: model.MustGetUUIDFromString(requestID)},
+				Action:      model.ApprovalActionPayrollMarkPaid,
+				Status:      model.ApprovalRequestStatusPending,
+				Permission:  perm,
+				RequesterID: requesterID,
+				ExpiredAt:   time.Now().Add(time.Hour),
+			}
+			if !tc.expiredAt.IsZero() {
+				request.ExpiredAt = tc.expiredAt
+			}
+			if tc.status != "" {
+				request.Status = tc.status
+			}
+
+			requestStore := &fakeApprovalRequestStore{request: request}
+			s := &store.Store{
+				ApprovalRequest: requestStore,
+				Permission:      &fakePermissionStore{granted: map[string]bool{tc.approverID + ":" + perm: tc.granted}},
+			}
+			cfg := config.LoadTestConfig()
+			ctrl := New(s, &fakeRepo{}, nil, &fakeDiscord{}, logger.NewLogrusLogger(), &cfg)
+
+			executed := false
+			ctrl.RegisterExecutor(model.ApprovalActionPayrollMarkPaid, func(request *model.ApprovalRequest) error {
+				executed = true
+				return tc.executorErr
+			})
+
+			got, err := ctrl.Approve(requestID, tc.approverID)
+			require.ErrorIs(t, err, tc.expectedErr)
+			require.Equal(t, tc.executed, executed)
+			require.Equal(t, tc.expectedState, requestStore.request.Status)
+			if tc.executorErr != nil {
+				// the failed request is still returned so the reviewer can see why
+				require.NotNil(t, got)
+				require.Equal(t, tc.executorErr.Error(), got.ErrorMessage)
+			}
+		})
+	}
+}
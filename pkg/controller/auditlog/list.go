@@ -0,0 +1,41 @@
+package auditlog
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/auditlog"
+)
+
+type ListInput struct {
+	model.Pagination
+
+	ActorType    string
+	ActorID      string
+	Action       string
+	ResourceType string
+	ResourceID   string
+	RequestID    string
+	From         *time.Time
+	To           *time.Time
+}
+
+func (r *controller) List(input ListInput) ([]*model.AuditLog, int64, error) {
+	filter := auditlog.AuditLogFilter{
+		ActorType:    input.ActorType,
+		ActorID:      input.ActorID,
+		Action:       input.Action,
+		ResourceType: input.ResourceType,
+		ResourceID:   input.ResourceID,
+		RequestID:    input.RequestID,
+		From:         input.From,
+		To:           input.To,
+	}
+
+	logs, total, err := r.store.AuditLog.All(r.repo.DB(), filter, input.Pagination)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return logs, total, nil
+}
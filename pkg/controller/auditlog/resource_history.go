@@ -0,0 +1,21 @@
+package auditlog
+
+import (
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/auditlog"
+)
+
+// ResourceHistory gets all changes made to a single resource, newest first
+func (r *controller) ResourceHistory(resourceType string, resourceID string, pagination model.Pagination) ([]*model.AuditLog, int64, error) {
+	filter := auditlog.AuditLogFilter{
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+	}
+
+	logs, total, err := r.store.AuditLog.All(r.repo.DB(), filter, pagination)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return logs, total, nil
+}
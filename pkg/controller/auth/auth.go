@@ -7,58 +7,50 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service/identity"
 	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
 )
 
 type AuthenticationInput struct {
+	Provider    string
 	Code        string
 	RedirectURL string
+	Token       string
 }
 
 func (r *controller) Auth(in AuthenticationInput) (*model.Employee, string, error) {
-	// 2.1 get access token from req code and redirect url
-	accessToken, err := r.service.Google.GetAccessToken(in.Code, in.RedirectURL)
+	// 2.1 resolve the login identity from provider
+	ident, err := r.authenticate(in)
 	if err != nil {
 		return nil, "", err
 	}
 
-	// 2.2 get login user email from access token
-	primaryEmail := ""
-	if r.config.Env == "prod" {
-		primaryEmail, err = r.service.Google.GetGoogleEmailLegacy(accessToken)
-		if err != nil {
-			return nil, "", err
-		}
-	} else {
-		primaryEmail, err = r.service.Google.GetGoogleEmail(accessToken)
-		if err != nil {
-			return nil, "", err
-		}
-	}
-
-	// 2.3 double check empty primary email
-	if primaryEmail == "" {
+	// 2.2 double check empty primary email
+	if ident.Email == "" {
 		return nil, "", ErrEmptyPrimaryEmail
 	}
 
-	// 2.4 check user is active
-	employee, err := r.store.Employee.OneByEmail(r.repo.DB(), primaryEmail)
+	// 2.3 get the employee owning this identity
+	employee, err := r.getEmployeeByIdentity(ident)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, "", ErrUserInactivated
-		}
 		return nil, "", err
 	}
 
+	// 2.4 check user is active
 	if employee.WorkingStatus == model.WorkingStatusLeft || employee.WorkingStatus == model.WorkingStatusOnBoarding {
 		return nil, "", ErrUserInactivated
 	}
 
-	// 2.5 generate jwt bearer token
+	// 2.5 remember the identity so the employee can keep logging in with it
+	if err := r.touchIdentity(employee.ID, ident); err != nil {
+		return nil, "", err
+	}
+
+	// 2.6 generate jwt bearer token
 	authenticationInfo := model.AuthenticationInfo{
 		UserID: employee.ID.String(),
 		Avatar: employee.Avatar,
-		Email:  primaryEmail,
+		Email:  ident.Email,
 	}
 
 	jwt, err := authutils.GenerateJWTToken(&authenticationInfo, time.Now().Add(24*365*time.Hour).Unix(), r.config.JWTSecretKey)
@@ -68,3 +60,74 @@ func (r *controller) Auth(in AuthenticationInput) (*model.Employee, string, erro
 
 	return employee, jwt, nil
 }
+
+// authenticate dispatches the credential to the requested identity provider
+func (r *controller) authenticate(in AuthenticationInput) (*identity.Identity, error) {
+	if in.Provider == "" {
+		in.Provider = identity.ProviderGoogle
+	}
+
+	if in.Provider == identity.ProviderMagicLink {
+		return r.consumeMagicLink(in.Token)
+	}
+
+	provider, err := r.service.Identity.Provider(in.Provider)
+	if err != nil {
+		return nil, ErrProviderNotSupported
+	}
+
+	return provider.Authenticate(identity.Credential{
+		Code:        in.Code,
+		RedirectURL: in.RedirectURL,
+	})
+}
+
+// getEmployeeByIdentity finds the employee by a linked identity first,
+// and falls back to matching the team or personal email
+func (r *controller) getEmployeeByIdentity(ident *identity.Identity) (*model.Employee, error) {
+	linked, err := r.store.EmployeeIdentity.OneByProviderSubject(r.repo.DB(), ident.Provider, ident.Subject)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if err == nil && linked.Employee != nil {
+		return linked.Employee, nil
+	}
+
+	employee, err := r.store.Employee.OneByEmail(r.repo.DB(), ident.Email)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrUserInactivated
+		}
+		return nil, err
+	}
+
+	return employee, nil
+}
+
+// touchIdentity links the identity to the employee if needed and records the login time
+func (r *controller) touchIdentity(employeeID model.UUID, ident *identity.Identity) error {
+	now := time.Now()
+
+	linked, err := r.store.EmployeeIdentity.OneByProviderSubject(r.repo.DB(), ident.Provider, ident.Subject)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	if err == nil {
+		_, err = r.store.EmployeeIdentity.UpdateSelectedFieldsByID(r.repo.DB(), linked.ID.String(), model.EmployeeIdentity{
+			Email:       ident.Email,
+			LastLoginAt: &now,
+		}, "email", "last_login_at")
+		return err
+	}
+
+	_, err = r.store.EmployeeIdentity.Create(r.repo.DB(), &model.EmployeeIdentity{
+		EmployeeID:  employeeID,
+		Provider:    ident.Provider,
+		Subject:     ident.Subject,
+		Email:       ident.Email,
+		LastLoginAt: &now,
+	})
+
+	return err
+}
@@ -3,8 +3,12 @@ package auth
 import "errors"
 
 var (
-	ErrUserInactivated   = errors.New("user is inactivated")
-	ErrEmptyPrimaryEmail = errors.New("empty primary email")
-	ErrUserNotFound      = errors.New("user is not found")
-	ErrRoleNotfound      = errors.New("role is not found")
+	ErrUserInactivated             = errors.New("user is inactivated")
+	ErrEmptyPrimaryEmail           = errors.New("empty primary email")
+	ErrUserNotFound                = errors.New("user is not found")
+	ErrRoleNotfound                = errors.New("role is not found")
+	ErrProviderNotSupported        = errors.New("login provider is not supported")
+	ErrInvalidMagicLink            = errors.New("magic link is invalid or expired")
+	ErrIdentityNotFound            = errors.New("identity is not found")
+	ErrIdentityLinkedToAnotherUser = errors.New("identity is linked to another user")
 )
@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service/identity"
+)
+
+// Providers lists login providers enabled on this server
+func (r *controller) Providers() []string {
+	providers := append([]string{}, r.service.Identity.Providers()...)
+	return append(providers, identity.ProviderMagicLink)
+}
+
+// ListIdentities gets all identities linked to the employee
+func (r *controller) ListIdentities(employeeID string) ([]*model.EmployeeIdentity, error) {
+	return r.store.EmployeeIdentity.GetByEmployeeID(r.repo.DB(), employeeID)
+}
+
+// LinkIdentity links another login identity to the logged-in employee,
+// so they can log in with a provider whose email does not match their employee record
+func (r *controller) LinkIdentity(employeeID string, in AuthenticationInput) (*model.EmployeeIdentity, error) {
+	ident, err := r.authenticate(in)
+	if err != nil {
+		return nil, err
+	}
+
+	linked, err := r.store.EmployeeIdentity.OneByProviderSubject(r.repo.DB(), ident.Provider, ident.Subject)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if err == nil {
+		if linked.EmployeeID.String() != employeeID {
+			return nil, ErrIdentityLinkedToAnotherUser
+		}
+		return linked, nil
+	}
+
+	id, err := model.UUIDFromString(employeeID)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+
+	now := time.Now()
+	return r.store.EmployeeIdentity.Create(r.repo.DB(), &model.EmployeeIdentity{
+		EmployeeID:  id,
+		Provider:    ident.Provider,
+		Subject:     ident.Subject,
+		Email:       ident.Email,
+		LastLoginAt: &now,
+	})
+}
+
+// UnlinkIdentity removes a linked identity of the employee
+func (r *controller) UnlinkIdentity(employeeID string, identityID string) error {
+	rec, err := r.store.EmployeeIdentity.One(r.repo.DB(), identityID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrIdentityNotFound
+		}
+		return err
+	}
+
+	if rec.EmployeeID.String() != employeeID {
+		return ErrIdentityNotFound
+	}
+
+	return r.store.EmployeeIdentity.Delete(r.repo.DB(), identityID)
+}
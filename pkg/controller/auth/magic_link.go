@@ -0,0 +1,108 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service/identity"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+)
+
+const (
+	magicLinkTokenLength = 48
+	magicLinkTTL         = 15 * time.Minute
+)
+
+// RequestMagicLink emails a one-time login link to an active employee,
+// unknown emails are ignored silently so the endpoint cannot be used to probe accounts
+func (r *controller) RequestMagicLink(email string) error {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "auth",
+		"method":     "RequestMagicLink",
+	})
+
+	email = strings.ToLower(strings.TrimSpace(email))
+
+	employee, err := r.store.Employee.OneByEmail(r.repo.DB(), email)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			l.Infof("magic link requested for unknown email", "email", email)
+			return nil
+		}
+		return err
+	}
+
+	if employee.WorkingStatus == model.WorkingStatusLeft || employee.WorkingStatus == model.WorkingStatusOnBoarding {
+		l.Infof("magic link requested for inactive employee", "employeeID", employee.ID)
+		return nil
+	}
+
+	token, err := authutils.GenerateUniqueNanoID(magicLinkTokenLength)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.store.MagicLinkToken.Create(r.repo.DB(), &model.MagicLinkToken{
+		EmployeeID: employee.ID,
+		Email:      email,
+		TokenHash:  hashMagicLinkToken(token),
+		ExpiredAt:  time.Now().Add(magicLinkTTL),
+	})
+	if err != nil {
+		return err
+	}
+
+	return r.service.GoogleMail.SendMagicLinkMail(&model.MagicLinkEmail{
+		Email: email,
+		Link:  fmt.Sprintf("%s/login/magic-link?token=%s", r.config.FortressURL, url.QueryEscape(token)),
+	})
+}
+
+// consumeMagicLink exchanges a magic link token for the identity it was issued to,
+// a token can only be used once
+func (r *controller) consumeMagicLink(token string) (*identity.Identity, error) {
+	if token == "" {
+		return nil, ErrInvalidMagicLink
+	}
+
+	rec, err := r.store.MagicLinkToken.OneByTokenHash(r.repo.DB(), hashMagicLinkToken(token))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInvalidMagicLink
+		}
+		return nil, err
+	}
+
+	now := time.Now()
+	if !rec.IsUsable(now) {
+		return nil, ErrInvalidMagicLink
+	}
+
+	ok, err := r.store.MagicLinkToken.MarkUsed(r.repo.DB(), rec.ID.String(), now)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrInvalidMagicLink
+	}
+
+	return &identity.Identity{
+		Provider: identity.ProviderMagicLink,
+		Subject:  rec.Email,
+		Email:    rec.Email,
+	}, nil
+}
+
+func hashMagicLinkToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -30,4 +30,9 @@ type IController interface {
 	Auth(in AuthenticationInput) (employee *model.Employee, jwt string, err error)
 	Me(userID string) (employee *model.Employee, perms []*model.Permission, err error)
 	CreateAPIKey(roleID string) (string, error)
+	RequestMagicLink(email string) error
+	Providers() []string
+	ListIdentities(employeeID string) ([]*model.EmployeeIdentity, error)
+	LinkIdentity(employeeID string, in AuthenticationInput) (*model.EmployeeIdentity, error)
+	UnlinkIdentity(employeeID string, identityID string) error
 }
@@ -0,0 +1,75 @@
+package budget
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// SendBurnAlerts alerts the heads of the fixed-cost projects by Discord DM when the burn of the budget
+// crosses a threshold which has not been alerted yet
+func (r *controller) SendBurnAlerts(now time.Time) (int, error) {
+	l := r.logger.AddField("method", "SendBurnAlerts")
+
+	budgets, err := r.store.ProjectBudget.GetAlertable(r.repo.DB())
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, b := range budgets {
+		if b.Project == nil {
+			continue
+		}
+
+		health, err := r.health(b, now)
+		if err != nil {
+			l.AddField("projectID", b.ProjectID).Error(err, "failed to get budget health")
+			continue
+		}
+
+		threshold, ok := b.NextAlertThreshold(health.BurnPercentage())
+		if !ok {
+			continue
+		}
+
+		msg := r.burnAlertMessage(b.Project, health, threshold)
+		alerted := map[model.UUID]bool{}
+		for _, h := range b.Project.Heads {
+			if alerted[h.EmployeeID] {
+				continue
+			}
+			if h.Employee.DiscordAccount == nil || h.Employee.DiscordAccount.DiscordID == "" {
+				l.AddField("employeeID", h.EmployeeID).Info("project head has no discord account to be alerted")
+				continue
+			}
+
+			if _, err := r.service.Discord.SendDirectMessage(h.Employee.DiscordAccount.DiscordID, msg); err != nil {
+				l.AddField("employeeID", h.EmployeeID).Error(err, "failed to send budget burn alert")
+				continue
+			}
+			alerted[h.EmployeeID] = true
+			sent++
+		}
+		if len(alerted) == 0 {
+			continue
+		}
+
+		if _, err := r.store.ProjectBudget.UpdateSelectedFieldsByID(r.repo.DB(), b.ID.String(), model.ProjectBudget{
+			AlertedThreshold: threshold,
+		}, "alerted_threshold"); err != nil {
+			return sent, err
+		}
+	}
+
+	return sent, nil
+}
+
+func (r *controller) burnAlertMessage(project *model.Project, health *model.ProjectBudgetHealth, threshold float64) string {
+	return fmt.Sprintf("Heads up, the budget of **%s** is %.2f%% burned, crossing the %.0f%% threshold.\n"+
+		"Burned: %.0f VND of %.0f VND, %.0f approved hours.\n%s/projects/%s",
+		project.Name, health.BurnPercentage(), threshold,
+		health.BurnedAmount, health.Budget.TotalAmount, health.ApprovedHours,
+		r.config.FortressURL, project.ID)
+}
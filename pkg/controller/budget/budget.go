@@ -0,0 +1,154 @@
+package budget
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/profitloss"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type UpsertInput struct {
+	ProjectID   string
+	TotalAmount float64
+	StartDate   time.Time
+	EndDate     *time.Time
+	// AlertThresholds are burn percentages, the default ones are used when it is empty
+	AlertThresholds []float64
+	Note            string
+	Now             time.Time
+}
+
+// GetHealth returns the budget of a project with its burn at a time
+func (r *controller) GetHealth(projectID string, now time.Time) (*model.ProjectBudgetHealth, error) {
+	project, err := r.getProject(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	budget, err := r.store.ProjectBudget.OneByProjectID(r.repo.DB(), project.ID.String())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrBudgetNotFound
+		}
+		return nil, err
+	}
+
+	return r.health(budget, now)
+}
+
+// Upsert sets the budget of a fixed-cost project, the project heads are alerted again from the current burn
+func (r *controller) Upsert(input UpsertInput) (*model.ProjectBudgetHealth, error) {
+	if input.TotalAmount <= 0 {
+		return nil, ErrInvalidAmount
+	}
+	if input.EndDate != nil && !input.EndDate.After(input.StartDate) {
+		return nil, ErrInvalidPeriod
+	}
+	for _, t := range input.AlertThresholds {
+		if t <= 0 {
+			return nil, ErrInvalidAlertThreshold
+		}
+	}
+
+	project, err := r.getProject(input.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if project.Type != model.ProjectTypeFixedCost {
+		return nil, ErrProjectNotFixedCost
+	}
+
+	thresholds := input.AlertThresholds
+	if len(thresholds) == 0 {
+		thresholds = model.DefaultBudgetAlertThresholds
+	}
+	alertThresholds, err := json.Marshal(thresholds)
+	if err != nil {
+		return nil, err
+	}
+
+	db := r.repo.DB()
+
+	budget, err := r.store.ProjectBudget.OneByProjectID(db, project.ID.String())
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		if _, err := r.store.ProjectBudget.Create(db, &model.ProjectBudget{
+			ProjectID:       project.ID,
+			TotalAmount:     input.TotalAmount,
+			StartDate:       input.StartDate,
+			EndDate:         input.EndDate,
+			AlertThresholds: alertThresholds,
+			Note:            input.Note,
+		}); err != nil {
+			return nil, err
+		}
+
+		return r.GetHealth(project.ID.String(), input.Now)
+	}
+
+	if budget.PhasesAmount(model.UUID{}) > input.TotalAmount {
+		return nil, ErrPhasesExceedBudget
+	}
+
+	if _, err := r.store.ProjectBudget.UpdateSelectedFieldsByID(db, budget.ID.String(), model.ProjectBudget{
+		TotalAmount:      input.TotalAmount,
+		StartDate:        input.StartDate,
+		EndDate:          input.EndDate,
+		AlertThresholds:  alertThresholds,
+		AlertedThreshold: 0,
+		Note:             input.Note,
+	}, "total_amount", "start_date", "end_date", "alert_thresholds", "alerted_threshold", "note"); err != nil {
+		return nil, err
+	}
+
+	return r.GetHealth(project.ID.String(), input.Now)
+}
+
+// health computes the burn of a budget from the cost of the project and its approved hours since the budget start
+func (r *controller) health(budget *model.ProjectBudget, now time.Time) (*model.ProjectBudgetHealth, error) {
+	rs := &model.ProjectBudgetHealth{Budget: budget}
+	if now.Before(budget.StartDate) {
+		return rs, nil
+	}
+
+	_, months, err := r.profitLoss.GetProjectProfitLoss(profitloss.ProjectInput{
+		ProjectID: budget.ProjectID.String(),
+		From:      time.Date(budget.StartDate.Year(), budget.StartDate.Month(), 1, 0, 0, 0, 0, time.UTC),
+		To:        time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range months {
+		rs.BurnedAmount += m.TotalCost()
+	}
+
+	hours, err := r.store.Timesheet.GetApprovedHoursByProjectID(r.repo.DB(), budget.ProjectID.String(), budget.StartDate, now)
+	if err != nil {
+		return nil, err
+	}
+	for _, h := range hours {
+		rs.ApprovedHours += h.Hours
+	}
+
+	return rs, nil
+}
+
+func (r *controller) getProject(projectID string) (*model.Project, error) {
+	project, err := r.store.Project.One(r.repo.DB(), projectID, false)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrProjectNotFound
+		}
+		return nil, err
+	}
+
+	return project, nil
+}
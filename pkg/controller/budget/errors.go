@@ -0,0 +1,16 @@
+package budget
+
+import "errors"
+
+var (
+	ErrProjectNotFound       = errors.New("project not found")
+	ErrProjectNotFixedCost   = errors.New("only fixed-cost projects have a budget")
+	ErrBudgetNotFound        = errors.New("project budget not found")
+	ErrPhaseNotFound         = errors.New("budget phase not found")
+	ErrInvalidAmount         = errors.New("amount must be more than 0")
+	ErrInvalidPeriod         = errors.New("end date must be after start date")
+	ErrPhasesExceedBudget    = errors.New("phases amount exceeds the total amount of the budget")
+	ErrPhaseOutsideBudget    = errors.New("phase must be within the start and end dates of the budget")
+	ErrPhaseInvoiced         = errors.New("invoiced budget phase can not be deleted")
+	ErrInvalidAlertThreshold = errors.New("alert thresholds must be more than 0")
+)
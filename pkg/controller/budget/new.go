@@ -0,0 +1,43 @@
+package budget
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller/profitloss"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type controller struct {
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+	profitLoss profitloss.IController
+}
+
+func New(store *store.Store, repo store.DBRepo, service *service.Service, profitLoss profitloss.IController, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+		profitLoss: profitLoss,
+	}
+}
+
+type IController interface {
+	GetHealth(projectID string, now time.Time) (health *model.ProjectBudgetHealth, err error)
+	Upsert(input UpsertInput) (health *model.ProjectBudgetHealth, err error)
+
+	CreatePhase(input PhaseInput) (phase *model.ProjectBudgetPhase, err error)
+	UpdatePhase(input PhaseInput) (phase *model.ProjectBudgetPhase, err error)
+	DeletePhase(projectID string, phaseID string) error
+
+	SendBurnAlerts(now time.Time) (sent int, err error)
+}
@@ -0,0 +1,138 @@
+package budget
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type PhaseInput struct {
+	ProjectID string
+	// PhaseID is only set when a phase is updated
+	PhaseID   string
+	Name      string
+	Amount    float64
+	StartDate time.Time
+	EndDate   *time.Time
+	Note      string
+}
+
+// CreatePhase adds a milestone to the budget of a project, the phases can not exceed the total amount
+func (r *controller) CreatePhase(input PhaseInput) (*model.ProjectBudgetPhase, error) {
+	budget, err := r.getBudget(input.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validatePhase(budget, input, model.UUID{}); err != nil {
+		return nil, err
+	}
+
+	phase, err := r.store.ProjectBudget.CreatePhase(r.repo.DB(), &model.ProjectBudgetPhase{
+		ProjectBudgetID: budget.ID,
+		Name:            input.Name,
+		Amount:          input.Amount,
+		StartDate:       input.StartDate,
+		EndDate:         input.EndDate,
+		Note:            input.Note,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.store.ProjectBudget.OnePhase(r.repo.DB(), phase.ID.String())
+}
+
+// UpdatePhase updates a milestone of the budget of a project
+func (r *controller) UpdatePhase(input PhaseInput) (*model.ProjectBudgetPhase, error) {
+	budget, err := r.getBudget(input.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	phase, err := r.getPhase(budget, input.PhaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validatePhase(budget, input, phase.ID); err != nil {
+		return nil, err
+	}
+
+	if _, err := r.store.ProjectBudget.UpdatePhaseSelectedFieldsByID(r.repo.DB(), phase.ID.String(), model.ProjectBudgetPhase{
+		Name:      input.Name,
+		Amount:    input.Amount,
+		StartDate: input.StartDate,
+		EndDate:   input.EndDate,
+		Note:      input.Note,
+	}, "name", "amount", "start_date", "end_date", "note"); err != nil {
+		return nil, err
+	}
+
+	return r.store.ProjectBudget.OnePhase(r.repo.DB(), phase.ID.String())
+}
+
+// DeletePhase deletes a milestone which has no invoice
+func (r *controller) DeletePhase(projectID string, phaseID string) error {
+	budget, err := r.getBudget(projectID)
+	if err != nil {
+		return err
+	}
+
+	phase, err := r.getPhase(budget, phaseID)
+	if err != nil {
+		return err
+	}
+	if len(phase.Invoices) > 0 {
+		return ErrPhaseInvoiced
+	}
+
+	return r.store.ProjectBudget.DeletePhase(r.repo.DB(), phase.ID.String())
+}
+
+func validatePhase(budget *model.ProjectBudget, input PhaseInput, phaseID model.UUID) error {
+	if input.Amount <= 0 {
+		return ErrInvalidAmount
+	}
+	if input.EndDate != nil && !input.EndDate.After(input.StartDate) {
+		return ErrInvalidPeriod
+	}
+
+	if input.StartDate.Before(budget.StartDate) {
+		return ErrPhaseOutsideBudget
+	}
+	if budget.EndDate != nil && (input.EndDate == nil || input.EndDate.After(*budget.EndDate)) {
+		return ErrPhaseOutsideBudget
+	}
+
+	if budget.PhasesAmount(phaseID)+input.Amount > budget.TotalAmount {
+		return ErrPhasesExceedBudget
+	}
+
+	return nil
+}
+
+func (r *controller) getBudget(projectID string) (*model.ProjectBudget, error) {
+	budget, err := r.store.ProjectBudget.OneByProjectID(r.repo.DB(), projectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrBudgetNotFound
+		}
+		return nil, err
+	}
+
+	return budget, nil
+}
+
+func (r *controller) getPhase(budget *model.ProjectBudget, phaseID string) (*model.ProjectBudgetPhase, error) {
+	for _, p := range budget.Phases {
+		if p.ID.String() == phaseID {
+			return p, nil
+		}
+	}
+
+	return nil, ErrPhaseNotFound
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/dwarvesf/fortress-api/pkg/handler/client/request"
 	"github.com/dwarvesf/fortress-api/pkg/model"
@@ -53,6 +55,7 @@ func (r *controller) Update(c *gin.Context, clientID string, input request.Updat
 	}
 
 	// Create client contact
+	contacts := make([]*model.ClientContact, 0, len(input.Contacts))
 	for _, clientContact := range input.Contacts {
 		// parse struct email to json
 		emails, err := json.Marshal(model.ClientEmail{Emails: clientContact.Emails})
@@ -60,7 +63,7 @@ func (r *controller) Update(c *gin.Context, clientID string, input request.Updat
 			return http.StatusInternalServerError, done(err)
 		}
 
-		_, err = r.store.ClientContact.Create(tx.DB(), &model.ClientContact{
+		contact, err := r.store.ClientContact.Create(tx.DB(), &model.ClientContact{
 			ClientID:      model.MustGetUUIDFromString(clientID),
 			Name:          clientContact.Name,
 			Role:          clientContact.Role,
@@ -71,7 +74,60 @@ func (r *controller) Update(c *gin.Context, clientID string, input request.Updat
 		if err != nil {
 			return http.StatusInternalServerError, done(err)
 		}
+		contacts = append(contacts, contact)
+	}
+
+	if err := r.moveClientPortalAccesses(tx.DB(), client.Contacts, contacts); err != nil {
+		return http.StatusInternalServerError, done(err)
 	}
 
 	return http.StatusOK, done(nil)
 }
+
+// moveClientPortalAccesses moves the client portal accesses of the deleted contacts to the new contact having the
+// same email, the accesses whose email is not used by any new contact are revoked. A contact has one access at most
+func (r *controller) moveClientPortalAccesses(db *gorm.DB, oldContacts []model.ClientContact, newContacts []*model.ClientContact) error {
+	now := time.Now()
+	hasAccess := make(map[model.UUID]bool, len(newContacts))
+	for _, old := range oldContacts {
+		access := old.PortalAccess
+		if access == nil {
+			continue
+		}
+
+		contact := findContactByEmail(newContacts, access.Email)
+		if contact == nil || hasAccess[contact.ID] {
+			if !access.IsActive() {
+				continue
+			}
+			if _, err := r.store.ClientPortalAccess.UpdateSelectedFieldsByID(db, access.ID.String(), model.ClientPortalAccess{
+				Status:    model.ClientPortalAccessStatusRevoked,
+				RevokedAt: &now,
+			}, "status", "token_hash", "token_expired_at", "revoked_at"); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if _, err := r.store.ClientPortalAccess.UpdateSelectedFieldsByID(db, access.ID.String(), model.ClientPortalAccess{
+			ClientContactID: contact.ID,
+		}, "client_contact_id"); err != nil {
+			return err
+		}
+		hasAccess[contact.ID] = true
+	}
+
+	return nil
+}
+
+func findContactByEmail(contacts []*model.ClientContact, email string) *model.ClientContact {
+	for _, c := range contacts {
+		for _, e := range c.GetEmails() {
+			if strings.EqualFold(e, email) {
+				return c
+			}
+		}
+	}
+
+	return nil
+}
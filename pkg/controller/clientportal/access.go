@@ -0,0 +1,254 @@
+package clientportal
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+)
+
+const (
+	loginTokenLength = 48
+	// invitationTTL is the lifetime of the login link sent when an access is issued
+	invitationTTL = 7 * 24 * time.Hour
+	// loginLinkTTL is the lifetime of the login links requested by the contacts
+	loginLinkTTL = 15 * time.Minute
+	// accessTokenTTL is the lifetime of a client portal session
+	accessTokenTTL = 7 * 24 * time.Hour
+)
+
+type IssueAccessInput struct {
+	ClientID  string
+	ContactID string
+	// Email is the email of the contact to send the login links to, the first email of the contact by default
+	Email    string
+	IssuedBy string
+	Now      time.Time
+}
+
+// IssueAccess gives a client contact access to the client portal and emails them a login link,
+// issuing the access again reactivates it, ends the sessions started before and sends a new link
+func (r *controller) IssueAccess(input IssueAccessInput) (*model.ClientPortalAccess, error) {
+	if err := authutils.CheckClientPortalConfig(r.config); err != nil {
+		return nil, err
+	}
+
+	client, contact, err := r.getContact(input.ClientID, input.ContactID)
+	if err != nil {
+		return nil, err
+	}
+
+	email, err := contactEmail(contact, input.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := authutils.GenerateUniqueNanoID(loginTokenLength)
+	if err != nil {
+		return nil, err
+	}
+	expiredAt := input.Now.Add(invitationTTL)
+
+	access := &model.ClientPortalAccess{
+		ClientID:        client.ID,
+		ClientContactID: contact.ID,
+		Email:           email,
+		Status:          model.ClientPortalAccessStatusActive,
+		TokenHash:       hashLoginToken(token),
+		TokenExpiredAt:  &expiredAt,
+	}
+	if model.IsUUIDFromString(input.IssuedBy) {
+		issuedBy := model.MustGetUUIDFromString(input.IssuedBy)
+		access.IssuedBy = &issuedBy
+	}
+
+	if contact.PortalAccess == nil {
+		access, err = r.store.ClientPortalAccess.Create(r.repo.DB(), access)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		access.ID = contact.PortalAccess.ID
+		access.LastLoginAt = contact.PortalAccess.LastLoginAt
+		access.SessionVersion = contact.PortalAccess.SessionVersion + 1
+		_, err = r.store.ClientPortalAccess.UpdateSelectedFieldsByID(r.repo.DB(), access.ID.String(), *access,
+			"email",
+			"status",
+			"issued_by",
+			"token_hash",
+			"token_expired_at",
+			"revoked_at",
+			"session_version")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := r.sendLoginLink(client, contact, email, token, true); err != nil {
+		return nil, err
+	}
+
+	return access, nil
+}
+
+// RevokeAccess stops a client contact from using the client portal, the sessions of the contact end right away
+func (r *controller) RevokeAccess(clientID string, contactID string, now time.Time) error {
+	_, contact, err := r.getContact(clientID, contactID)
+	if err != nil {
+		return err
+	}
+	if contact.PortalAccess == nil {
+		return ErrAccessNotFound
+	}
+
+	_, err = r.store.ClientPortalAccess.UpdateSelectedFieldsByID(r.repo.DB(), contact.PortalAccess.ID.String(), model.ClientPortalAccess{
+		Status:         model.ClientPortalAccessStatusRevoked,
+		RevokedAt:      &now,
+		SessionVersion: contact.PortalAccess.SessionVersion + 1,
+	}, "status", "token_hash", "token_expired_at", "revoked_at", "session_version")
+	return err
+}
+
+// RequestLoginLink emails a one-time login link to a client contact with an active access,
+// unknown emails are ignored silently so the endpoint cannot be used to probe accounts
+func (r *controller) RequestLoginLink(email string, now time.Time) error {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "clientportal",
+		"method":     "RequestLoginLink",
+	})
+
+	if err := authutils.CheckClientPortalConfig(r.config); err != nil {
+		return err
+	}
+
+	email = strings.ToLower(strings.TrimSpace(email))
+
+	access, err := r.store.ClientPortalAccess.OneActiveByEmail(r.repo.DB(), email)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			l.Infof("client portal login link requested for unknown email", "email", email)
+			return nil
+		}
+		return err
+	}
+
+	client, contact, err := r.getContact(access.ClientID.String(), access.ClientContactID.String())
+	if err != nil {
+		return err
+	}
+
+	token, err := authutils.GenerateUniqueNanoID(loginTokenLength)
+	if err != nil {
+		return err
+	}
+	expiredAt := now.Add(loginLinkTTL)
+
+	_, err = r.store.ClientPortalAccess.UpdateSelectedFieldsByID(r.repo.DB(), access.ID.String(), model.ClientPortalAccess{
+		TokenHash:      hashLoginToken(token),
+		TokenExpiredAt: &expiredAt,
+	}, "token_hash", "token_expired_at")
+	if err != nil {
+		return err
+	}
+
+	return r.sendLoginLink(client, contact, email, token, false)
+}
+
+// Login exchanges a login link token for a client portal token, a login link can only be used once
+func (r *controller) Login(token string, now time.Time) (string, error) {
+	if token == "" {
+		return "", ErrInvalidLoginLink
+	}
+
+	tokenHash := hashLoginToken(token)
+	access, err := r.store.ClientPortalAccess.OneByTokenHash(r.repo.DB(), tokenHash)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", ErrInvalidLoginLink
+		}
+		return "", err
+	}
+
+	if !access.IsTokenUsable(now) {
+		return "", ErrInvalidLoginLink
+	}
+
+	ok, err := r.store.ClientPortalAccess.MarkTokenUsed(r.repo.DB(), access.ID.String(), tokenHash, now)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", ErrInvalidLoginLink
+	}
+
+	return authutils.GenerateClientPortalToken(r.config, &model.ClientPortalClaims{
+		AccessID:       access.ID.String(),
+		ContactID:      access.ClientContactID.String(),
+		ClientID:       access.ClientID.String(),
+		SessionVersion: access.SessionVersion,
+	}, now.Add(accessTokenTTL))
+}
+
+func (r *controller) sendLoginLink(client *model.Client, contact *model.ClientContact, email string, token string, isInvitation bool) error {
+	return r.service.GoogleMail.SendClientPortalMail(&model.ClientPortalEmail{
+		Email:        email,
+		Name:         contact.Name,
+		ClientName:   client.Name,
+		Link:         fmt.Sprintf("%s/login?token=%s", r.config.ClientPortal.URL, url.QueryEscape(token)),
+		IsInvitation: isInvitation,
+	})
+}
+
+// getContact gets a contact of a client with its portal access
+func (r *controller) getContact(clientID string, contactID string) (*model.Client, *model.ClientContact, error) {
+	client, err := r.store.Client.One(r.repo.DB(), clientID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, ErrClientNotFound
+		}
+		return nil, nil, err
+	}
+
+	for i := range client.Contacts {
+		if client.Contacts[i].ID.String() == contactID {
+			return client, &client.Contacts[i], nil
+		}
+	}
+
+	return nil, nil, ErrClientContactNotFound
+}
+
+// contactEmail returns the email of a contact to send the login links to
+func contactEmail(contact *model.ClientContact, email string) (string, error) {
+	emails := contact.GetEmails()
+	if len(emails) == 0 {
+		return "", ErrContactEmailRequired
+	}
+
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return strings.ToLower(emails[0]), nil
+	}
+
+	for _, e := range emails {
+		if strings.EqualFold(e, email) {
+			return strings.ToLower(e), nil
+		}
+	}
+
+	return "", ErrInvalidContactEmail
+}
+
+func hashLoginToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,14 @@
+package clientportal
+
+import "errors"
+
+var (
+	ErrClientNotFound        = errors.New("client not found")
+	ErrClientContactNotFound = errors.New("client contact not found")
+	ErrContactEmailRequired  = errors.New("client contact has no email")
+	ErrInvalidContactEmail   = errors.New("email is not an email of the client contact")
+	ErrAccessNotFound        = errors.New("client portal access not found")
+	ErrAccessRevoked         = errors.New("client portal access is revoked")
+	ErrInvalidLoginLink      = errors.New("login link is invalid or expired")
+	ErrProjectNotFound       = errors.New("project not found")
+)
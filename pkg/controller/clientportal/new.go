@@ -0,0 +1,42 @@
+package clientportal
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type controller struct {
+	store   *store.Store
+	service *service.Service
+	logger  logger.Logger
+	repo    store.DBRepo
+	config  *config.Config
+}
+
+func New(store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:   store,
+		repo:    repo,
+		service: service,
+		logger:  logger,
+		config:  cfg,
+	}
+}
+
+type IController interface {
+	IssueAccess(input IssueAccessInput) (access *model.ClientPortalAccess, err error)
+	RevokeAccess(clientID string, contactID string, now time.Time) error
+	RequestLoginLink(email string, now time.Time) error
+	Login(token string, now time.Time) (accessToken string, err error)
+
+	Me(accessID string) (me *Me, err error)
+	GetProjects(accessID string) (projects []*model.Project, err error)
+	GetProject(accessID string, projectID string) (project *ProjectDetail, err error)
+	GetInvoices(accessID string, projectID string) (invoices []*model.Invoice, err error)
+	GetChangelogs(accessID string, projectID string) (changelogs []*model.ProjectChangelog, err error)
+}
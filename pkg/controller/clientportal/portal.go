@@ -0,0 +1,159 @@
+package clientportal
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// clientInvoiceStatuses are the statuses of the invoices a client has received
+var clientInvoiceStatuses = []model.InvoiceStatus{
+	model.InvoiceStatusSent,
+	model.InvoiceStatusOverdue,
+	model.InvoiceStatusPaid,
+}
+
+// Me is the client contact using the client portal
+type Me struct {
+	Access  *model.ClientPortalAccess
+	Client  *model.Client
+	Contact *model.ClientContact
+}
+
+// ProjectDetail is a project as seen by its client, with its active members and its milestones
+type ProjectDetail struct {
+	Project    *model.Project
+	Members    []*model.ProjectMember
+	Milestones []*model.ProjectBudgetPhase
+}
+
+// Me gets the client contact of an access
+func (r *controller) Me(accessID string) (*Me, error) {
+	access, err := r.getAccess(accessID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, contact, err := r.getContact(access.ClientID.String(), access.ClientContactID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &Me{
+		Access:  access,
+		Client:  client,
+		Contact: contact,
+	}, nil
+}
+
+// GetProjects gets the projects of the client of an access
+func (r *controller) GetProjects(accessID string) ([]*model.Project, error) {
+	access, err := r.getAccess(accessID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.store.Project.GetByClientID(r.repo.DB(), access.ClientID.String())
+}
+
+// GetProject gets a project of the client of an access with its active members and the phases of its budget as milestones
+func (r *controller) GetProject(accessID string, projectID string) (*ProjectDetail, error) {
+	project, err := r.getProject(accessID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := r.store.ProjectMember.GetAssignedMembers(r.repo.DB(), project.ID.String(), model.ProjectMemberStatusActive.String(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	leads, err := r.store.ProjectHead.GetActiveLeadsByProjectID(r.repo.DB(), project.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range members {
+		for _, h := range leads {
+			if h.EmployeeID == m.EmployeeID && h.IsLead() {
+				m.IsLead = true
+			}
+		}
+	}
+
+	rs := &ProjectDetail{
+		Project: project,
+		Members: members,
+	}
+
+	budget, err := r.store.ProjectBudget.OneByProjectID(r.repo.DB(), project.ID.String())
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if budget != nil {
+		rs.Milestones = budget.Phases
+	}
+
+	return rs, nil
+}
+
+// GetInvoices gets the invoices sent for a project of the client of an access
+func (r *controller) GetInvoices(accessID string, projectID string) ([]*model.Invoice, error) {
+	project, err := r.getProject(accessID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.store.Invoice.GetByProjectID(r.repo.DB(), project.ID.String(), clientInvoiceStatuses)
+}
+
+// GetChangelogs gets the changelogs sent for a project of the client of an access
+func (r *controller) GetChangelogs(accessID string, projectID string) ([]*model.ProjectChangelog, error) {
+	project, err := r.getProject(accessID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.store.ProjectChangelog.GetByProjectID(r.repo.DB(), project.ID.String())
+}
+
+func (r *controller) getAccess(accessID string) (*model.ClientPortalAccess, error) {
+	access, err := r.store.ClientPortalAccess.One(r.repo.DB(), accessID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAccessNotFound
+		}
+		return nil, err
+	}
+	if !access.IsActive() {
+		return nil, ErrAccessRevoked
+	}
+
+	return access, nil
+}
+
+// getProject gets a project of the client of an access, the projects of other clients are not found
+func (r *controller) getProject(accessID string, projectID string) (*model.Project, error) {
+	access, err := r.getAccess(accessID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !model.IsUUIDFromString(projectID) {
+		return nil, ErrProjectNotFound
+	}
+
+	project, err := r.store.Project.One(r.repo.DB(), projectID, false)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrProjectNotFound
+		}
+		return nil, err
+	}
+	if project.ClientID != access.ClientID {
+		return nil, ErrProjectNotFound
+	}
+
+	return project, nil
+}
@@ -2,12 +2,29 @@ package controller
 
 import (
 	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller/allocation"
+	"github.com/dwarvesf/fortress-api/pkg/controller/approval"
+	"github.com/dwarvesf/fortress-api/pkg/controller/auditlog"
 	"github.com/dwarvesf/fortress-api/pkg/controller/auth"
 	"github.com/dwarvesf/fortress-api/pkg/controller/brainerylogs"
+	"github.com/dwarvesf/fortress-api/pkg/controller/budget"
 	"github.com/dwarvesf/fortress-api/pkg/controller/client"
+	"github.com/dwarvesf/fortress-api/pkg/controller/clientportal"
 	"github.com/dwarvesf/fortress-api/pkg/controller/discord"
 	"github.com/dwarvesf/fortress-api/pkg/controller/employee"
 	"github.com/dwarvesf/fortress-api/pkg/controller/invoice"
+	"github.com/dwarvesf/fortress-api/pkg/controller/objective"
+	"github.com/dwarvesf/fortress-api/pkg/controller/oneonone"
+	"github.com/dwarvesf/fortress-api/pkg/controller/performancereview"
+	"github.com/dwarvesf/fortress-api/pkg/controller/profitloss"
+	"github.com/dwarvesf/fortress-api/pkg/controller/project"
+	"github.com/dwarvesf/fortress-api/pkg/controller/projecthealth"
+	"github.com/dwarvesf/fortress-api/pkg/controller/ratecard"
+	"github.com/dwarvesf/fortress-api/pkg/controller/staffing"
+	"github.com/dwarvesf/fortress-api/pkg/controller/surveycampaign"
+	"github.com/dwarvesf/fortress-api/pkg/controller/surveytemplate"
+	"github.com/dwarvesf/fortress-api/pkg/controller/timesheet"
+	"github.com/dwarvesf/fortress-api/pkg/controller/workunit"
 	"github.com/dwarvesf/fortress-api/pkg/logger"
 	"github.com/dwarvesf/fortress-api/pkg/service"
 	"github.com/dwarvesf/fortress-api/pkg/store"
@@ -15,21 +32,58 @@ import (
 )
 
 type Controller struct {
-	Auth        auth.IController
-	BraineryLog brainerylogs.IController
-	Client      client.IController
-	Employee    employee.IController
-	Invoice     invoice.IController
-	Discord     discord.IController
+	Allocation        allocation.IController
+	Approval          approval.IController
+	AuditLog          auditlog.IController
+	Auth              auth.IController
+	BraineryLog       brainerylogs.IController
+	Budget            budget.IController
+	Client            client.IController
+	ClientPortal      clientportal.IController
+	Employee          employee.IController
+	Invoice           invoice.IController
+	Objective         objective.IController
+	OneOnOne          oneonone.IController
+	PerformanceReview performancereview.IController
+	ProfitLoss        profitloss.IController
+	Project           project.IController
+	ProjectHealth     projecthealth.IController
+	RateCard          ratecard.IController
+	Staffing          staffing.IController
+	SurveyCampaign    surveycampaign.IController
+	Timesheet         timesheet.IController
+	SurveyTemplate    surveytemplate.IController
+	WorkUnit          workunit.IController
+	Discord           discord.IController
 }
 
 func New(store *store.Store, repo store.DBRepo, service *service.Service, worker *worker.Worker, logger logger.Logger, cfg *config.Config) *Controller {
+	discordCtrl := discord.New(store, repo, service, logger, cfg)
+	profitLossCtrl := profitloss.New(store, repo, service, logger, cfg)
+
 	return &Controller{
-		Auth:        auth.New(store, repo, service, logger, cfg),
-		BraineryLog: brainerylogs.New(store, repo, service, logger, cfg),
-		Client:      client.New(store, repo, service, logger, cfg),
-		Employee:    employee.New(store, repo, service, logger, cfg),
-		Invoice:     invoice.New(store, repo, service, worker, logger, cfg),
-		Discord:     discord.New(store, repo, service, logger, cfg),
+		Allocation:        allocation.New(store, repo, service, logger, cfg),
+		Approval:          approval.New(store, repo, service, discordCtrl, logger, cfg),
+		AuditLog:          auditlog.New(store, repo, service, logger, cfg),
+		Auth:              auth.New(store, repo, service, logger, cfg),
+		BraineryLog:       brainerylogs.New(store, repo, service, logger, cfg),
+		Budget:            budget.New(store, repo, service, profitLossCtrl, logger, cfg),
+		Client:            client.New(store, repo, service, logger, cfg),
+		ClientPortal:      clientportal.New(store, repo, service, logger, cfg),
+		Employee:          employee.New(store, repo, service, logger, cfg),
+		Invoice:           invoice.New(store, repo, service, worker, logger, cfg),
+		Objective:         objective.New(store, repo, service, logger, cfg),
+		OneOnOne:          oneonone.New(store, repo, service, logger, cfg),
+		PerformanceReview: performancereview.New(store, repo, service, logger, cfg),
+		ProfitLoss:        profitLossCtrl,
+		Project:           project.New(store, repo, service, logger, cfg),
+		ProjectHealth:     projecthealth.New(store, repo, service, logger, cfg),
+		RateCard:          ratecard.New(store, repo, service, logger, cfg),
+		Staffing:          staffing.New(store, repo, service, discordCtrl, logger, cfg),
+		SurveyCampaign:    surveycampaign.New(store, repo, service, logger, cfg),
+		Timesheet:         timesheet.New(store, repo, service, logger, cfg),
+		SurveyTemplate:    surveytemplate.New(store, repo, service, logger, cfg),
+		WorkUnit:          workunit.New(store, repo, service, logger, cfg),
+		Discord:           discordCtrl,
 	}
 }
@@ -5,6 +5,7 @@ import (
 )
 
 var (
+	ErrEncryptionDisabled                         = errors.New("field encryption is not configured")
 	ErrCannotSelfReferral                         = errors.New("cannot self referral")
 	ErrCantFindLineManager                        = errors.New("can't find line manager with the input id")
 	ErrChapterNotFound                            = errors.New("chapter not found")
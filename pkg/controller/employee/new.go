@@ -38,6 +38,7 @@ type IController interface {
 	UpdatePersonalInfo(employeeID string, body UpdatePersonalInfoInput) (employee *model.Employee, err error)
 	UploadAvatar(uuidUserID model.UUID, file *multipart.FileHeader, params UploadAvatarInput) (filePath string, err error)
 	UpdateRole(userID string, input UpdateRoleInput) (err error)
+	ReencryptPII() (updated int, err error)
 	GetLineManagers(userInfo *model.CurrentLoggedUserInfo) (employees []*model.Employee, err error)
 	UpdateBaseSalary(l logger.Logger, employeeID string, body UpdateBaseSalaryInput) (employee *model.BaseSalary, err error)
 	ListWithLocation() (employees []*model.Employee, err error)
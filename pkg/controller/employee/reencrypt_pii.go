@@ -0,0 +1,78 @@
+package employee
+
+import (
+	"fmt"
+
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/utils/cryptoutils"
+)
+
+// ReencryptPII encrypts employee PII which is still in plain text or was encrypted
+// with an old key, it is run after rotating the current key
+func (r *controller) ReencryptPII() (int, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "employee",
+		"method":     "ReencryptPII",
+	})
+
+	k := cryptoutils.DefaultKeyring()
+	if k == nil {
+		return 0, ErrEncryptionDisabled
+	}
+
+	// left employees are kept for payroll history, so they are rotated as well
+	db := r.repo.DB().Unscoped()
+
+	rows, err := r.store.Employee.GetRawColumns(db, model.EmployeeEncryptedColumns)
+	if err != nil {
+		return 0, err
+	}
+
+	updated := 0
+	for _, row := range rows {
+		if !needsRotation(k, row) {
+			continue
+		}
+
+		id := toString(row["id"])
+		emp, err := r.store.Employee.One(db, id, false)
+		if err != nil {
+			l.Errorf(err, "failed to get employee", "id", id)
+			return updated, err
+		}
+
+		// saving the decrypted values encrypts them again with the current key
+		if _, err := r.store.Employee.UpdateSelectedFieldsByID(db, id, *emp, model.EmployeeEncryptedColumns...); err != nil {
+			l.Errorf(err, "failed to re-encrypt employee", "id", id)
+			return updated, err
+		}
+
+		updated++
+	}
+
+	return updated, nil
+}
+
+func needsRotation(k *cryptoutils.Keyring, row map[string]interface{}) bool {
+	for _, column := range model.EmployeeEncryptedColumns {
+		if k.NeedsRotation(toString(row[column])) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func toString(v interface{}) string {
+	switch o := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return o
+	case []byte:
+		return string(o)
+	default:
+		return fmt.Sprintf("%v", o)
+	}
+}
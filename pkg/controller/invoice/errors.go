@@ -4,6 +4,7 @@ import "errors"
 
 var (
 	ErrBankAccountNotFound             = errors.New("bank account not found")
+	ErrBudgetPhaseNotFound             = errors.New("budget phase not found in the project")
 	ErrCouldNotGetTheLatestInvoice     = errors.New("could not get the latest invoice")
 	ErrCouldNotGetTheNextInvoiceNumber = errors.New("could not get the next invoice number")
 	ErrInvoiceNotFound                 = errors.New("invoice not found")
@@ -69,6 +69,26 @@ func (c *controller) Send(iv *model.Invoice) (*model.Invoice, error) {
 	}
 	iv.Project = p
 
+	// check the budget phase belongs to the project
+	if iv.BudgetPhaseID != nil {
+		budget, err := c.store.ProjectBudget.OneByProjectID(c.repo.DB(), p.ID.String())
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			l.Error(err, "failed to get project budget")
+			return nil, err
+		}
+
+		found := false
+		if budget != nil {
+			for _, phase := range budget.Phases {
+				found = found || phase.ID == *iv.BudgetPhaseID
+			}
+		}
+		if !found {
+			l.Error(ErrBudgetPhaseNotFound, "budget phase not found")
+			return nil, ErrBudgetPhaseNotFound
+		}
+	}
+
 	nextInvoiceNumber, err := c.store.Invoice.GetNextInvoiceNumber(c.repo.DB(), now.Year(), p.Code)
 	if err != nil {
 		l.Error(err, "failed to get next invoice Number")
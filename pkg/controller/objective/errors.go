@@ -0,0 +1,24 @@
+package objective
+
+import "errors"
+
+var (
+	ErrObjectiveNotFound      = errors.New("objective not found")
+	ErrParentNotFound         = errors.New("parent objective not found")
+	ErrKeyResultNotFound      = errors.New("key result not found")
+	ErrOwnerNotFound          = errors.New("owner not found")
+	ErrEmployeeNotFound       = errors.New("employee not found")
+	ErrProjectNotFound        = errors.New("project not found")
+	ErrChapterNotFound        = errors.New("chapter not found")
+	ErrInvalidLevel           = errors.New("invalid objective level")
+	ErrInvalidStatus          = errors.New("invalid objective status")
+	ErrMissingLevelTarget     = errors.New("chapter, project and individual objectives need their chapter, project or employee")
+	ErrInvalidPeriod          = errors.New("end date must be after start date")
+	ErrInvalidCheckInInterval = errors.New("check-in interval must be at least 1 day")
+	ErrInvalidParent          = errors.New("an objective can not be aligned to itself, one of its own objectives or a cancelled objective")
+	ErrObjectiveNotActive     = errors.New("objective is not active")
+	ErrInvalidConfidence      = errors.New("confidence must be between 1 and 10")
+	ErrNotAllowedToCreate     = errors.New("only individual objectives of your own can be created without permission")
+	ErrNotAllowedToEdit       = errors.New("only the owner can edit the objective")
+	ErrNotAllowedToCheckIn    = errors.New("only the owner of the key result or of the objective can check in")
+)
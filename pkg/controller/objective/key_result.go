@@ -0,0 +1,189 @@
+package objective
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type KeyResultInput struct {
+	ObjectiveID string
+	ID          string
+	Title       string
+	OwnerID     string
+	Unit        string
+	StartValue  float64
+	TargetValue float64
+	UserID      string
+	CanEdit     bool
+}
+
+// CreateKeyResult adds a key result to an objective, it starts at its start value.
+// The owner of the key result defaults to the owner of the objective
+func (r *controller) CreateKeyResult(input KeyResultInput) (*model.KeyResult, error) {
+	o, err := r.getEditableObjective(input.ObjectiveID, input.UserID, input.CanEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	kr := &model.KeyResult{
+		BaseModel:    model.BaseModel{ID: model.NewUUID()},
+		ObjectiveID:  o.ID,
+		CurrentValue: input.StartValue,
+	}
+	if err := r.setKeyResultInput(kr, o, input); err != nil {
+		return nil, err
+	}
+
+	if _, err := r.store.Objective.CreateKeyResult(r.repo.DB(), kr); err != nil {
+		return nil, err
+	}
+
+	return kr, nil
+}
+
+// UpdateKeyResult updates the definition of a key result, its current value only changes by check-ins
+func (r *controller) UpdateKeyResult(input KeyResultInput) (*model.KeyResult, error) {
+	o, err := r.getEditableObjective(input.ObjectiveID, input.UserID, input.CanEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	kr, err := r.getKeyResult(input.ObjectiveID, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.setKeyResultInput(kr, o, input); err != nil {
+		return nil, err
+	}
+
+	_, err = r.store.Objective.UpdateKeyResultSelectedFieldsByID(r.repo.DB(), input.ID, *kr,
+		"title",
+		"owner_id",
+		"unit",
+		"start_value",
+		"target_value",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return kr, nil
+}
+
+type CheckInInput struct {
+	ObjectiveID string
+	KeyResultID string
+	Value       float64
+	Confidence  int
+	Note        string
+	UserID      string
+	CanEdit     bool
+}
+
+// CheckIn records the current value of a key result in its progress history
+func (r *controller) CheckIn(input CheckInInput) (*model.KeyResultCheckIn, error) {
+	if input.Confidence != 0 && (input.Confidence < 1 || input.Confidence > 10) {
+		return nil, ErrInvalidConfidence
+	}
+
+	o, err := r.getObjective(input.ObjectiveID)
+	if err != nil {
+		return nil, err
+	}
+	if o.Status != model.ObjectiveStatusActive {
+		return nil, ErrObjectiveNotActive
+	}
+
+	kr, err := r.getKeyResult(input.ObjectiveID, input.KeyResultID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !input.CanEdit && kr.OwnerID.String() != input.UserID && o.OwnerID.String() != input.UserID {
+		return nil, ErrNotAllowedToCheckIn
+	}
+
+	tx, done := r.repo.NewTransaction()
+
+	checkIn := &model.KeyResultCheckIn{
+		BaseModel:   model.BaseModel{ID: model.NewUUID()},
+		KeyResultID: kr.ID,
+		EmployeeID:  model.MustGetUUIDFromString(input.UserID),
+		Value:       input.Value,
+		Progress:    kr.ProgressAt(input.Value),
+		Confidence:  input.Confidence,
+		Note:        input.Note,
+	}
+	if _, err := r.store.Objective.CreateCheckIn(tx.DB(), checkIn); err != nil {
+		return nil, done(err)
+	}
+
+	now := time.Now()
+	kr.CurrentValue = input.Value
+	kr.LastCheckInAt = &now
+	if _, err := r.store.Objective.UpdateKeyResultSelectedFieldsByID(tx.DB(), kr.ID.String(), *kr, "current_value", "last_check_in_at"); err != nil {
+		return nil, done(err)
+	}
+
+	return checkIn, done(nil)
+}
+
+// ListCheckIns returns the progress history of a key result, latest first
+func (r *controller) ListCheckIns(objectiveID string, keyResultID string, pagination model.Pagination) ([]*model.KeyResultCheckIn, int64, error) {
+	if _, err := r.getKeyResult(objectiveID, keyResultID); err != nil {
+		return nil, 0, err
+	}
+
+	return r.store.Objective.GetCheckIns(r.repo.DB(), keyResultID, pagination)
+}
+
+func (r *controller) setKeyResultInput(kr *model.KeyResult, o *model.Objective, input KeyResultInput) error {
+	kr.Title = input.Title
+	kr.Unit = input.Unit
+	kr.StartValue = input.StartValue
+	kr.TargetValue = input.TargetValue
+	kr.OwnerID = o.OwnerID
+
+	if input.OwnerID != "" {
+		owner, err := r.store.Employee.One(r.repo.DB(), input.OwnerID, false)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrOwnerNotFound
+			}
+			return err
+		}
+		kr.OwnerID = owner.ID
+	}
+
+	return nil
+}
+
+func (r *controller) getEditableObjective(id string, userID string, canEdit bool) (*model.Objective, error) {
+	o, err := r.getObjective(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !canEdit && o.OwnerID.String() != userID {
+		return nil, ErrNotAllowedToEdit
+	}
+
+	return o, nil
+}
+
+func (r *controller) getKeyResult(objectiveID string, id string) (*model.KeyResult, error) {
+	kr, err := r.store.Objective.OneKeyResult(r.repo.DB(), objectiveID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrKeyResultNotFound
+		}
+		return nil, err
+	}
+
+	return kr, nil
+}
@@ -0,0 +1,43 @@
+package objective
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type controller struct {
+	store   *store.Store
+	service *service.Service
+	logger  logger.Logger
+	repo    store.DBRepo
+	config  *config.Config
+}
+
+func New(store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:   store,
+		repo:    repo,
+		service: service,
+		logger:  logger,
+		config:  cfg,
+	}
+}
+
+type IController interface {
+	Create(input CreateInput) (objective *model.Objective, err error)
+	List(input ListInput) (objectives []*model.Objective, total int64, err error)
+	Detail(id string) (objective *model.Objective, err error)
+	Update(input UpdateInput) (objective *model.Objective, err error)
+	EmployeeGoals(employeeID string, from, to *time.Time) (objectives []*model.Objective, err error)
+
+	CreateKeyResult(input KeyResultInput) (keyResult *model.KeyResult, err error)
+	UpdateKeyResult(input KeyResultInput) (keyResult *model.KeyResult, err error)
+	CheckIn(input CheckInInput) (checkIn *model.KeyResultCheckIn, err error)
+	ListCheckIns(objectiveID string, keyResultID string, pagination model.Pagination) (checkIns []*model.KeyResultCheckIn, total int64, err error)
+	SendCheckInReminders() (sent int, err error)
+}
@@ -0,0 +1,335 @@
+package objective
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/objective"
+)
+
+const defaultCheckInIntervalDays = 7
+
+type ObjectiveInput struct {
+	Title               string
+	Description         string
+	Level               model.ObjectiveLevel
+	ParentID            string
+	OwnerID             string
+	ProjectID           string
+	ChapterID           string
+	EmployeeID          string
+	StartDate           time.Time
+	EndDate             time.Time
+	CheckInIntervalDays int
+}
+
+type CreateInput struct {
+	ObjectiveInput
+
+	UserID string
+	// CanCreate is true when the user can create any objective, others only create their own individual objectives
+	CanCreate bool
+}
+
+// Create creates an active objective, an individual objective belongs to its owner when no employee is given
+func (r *controller) Create(input CreateInput) (*model.Objective, error) {
+	o := &model.Objective{
+		BaseModel: model.BaseModel{ID: model.NewUUID()},
+		Status:    model.ObjectiveStatusActive,
+	}
+	if input.OwnerID == "" {
+		input.OwnerID = input.UserID
+	}
+	if input.Level == model.ObjectiveLevelIndividual && input.EmployeeID == "" {
+		input.EmployeeID = input.OwnerID
+	}
+
+	isOwnIndividual := input.Level == model.ObjectiveLevelIndividual && input.EmployeeID == input.UserID && input.OwnerID == input.UserID
+	if !input.CanCreate && !isOwnIndividual {
+		return nil, ErrNotAllowedToCreate
+	}
+
+	if err := r.setObjectiveInput(o, input.ObjectiveInput); err != nil {
+		return nil, err
+	}
+
+	creatorID, err := model.UUIDFromString(input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	o.CreatedBy = creatorID
+
+	if _, err := r.store.Objective.Create(r.repo.DB(), o); err != nil {
+		return nil, err
+	}
+
+	return r.Detail(o.ID.String())
+}
+
+type ListInput struct {
+	model.Pagination
+
+	Level      string
+	Status     string
+	ParentID   string
+	OwnerID    string
+	ProjectID  string
+	ChapterID  string
+	EmployeeID string
+	From       *time.Time
+	To         *time.Time
+}
+
+func (r *controller) List(input ListInput) ([]*model.Objective, int64, error) {
+	objectives, total, err := r.store.Objective.All(r.repo.DB(), objective.Filter{
+		Level:      input.Level,
+		Status:     input.Status,
+		ParentID:   input.ParentID,
+		OwnerID:    input.OwnerID,
+		ProjectID:  input.ProjectID,
+		ChapterID:  input.ChapterID,
+		EmployeeID: input.EmployeeID,
+		From:       input.From,
+		To:         input.To,
+	}, input.Pagination)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := r.rollUpProgress(objectives); err != nil {
+		return nil, 0, err
+	}
+
+	return objectives, total, nil
+}
+
+// Detail returns an objective with its key results and its progress rolled up from the objectives aligned to it
+func (r *controller) Detail(id string) (*model.Objective, error) {
+	o, err := r.getObjective(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.rollUpProgress([]*model.Objective{o}); err != nil {
+		return nil, err
+	}
+
+	return o, nil
+}
+
+type UpdateInput struct {
+	ObjectiveInput
+
+	ID     string
+	Status model.ObjectiveStatus
+	UserID string
+	// CanEdit is true when the user can edit any objective, others only edit the objectives they own
+	CanEdit bool
+}
+
+// Update updates an objective, the level and what it belongs to can change as well
+func (r *controller) Update(input UpdateInput) (*model.Objective, error) {
+	if !input.Status.IsValid() {
+		return nil, ErrInvalidStatus
+	}
+
+	o, err := r.getObjective(input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !input.CanEdit && o.OwnerID.String() != input.UserID {
+		return nil, ErrNotAllowedToEdit
+	}
+
+	if input.OwnerID == "" {
+		input.OwnerID = o.OwnerID.String()
+	}
+	if input.Level == model.ObjectiveLevelIndividual && input.EmployeeID == "" {
+		input.EmployeeID = input.OwnerID
+	}
+
+	if err := r.setObjectiveInput(o, input.ObjectiveInput); err != nil {
+		return nil, err
+	}
+	o.Status = input.Status
+
+	_, err = r.store.Objective.UpdateSelectedFieldsByID(r.repo.DB(), input.ID, *o,
+		"title",
+		"description",
+		"level",
+		"status",
+		"parent_id",
+		"owner_id",
+		"project_id",
+		"chapter_id",
+		"employee_id",
+		"start_date",
+		"end_date",
+		"check_in_interval_days",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Detail(input.ID)
+}
+
+// EmployeeGoals returns the individual objectives of an employee which are not cancelled, overlapping the given period
+func (r *controller) EmployeeGoals(employeeID string, from, to *time.Time) ([]*model.Objective, error) {
+	objectives, _, err := r.List(ListInput{
+		Level:      model.ObjectiveLevelIndividual.String(),
+		EmployeeID: employeeID,
+		From:       from,
+		To:         to,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make([]*model.Objective, 0, len(objectives))
+	for _, o := range objectives {
+		if o.Status != model.ObjectiveStatusCancelled {
+			rs = append(rs, o)
+		}
+	}
+
+	return rs, nil
+}
+
+// setObjectiveInput validates the input and sets it to the objective, the chapter, project and employee
+// are only kept for the level they belong to
+func (r *controller) setObjectiveInput(o *model.Objective, input ObjectiveInput) error {
+	db := r.repo.DB()
+
+	if !input.Level.IsValid() {
+		return ErrInvalidLevel
+	}
+	if !input.EndDate.After(input.StartDate) {
+		return ErrInvalidPeriod
+	}
+	if input.CheckInIntervalDays == 0 {
+		input.CheckInIntervalDays = defaultCheckInIntervalDays
+	}
+	if input.CheckInIntervalDays < 1 {
+		return ErrInvalidCheckInInterval
+	}
+
+	o.Title = input.Title
+	o.Description = input.Description
+	o.Level = input.Level
+	o.StartDate = input.StartDate
+	o.EndDate = input.EndDate
+	o.CheckInIntervalDays = input.CheckInIntervalDays
+	o.ProjectID = model.UUID{}
+	o.ChapterID = model.UUID{}
+	o.EmployeeID = model.UUID{}
+
+	owner, err := r.store.Employee.One(db, input.OwnerID, false)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrOwnerNotFound
+		}
+		return err
+	}
+	o.OwnerID = owner.ID
+
+	switch input.Level {
+	case model.ObjectiveLevelChapter:
+		if input.ChapterID == "" {
+			return ErrMissingLevelTarget
+		}
+		exists, err := r.store.Chapter.IsExist(db, input.ChapterID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return ErrChapterNotFound
+		}
+		o.ChapterID = model.MustGetUUIDFromString(input.ChapterID)
+
+	case model.ObjectiveLevelProject:
+		if input.ProjectID == "" {
+			return ErrMissingLevelTarget
+		}
+		exists, err := r.store.Project.IsExist(db, input.ProjectID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return ErrProjectNotFound
+		}
+		o.ProjectID = model.MustGetUUIDFromString(input.ProjectID)
+
+	case model.ObjectiveLevelIndividual:
+		employee, err := r.store.Employee.One(db, input.EmployeeID, false)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrEmployeeNotFound
+			}
+			return err
+		}
+		o.EmployeeID = employee.ID
+	}
+
+	o.ParentID = model.UUID{}
+	if input.ParentID != "" {
+		if input.ParentID == o.ID.String() {
+			return ErrInvalidParent
+		}
+
+		parent, err := r.store.Objective.One(db, input.ParentID, false)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrParentNotFound
+			}
+			return err
+		}
+		if parent.Status == model.ObjectiveStatusCancelled {
+			return ErrInvalidParent
+		}
+
+		descendants, err := r.store.Objective.GetDescendants(db, o.ID.String())
+		if err != nil {
+			return err
+		}
+		for _, d := range descendants {
+			if d.ID == parent.ID {
+				return ErrInvalidParent
+			}
+		}
+
+		o.ParentID = parent.ID
+	}
+
+	return nil
+}
+
+// rollUpProgress sets the progress of the objectives from their key results and the objectives aligned to them
+func (r *controller) rollUpProgress(objectives []*model.Objective) error {
+	for _, o := range objectives {
+		descendants, err := r.store.Objective.GetDescendants(r.repo.DB(), o.ID.String())
+		if err != nil {
+			return err
+		}
+
+		o.Progress = model.RollUpObjectiveProgress(append([]*model.Objective{o}, descendants...))[o.ID]
+	}
+
+	return nil
+}
+
+func (r *controller) getObjective(id string) (*model.Objective, error) {
+	o, err := r.store.Objective.One(r.repo.DB(), id, true)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrObjectiveNotFound
+		}
+		return nil, err
+	}
+
+	return o, nil
+}
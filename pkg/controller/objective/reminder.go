@@ -0,0 +1,70 @@
+package objective
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// SendCheckInReminders reminds owners of key results which are due for a check-in by Discord DM,
+// owners with several due key results get a single message
+func (r *controller) SendCheckInReminders() (int, error) {
+	l := r.logger.AddField("method", "SendCheckInReminders")
+
+	keyResults, err := r.store.Objective.GetCheckInDueKeyResults(r.repo.DB())
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	byOwner := map[model.UUID][]*model.KeyResult{}
+	var ownerIDs []model.UUID
+	for _, kr := range keyResults {
+		if kr.Objective == nil || kr.Owner == nil || !kr.IsCheckInReminderDue(kr.Objective.CheckInIntervalDays, now) {
+			continue
+		}
+		if _, ok := byOwner[kr.OwnerID]; !ok {
+			ownerIDs = append(ownerIDs, kr.OwnerID)
+		}
+		byOwner[kr.OwnerID] = append(byOwner[kr.OwnerID], kr)
+	}
+
+	sent := 0
+	for _, ownerID := range ownerIDs {
+		krs := byOwner[ownerID]
+		owner := krs[0].Owner
+
+		if owner.DiscordAccount == nil || owner.DiscordAccount.DiscordID == "" {
+			l.AddField("ownerID", ownerID).Info("owner has no discord account to be reminded")
+			continue
+		}
+
+		if _, err := r.service.Discord.SendDirectMessage(owner.DiscordAccount.DiscordID, r.checkInReminderMessage(owner, krs)); err != nil {
+			l.AddField("ownerID", ownerID).Error(err, "failed to send check-in reminder")
+			continue
+		}
+
+		for _, kr := range krs {
+			kr.LastRemindedAt = &now
+			if _, err := r.store.Objective.UpdateKeyResultSelectedFieldsByID(r.repo.DB(), kr.ID.String(), *kr, "last_reminded_at"); err != nil {
+				return sent, err
+			}
+		}
+		sent++
+	}
+
+	return sent, nil
+}
+
+func (r *controller) checkInReminderMessage(owner *model.Employee, keyResults []*model.KeyResult) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Hi %s, it is time to check in on your key results:\n", owner.DisplayName))
+	for _, kr := range keyResults {
+		sb.WriteString(fmt.Sprintf("• **%s** (%s): %.0f%%\n", kr.Title, kr.Objective.Title, kr.Progress()*100))
+	}
+	sb.WriteString(fmt.Sprintf("%s/okrs", r.config.FortressURL))
+
+	return sb.String()
+}
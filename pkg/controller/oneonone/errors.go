@@ -0,0 +1,19 @@
+package oneonone
+
+import "errors"
+
+var (
+	ErrMeetingNotFound         = errors.New("1:1 meeting not found")
+	ErrReportNotFound          = errors.New("report not found")
+	ErrAgendaItemNotFound      = errors.New("agenda item not found")
+	ErrActionItemNotFound      = errors.New("action item not found")
+	ErrInvalidPeriod           = errors.New("end time must be after start time")
+	ErrInvalidStatus           = errors.New("invalid 1:1 meeting status")
+	ErrInvalidActionItemStatus = errors.New("invalid action item status")
+	ErrMeetingCancelled        = errors.New("1:1 meeting is cancelled")
+	ErrOwnerNotParticipant     = errors.New("action item owner must be the manager or the report of the meeting")
+	ErrNotLineManager          = errors.New("only the line manager of the employee can schedule 1:1 meetings with them")
+	ErrNotManager              = errors.New("only the manager of the meeting can do this")
+	ErrNotParticipant          = errors.New("only the manager and the report of the meeting can do this")
+	ErrNotAuthor               = errors.New("only the author of the agenda item can change its content")
+)
@@ -0,0 +1,174 @@
+package oneonone
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type AgendaItemInput struct {
+	MeetingID   string
+	ID          string
+	Content     string
+	IsDiscussed bool
+	UserID      string
+}
+
+type ActionItemInput struct {
+	MeetingID string
+	ID        string
+	Content   string
+	// OwnerID is the manager or the report, the user owns the action item when it is empty
+	OwnerID string
+	DueDate *time.Time
+	Status  model.OneOnOneActionItemStatus
+	UserID  string
+}
+
+// CreateAgendaItem adds a topic to the shared agenda of a meeting
+func (r *controller) CreateAgendaItem(input AgendaItemInput) (*model.OneOnOneAgendaItem, error) {
+	meeting, err := r.getParticipatedMeeting(input.MeetingID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if meeting.Status == model.OneOnOneStatusCancelled {
+		return nil, ErrMeetingCancelled
+	}
+
+	item := &model.OneOnOneAgendaItem{
+		BaseModel:   model.BaseModel{ID: model.NewUUID()},
+		MeetingID:   meeting.ID,
+		AuthorID:    model.MustGetUUIDFromString(input.UserID),
+		Content:     input.Content,
+		IsDiscussed: input.IsDiscussed,
+	}
+	if _, err := r.store.OneOnOne.CreateAgendaItem(r.repo.DB(), item); err != nil {
+		return nil, err
+	}
+
+	return r.store.OneOnOne.OneAgendaItem(r.repo.DB(), input.MeetingID, item.ID.String())
+}
+
+// UpdateAgendaItem marks an agenda item as discussed, only its author can change its content
+func (r *controller) UpdateAgendaItem(input AgendaItemInput) (*model.OneOnOneAgendaItem, error) {
+	if _, err := r.getParticipatedMeeting(input.MeetingID, input.UserID); err != nil {
+		return nil, err
+	}
+
+	item, err := r.store.OneOnOne.OneAgendaItem(r.repo.DB(), input.MeetingID, input.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAgendaItemNotFound
+		}
+		return nil, err
+	}
+
+	if item.Content != input.Content && item.AuthorID.String() != input.UserID {
+		return nil, ErrNotAuthor
+	}
+
+	item.Content = input.Content
+	item.IsDiscussed = input.IsDiscussed
+	if _, err := r.store.OneOnOne.UpdateAgendaItemSelectedFieldsByID(r.repo.DB(), input.ID, *item, "content", "is_discussed"); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// CreateActionItem adds a follow-up to a meeting, it stays open in the next meetings until it is done
+func (r *controller) CreateActionItem(input ActionItemInput) (*model.OneOnOneActionItem, error) {
+	meeting, err := r.getParticipatedMeeting(input.MeetingID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if meeting.Status == model.OneOnOneStatusCancelled {
+		return nil, ErrMeetingCancelled
+	}
+
+	if input.OwnerID == "" {
+		input.OwnerID = input.UserID
+	}
+	if !meeting.IsParticipant(input.OwnerID) {
+		return nil, ErrOwnerNotParticipant
+	}
+
+	item := &model.OneOnOneActionItem{
+		BaseModel: model.BaseModel{ID: model.NewUUID()},
+		MeetingID: meeting.ID,
+		Status:    model.OneOnOneActionItemStatusOpen,
+	}
+	setActionItemInput(item, input)
+
+	if _, err := r.store.OneOnOne.CreateActionItem(r.repo.DB(), item); err != nil {
+		return nil, err
+	}
+
+	return r.store.OneOnOne.OneActionItem(r.repo.DB(), input.MeetingID, item.ID.String())
+}
+
+// UpdateActionItem updates an action item of a meeting, either participant can close it
+func (r *controller) UpdateActionItem(input ActionItemInput) (*model.OneOnOneActionItem, error) {
+	if !input.Status.IsValid() {
+		return nil, ErrInvalidActionItemStatus
+	}
+
+	meeting, err := r.getParticipatedMeeting(input.MeetingID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.OwnerID == "" {
+		input.OwnerID = input.UserID
+	}
+	if !meeting.IsParticipant(input.OwnerID) {
+		return nil, ErrOwnerNotParticipant
+	}
+
+	item, err := r.store.OneOnOne.OneActionItem(r.repo.DB(), input.MeetingID, input.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrActionItemNotFound
+		}
+		return nil, err
+	}
+
+	switch {
+	case input.Status == model.OneOnOneActionItemStatusDone && item.Status != model.OneOnOneActionItemStatusDone:
+		now := time.Now()
+		item.CompletedAt = &now
+	case input.Status == model.OneOnOneActionItemStatusOpen:
+		item.CompletedAt = nil
+	}
+	item.Status = input.Status
+	setActionItemInput(item, input)
+
+	if _, err := r.store.OneOnOne.UpdateActionItemSelectedFieldsByID(r.repo.DB(), input.ID, *item,
+		"content", "owner_id", "due_date", "status", "completed_at"); err != nil {
+		return nil, err
+	}
+
+	return r.store.OneOnOne.OneActionItem(r.repo.DB(), input.MeetingID, input.ID)
+}
+
+func (r *controller) getParticipatedMeeting(id string, userID string) (*model.OneOnOneMeeting, error) {
+	meeting, err := r.getMeeting(id, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if !meeting.IsParticipant(userID) {
+		return nil, ErrNotParticipant
+	}
+
+	return meeting, nil
+}
+
+func setActionItemInput(item *model.OneOnOneActionItem, input ActionItemInput) {
+	item.Content = input.Content
+	item.OwnerID = model.MustGetUUIDFromString(input.OwnerID)
+	item.DueDate = input.DueDate
+}
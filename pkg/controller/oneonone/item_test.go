@@ -0,0 +1,17 @@
+package oneonone
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestController_CreateItem_NotParticipant(t *testing.T) {
+	ctrl := newTestController(&fakeOneOnOneStore{meeting: newTestMeeting()})
+
+	_, err := ctrl.CreateAgendaItem(AgendaItemInput{MeetingID: meetingID, Content: "career path", UserID: outsideID})
+	require.ErrorIs(t, err, ErrNotParticipant)
+
+	_, err = ctrl.CreateActionItem(ActionItemInput{MeetingID: meetingID, Content: "write the proposal", UserID: outsideID})
+	require.ErrorIs(t, err, ErrNotParticipant)
+}
@@ -0,0 +1,285 @@
+package oneonone
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/oneonone"
+)
+
+// ScheduleInput is when a meeting takes place and the google calendar event it is synced with
+type ScheduleInput struct {
+	Title            string
+	Description      string
+	StartTime        time.Time
+	EndTime          time.Time
+	GoogleCalendarID string
+	HangoutLink      string
+}
+
+type CreateInput struct {
+	ScheduleInput
+	ReportID string
+	UserID   string
+}
+
+type ListInput struct {
+	model.Pagination
+
+	UserID string
+	// Role keeps the meetings the user is the manager or the report of
+	Role string
+	// EmployeeID keeps the meetings with this employee
+	EmployeeID string
+	Status     string
+	From       *time.Time
+	To         *time.Time
+}
+
+type UpdateInput struct {
+	ScheduleInput
+	ID     string
+	Status model.OneOnOneStatus
+	UserID string
+}
+
+// Create schedules a 1:1 meeting of the user with one of their reports
+func (r *controller) Create(input CreateInput) (*model.OneOnOneMeeting, error) {
+	if !input.EndTime.After(input.StartTime) {
+		return nil, ErrInvalidPeriod
+	}
+
+	report, err := r.store.Employee.One(r.repo.DB(), input.ReportID, false)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrReportNotFound
+		}
+		return nil, err
+	}
+
+	if report.LineManagerID.String() != input.UserID {
+		return nil, ErrNotLineManager
+	}
+
+	if input.Title == "" {
+		manager, err := r.store.Employee.One(r.repo.DB(), input.UserID, false)
+		if err != nil {
+			return nil, err
+		}
+		input.Title = fmt.Sprintf("1:1 %s / %s", displayName(manager), displayName(report))
+	}
+
+	tx, done := r.repo.NewTransaction()
+
+	schedule := &model.Schedule{
+		BaseModel:    model.BaseModel{ID: model.NewUUID()},
+		Name:         input.Title,
+		Description:  input.Description,
+		ScheduleType: model.ScheduleTypeOneOnOne,
+		StartTime:    &input.StartTime,
+		EndTime:      &input.EndTime,
+	}
+	if input.GoogleCalendarID != "" {
+		schedule.GoogleCalendar = &model.ScheduleGoogleCalendar{
+			GoogleCalendarID: input.GoogleCalendarID,
+			HangoutLink:      input.HangoutLink,
+		}
+	}
+	if _, err := r.store.Schedule.Create(tx.DB(), schedule); err != nil {
+		return nil, done(err)
+	}
+
+	meeting := &model.OneOnOneMeeting{
+		BaseModel:  model.BaseModel{ID: model.NewUUID()},
+		ManagerID:  model.MustGetUUIDFromString(input.UserID),
+		ReportID:   report.ID,
+		ScheduleID: schedule.ID,
+		Status:     model.OneOnOneStatusScheduled,
+	}
+	if _, err := r.store.OneOnOne.Create(tx.DB(), meeting); err != nil {
+		return nil, done(err)
+	}
+
+	if err := done(nil); err != nil {
+		return nil, err
+	}
+
+	return r.Detail(meeting.ID.String(), input.UserID)
+}
+
+// List returns the 1:1 meetings of the user, latest first
+func (r *controller) List(input ListInput) ([]*model.OneOnOneMeeting, int64, error) {
+	filter := oneonone.Filter{
+		ParticipantID: input.UserID,
+		Status:        input.Status,
+		From:          input.From,
+		To:            input.To,
+	}
+
+	switch input.Role {
+	case "manager":
+		filter.ManagerID = input.UserID
+		filter.ReportID = input.EmployeeID
+	case "report":
+		filter.ReportID = input.UserID
+		filter.ManagerID = input.EmployeeID
+	default:
+		filter.CounterpartID = input.EmployeeID
+	}
+
+	meetings, total, err := r.store.OneOnOne.All(r.repo.DB(), filter, input.Pagination)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, m := range meetings {
+		hideManagerNote(m, input.UserID)
+	}
+
+	return meetings, total, nil
+}
+
+// Detail returns a meeting with its agenda, its action items and the open items carried over from the earlier meetings
+func (r *controller) Detail(id string, userID string) (*model.OneOnOneMeeting, error) {
+	meeting, err := r.getMeeting(id, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !meeting.IsParticipant(userID) {
+		return nil, ErrNotParticipant
+	}
+
+	hideManagerNote(meeting, userID)
+
+	before := meeting.CreatedAt
+	if meeting.Schedule != nil && meeting.Schedule.StartTime != nil {
+		before = *meeting.Schedule.StartTime
+	}
+
+	managerID, reportID := meeting.ManagerID.String(), meeting.ReportID.String()
+
+	meeting.CarriedOverAgendaItems, err = r.store.OneOnOne.GetOpenAgendaItems(r.repo.DB(), managerID, reportID, before, id)
+	if err != nil {
+		return nil, err
+	}
+
+	meeting.CarriedOverActionItems, err = r.store.OneOnOne.GetOpenActionItems(r.repo.DB(), managerID, reportID, before, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return meeting, nil
+}
+
+// Update reschedules a meeting, changes its status or links it to a google calendar event
+func (r *controller) Update(input UpdateInput) (*model.OneOnOneMeeting, error) {
+	if !input.Status.IsValid() {
+		return nil, ErrInvalidStatus
+	}
+	if !input.EndTime.After(input.StartTime) {
+		return nil, ErrInvalidPeriod
+	}
+
+	meeting, err := r.getMeeting(input.ID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if meeting.ManagerID.String() != input.UserID {
+		return nil, ErrNotManager
+	}
+
+	tx, done := r.repo.NewTransaction()
+
+	schedule := meeting.Schedule
+	if input.Title != "" {
+		schedule.Name = input.Title
+	}
+	schedule.Description = input.Description
+	schedule.StartTime = &input.StartTime
+	schedule.EndTime = &input.EndTime
+	if _, err := r.store.Schedule.UpdateSelectedFieldsByID(tx.DB(), schedule.ID.String(), *schedule, "name", "description", "start_time", "end_time"); err != nil {
+		return nil, done(err)
+	}
+
+	if input.GoogleCalendarID != "" {
+		if schedule.GoogleCalendar == nil {
+			calendar := &model.ScheduleGoogleCalendar{
+				ScheduleID:       schedule.ID,
+				GoogleCalendarID: input.GoogleCalendarID,
+				HangoutLink:      input.HangoutLink,
+			}
+			if _, err := r.store.Schedule.CreateGoogleCalendar(tx.DB(), calendar); err != nil {
+				return nil, done(err)
+			}
+		} else {
+			calendar := schedule.GoogleCalendar
+			calendar.GoogleCalendarID = input.GoogleCalendarID
+			calendar.HangoutLink = input.HangoutLink
+			if _, err := r.store.Schedule.UpdateGoogleCalendarSelectedFieldsByID(tx.DB(), calendar.ID.String(), *calendar, "google_calendar_id", "hangout_link"); err != nil {
+				return nil, done(err)
+			}
+		}
+	}
+
+	meeting.Status = input.Status
+	if _, err := r.store.OneOnOne.UpdateSelectedFieldsByID(tx.DB(), meeting.ID.String(), *meeting, "status"); err != nil {
+		return nil, done(err)
+	}
+
+	if err := done(nil); err != nil {
+		return nil, err
+	}
+
+	return r.Detail(input.ID, input.UserID)
+}
+
+// UpdateManagerNote saves the private note of the manager of a meeting
+func (r *controller) UpdateManagerNote(id string, userID string, note string) (*model.OneOnOneMeeting, error) {
+	meeting, err := r.getMeeting(id, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if meeting.ManagerID.String() != userID {
+		return nil, ErrNotManager
+	}
+
+	meeting.ManagerNote = note
+	if _, err := r.store.OneOnOne.UpdateSelectedFieldsByID(r.repo.DB(), id, *meeting, "manager_note"); err != nil {
+		return nil, err
+	}
+
+	return r.Detail(id, userID)
+}
+
+func (r *controller) getMeeting(id string, preload bool) (*model.OneOnOneMeeting, error) {
+	meeting, err := r.store.OneOnOne.One(r.repo.DB(), id, preload)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrMeetingNotFound
+		}
+		return nil, err
+	}
+
+	return meeting, nil
+}
+
+// hideManagerNote keeps the private note of the manager from anyone else
+func hideManagerNote(meeting *model.OneOnOneMeeting, userID string) {
+	if meeting.ManagerID.String() != userID {
+		meeting.ManagerNote = ""
+	}
+}
+
+func displayName(e *model.Employee) string {
+	if e.DisplayName != "" {
+		return e.DisplayName
+	}
+	return e.FullName
+}
@@ -0,0 +1,154 @@
+package oneonone
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/store/oneonone"
+)
+
+const (
+	meetingID = "5a5c1ac6-5f24-4a4a-9e6c-01b1e5d0a0c1"
+	managerID = "2655832e-f009-4b73-a535-64c3a22e558f"
+	reportID  = "ecea9d15-05ba-4a4e-9787-54210e3b98ce"
+	outsideID = "608ea227-45a5-4c8a-af43-6c7280d96340"
+)
+
+type fakeRepo struct {
+	store.DBRepo
+}
+
+func (r *fakeRepo) DB() *gorm.DB {
+	return nil
+}
+
+// fakeOneOnOneStore returns a fresh copy of the meeting on each call, like a database would
+type fakeOneOnOneStore struct {
+	oneonone.IStore
+	meeting model.OneOnOneMeeting
+	filter  oneonone.Filter
+}
+
+func (s *fakeOneOnOneStore) One(db *gorm.DB, id string, preload bool) (*model.OneOnOneMeeting, error) {
+	m := s.meeting
+	return &m, nil
+}
+
+func (s *fakeOneOnOneStore) All(db *gorm.DB, filter oneonone.Filter, pagination model.Pagination) ([]*model.OneOnOneMeeting, int64, error) {
+	s.filter = filter
+	m := s.meeting
+	return []*model.OneOnOneMeeting{&m}, 1, nil
+}
+
+func (s *fakeOneOnOneStore) GetOpenAgendaItems(db *gorm.DB, managerID string, reportID string, before time.Time, excludedMeetingID string) ([]*model.OneOnOneAgendaItem, error) {
+	return nil, nil
+}
+
+func (s *fakeOneOnOneStore) GetOpenActionItems(db *gorm.DB, managerID string, reportID string, before time.Time, excludedMeetingID string) ([]*model.OneOnOneActionItem, error) {
+	return nil, nil
+}
+
+func newTestController(meetingStore *fakeOneOnOneStore) IController {
+	cfg := config.LoadTestConfig()
+	return New(&store.Store{OneOnOne: meetingStore}, &fakeRepo{}, nil, logger.NewLogrusLogger(), &cfg)
+}
+
+func newTestMeeting() model.OneOnOneMeeting {
+	return model.OneOnOneMeeting{
+		BaseModel:   model.BaseModel{ID: model.MustGetUUIDFromString(meetingID), CreatedAt: time.Now()},
+		ManagerID:   model.MustGetUUIDFromString(managerID),
+		ReportID:    model.MustGetUUIDFromString(reportID),
+		ManagerNote: "struggling with the project",
+	}
+}
+
+func TestController_List(t *testing.T) {
+	tcs := map[string]struct {
+		userID       string
+		expectedNote string
+	}{
+		"manager sees their note": {
+			userID:       managerID,
+			expectedNote: "struggling with the project",
+		},
+		"report does not see the note of the manager": {
+			userID: reportID,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			meetingStore := &fakeOneOnOneStore{meeting: newTestMeeting()}
+
+			meetings, total, err := newTestController(meetingStore).List(ListInput{UserID: tc.userID})
+			require.NoError(t, err)
+			require.EqualValues(t, 1, total)
+			require.Equal(t, tc.expectedNote, meetings[0].ManagerNote)
+			// only the meetings of the user are listed
+			require.Equal(t, tc.userID, meetingStore.filter.ParticipantID)
+		})
+	}
+}
+
+func TestController_Detail(t *testing.T) {
+	tcs := map[string]struct {
+		userID       string
+		expectedNote string
+		expectedErr  error
+	}{
+		"manager sees their note": {
+			userID:       managerID,
+			expectedNote: "struggling with the project",
+		},
+		"report does not see the note of the manager": {
+			userID: reportID,
+		},
+		"employee outside the meeting": {
+			userID:      outsideID,
+			expectedErr: ErrNotParticipant,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			meetingStore := &fakeOneOnOneStore{meeting: newTestMeeting()}
+
+			meeting, err := newTestController(meetingStore).Detail(meetingID, tc.userID)
+			require.ErrorIs(t, err, tc.expectedErr)
+			if tc.expectedErr != nil {
+				require.Nil(t, meeting)
+				return
+			}
+			require.Equal(t, tc.expectedNote, meeting.ManagerNote)
+		})
+	}
+}
+
+func TestController_UpdateManagerNote(t *testing.T) {
+	tcs := map[string]struct {
+		userID      string
+		expectedErr error
+	}{
+		"report can not write the note of the manager": {
+			userID:      reportID,
+			expectedErr: ErrNotManager,
+		},
+		"employee outside the meeting": {
+			userID:      outsideID,
+			expectedErr: ErrNotManager,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			meetingStore := &fakeOneOnOneStore{meeting: newTestMeeting()}
+
+			_, err := newTestController(meetingStore).UpdateManagerNote(meetingID, tc.userID, "note")
+			require.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package oneonone
+
+import (
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type controller struct {
+	store   *store.Store
+	service *service.Service
+	logger  logger.Logger
+	repo    store.DBRepo
+	config  *config.Config
+}
+
+func New(store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:   store,
+		repo:    repo,
+		service: service,
+		logger:  logger,
+		config:  cfg,
+	}
+}
+
+type IController interface {
+	Create(input CreateInput) (meeting *model.OneOnOneMeeting, err error)
+	List(input ListInput) (meetings []*model.OneOnOneMeeting, total int64, err error)
+	Detail(id string, userID string) (meeting *model.OneOnOneMeeting, err error)
+	Update(input UpdateInput) (meeting *model.OneOnOneMeeting, err error)
+	UpdateManagerNote(id string, userID string, note string) (meeting *model.OneOnOneMeeting, err error)
+
+	CreateAgendaItem(input AgendaItemInput) (item *model.OneOnOneAgendaItem, err error)
+	UpdateAgendaItem(input AgendaItemInput) (item *model.OneOnOneAgendaItem, err error)
+	CreateActionItem(input ActionItemInput) (item *model.OneOnOneActionItem, err error)
+	UpdateActionItem(input ActionItemInput) (item *model.OneOnOneActionItem, err error)
+}
@@ -0,0 +1,199 @@
+package performancereview
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/performancereview"
+)
+
+type CreateInput struct {
+	Name              string
+	StartDate         time.Time
+	EndDate           time.Time
+	PeerReviewEventID string
+	CreatedBy         string
+}
+
+// Create creates a cycle in the assessment stage with a review for every full-time and probation employee,
+// their current line manager is the reviewer
+func (r *controller) Create(input CreateInput) (*model.PerformanceReviewCycle, error) {
+	if !input.EndDate.After(input.StartDate) {
+		return nil, ErrInvalidPeriod
+	}
+
+	tx, done := r.repo.NewTransaction()
+
+	creator, err := r.store.Employee.One(tx.DB(), input.CreatedBy, false)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, done(ErrCreatorNotFound)
+		}
+		return nil, done(err)
+	}
+
+	cycle := &model.PerformanceReviewCycle{
+		BaseModel: model.BaseModel{ID: model.NewUUID()},
+		Name:      input.Name,
+		StartDate: input.StartDate,
+		EndDate:   input.EndDate,
+		Status:    model.PerformanceReviewCycleStatusAssessment,
+		CreatedBy: creator.ID,
+		Creator:   creator,
+	}
+
+	if input.PeerReviewEventID != "" {
+		event, err := r.store.FeedbackEvent.One(tx.DB(), input.PeerReviewEventID, false)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, done(ErrPeerReviewNotFound)
+			}
+			return nil, done(err)
+		}
+		if event.Type != model.EventTypeSurvey || event.Subtype != model.EventSubtypePeerReview {
+			return nil, done(ErrInvalidPeerReview)
+		}
+
+		cycle.PeerReviewEventID = event.ID
+		cycle.PeerReviewEvent = event
+	}
+
+	var reviews []model.PerformanceReview
+	for _, status := range []model.WorkingStatus{model.WorkingStatusFullTime, model.WorkingStatusProbation} {
+		employees, err := r.store.Employee.GetByWorkingStatus(tx.DB(), status)
+		if err != nil {
+			return nil, done(err)
+		}
+
+		for _, e := range employees {
+			reviews = append(reviews, model.PerformanceReview{
+				BaseModel:  model.BaseModel{ID: model.NewUUID()},
+				CycleID:    cycle.ID,
+				EmployeeID: e.ID,
+				ReviewerID: e.LineManagerID,
+				Status:     model.PerformanceReviewStatusPending,
+			})
+		}
+	}
+	if len(reviews) == 0 {
+		return nil, done(ErrNoEmployeeToReview)
+	}
+
+	if _, err := r.store.PerformanceReview.Create(tx.DB(), cycle); err != nil {
+		return nil, done(err)
+	}
+
+	if _, err := r.store.PerformanceReview.BatchCreateReviews(tx.DB(), reviews); err != nil {
+		return nil, done(err)
+	}
+
+	return cycle, done(nil)
+}
+
+type ListInput struct {
+	model.Pagination
+
+	Status  string
+	Keyword string
+}
+
+func (r *controller) List(input ListInput) ([]*model.PerformanceReviewCycle, int64, error) {
+	filter := performancereview.Filter{
+		Status:  input.Status,
+		Keyword: input.Keyword,
+	}
+
+	return r.store.PerformanceReview.All(r.repo.DB(), filter, input.Pagination)
+}
+
+func (r *controller) Detail(id string) (*model.PerformanceReviewCycle, error) {
+	cycle, err := r.store.PerformanceReview.One(r.repo.DB(), id, true)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrCycleNotFound
+		}
+		return nil, err
+	}
+
+	return cycle, nil
+}
+
+// UpdateStatus moves a cycle to its next stage. Calibration starts once every review is assessed,
+// finalizing the cycle stores the final rating of every review in the history of the employee
+func (r *controller) UpdateStatus(id string, status model.PerformanceReviewCycleStatus) (*model.PerformanceReviewCycle, error) {
+	tx, done := r.repo.NewTransaction()
+
+	cycle, err := r.store.PerformanceReview.One(tx.DB(), id, true)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, done(ErrCycleNotFound)
+		}
+		return nil, done(err)
+	}
+
+	if status != cycle.Status.Next() {
+		return nil, done(ErrInvalidStatusTransition)
+	}
+
+	reviews, err := r.store.PerformanceReview.GetReviewsByCycleID(tx.DB(), id)
+	if err != nil {
+		return nil, done(err)
+	}
+
+	switch status {
+	case model.PerformanceReviewCycleStatusCalibration:
+		for _, review := range reviews {
+			if review.Status == model.PerformanceReviewStatusPending {
+				return nil, done(ErrPendingAssessments)
+			}
+		}
+
+	case model.PerformanceReviewCycleStatusFinalized:
+		now := time.Now()
+		for _, review := range reviews {
+			review.FinalRating = review.ResolveFinalRating()
+			review.Status = model.PerformanceReviewStatusFinalized
+			review.FinalizedAt = &now
+
+			_, err := r.store.PerformanceReview.UpdateReviewSelectedFieldsByID(tx.DB(), review.ID.String(), *review,
+				"final_rating",
+				"status",
+				"finalized_at",
+			)
+			if err != nil {
+				return nil, done(err)
+			}
+		}
+	}
+
+	cycle.Status = status
+	if _, err := r.store.PerformanceReview.UpdateSelectedFieldsByID(tx.DB(), id, *cycle, "status"); err != nil {
+		return nil, done(err)
+	}
+
+	return cycle, done(nil)
+}
+
+type ListReviewsInput struct {
+	model.Pagination
+
+	CycleID    string
+	ReviewerID string
+	Status     string
+}
+
+// ListReviews returns the reviews of a cycle
+func (r *controller) ListReviews(input ListReviewsInput) ([]*model.PerformanceReview, int64, error) {
+	if _, err := r.Detail(input.CycleID); err != nil {
+		return nil, 0, err
+	}
+
+	return r.store.PerformanceReview.GetReviews(r.repo.DB(), performancereview.ReviewFilter{
+		CycleID:    input.CycleID,
+		ReviewerID: input.ReviewerID,
+		Status:     input.Status,
+	}, input.Pagination)
+}
@@ -0,0 +1,21 @@
+package performancereview
+
+import "errors"
+
+var (
+	ErrCycleNotFound           = errors.New("performance review cycle not found")
+	ErrReviewNotFound          = errors.New("performance review not found")
+	ErrCreatorNotFound         = errors.New("performance review cycle creator not found")
+	ErrEmployeeNotFound        = errors.New("employee not found")
+	ErrPeerReviewNotFound      = errors.New("peer-review survey not found")
+	ErrInvalidPeerReview       = errors.New("survey is not a peer-review survey")
+	ErrInvalidPeriod           = errors.New("end date must be after start date")
+	ErrNoEmployeeToReview      = errors.New("there is no employee to review")
+	ErrInvalidStatusTransition = errors.New("cycle can only move to its next stage: assessment, calibration then finalized")
+	ErrPendingAssessments      = errors.New("every review must be assessed before calibration")
+	ErrNotInAssessment         = errors.New("assessments can only be submitted during the assessment stage")
+	ErrNotInCalibration        = errors.New("ratings can only be calibrated during the calibration stage")
+	ErrInvalidRating           = errors.New("invalid performance rating")
+	ErrNotAllowedToAssess      = errors.New("only the reviewer can submit the assessment")
+	ErrNotAllowedToView        = errors.New("only the reviewer can view the review packet")
+)
@@ -0,0 +1,40 @@
+package performancereview
+
+import (
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type controller struct {
+	store   *store.Store
+	service *service.Service
+	logger  logger.Logger
+	repo    store.DBRepo
+	config  *config.Config
+}
+
+func New(store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:   store,
+		repo:    repo,
+		service: service,
+		logger:  logger,
+		config:  cfg,
+	}
+}
+
+type IController interface {
+	Create(input CreateInput) (cycle *model.PerformanceReviewCycle, err error)
+	List(input ListInput) (cycles []*model.PerformanceReviewCycle, total int64, err error)
+	Detail(id string) (cycle *model.PerformanceReviewCycle, err error)
+	UpdateStatus(id string, status model.PerformanceReviewCycleStatus) (cycle *model.PerformanceReviewCycle, err error)
+	ListReviews(input ListReviewsInput) (reviews []*model.PerformanceReview, total int64, err error)
+
+	Packet(input PacketInput) (packet *model.PerformanceReviewPacket, err error)
+	SubmitAssessment(input SubmitAssessmentInput) (review *model.PerformanceReview, err error)
+	Calibrate(input CalibrateInput) (review *model.PerformanceReview, err error)
+	EmployeeHistory(employeeID string, pagination model.Pagination) (reviews []*model.PerformanceReview, total int64, err error)
+}
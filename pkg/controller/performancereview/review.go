@@ -0,0 +1,180 @@
+package performancereview
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/employeeeventreviewer"
+	"github.com/dwarvesf/fortress-api/pkg/store/performancereview"
+)
+
+type PacketInput struct {
+	ReviewID string
+	UserID   string
+	// CanRead is true when the user can read every performance review, others only read the ones they review
+	CanRead bool
+}
+
+// Packet assembles what is known about the employee during the cycle period: the peer-review and self-review answers,
+// the line manager assessment, the projects and work units they worked on, their brainery logs and engagement
+func (r *controller) Packet(input PacketInput) (*model.PerformanceReviewPacket, error) {
+	review, err := r.getReview(input.ReviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !input.CanRead && review.ReviewerID.String() != input.UserID {
+		return nil, ErrNotAllowedToView
+	}
+
+	db := r.repo.DB()
+	employeeID := review.EmployeeID.String()
+	from, to := review.Cycle.StartDate, review.Cycle.EndDate
+
+	packet := &model.PerformanceReviewPacket{Review: review}
+
+	if !review.Cycle.PeerReviewEventID.IsZero() {
+		packet.Reviews, err = r.store.EmployeeEventReviewer.GetAnswered(db, employeeeventreviewer.GetAnsweredInput{
+			EventID:    review.Cycle.PeerReviewEventID.String(),
+			EmployeeID: employeeID,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	packet.ProjectMembers, err = r.store.ProjectMember.GetByEmployeeIDInTimeRange(db, employeeID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	packet.WorkUnitMembers, err = r.store.WorkUnitMember.GetByEmployeeIDInTimeRange(db, employeeID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	packet.BraineryLogs, err = r.store.BraineryLog.GetByEmployeeIDInTimeRange(db, employeeID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	engagement, err := r.store.PerformanceReview.GetEngagementStats(db, employeeID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	packet.Engagement = *engagement
+
+	return packet, nil
+}
+
+type SubmitAssessmentInput struct {
+	ReviewID       string
+	UserID         string
+	CanEdit        bool
+	Assessment     []model.PerformanceAssessmentItem
+	ProposedRating model.PerformanceRating
+}
+
+// SubmitAssessment saves the line manager assessment and proposed rating, it can be resubmitted until calibration starts
+func (r *controller) SubmitAssessment(input SubmitAssessmentInput) (*model.PerformanceReview, error) {
+	if err := model.ValidateAssessment(input.Assessment); err != nil {
+		return nil, err
+	}
+	if !input.ProposedRating.IsValid() {
+		return nil, ErrInvalidRating
+	}
+
+	review, err := r.getReview(input.ReviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !input.CanEdit && review.ReviewerID.String() != input.UserID {
+		return nil, ErrNotAllowedToAssess
+	}
+	if review.Cycle.Status != model.PerformanceReviewCycleStatusAssessment {
+		return nil, ErrNotInAssessment
+	}
+
+	review.Assessment = input.Assessment
+	review.ProposedRating = input.ProposedRating
+	review.Status = model.PerformanceReviewStatusAssessed
+
+	_, err = r.store.PerformanceReview.UpdateReviewSelectedFieldsByID(r.repo.DB(), input.ReviewID, *review,
+		"assessment",
+		"proposed_rating",
+		"status",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return review, nil
+}
+
+type CalibrateInput struct {
+	ReviewID string
+	Rating   model.PerformanceRating
+	Note     string
+}
+
+// Calibrate overrides the proposed rating of a review during the calibration stage
+func (r *controller) Calibrate(input CalibrateInput) (*model.PerformanceReview, error) {
+	if !input.Rating.IsValid() {
+		return nil, ErrInvalidRating
+	}
+
+	review, err := r.getReview(input.ReviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	if review.Cycle.Status != model.PerformanceReviewCycleStatusCalibration {
+		return nil, ErrNotInCalibration
+	}
+
+	review.CalibratedRating = input.Rating
+	review.CalibrationNote = input.Note
+	review.Status = model.PerformanceReviewStatusCalibrated
+
+	_, err = r.store.PerformanceReview.UpdateReviewSelectedFieldsByID(r.repo.DB(), input.ReviewID, *review,
+		"calibrated_rating",
+		"calibration_note",
+		"status",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return review, nil
+}
+
+// EmployeeHistory returns the finalized reviews of an employee, latest cycle first
+func (r *controller) EmployeeHistory(employeeID string, pagination model.Pagination) ([]*model.PerformanceReview, int64, error) {
+	exists, err := r.store.Employee.IsExist(r.repo.DB(), employeeID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !exists {
+		return nil, 0, ErrEmployeeNotFound
+	}
+
+	return r.store.PerformanceReview.GetReviews(r.repo.DB(), performancereview.ReviewFilter{
+		EmployeeID: employeeID,
+		Status:     model.PerformanceReviewStatusFinalized.String(),
+	}, pagination)
+}
+
+func (r *controller) getReview(id string) (*model.PerformanceReview, error) {
+	review, err := r.store.PerformanceReview.OneReview(r.repo.DB(), id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrReviewNotFound
+		}
+		return nil, err
+	}
+
+	return review, nil
+}
@@ -0,0 +1,8 @@
+package profitloss
+
+import "errors"
+
+var (
+	ErrProjectNotFound = errors.New("project not found")
+	ErrInvalidPeriod   = errors.New("from month must not be after to month")
+)
@@ -0,0 +1,242 @@
+package profitloss
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type ProjectInput struct {
+	ProjectID string
+	// From and To are the first days of the first and last months
+	From time.Time
+	To   time.Time
+}
+
+type CompanyInput struct {
+	// From and To are the first days of the first and last months
+	From time.Time
+	To   time.Time
+}
+
+type monthKey struct {
+	year  int
+	month int
+}
+
+func toMonthKey(t time.Time) monthKey {
+	return monthKey{year: t.Year(), month: int(t.Month())}
+}
+
+// projectMonths is the profit and loss of projects by month
+type projectMonths map[model.UUID]map[monthKey]*model.ProfitLoss
+
+func (p projectMonths) get(projectID model.UUID, year, month int) *model.ProfitLoss {
+	if p[projectID] == nil {
+		p[projectID] = map[monthKey]*model.ProfitLoss{}
+	}
+
+	k := monthKey{year: year, month: month}
+	if p[projectID][k] == nil {
+		p[projectID][k] = &model.ProfitLoss{}
+	}
+
+	return p[projectID][k]
+}
+
+// GetProjectProfitLoss returns the profit and loss of a project for each month between from and to
+func (r *controller) GetProjectProfitLoss(input ProjectInput) (*model.Project, []*model.ProjectProfitLoss, error) {
+	if input.From.After(input.To) {
+		return nil, nil, ErrInvalidPeriod
+	}
+
+	project, err := r.store.Project.One(r.repo.DB(), input.ProjectID, false)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, ErrProjectNotFound
+		}
+		return nil, nil, err
+	}
+
+	pl, err := r.calculate([]*model.Project{project}, input.From, input.To)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	months := make([]*model.ProjectProfitLoss, 0)
+	for m := input.From; !m.After(input.To); m = m.AddDate(0, 1, 0) {
+		p := model.ProjectProfitLoss{Month: m}
+		if v := pl[project.ID][toMonthKey(m)]; v != nil {
+			p.ProfitLoss = *v
+		}
+		months = append(months, &p)
+	}
+
+	return project, months, nil
+}
+
+// GetCompanyProfitLoss returns the profit and loss of the company for each month between from and to,
+// rolled up by organization and project type
+func (r *controller) GetCompanyProfitLoss(input CompanyInput) ([]*model.ProfitLossMonth, error) {
+	if input.From.After(input.To) {
+		return nil, ErrInvalidPeriod
+	}
+
+	projects, err := r.store.Project.GetAllWithOrganization(r.repo.DB())
+	if err != nil {
+		return nil, err
+	}
+
+	pl, err := r.calculate(projects, input.From, input.To)
+	if err != nil {
+		return nil, err
+	}
+
+	type groupKey struct {
+		organizationID model.UUID
+		projectType    model.ProjectType
+	}
+
+	months := make([]*model.ProfitLossMonth, 0)
+	for m := input.From; !m.After(input.To); m = m.AddDate(0, 1, 0) {
+		month := &model.ProfitLossMonth{Month: m, Groups: make([]*model.ProfitLossGroup, 0)}
+		groups := map[groupKey]*model.ProfitLossGroup{}
+
+		for _, p := range projects {
+			v := pl[p.ID][toMonthKey(m)]
+			if v == nil {
+				continue
+			}
+
+			k := groupKey{organizationID: p.OrganizationID, projectType: p.Type}
+			if groups[k] == nil {
+				groups[k] = &model.ProfitLossGroup{Organization: p.Organization, ProjectType: p.Type}
+				month.Groups = append(month.Groups, groups[k])
+			}
+			groups[k].Add(*v)
+			groups[k].Projects++
+			month.Total.Add(*v)
+		}
+
+		sort.SliceStable(month.Groups, func(i, j int) bool {
+			return month.Groups[i].InvoicedRevenue > month.Groups[j].InvoicedRevenue
+		})
+		months = append(months, month)
+	}
+
+	return months, nil
+}
+
+// calculate computes the revenue and the costs of projects by month. The payroll of an employee is
+// split across their projects by the average allocation in the month
+func (r *controller) calculate(projects []*model.Project, from, to time.Time) (projectMonths, error) {
+	rs := projectMonths{}
+	if len(projects) == 0 {
+		return rs, nil
+	}
+
+	db := r.repo.DB()
+
+	projectIDs := make([]string, 0, len(projects))
+	for _, p := range projects {
+		projectIDs = append(projectIDs, p.ID.String())
+	}
+
+	invoiced, err := r.store.ProfitLoss.GetInvoicedRevenues(db, projectIDs, from, to)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range invoiced {
+		rs.get(a.ProjectID, a.Year, a.Month).InvoicedRevenue += a.Amount
+	}
+
+	collected, err := r.store.ProfitLoss.GetCollectedRevenues(db, projectIDs, from, to)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range collected {
+		rs.get(a.ProjectID, a.Year, a.Month).CollectedRevenue += a.Amount
+	}
+
+	commissions, err := r.store.ProfitLoss.GetCommissions(db, projectIDs, from, to)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range commissions {
+		rs.get(a.ProjectID, a.Year, a.Month).CommissionCost += a.Amount
+	}
+
+	expenses, err := r.store.ProfitLoss.GetExpenses(db, projectIDs, from, to)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range expenses {
+		rs.get(a.ProjectID, a.Year, a.Month).ExpenseCost += a.Amount
+	}
+
+	end := to.AddDate(0, 1, -1)
+
+	members, err := r.store.ProjectMember.GetByProjectIDsInPeriod(db, projectIDs, from, end)
+	if err != nil {
+		return nil, err
+	}
+
+	employeeIDs := make([]string, 0)
+	seen := map[model.UUID]bool{}
+	for _, m := range members {
+		if m.EmployeeID.IsZero() || seen[m.EmployeeID] {
+			continue
+		}
+		seen[m.EmployeeID] = true
+		employeeIDs = append(employeeIDs, m.EmployeeID.String())
+	}
+	if len(employeeIDs) == 0 {
+		return rs, nil
+	}
+
+	payrolls, err := r.store.ProfitLoss.GetPayrollCosts(db, employeeIDs, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	// all the memberships of the employees, the payroll is shared with their other projects
+	memberships, err := r.store.ProjectMember.GetByEmployeeIDsInPeriod(db, employeeIDs, from, end)
+	if err != nil {
+		return nil, err
+	}
+
+	employeeMembers := map[model.UUID][]*model.ProjectMember{}
+	for _, m := range memberships {
+		employeeMembers[m.EmployeeID] = append(employeeMembers[m.EmployeeID], m)
+	}
+
+	inScope := map[model.UUID]bool{}
+	for _, p := range projects {
+		inScope[p.ID] = true
+	}
+
+	for _, p := range payrolls {
+		monthStart := time.Date(p.Year, time.Month(p.Month), 1, 0, 0, 0, 0, time.UTC)
+		monthEnd := monthStart.AddDate(0, 1, -1)
+
+		allocations := map[*model.ProjectMember]float64{}
+		var total float64
+		for _, m := range employeeMembers[p.EmployeeID] {
+			allocations[m] = m.AverageAllocationInPeriod(monthStart, monthEnd)
+			total += allocations[m]
+		}
+
+		for m, allocation := range allocations {
+			if !inScope[m.ProjectID] {
+				continue
+			}
+			rs.get(m.ProjectID, p.Year, p.Month).PayrollCost += model.AllocatePayrollCost(p.Amount, allocation, total)
+		}
+	}
+
+	return rs, nil
+}
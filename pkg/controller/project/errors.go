@@ -0,0 +1,15 @@
+package project
+
+import "errors"
+
+var (
+	ErrProjectNotFound      = errors.New("project not found")
+	ErrInvalidProjectStatus = errors.New("invalid value for project status")
+	ErrSameProjectStatus    = errors.New("project is already in this status")
+	ErrTransitionNotAllowed = errors.New("project can not move to this status from its current status")
+	ErrReasonRequired       = errors.New("reason is required to pause or close a project")
+	ErrStartDateRequired    = errors.New("start date is required to activate a project")
+	ErrEndDateRequired      = errors.New("end date is required to close a project")
+	ErrInvalidEndDate       = errors.New("end date must be after the start date of the project")
+	ErrFinalInvoiceRequired = errors.New("project must be invoiced up to the month of its end date before it is closed")
+)
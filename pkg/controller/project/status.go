@@ -0,0 +1,204 @@
+package project
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type UpdateStatusInput struct {
+	ProjectID string
+	Status    model.ProjectStatus
+	Reason    string
+	// StartDate is required to activate a project without start date
+	StartDate *time.Time
+	// EndDate is required to close a project without end date
+	EndDate *time.Time
+	ActorID string
+	Now     time.Time
+}
+
+// UpdateStatus moves a project to another status of its lifecycle and records the transition.
+// Closing a project inactivates its members, slots and work units and stops its surveys,
+// pausing a project notifies its heads
+func (r *controller) UpdateStatus(input UpdateStatusInput) (*model.Project, error) {
+	if !input.Status.IsValid() {
+		return nil, ErrInvalidProjectStatus
+	}
+
+	db := r.repo.DB()
+
+	project, err := r.store.Project.One(db, input.ProjectID, false)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrProjectNotFound
+		}
+		return nil, err
+	}
+
+	from := project.Status
+	if from == input.Status {
+		return nil, ErrSameProjectStatus
+	}
+	if !from.CanTransitionTo(input.Status) {
+		return nil, ErrTransitionNotAllowed
+	}
+	if input.Status.RequiresReason() && input.Reason == "" {
+		return nil, ErrReasonRequired
+	}
+
+	if err := r.validateStatus(project, input); err != nil {
+		return nil, err
+	}
+
+	tx, done := r.repo.NewTransaction()
+
+	if _, err := r.store.Project.UpdateSelectedFieldsByID(tx.DB(), project.ID.String(), *project,
+		"status", "start_date", "end_date", "allows_sending_survey"); err != nil {
+		return nil, done(err)
+	}
+
+	transition := &model.ProjectStatusTransition{
+		ProjectID:  project.ID,
+		FromStatus: from,
+		ToStatus:   input.Status,
+		Reason:     input.Reason,
+	}
+	if model.IsUUIDFromString(input.ActorID) {
+		actorID := model.MustGetUUIDFromString(input.ActorID)
+		transition.ActorID = &actorID
+	}
+
+	if _, err := r.store.ProjectStatusTransition.Create(tx.DB(), transition); err != nil {
+		return nil, done(err)
+	}
+
+	if input.Status == model.ProjectStatusClosed {
+		if err := r.closeProject(tx.DB(), project); err != nil {
+			return nil, done(err)
+		}
+	}
+
+	if err := done(nil); err != nil {
+		return nil, err
+	}
+
+	if input.Status == model.ProjectStatusPaused {
+		r.notifyPaused(project, input.Reason)
+	}
+
+	return project, nil
+}
+
+// GetStatusTransitions returns the status history of a project, newest first
+func (r *controller) GetStatusTransitions(projectID string) ([]*model.ProjectStatusTransition, error) {
+	db := r.repo.DB()
+
+	if _, err := r.store.Project.One(db, projectID, false); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrProjectNotFound
+		}
+		return nil, err
+	}
+
+	return r.store.ProjectStatusTransition.GetByProjectID(db, projectID)
+}
+
+// validateStatus checks the fields required by the new status and sets them on the project
+func (r *controller) validateStatus(project *model.Project, input UpdateStatusInput) error {
+	switch input.Status {
+	case model.ProjectStatusActive:
+		if input.StartDate != nil {
+			project.StartDate = input.StartDate
+		}
+		if project.StartDate == nil {
+			return ErrStartDateRequired
+		}
+		project.EndDate = nil
+
+	case model.ProjectStatusClosed:
+		if input.EndDate != nil {
+			project.EndDate = input.EndDate
+		}
+		if project.EndDate == nil {
+			return ErrEndDateRequired
+		}
+		if project.StartDate != nil && project.EndDate.Before(*project.StartDate) {
+			return ErrInvalidEndDate
+		}
+
+		if project.RequiresFinalInvoice() {
+			invoice, err := r.store.Invoice.GetLatestInvoiceByProject(r.repo.DB(), project.ID.String())
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+			if errors.Is(err, gorm.ErrRecordNotFound) || !invoice.IsFinalInvoice(*project.EndDate) {
+				return ErrFinalInvoiceRequired
+			}
+		}
+		project.AllowsSendingSurvey = false
+	}
+
+	project.Status = input.Status
+	return nil
+}
+
+// closeProject inactivates the members, the pending slots and the work units of a closed project
+func (r *controller) closeProject(db *gorm.DB, project *model.Project) error {
+	projectID := project.ID.String()
+
+	if err := r.store.ProjectMember.UpdateToInactiveByProjectID(db, projectID, *project.EndDate); err != nil {
+		return err
+	}
+
+	if err := r.store.ProjectSlot.UpdateSelectedFieldByProjectID(db, projectID,
+		model.ProjectSlot{Status: model.ProjectMemberStatusInactive}, "status"); err != nil {
+		return err
+	}
+
+	if err := r.store.WorkUnit.ArchiveByProjectID(db, projectID); err != nil {
+		return err
+	}
+
+	return r.store.WorkUnitMember.UpdateToInactiveByProjectID(db, projectID, *project.EndDate)
+}
+
+// notifyPaused sends a Discord DM to the heads of a paused project, failures do not revert the pause
+func (r *controller) notifyPaused(project *model.Project, reason string) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "project",
+		"method":     "notifyPaused",
+		"projectID":  project.ID,
+	})
+
+	heads, err := r.store.ProjectHead.GetActiveWithDiscordAccountByProjectID(r.repo.DB(), project.ID.String())
+	if err != nil {
+		l.Error(err, "failed to get project heads")
+		return
+	}
+
+	msg := fmt.Sprintf("**%s** has been paused.\nReason: %s\n%s/projects/%s",
+		project.Name, reason, r.config.FortressURL, project.ID)
+
+	notified := map[model.UUID]bool{}
+	for _, h := range heads {
+		if notified[h.EmployeeID] {
+			continue
+		}
+		if h.Employee.DiscordAccount == nil || h.Employee.DiscordAccount.DiscordID == "" {
+			l.AddField("employeeID", h.EmployeeID).Info("project head has no discord account to be notified")
+			continue
+		}
+
+		if _, err := r.service.Discord.SendDirectMessage(h.Employee.DiscordAccount.DiscordID, msg); err != nil {
+			l.AddField("employeeID", h.EmployeeID).Error(err, "failed to notify project head")
+			continue
+		}
+		notified[h.EmployeeID] = true
+	}
+}
@@ -0,0 +1,96 @@
+package projecthealth
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// digestWeakestSignals is the number of lowest scoring signals listed for a project in the digest
+const digestWeakestSignals = 2
+
+// SendRedDigest posts the projects which turned red in the week of now to the project health Discord channel
+func (r *controller) SendRedDigest(now time.Time) (int, error) {
+	l := r.logger.AddField("method", "SendRedDigest")
+
+	week := toWeek(now)
+	scores, err := r.store.ProjectHealthScore.GetByWeek(r.repo.DB(), week)
+	if err != nil {
+		return 0, err
+	}
+
+	previous, err := r.store.ProjectHealthScore.GetByWeek(r.repo.DB(), week.AddDate(0, 0, -7))
+	if err != nil {
+		return 0, err
+	}
+	previousScores := make(map[model.UUID]*model.ProjectHealthScore, len(previous))
+	for _, s := range previous {
+		previousScores[s.ProjectID] = s
+	}
+
+	var turnedRed []*model.ProjectHealthScore
+	for _, s := range scores {
+		if s.TurnedRed(previousScores[s.ProjectID]) {
+			turnedRed = append(turnedRed, s)
+		}
+	}
+	if len(turnedRed) == 0 {
+		return 0, nil
+	}
+
+	if r.config.Discord.Webhooks.ProjectHealth == "" {
+		l.Info("project health webhook is not set, skip the red digest")
+		return 0, nil
+	}
+
+	if _, err := r.service.Discord.SendMessage(r.redDigestMessage(week, turnedRed, previousScores), r.config.Discord.Webhooks.ProjectHealth); err != nil {
+		return 0, err
+	}
+
+	return len(turnedRed), nil
+}
+
+func (r *controller) redDigestMessage(week time.Time, scores []*model.ProjectHealthScore, previousScores map[model.UUID]*model.ProjectHealthScore) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("**Project health, week of %s**: %d projects turned red\n", week.Format("2006-01-02"), len(scores)))
+
+	for _, s := range scores {
+		name := s.ProjectID.String()
+		if s.Project != nil {
+			name = s.Project.Name
+		}
+
+		was := "not scored"
+		if p := previousScores[s.ProjectID]; p != nil {
+			was = fmt.Sprintf("%.2f %s", p.Score, p.Status)
+		}
+
+		sb.WriteString(fmt.Sprintf("• **%s** scored %.2f, was %s. Weakest: %s. %s/projects/%s/health\n",
+			name, s.Score, was, weakestSignals(s), r.config.FortressURL, s.ProjectID))
+	}
+
+	return sb.String()
+}
+
+// weakestSignals lists the available signals with the lowest scores
+func weakestSignals(s *model.ProjectHealthScore) string {
+	var signals []model.ProjectHealthSignalScore
+	for _, sig := range s.GetSignals() {
+		if sig.Available && sig.Weight > 0 {
+			signals = append(signals, sig)
+		}
+	}
+	sort.SliceStable(signals, func(i, j int) bool {
+		return signals[i].Score < signals[j].Score
+	})
+
+	var rs []string
+	for i := 0; i < len(signals) && i < digestWeakestSignals; i++ {
+		rs = append(rs, fmt.Sprintf("%s %.0f (%s)", signals[i].Signal, signals[i].Score, signals[i].Detail))
+	}
+
+	return strings.Join(rs, ", ")
+}
@@ -0,0 +1,10 @@
+package projecthealth
+
+import "errors"
+
+var (
+	ErrProjectNotFound = errors.New("project not found")
+	ErrInvalidSignal   = errors.New("invalid project health signal")
+	ErrInvalidWeight   = errors.New("weight must not be less than 0")
+	ErrNoWeight        = errors.New("at least one signal must have a weight")
+)
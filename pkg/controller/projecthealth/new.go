@@ -0,0 +1,40 @@
+package projecthealth
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type controller struct {
+	store   *store.Store
+	service *service.Service
+	logger  logger.Logger
+	repo    store.DBRepo
+	config  *config.Config
+}
+
+func New(store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:   store,
+		repo:    repo,
+		service: service,
+		logger:  logger,
+		config:  cfg,
+	}
+}
+
+type IController interface {
+	GetPortfolio(input PortfolioInput) (portfolio *model.ProjectHealthPortfolio, err error)
+	GetProjectHealth(projectID string, weeks int) (project *model.Project, scores []*model.ProjectHealthScore, err error)
+
+	GetWeights() (weights []*model.ProjectHealthWeight, err error)
+	UpdateWeights(weights map[model.ProjectHealthSignal]float64) ([]*model.ProjectHealthWeight, error)
+
+	ComputeWeeklyScores(now time.Time) (scores []*model.ProjectHealthScore, err error)
+	SendRedDigest(now time.Time) (turnedRed int, err error)
+}
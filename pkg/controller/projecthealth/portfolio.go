@@ -0,0 +1,74 @@
+package projecthealth
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type PortfolioInput struct {
+	// Week is any day of the week, the latest scored week when it is nil
+	Week   *time.Time
+	Status model.ProjectHealthStatus
+}
+
+// GetPortfolio returns the scores of all projects for a week, the least healthy first, with their scores of the week before
+func (r *controller) GetPortfolio(input PortfolioInput) (*model.ProjectHealthPortfolio, error) {
+	week := input.Week
+	if week == nil {
+		latest, err := r.store.ProjectHealthScore.GetLatestWeek(r.repo.DB())
+		if err != nil {
+			return nil, err
+		}
+		if latest == nil {
+			return &model.ProjectHealthPortfolio{Week: toWeek(time.Now())}, nil
+		}
+		week = latest
+	}
+
+	rs := &model.ProjectHealthPortfolio{
+		Week:           toWeek(*week),
+		PreviousScores: map[model.UUID]*model.ProjectHealthScore{},
+	}
+
+	scores, err := r.store.ProjectHealthScore.GetByWeek(r.repo.DB(), rs.Week)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range scores {
+		if input.Status == "" || s.Status == input.Status {
+			rs.Scores = append(rs.Scores, s)
+		}
+	}
+
+	previous, err := r.store.ProjectHealthScore.GetByWeek(r.repo.DB(), rs.Week.AddDate(0, 0, -7))
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range previous {
+		rs.PreviousScores[s.ProjectID] = s
+	}
+
+	return rs, nil
+}
+
+// GetProjectHealth returns a project with its scores of the latest weeks, the most recent week first
+func (r *controller) GetProjectHealth(projectID string, weeks int) (*model.Project, []*model.ProjectHealthScore, error) {
+	project, err := r.store.Project.One(r.repo.DB(), projectID, false)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, ErrProjectNotFound
+		}
+		return nil, nil, err
+	}
+
+	scores, err := r.store.ProjectHealthScore.GetByProjectID(r.repo.DB(), project.ID.String(), weeks)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return project, scores, nil
+}
@@ -0,0 +1,185 @@
+package projecthealth
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/project"
+	"github.com/dwarvesf/fortress-api/pkg/utils/timeutil"
+)
+
+// ComputeWeeklyScores scores the active projects for the week of now, a week scored again is replaced
+func (r *controller) ComputeWeeklyScores(now time.Time) ([]*model.ProjectHealthScore, error) {
+	l := r.logger.AddField("method", "ComputeWeeklyScores")
+
+	weights, err := r.weights()
+	if err != nil {
+		return nil, err
+	}
+
+	projects, _, err := r.store.Project.All(r.repo.DB(), project.GetListProjectInput{
+		Statuses: []string{model.ProjectStatusActive.String()},
+	}, model.Pagination{})
+	if err != nil {
+		return nil, err
+	}
+
+	week := toWeek(now)
+	var rs []*model.ProjectHealthScore
+	for _, p := range projects {
+		signals, err := r.signals(p, now)
+		if err != nil {
+			l.AddField("projectID", p.ID).Error(err, "failed to get project health signals")
+			continue
+		}
+
+		score, ok := model.NewProjectHealthScore(p.ID, week, signals, weights)
+		if !ok {
+			l.AddField("projectID", p.ID).Info("project has no weighted health signal to be scored")
+			continue
+		}
+
+		score, err = r.store.ProjectHealthScore.Upsert(r.repo.DB(), score)
+		if err != nil {
+			return rs, err
+		}
+		rs = append(rs, score)
+	}
+
+	return rs, nil
+}
+
+// signals collects the health signals of a project, a signal without data is not available
+func (r *controller) signals(p *model.Project, now time.Time) ([]model.ProjectHealthSignalScore, error) {
+	rs := []model.ProjectHealthSignalScore{
+		ratingSignal(model.ProjectHealthSignalAccountRating, p.AccountRating),
+		ratingSignal(model.ProjectHealthSignalDeliveryRating, p.DeliveryRating),
+		ratingSignal(model.ProjectHealthSignalLeadRating, p.LeadRating),
+	}
+
+	audit := model.ProjectHealthSignalScore{Signal: model.ProjectHealthSignalAudit}
+	health := model.ProjectHealthSignalScore{Signal: model.ProjectHealthSignalEngineeringHealth}
+	if p.ProjectNotion != nil && !p.ProjectNotion.AuditNotionID.IsZero() {
+		audits, err := r.store.Dashboard.GetAverageAuditByProjectNotionID(r.repo.DB(), p.ProjectNotion.AuditNotionID.String())
+		if err != nil {
+			return nil, err
+		}
+		if len(audits) > 0 {
+			audit = auditSignal(model.ProjectHealthSignalAudit, audits[0].Avg, audits[0].Quarter)
+		}
+
+		healths, err := r.store.Dashboard.AverageEngineeringHealthByProjectNotionID(r.repo.DB(), p.ProjectNotion.AuditNotionID.String())
+		if err != nil {
+			return nil, err
+		}
+		if len(healths) > 0 {
+			health = auditSignal(model.ProjectHealthSignalEngineeringHealth, healths[0].Avg, healths[0].Quarter)
+		}
+	}
+	rs = append(rs, audit, health)
+
+	actionItems := model.ProjectHealthSignalScore{Signal: model.ProjectHealthSignalActionItems}
+	squashes, err := r.store.Dashboard.GetActionItemSquashReportsByProjectID(r.repo.DB(), p.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	if len(squashes) > 0 {
+		s := squashes[0]
+		actionItems.Available = true
+		actionItems.Value = float64(s.All)
+		actionItems.Score = model.ActionItemsHealthScore(s.High, s.Medium, s.Low)
+		actionItems.Detail = fmt.Sprintf("%d high, %d medium and %d low open action items on %s",
+			s.High, s.Medium, s.Low, s.SnapDate.Format("2006-01-02"))
+	}
+	rs = append(rs, actionItems)
+
+	workload := model.ProjectHealthSignalScore{Signal: model.ProjectHealthSignalWorkload}
+	survey, err := r.store.Dashboard.GetLatestWorkSurveyByProjectID(r.repo.DB(), p.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	if survey != nil {
+		if score, ok := model.LikertHealthScore(survey.Workload); ok {
+			workload.Available = true
+			workload.Value = survey.Workload
+			workload.Score = score
+			workload.Detail = fmt.Sprintf("average workload answer %.2f of 5 in the work survey of %s",
+				survey.Workload, survey.EndDate.Format("2006-01-02"))
+		}
+	}
+	rs = append(rs, workload)
+
+	invoices, err := r.store.Invoice.GetOverdueByProjectID(r.repo.DB(), p.ID.String(), now)
+	if err != nil {
+		return nil, err
+	}
+	rs = append(rs, overdueInvoicesSignal(invoices, now))
+
+	return rs, nil
+}
+
+func ratingSignal(signal model.ProjectHealthSignal, rating int) model.ProjectHealthSignalScore {
+	rs := model.ProjectHealthSignalScore{Signal: signal}
+
+	score, ok := model.LikertHealthScore(float64(rating))
+	if !ok {
+		return rs
+	}
+
+	rs.Available = true
+	rs.Value = float64(rating)
+	rs.Score = score
+	rs.Detail = fmt.Sprintf("rated %d of 5", rating)
+	return rs
+}
+
+func auditSignal(signal model.ProjectHealthSignal, avg float64, quarter string) model.ProjectHealthSignalScore {
+	rs := model.ProjectHealthSignalScore{Signal: signal}
+
+	score, ok := model.AuditHealthScore(avg)
+	if !ok {
+		return rs
+	}
+
+	rs.Available = true
+	rs.Value = avg
+	rs.Score = score
+	rs.Detail = fmt.Sprintf("scored %.2f of 5 in %s", avg, quarter)
+	return rs
+}
+
+func overdueInvoicesSignal(invoices []*model.Invoice, now time.Time) model.ProjectHealthSignalScore {
+	rs := model.ProjectHealthSignalScore{
+		Signal:    model.ProjectHealthSignalOverdueInvoices,
+		Available: true,
+		Value:     float64(len(invoices)),
+		Score:     100,
+		Detail:    "no overdue invoice",
+	}
+	if len(invoices) == 0 {
+		return rs
+	}
+
+	oldest := 0
+	for _, iv := range invoices {
+		if iv.DueAt == nil {
+			continue
+		}
+		if days := int(math.Floor(now.Sub(*iv.DueAt).Hours() / 24)); days > oldest {
+			oldest = days
+		}
+	}
+
+	// an overdue invoice costs points even when its due date is unknown or today
+	rs.Score = model.OverdueInvoicesHealthScore(int(math.Max(float64(oldest), 1)))
+	rs.Detail = fmt.Sprintf("%d overdue invoices, the oldest %d days past due", len(invoices), oldest)
+	return rs
+}
+
+// toWeek returns the monday of the week of a time
+func toWeek(t time.Time) time.Time {
+	monday := timeutil.GetStartDayOfWeek(t)
+	return time.Date(monday.Year(), monday.Month(), monday.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,79 @@
+package projecthealth
+
+import (
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// GetWeights returns the weight of every signal, the default one when it is not configured
+func (r *controller) GetWeights() ([]*model.ProjectHealthWeight, error) {
+	weights, err := r.weights()
+	if err != nil {
+		return nil, err
+	}
+
+	return toWeightList(weights), nil
+}
+
+// UpdateWeights sets the weights of some signals, the weights only apply to the scores computed afterwards
+func (r *controller) UpdateWeights(input map[model.ProjectHealthSignal]float64) ([]*model.ProjectHealthWeight, error) {
+	weights, err := r.weights()
+	if err != nil {
+		return nil, err
+	}
+
+	for s, w := range input {
+		if !s.IsValid() {
+			return nil, ErrInvalidSignal
+		}
+		if w < 0 {
+			return nil, ErrInvalidWeight
+		}
+		weights[s] = w
+	}
+
+	total := 0.0
+	for _, w := range weights {
+		total += w
+	}
+	if total <= 0 {
+		return nil, ErrNoWeight
+	}
+
+	tx, done := r.repo.NewTransaction()
+
+	for s, w := range input {
+		if _, err := r.store.ProjectHealthWeight.Upsert(tx.DB(), &model.ProjectHealthWeight{
+			Signal: s,
+			Weight: w,
+		}); err != nil {
+			return nil, done(err)
+		}
+	}
+
+	if err := done(nil); err != nil {
+		return nil, err
+	}
+
+	return toWeightList(weights), nil
+}
+
+func (r *controller) weights() (map[model.ProjectHealthSignal]float64, error) {
+	weights, err := r.store.ProjectHealthWeight.All(r.repo.DB())
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ToProjectHealthWeightMap(weights), nil
+}
+
+func toWeightList(weights map[model.ProjectHealthSignal]float64) []*model.ProjectHealthWeight {
+	rs := make([]*model.ProjectHealthWeight, 0, len(model.ProjectHealthSignals))
+	for _, s := range model.ProjectHealthSignals {
+		rs = append(rs, &model.ProjectHealthWeight{
+			Signal: s,
+			Weight: weights[s],
+		})
+	}
+
+	return rs
+}
@@ -0,0 +1,18 @@
+package ratecard
+
+import "errors"
+
+var (
+	ErrRateCardNotFound      = errors.New("rate card not found")
+	ErrRateNotFound          = errors.New("rate not found in the rate card of the project")
+	ErrClientNotFound        = errors.New("client not found")
+	ErrProjectNotFound       = errors.New("project not found")
+	ErrProjectMemberNotFound = errors.New("project member not found")
+	ErrMemberRateNotFound    = errors.New("rate change not found")
+	ErrInvalidOwner          = errors.New("rate card must belong to either a client or a project")
+	ErrInvalidPeriod         = errors.New("rate card end date must be after its start date")
+	ErrInvalidRate           = errors.New("rate must be more than 0 and discount can not be negative")
+	ErrDuplicatedRate        = errors.New("rate card has several rates for a same seniority and position")
+	ErrRateOutsideMembership = errors.New("rate change must be within the start and end dates of the project member")
+	ErrMemberRateApplied     = errors.New("rate change already took effect and is kept in the pricing history")
+)
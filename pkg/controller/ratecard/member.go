@@ -0,0 +1,159 @@
+package ratecard
+
+import (
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type MemberRateInput struct {
+	ProjectID string
+	MemberID  string
+	Rate      decimal.Decimal
+	Discount  decimal.Decimal
+	// UseRateCard takes the rate and the discount from the rate card of the project valid at the effective date
+	UseRateCard   bool
+	EffectiveFrom time.Time
+	Note          string
+	CreatedBy     *model.UUID
+	Now           time.Time
+}
+
+// ListMemberRates returns the pricing history of a project member ordered by effective date
+func (r *controller) ListMemberRates(projectID string, memberID string) ([]*model.ProjectMemberRate, error) {
+	if _, err := r.getMember(projectID, memberID); err != nil {
+		return nil, err
+	}
+
+	return r.store.ProjectMemberRate.GetByProjectMemberID(r.repo.DB(), memberID)
+}
+
+// ChangeMemberRate records a rate change of a project member from a date, the current rate of the member
+// is updated when the change is already in effect
+func (r *controller) ChangeMemberRate(input MemberRateInput) (*model.ProjectMemberRate, error) {
+	member, err := r.getMember(input.ProjectID, input.MemberID)
+	if err != nil {
+		return nil, err
+	}
+
+	if member.StartDate != nil && input.EffectiveFrom.Before(*member.StartDate) {
+		return nil, ErrRateOutsideMembership
+	}
+	if member.EndDate != nil && input.EffectiveFrom.After(*member.EndDate) {
+		return nil, ErrRateOutsideMembership
+	}
+
+	db := r.repo.DB()
+
+	rate := &model.ProjectMemberRate{
+		ProjectMemberID: member.ID,
+		Rate:            input.Rate,
+		Discount:        input.Discount,
+		EffectiveFrom:   input.EffectiveFrom,
+		CreatedBy:       input.CreatedBy,
+		Note:            input.Note,
+	}
+
+	if input.UseRateCard {
+		positions, err := r.store.ProjectMemberPosition.GetByProjectMemberID(db, member.ID.String())
+		if err != nil {
+			return nil, err
+		}
+
+		positionIDs := make([]model.UUID, 0, len(positions))
+		for _, p := range positions {
+			positionIDs = append(positionIDs, p.PositionID)
+		}
+
+		card, cardRate, err := r.GetRate(input.ProjectID, member.SeniorityID, positionIDs, input.EffectiveFrom)
+		if err != nil {
+			return nil, err
+		}
+
+		rate.RateCardID = &card.ID
+		rate.Rate = cardRate.Rate
+		rate.Discount = cardRate.Discount
+	}
+
+	if rate.Rate.IsNegative() || rate.Discount.IsNegative() {
+		return nil, ErrInvalidRate
+	}
+
+	if _, err := r.store.ProjectMemberRate.Create(db, rate); err != nil {
+		return nil, err
+	}
+
+	if !rate.EffectiveFrom.After(input.Now) {
+		if _, err := r.ApplyMemberRates(input.Now); err != nil {
+			return nil, err
+		}
+	}
+
+	return rate, nil
+}
+
+// DeleteMemberRate removes a rate change which has not taken effect yet, past changes explain the invoices
+// already sent and are kept
+func (r *controller) DeleteMemberRate(projectID string, memberID string, id string, now time.Time) error {
+	if _, err := r.getMember(projectID, memberID); err != nil {
+		return err
+	}
+
+	db := r.repo.DB()
+
+	rate, err := r.store.ProjectMemberRate.One(db, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrMemberRateNotFound
+		}
+		return err
+	}
+	if rate.ProjectMemberID.String() != memberID {
+		return ErrMemberRateNotFound
+	}
+	if !rate.EffectiveFrom.After(now) {
+		return ErrMemberRateApplied
+	}
+
+	return r.store.ProjectMemberRate.Delete(db, id)
+}
+
+// ApplyMemberRates sets the current rate of the project members to the rate change in effect at a date
+func (r *controller) ApplyMemberRates(now time.Time) (int, error) {
+	db := r.repo.DB()
+
+	rates, err := r.store.ProjectMemberRate.GetUnapplied(db, now)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, rate := range rates {
+		if _, err := r.store.ProjectMember.UpdateSelectedFieldsByID(db, rate.ProjectMemberID.String(), model.ProjectMember{
+			Rate:     rate.Rate,
+			Discount: rate.Discount,
+		}, "rate", "discount"); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(rates), nil
+}
+
+func (r *controller) getMember(projectID string, memberID string) (*model.ProjectMember, error) {
+	member, err := r.store.ProjectMember.OneByID(r.repo.DB(), memberID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrProjectMemberNotFound
+		}
+		return nil, err
+	}
+	if member.ProjectID.String() != projectID {
+		return nil, ErrProjectMemberNotFound
+	}
+
+	return member, nil
+}
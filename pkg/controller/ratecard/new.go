@@ -0,0 +1,45 @@
+package ratecard
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/store/ratecard"
+)
+
+type controller struct {
+	store   *store.Store
+	service *service.Service
+	logger  logger.Logger
+	repo    store.DBRepo
+	config  *config.Config
+}
+
+func New(store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:   store,
+		repo:    repo,
+		service: service,
+		logger:  logger,
+		config:  cfg,
+	}
+}
+
+type IController interface {
+	List(filter ratecard.GetListFilter) (cards []*model.RateCard, err error)
+	Get(id string) (card *model.RateCard, err error)
+	Create(input RateCardInput) (card *model.RateCard, err error)
+	Update(id string, input RateCardInput) (card *model.RateCard, err error)
+	Delete(id string) error
+	GetRate(projectID string, seniorityID model.UUID, positionIDs []model.UUID, date time.Time) (card *model.RateCard, rate *model.RateCardRate, err error)
+	GetRenewals(input RenewalInput) (cards []*model.RateCard, err error)
+
+	ListMemberRates(projectID string, memberID string) (rates []*model.ProjectMemberRate, err error)
+	ChangeMemberRate(input MemberRateInput) (rate *model.ProjectMemberRate, err error)
+	DeleteMemberRate(projectID string, memberID string, id string, now time.Time) error
+	ApplyMemberRates(now time.Time) (applied int, err error)
+}
@@ -0,0 +1,239 @@
+package ratecard
+
+import (
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/ratecard"
+)
+
+type RateCardInput struct {
+	ClientID  *model.UUID
+	ProjectID *model.UUID
+	Name      string
+	StartDate time.Time
+	EndDate   *time.Time
+	Note      string
+	Rates     []RateInput
+}
+
+type RateInput struct {
+	SeniorityID model.UUID
+	PositionID  *model.UUID
+	Rate        decimal.Decimal
+	Discount    decimal.Decimal
+}
+
+type RenewalInput struct {
+	// Days is the number of days from now in which the rate cards end
+	Days int
+	Now  time.Time
+}
+
+// List returns the rate cards of a client or a project, newest first
+func (r *controller) List(filter ratecard.GetListFilter) ([]*model.RateCard, error) {
+	return r.store.RateCard.All(r.repo.DB(), filter)
+}
+
+// Get returns a rate card with its rates
+func (r *controller) Get(id string) (*model.RateCard, error) {
+	card, err := r.store.RateCard.One(r.repo.DB(), id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRateCardNotFound
+		}
+		return nil, err
+	}
+
+	return card, nil
+}
+
+// Create creates a rate card of a client or a project with its rates
+func (r *controller) Create(input RateCardInput) (*model.RateCard, error) {
+	if err := r.validate(input); err != nil {
+		return nil, err
+	}
+
+	tx, done := r.repo.NewTransaction()
+
+	card, err := r.store.RateCard.Create(tx.DB(), &model.RateCard{
+		ClientID:  input.ClientID,
+		ProjectID: input.ProjectID,
+		Name:      input.Name,
+		StartDate: input.StartDate,
+		EndDate:   input.EndDate,
+		Note:      input.Note,
+	})
+	if err != nil {
+		return nil, done(err)
+	}
+
+	if err := r.store.RateCard.CreateRates(tx.DB(), toRates(card.ID, input.Rates)); err != nil {
+		return nil, done(err)
+	}
+
+	if err := done(nil); err != nil {
+		return nil, err
+	}
+
+	return r.Get(card.ID.String())
+}
+
+// Update replaces a rate card and its rates, the rates already charged to members are kept in their history
+func (r *controller) Update(id string, input RateCardInput) (*model.RateCard, error) {
+	if _, err := r.Get(id); err != nil {
+		return nil, err
+	}
+
+	if err := r.validate(input); err != nil {
+		return nil, err
+	}
+
+	tx, done := r.repo.NewTransaction()
+
+	if _, err := r.store.RateCard.UpdateSelectedFieldsByID(tx.DB(), id, model.RateCard{
+		ClientID:  input.ClientID,
+		ProjectID: input.ProjectID,
+		Name:      input.Name,
+		StartDate: input.StartDate,
+		EndDate:   input.EndDate,
+		Note:      input.Note,
+	}, "client_id", "project_id", "name", "start_date", "end_date", "note"); err != nil {
+		return nil, done(err)
+	}
+
+	if err := r.store.RateCard.DeleteRatesByRateCardID(tx.DB(), id); err != nil {
+		return nil, done(err)
+	}
+
+	if err := r.store.RateCard.CreateRates(tx.DB(), toRates(model.MustGetUUIDFromString(id), input.Rates)); err != nil {
+		return nil, done(err)
+	}
+
+	if err := done(nil); err != nil {
+		return nil, err
+	}
+
+	return r.Get(id)
+}
+
+// Delete removes a rate card
+func (r *controller) Delete(id string) error {
+	if _, err := r.Get(id); err != nil {
+		return err
+	}
+
+	return r.store.RateCard.Delete(r.repo.DB(), id)
+}
+
+// GetRate returns the rate card of a project valid at a date and its rate for a seniority and positions
+func (r *controller) GetRate(projectID string, seniorityID model.UUID, positionIDs []model.UUID, date time.Time) (*model.RateCard, *model.RateCardRate, error) {
+	db := r.repo.DB()
+
+	project, err := r.store.Project.One(db, projectID, false)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, ErrProjectNotFound
+		}
+		return nil, nil, err
+	}
+
+	clientID := ""
+	if !project.ClientID.IsZero() {
+		clientID = project.ClientID.String()
+	}
+
+	cards, err := r.store.RateCard.GetByProject(db, project.ID.String(), clientID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	card := model.FindProjectRateCard(cards, project, date)
+	if card == nil {
+		return nil, nil, ErrRateNotFound
+	}
+
+	rate := card.FindRate(seniorityID, positionIDs)
+	if rate == nil {
+		return nil, nil, ErrRateNotFound
+	}
+
+	return card, rate, nil
+}
+
+// GetRenewals returns the rate cards ending in the next days, the contract rates of these cards are due for renewal
+func (r *controller) GetRenewals(input RenewalInput) ([]*model.RateCard, error) {
+	from := time.Date(input.Now.Year(), input.Now.Month(), input.Now.Day(), 0, 0, 0, 0, time.UTC)
+	return r.store.RateCard.GetRenewing(r.repo.DB(), from, from.AddDate(0, 0, input.Days))
+}
+
+func (r *controller) validate(input RateCardInput) error {
+	if (input.ClientID == nil) == (input.ProjectID == nil) {
+		return ErrInvalidOwner
+	}
+	if input.EndDate != nil && !input.EndDate.After(input.StartDate) {
+		return ErrInvalidPeriod
+	}
+
+	type rateKey struct {
+		seniorityID model.UUID
+		positionID  model.UUID
+	}
+
+	seen := map[rateKey]bool{}
+	for _, rate := range input.Rates {
+		if !rate.Rate.IsPositive() || rate.Discount.IsNegative() {
+			return ErrInvalidRate
+		}
+
+		k := rateKey{seniorityID: rate.SeniorityID}
+		if rate.PositionID != nil {
+			k.positionID = *rate.PositionID
+		}
+		if seen[k] {
+			return ErrDuplicatedRate
+		}
+		seen[k] = true
+	}
+
+	db := r.repo.DB()
+
+	if input.ClientID != nil {
+		if _, err := r.store.Client.One(db, input.ClientID.String()); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrClientNotFound
+			}
+			return err
+		}
+	}
+
+	if input.ProjectID != nil {
+		if _, err := r.store.Project.One(db, input.ProjectID.String(), false); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrProjectNotFound
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func toRates(rateCardID model.UUID, input []RateInput) []*model.RateCardRate {
+	rates := make([]*model.RateCardRate, 0, len(input))
+	for _, rate := range input {
+		rates = append(rates, &model.RateCardRate{
+			RateCardID:  rateCardID,
+			SeniorityID: rate.SeniorityID,
+			PositionID:  rate.PositionID,
+			Rate:        rate.Rate,
+			Discount:    rate.Discount,
+		})
+	}
+
+	return rates
+}
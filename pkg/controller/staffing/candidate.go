@@ -0,0 +1,256 @@
+package staffing
+
+import (
+	"errors"
+	"sort"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/employee"
+)
+
+// DefaultProposedCandidates is the number of candidates proposed when no limit is given
+const DefaultProposedCandidates = 5
+
+type ProposeInput struct {
+	ID    string
+	Limit int
+}
+
+type UpdateCandidateStatusInput struct {
+	RequestID   string
+	CandidateID string
+	Status      model.StaffingCandidateStatus
+	Note        string
+}
+
+// Propose ranks the employees who have room for the request from its start date, or within a few weeks after,
+// and proposes the best ones. Earlier proposals which are not taken further are replaced
+func (r *controller) Propose(input ProposeInput) (*model.StaffingRequest, error) {
+	if input.Limit <= 0 {
+		input.Limit = DefaultProposedCandidates
+	}
+
+	request, err := r.getRequest(input.ID)
+	if err != nil {
+		return nil, err
+	}
+	if request.IsClosed() {
+		return nil, ErrRequestClosed
+	}
+	if request.Status == model.StaffingRequestStatusConfirmed {
+		return nil, ErrRequestAlreadyConfirmed
+	}
+
+	db := r.repo.DB()
+
+	employees, _, err := r.store.Employee.All(db, employee.EmployeeFilter{
+		WorkingStatuses: []string{
+			model.WorkingStatusOnBoarding.String(),
+			model.WorkingStatusProbation.String(),
+			model.WorkingStatusFullTime.String(),
+		},
+		Organizations: []string{model.OrganizationCodeDwarves},
+		Preload:       true,
+	}, model.Pagination{
+		Page: 0,
+		Size: 1000,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// employees taken further in the request are not proposed again
+	kept := map[model.UUID]bool{}
+	for _, c := range request.Candidates {
+		if c.Status != model.StaffingCandidateStatusProposed {
+			kept[c.EmployeeID] = true
+		}
+	}
+
+	ids := make([]string, 0, len(employees))
+	for _, e := range employees {
+		ids = append(ids, e.ID.String())
+	}
+
+	firstWeek := model.TimesheetWeekStart(request.StartDate)
+	lastWeek := firstWeek.AddDate(0, 0, 7*model.StaffingSoonAvailableWeeks)
+
+	members, err := r.store.ProjectMember.GetAllocatedByEmployeeIDs(db, ids, firstWeek, lastWeek.AddDate(0, 0, 6))
+	if err != nil {
+		return nil, err
+	}
+
+	membersByEmployee := map[model.UUID][]*model.ProjectMember{}
+	inProject := map[model.UUID]bool{}
+	for _, m := range members {
+		membersByEmployee[m.EmployeeID] = append(membersByEmployee[m.EmployeeID], m)
+		if m.ProjectID == request.ProjectID {
+			inProject[m.EmployeeID] = true
+		}
+	}
+
+	requirement := toStaffingRequirement(request)
+
+	candidates := make([]*model.StaffingCandidate, 0)
+	for _, e := range employees {
+		if kept[e.ID] || inProject[e.ID] {
+			continue
+		}
+
+		for delay := 0; delay <= model.StaffingSoonAvailableWeeks; delay++ {
+			weekStart := firstWeek.AddDate(0, 0, 7*delay)
+
+			var allocation float64
+			for _, m := range membersByEmployee[e.ID] {
+				allocation += m.AllocationInPeriod(weekStart, weekStart.AddDate(0, 0, 6))
+			}
+			if allocation+request.AllocationPercentage > model.MaxAllocationPercentage {
+				continue
+			}
+
+			availableFrom := request.StartDate
+			if delay > 0 {
+				availableFrom = weekStart
+			}
+
+			candidates = append(candidates, &model.StaffingCandidate{
+				StaffingRequestID: request.ID,
+				EmployeeID:        e.ID,
+				Score:             model.ScoreStaffingCandidate(requirement, e, allocation, delay),
+				Allocation:        allocation,
+				AvailableFrom:     availableFrom,
+				Status:            model.StaffingCandidateStatusProposed,
+			})
+			break
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+		return candidates[i].AvailableFrom.Before(candidates[j].AvailableFrom)
+	})
+	if len(candidates) > input.Limit {
+		candidates = candidates[:input.Limit]
+	}
+
+	tx, done := r.repo.NewTransaction()
+
+	if err := r.store.StaffingRequest.DeleteCandidatesByStatus(tx.DB(), input.ID, model.StaffingCandidateStatusProposed); err != nil {
+		return nil, done(err)
+	}
+	if err := r.store.StaffingRequest.CreateCandidates(tx.DB(), candidates); err != nil {
+		return nil, done(err)
+	}
+
+	if request.Status == model.StaffingRequestStatusOpen {
+		if _, err := r.store.StaffingRequest.UpdateSelectedFieldsByID(tx.DB(), input.ID, model.StaffingRequest{
+			Status: model.StaffingRequestStatusProposed,
+		}, "status"); err != nil {
+			return nil, done(err)
+		}
+	}
+
+	if err := done(nil); err != nil {
+		return nil, err
+	}
+
+	return r.getRequest(input.ID)
+}
+
+// UpdateCandidateStatus moves a candidate to interviewing, confirmed or rejected, the request follows its candidates
+func (r *controller) UpdateCandidateStatus(input UpdateCandidateStatusInput) (*model.StaffingRequest, error) {
+	request, err := r.getRequest(input.RequestID)
+	if err != nil {
+		return nil, err
+	}
+	if request.IsClosed() {
+		return nil, ErrRequestClosed
+	}
+
+	candidate, err := r.store.StaffingRequest.OneCandidate(r.repo.DB(), input.RequestID, input.CandidateID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrCandidateNotFound
+		}
+		return nil, err
+	}
+
+	status := request.Status
+
+	switch input.Status {
+	case model.StaffingCandidateStatusInterviewing:
+		if candidate.Status != model.StaffingCandidateStatusProposed {
+			return nil, ErrInvalidCandidateStatus
+		}
+		if status != model.StaffingRequestStatusConfirmed {
+			status = model.StaffingRequestStatusInterviewing
+		}
+	case model.StaffingCandidateStatusConfirmed:
+		if candidate.Status != model.StaffingCandidateStatusProposed && candidate.Status != model.StaffingCandidateStatusInterviewing {
+			return nil, ErrInvalidCandidateStatus
+		}
+		if status == model.StaffingRequestStatusConfirmed {
+			return nil, ErrRequestAlreadyConfirmed
+		}
+		status = model.StaffingRequestStatusConfirmed
+	case model.StaffingCandidateStatusRejected:
+		if candidate.Status == model.StaffingCandidateStatusRejected {
+			return nil, ErrInvalidCandidateStatus
+		}
+		if candidate.Status == model.StaffingCandidateStatusConfirmed {
+			status = model.StaffingRequestStatusProposed
+			for _, c := range request.Candidates {
+				if c.ID != candidate.ID && c.Status == model.StaffingCandidateStatusInterviewing {
+					status = model.StaffingRequestStatusInterviewing
+				}
+			}
+		}
+	default:
+		return nil, ErrInvalidCandidateStatus
+	}
+
+	tx, done := r.repo.NewTransaction()
+
+	if _, err := r.store.StaffingRequest.UpdateCandidateSelectedFieldsByID(tx.DB(), input.CandidateID, model.StaffingCandidate{
+		Status: input.Status,
+		Note:   input.Note,
+	}, "status", "note"); err != nil {
+		return nil, done(err)
+	}
+
+	if status != request.Status {
+		if _, err := r.store.StaffingRequest.UpdateSelectedFieldsByID(tx.DB(), input.RequestID, model.StaffingRequest{
+			Status: status,
+		}, "status"); err != nil {
+			return nil, done(err)
+		}
+	}
+
+	if err := done(nil); err != nil {
+		return nil, err
+	}
+
+	return r.getRequest(input.RequestID)
+}
+
+func toStaffingRequirement(request *model.StaffingRequest) model.StaffingRequirement {
+	requirement := model.StaffingRequirement{}
+
+	if request.Seniority != nil {
+		requirement.SeniorityLevel = request.Seniority.Level
+	}
+	if request.ProjectSlot != nil {
+		for _, p := range request.ProjectSlot.ProjectSlotPositions {
+			requirement.PositionIDs = append(requirement.PositionIDs, p.PositionID)
+		}
+	}
+	for _, s := range request.Stacks {
+		requirement.StackIDs = append(requirement.StackIDs, s.StackID)
+	}
+
+	return requirement
+}
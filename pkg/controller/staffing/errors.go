@@ -0,0 +1,19 @@
+package staffing
+
+import "errors"
+
+var (
+	ErrStaffingRequestNotFound     = errors.New("staffing request not found")
+	ErrCandidateNotFound           = errors.New("candidate not found")
+	ErrProjectSlotNotFound         = errors.New("project slot not found")
+	ErrSeniorityNotFound           = errors.New("seniority not found")
+	ErrStackNotFound               = errors.New("stack not found")
+	ErrSlotNotPending              = errors.New("only pending project slots can be requested")
+	ErrSlotAlreadyRequested        = errors.New("project slot already has an open staffing request")
+	ErrInvalidAllocationPercentage = errors.New("allocation percentage must be between 0 and 100")
+	ErrRequestClosed               = errors.New("staffing request is filled or cancelled")
+	ErrRequestNotConfirmed         = errors.New("only confirmed staffing requests can be filled")
+	ErrRequestAlreadyConfirmed     = errors.New("staffing request already has a confirmed candidate")
+	ErrInvalidCandidateStatus      = errors.New("invalid candidate status change")
+	ErrEmployeeAlreadyInProject    = errors.New("employee is already an active member of the project")
+)
@@ -0,0 +1,41 @@
+package staffing
+
+import (
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller/discord"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type controller struct {
+	store   *store.Store
+	service *service.Service
+	logger  logger.Logger
+	repo    store.DBRepo
+	config  *config.Config
+	discord discord.IController
+}
+
+func New(store *store.Store, repo store.DBRepo, service *service.Service, discord discord.IController, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:   store,
+		repo:    repo,
+		service: service,
+		logger:  logger,
+		config:  cfg,
+		discord: discord,
+	}
+}
+
+type IController interface {
+	List(input ListInput) (requests []*model.StaffingRequest, total int64, err error)
+	Detail(id string) (request *model.StaffingRequest, err error)
+	Create(input CreateInput) (request *model.StaffingRequest, err error)
+	Cancel(id string) (request *model.StaffingRequest, err error)
+	Fill(id string, userID string) (request *model.StaffingRequest, err error)
+
+	Propose(input ProposeInput) (request *model.StaffingRequest, err error)
+	UpdateCandidateStatus(input UpdateCandidateStatusInput) (request *model.StaffingRequest, err error)
+}
@@ -0,0 +1,308 @@
+package staffing
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/staffingrequest"
+)
+
+type ListInput struct {
+	model.Pagination
+
+	ProjectID string
+	Statuses  []string
+}
+
+type CreateInput struct {
+	ProjectSlotID string
+	// SeniorityID defaults to the seniority of the slot
+	SeniorityID string
+	StackIDs    []string
+	StartDate   time.Time
+	// AllocationPercentage defaults to the allocation of the deployment type of the slot
+	AllocationPercentage *float64
+	Note                 string
+	UserID               string
+}
+
+// List returns staffing requests, soonest start date first
+func (r *controller) List(input ListInput) ([]*model.StaffingRequest, int64, error) {
+	return r.store.StaffingRequest.All(r.repo.DB(), staffingrequest.Filter{
+		ProjectID: input.ProjectID,
+		Statuses:  input.Statuses,
+	}, input.Pagination)
+}
+
+// Detail returns a staffing request with its slot, stacks and ranked candidates
+func (r *controller) Detail(id string) (*model.StaffingRequest, error) {
+	return r.getRequest(id)
+}
+
+// Create turns a pending project slot into a staffing request
+func (r *controller) Create(input CreateInput) (*model.StaffingRequest, error) {
+	db := r.repo.DB()
+
+	slot, err := r.store.ProjectSlot.One(db, input.ProjectSlotID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrProjectSlotNotFound
+		}
+		return nil, err
+	}
+	if slot.Status != model.ProjectMemberStatusPending {
+		return nil, ErrSlotNotPending
+	}
+
+	requested, err := r.store.StaffingRequest.IsExistOpenBySlotID(db, input.ProjectSlotID)
+	if err != nil {
+		return nil, err
+	}
+	if requested {
+		return nil, ErrSlotAlreadyRequested
+	}
+
+	seniorityID := slot.SeniorityID
+	if input.SeniorityID != "" {
+		seniorityID = model.MustGetUUIDFromString(input.SeniorityID)
+	}
+	if _, err := r.store.Seniority.One(db, seniorityID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSeniorityNotFound
+		}
+		return nil, err
+	}
+
+	stackIDs := make([]model.UUID, 0, len(input.StackIDs))
+	for _, id := range input.StackIDs {
+		stackIDs = append(stackIDs, model.MustGetUUIDFromString(id))
+	}
+	if len(stackIDs) > 0 {
+		stacks, err := r.store.Stack.GetByIDs(db, stackIDs)
+		if err != nil {
+			return nil, err
+		}
+		if len(stacks) != len(stackIDs) {
+			return nil, ErrStackNotFound
+		}
+	}
+
+	allocation := model.DefaultAllocationPercentage(slot.DeploymentType)
+	if input.AllocationPercentage != nil {
+		allocation = *input.AllocationPercentage
+	}
+	if allocation < 0 || allocation > model.MaxAllocationPercentage {
+		return nil, ErrInvalidAllocationPercentage
+	}
+
+	tx, done := r.repo.NewTransaction()
+
+	request, err := r.store.StaffingRequest.Create(tx.DB(), &model.StaffingRequest{
+		ProjectID:            slot.ProjectID,
+		ProjectSlotID:        slot.ID,
+		SeniorityID:          seniorityID,
+		StartDate:            input.StartDate,
+		AllocationPercentage: allocation,
+		Status:               model.StaffingRequestStatusOpen,
+		Note:                 input.Note,
+		RequestedBy:          model.MustGetUUIDFromString(input.UserID),
+	})
+	if err != nil {
+		return nil, done(err)
+	}
+
+	stacks := make([]*model.StaffingRequestStack, 0, len(stackIDs))
+	for _, id := range stackIDs {
+		stacks = append(stacks, &model.StaffingRequestStack{
+			StaffingRequestID: request.ID,
+			StackID:           id,
+		})
+	}
+	if err := r.store.StaffingRequest.CreateStacks(tx.DB(), stacks); err != nil {
+		return nil, done(err)
+	}
+
+	if err := done(nil); err != nil {
+		return nil, err
+	}
+
+	return r.getRequest(request.ID.String())
+}
+
+// Cancel closes a staffing request which is not filled
+func (r *controller) Cancel(id string) (*model.StaffingRequest, error) {
+	request, err := r.getRequest(id)
+	if err != nil {
+		return nil, err
+	}
+	if request.IsClosed() {
+		return nil, ErrRequestClosed
+	}
+
+	if _, err := r.store.StaffingRequest.UpdateSelectedFieldsByID(r.repo.DB(), id, model.StaffingRequest{
+		Status: model.StaffingRequestStatusCancelled,
+	}, "status"); err != nil {
+		return nil, err
+	}
+
+	return r.getRequest(id)
+}
+
+// Fill assigns the confirmed candidate of a staffing request to its slot, the slot becomes active.
+// The member gets the same rate history and discord log as members assigned to a project directly
+func (r *controller) Fill(id string, userID string) (*model.StaffingRequest, error) {
+	request, err := r.getRequest(id)
+	if err != nil {
+		return nil, err
+	}
+	if request.IsClosed() {
+		return nil, ErrRequestClosed
+	}
+	if request.Status != model.StaffingRequestStatusConfirmed {
+		return nil, ErrRequestNotConfirmed
+	}
+
+	var candidate *model.StaffingCandidate
+	for _, c := range request.Candidates {
+		if c.Status == model.StaffingCandidateStatusConfirmed {
+			candidate = c
+		}
+	}
+	if candidate == nil {
+		return nil, ErrRequestNotConfirmed
+	}
+
+	slot := request.ProjectSlot
+	if slot == nil {
+		return nil, ErrProjectSlotNotFound
+	}
+	if slot.Status != model.ProjectMemberStatusPending {
+		return nil, ErrSlotNotPending
+	}
+
+	_, err = r.store.ProjectMember.GetActiveMemberInProject(r.repo.DB(), request.ProjectID.String(), candidate.EmployeeID.String())
+	if err == nil {
+		return nil, ErrEmployeeAlreadyInProject
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	tx, done := r.repo.NewTransaction()
+
+	startDate := request.StartDate
+	member := &model.ProjectMember{
+		ProjectID:      request.ProjectID,
+		EmployeeID:     candidate.EmployeeID,
+		SeniorityID:    request.SeniorityID,
+		ProjectSlotID:  slot.ID,
+		DeploymentType: slot.DeploymentType,
+		Status:         model.ProjectMemberStatusActive,
+		StartDate:      &startDate,
+		Rate:           slot.Rate,
+		Discount:       slot.Discount,
+		UpsellPersonID: slot.UpsellPersonID,
+		Note:           slot.Note,
+	}
+	if err := r.store.ProjectMember.Create(tx.DB(), member); err != nil {
+		return nil, done(err)
+	}
+
+	// start the pricing history of the member from its start date
+	rate := &model.ProjectMemberRate{
+		ProjectMemberID: member.ID,
+		Rate:            member.Rate,
+		Discount:        member.Discount,
+		EffectiveFrom:   startDate,
+	}
+	if model.IsUUIDFromString(userID) {
+		createdBy := model.MustGetUUIDFromString(userID)
+		rate.CreatedBy = &createdBy
+	}
+	if _, err := r.store.ProjectMemberRate.Create(tx.DB(), rate); err != nil {
+		return nil, done(err)
+	}
+
+	positions := make([]model.ProjectMemberPosition, 0, len(slot.ProjectSlotPositions))
+	for _, p := range slot.ProjectSlotPositions {
+		positions = append(positions, model.ProjectMemberPosition{
+			ProjectMemberID: member.ID,
+			PositionID:      p.PositionID,
+		})
+	}
+	if len(positions) > 0 {
+		if err := r.store.ProjectMemberPosition.Create(tx.DB(), positions...); err != nil {
+			return nil, done(err)
+		}
+	}
+
+	// members without allocations are allocated by their deployment type
+	if request.AllocationPercentage != model.DefaultAllocationPercentage(slot.DeploymentType) {
+		if _, err := r.store.ProjectMemberAllocation.Create(tx.DB(), &model.ProjectMemberAllocation{
+			ProjectMemberID: member.ID,
+			Percentage:      request.AllocationPercentage,
+			StartDate:       startDate,
+		}); err != nil {
+			return nil, done(err)
+		}
+	}
+
+	if _, err := r.store.ProjectSlot.UpdateSelectedFieldsByID(tx.DB(), slot.ID.String(), model.ProjectSlot{
+		Status:      model.ProjectMemberStatusActive,
+		SeniorityID: request.SeniorityID,
+	}, "status", "seniority_id"); err != nil {
+		return nil, done(err)
+	}
+
+	now := time.Now()
+	if _, err := r.store.StaffingRequest.UpdateSelectedFieldsByID(tx.DB(), id, model.StaffingRequest{
+		Status:          model.StaffingRequestStatusFilled,
+		FilledAt:        &now,
+		ProjectMemberID: member.ID,
+	}, "status", "filled_at", "project_member_id"); err != nil {
+		return nil, done(err)
+	}
+
+	if err := done(nil); err != nil {
+		return nil, err
+	}
+
+	projectName := ""
+	if request.Project != nil {
+		projectName = request.Project.Name
+	}
+	err = r.discord.Log(model.LogDiscordInput{
+		Type: "project_member_add",
+		Data: map[string]interface{}{
+			"employee_id":         userID,
+			"updated_employee_id": member.EmployeeID.String(),
+			"project_name":        projectName,
+			"deployment_type":     member.DeploymentType.String(),
+		},
+	})
+	if err != nil {
+		r.logger.Fields(logger.Fields{
+			"controller": "staffing",
+			"method":     "Fill",
+			"id":         id,
+		}).Error(err, "failed to log project member add")
+	}
+
+	return r.getRequest(id)
+}
+
+func (r *controller) getRequest(id string) (*model.StaffingRequest, error) {
+	request, err := r.store.StaffingRequest.One(r.repo.DB(), id, true)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrStaffingRequestNotFound
+		}
+		return nil, err
+	}
+
+	return request, nil
+}
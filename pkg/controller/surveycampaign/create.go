@@ -0,0 +1,152 @@
+package surveycampaign
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type CreateInput struct {
+	Name               string
+	Subtype            model.EventSubtype
+	Recurrence         model.SurveyRecurrence
+	StartDate          time.Time
+	LeadDays           int
+	DeadlineDays       int
+	ReminderDays       []int
+	AutoClose          bool
+	TemplateID         string
+	Anonymity          model.SurveyAnonymity
+	AnonymityThreshold int
+	ParticipantRule    model.SurveyParticipantRule
+	CreatedBy          string
+}
+
+// Create creates an active survey campaign, its first survey is sent by the scheduler
+func (r *controller) Create(input CreateInput) (*model.SurveyCampaign, error) {
+	campaign := &model.SurveyCampaign{
+		Name:               input.Name,
+		Subtype:            input.Subtype,
+		Recurrence:         input.Recurrence,
+		StartDate:          time.Date(input.StartDate.Year(), input.StartDate.Month(), input.StartDate.Day(), 0, 0, 0, 0, time.UTC),
+		LeadDays:           input.LeadDays,
+		DeadlineDays:       input.DeadlineDays,
+		ReminderDays:       input.ReminderDays,
+		AutoClose:          input.AutoClose,
+		Anonymity:          input.Anonymity,
+		AnonymityThreshold: input.AnonymityThreshold,
+		ParticipantRule:    input.ParticipantRule,
+		IsActive:           true,
+	}
+	if campaign.Anonymity == "" {
+		campaign.Anonymity = model.SurveyAnonymityIdentified
+	}
+
+	if err := r.validate(campaign); err != nil {
+		return nil, err
+	}
+
+	tx, done := r.repo.NewTransaction()
+
+	creator, err := r.store.Employee.One(tx.DB(), input.CreatedBy, false)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, done(ErrCreatorNotFound)
+		}
+		return nil, done(err)
+	}
+	campaign.CreatedBy = creator.ID
+
+	template, err := r.getTemplate(tx.DB(), input.TemplateID, campaign.Subtype)
+	if err != nil {
+		return nil, done(err)
+	}
+	if template != nil {
+		campaign.TemplateID = template.ID
+	}
+
+	campaign, err = r.store.SurveyCampaign.Create(tx.DB(), campaign)
+	if err != nil {
+		return nil, done(err)
+	}
+
+	campaign.Creator = creator
+	campaign.Template = template
+
+	return campaign, done(nil)
+}
+
+// validate checks the campaign can create surveys the same way POST /surveys would
+func (r *controller) validate(campaign *model.SurveyCampaign) error {
+	if !campaign.Subtype.IsValidSurvey() {
+		return ErrInvalidSubtype
+	}
+	if !campaign.Recurrence.IsValidFor(campaign.Subtype) {
+		return ErrInvalidRecurrence
+	}
+	if campaign.LeadDays < 0 || campaign.LeadDays >= campaign.Recurrence.MinDays() {
+		return ErrInvalidLeadDays
+	}
+	if campaign.DeadlineDays < 0 {
+		return ErrInvalidDeadlineDays
+	}
+
+	if campaign.DeadlineDays == 0 && (len(campaign.ReminderDays) > 0 || campaign.AutoClose) {
+		return ErrDeadlineRequired
+	}
+	seen := map[int]bool{}
+	for _, d := range campaign.ReminderDays {
+		if d < 0 || d >= campaign.DeadlineDays || seen[d] {
+			return ErrInvalidReminderDays
+		}
+		seen[d] = true
+	}
+
+	if !campaign.Anonymity.IsValid() {
+		return ErrInvalidAnonymity
+	}
+	if campaign.Anonymity.IsDetached() {
+		if campaign.Subtype != model.EventSubtypeEngagement {
+			return ErrAnonymityNotSupported
+		}
+		// a group of one is never anonymous
+		if campaign.AnonymityThreshold != 0 && campaign.AnonymityThreshold < 2 {
+			return ErrInvalidThreshold
+		}
+		if campaign.Anonymity == model.SurveyAnonymityPseudonymous && (r.config == nil || r.config.Survey.PseudonymSecret == "") {
+			return ErrPseudonymSecretNotConfigured
+		}
+	}
+
+	for _, id := range campaign.ParticipantRule.ProjectIDs {
+		if !model.IsUUIDFromString(id) {
+			return ErrInvalidProjectID
+		}
+	}
+
+	return nil
+}
+
+// getTemplate returns the survey template used by the campaign, nil when the campaign uses the seeded questions
+func (r *controller) getTemplate(db *gorm.DB, templateID string, subtype model.EventSubtype) (*model.SurveyTemplate, error) {
+	if templateID == "" {
+		return nil, nil
+	}
+
+	template, err := r.store.SurveyTemplate.One(db, templateID, false)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSurveyTemplateNotFound
+		}
+		return nil, err
+	}
+
+	if template.Subtype != "" && template.Subtype != subtype {
+		return nil, ErrTemplateSubtypeMismatch
+	}
+
+	return template, nil
+}
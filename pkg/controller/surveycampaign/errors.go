@@ -0,0 +1,21 @@
+package surveycampaign
+
+import "errors"
+
+var (
+	ErrSurveyCampaignNotFound       = errors.New("survey campaign not found")
+	ErrSurveyTemplateNotFound       = errors.New("survey template not found")
+	ErrCreatorNotFound              = errors.New("survey campaign creator not found")
+	ErrInvalidSubtype               = errors.New("invalid survey subtype")
+	ErrInvalidRecurrence            = errors.New("recurrence is not supported by the survey subtype")
+	ErrInvalidLeadDays              = errors.New("lead days must be shorter than the period of the campaign")
+	ErrInvalidDeadlineDays          = errors.New("deadline days must not be negative")
+	ErrInvalidReminderDays          = errors.New("reminder days must be unique, not negative and before the deadline")
+	ErrDeadlineRequired             = errors.New("reminders and auto-close need a deadline")
+	ErrInvalidAnonymity             = errors.New("invalid anonymity mode")
+	ErrAnonymityNotSupported        = errors.New("only engagement surveys can be anonymous")
+	ErrInvalidThreshold             = errors.New("anonymity threshold must be at least 2")
+	ErrPseudonymSecretNotConfigured = errors.New("pseudonymous surveys need SURVEY_PSEUDONYM_SECRET to be configured")
+	ErrTemplateSubtypeMismatch      = errors.New("survey template is not made for this survey type")
+	ErrInvalidProjectID             = errors.New("invalid project id in participant rule")
+)
@@ -0,0 +1,49 @@
+package surveycampaign
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/surveycampaign"
+)
+
+type ListInput struct {
+	model.Pagination
+
+	Subtype  string
+	IsActive *bool
+	Keyword  string
+}
+
+func (r *controller) List(input ListInput) ([]*model.SurveyCampaign, int64, error) {
+	filter := surveycampaign.Filter{
+		Subtype:  input.Subtype,
+		IsActive: input.IsActive,
+		Keyword:  input.Keyword,
+	}
+
+	return r.store.SurveyCampaign.All(r.repo.DB(), filter, input.Pagination)
+}
+
+func (r *controller) Detail(id string) (*model.SurveyCampaign, error) {
+	campaign, err := r.store.SurveyCampaign.One(r.repo.DB(), id, true)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSurveyCampaignNotFound
+		}
+		return nil, err
+	}
+
+	return campaign, nil
+}
+
+// ListRuns returns the history of the surveys created by a campaign
+func (r *controller) ListRuns(id string, pagination model.Pagination) ([]*model.SurveyCampaignRun, int64, error) {
+	if _, err := r.Detail(id); err != nil {
+		return nil, 0, err
+	}
+
+	return r.store.SurveyCampaign.GetRuns(r.repo.DB(), id, pagination)
+}
@@ -0,0 +1,35 @@
+package surveycampaign
+
+import (
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type controller struct {
+	store   *store.Store
+	service *service.Service
+	logger  logger.Logger
+	repo    store.DBRepo
+	config  *config.Config
+}
+
+func New(store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:   store,
+		repo:    repo,
+		service: service,
+		logger:  logger,
+		config:  cfg,
+	}
+}
+
+type IController interface {
+	Create(input CreateInput) (campaign *model.SurveyCampaign, err error)
+	List(input ListInput) (campaigns []*model.SurveyCampaign, total int64, err error)
+	Detail(id string) (campaign *model.SurveyCampaign, err error)
+	Update(input UpdateInput) (campaign *model.SurveyCampaign, err error)
+	ListRuns(id string, pagination model.Pagination) (runs []*model.SurveyCampaignRun, total int64, err error)
+}
@@ -0,0 +1,82 @@
+package surveycampaign
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type UpdateInput struct {
+	ID                 string
+	Name               string
+	LeadDays           int
+	DeadlineDays       int
+	ReminderDays       []int
+	AutoClose          bool
+	TemplateID         string
+	Anonymity          model.SurveyAnonymity
+	AnonymityThreshold int
+	ParticipantRule    model.SurveyParticipantRule
+	IsActive           bool
+}
+
+// Update updates the settings of a campaign, they apply to the surveys created from now on.
+// The subtype, recurrence and start date are kept so the periods of the history do not change
+func (r *controller) Update(input UpdateInput) (*model.SurveyCampaign, error) {
+	tx, done := r.repo.NewTransaction()
+
+	campaign, err := r.store.SurveyCampaign.One(tx.DB(), input.ID, true)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, done(ErrSurveyCampaignNotFound)
+		}
+		return nil, done(err)
+	}
+
+	campaign.Name = input.Name
+	campaign.LeadDays = input.LeadDays
+	campaign.DeadlineDays = input.DeadlineDays
+	campaign.ReminderDays = input.ReminderDays
+	campaign.AutoClose = input.AutoClose
+	campaign.Anonymity = input.Anonymity
+	campaign.AnonymityThreshold = input.AnonymityThreshold
+	campaign.ParticipantRule = input.ParticipantRule
+	campaign.IsActive = input.IsActive
+	if campaign.Anonymity == "" {
+		campaign.Anonymity = model.SurveyAnonymityIdentified
+	}
+
+	if err := r.validate(campaign); err != nil {
+		return nil, done(err)
+	}
+
+	template, err := r.getTemplate(tx.DB(), input.TemplateID, campaign.Subtype)
+	if err != nil {
+		return nil, done(err)
+	}
+	campaign.Template = template
+	campaign.TemplateID = model.UUID{}
+	if template != nil {
+		campaign.TemplateID = template.ID
+	}
+
+	_, err = r.store.SurveyCampaign.UpdateSelectedFieldsByID(tx.DB(), input.ID, *campaign,
+		"name",
+		"lead_days",
+		"deadline_days",
+		"reminder_days",
+		"auto_close",
+		"template_id",
+		"anonymity",
+		"anonymity_threshold",
+		"participant_rule",
+		"is_active",
+	)
+	if err != nil {
+		return nil, done(err)
+	}
+
+	return campaign, done(nil)
+}
@@ -0,0 +1,100 @@
+package surveytemplate
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type QuestionInput struct {
+	Key       string
+	Type      model.QuestionType
+	Content   string
+	Domain    model.QuestionDomain
+	Required  bool
+	Options   []string
+	MinValue  *int
+	MaxValue  *int
+	ParentKey string
+	ShowIf    []string
+}
+
+type CreateInput struct {
+	Name        string
+	Description string
+	Subtype     model.EventSubtype
+	Questions   []QuestionInput
+	CreatedBy   string
+}
+
+// Create creates a survey template with its first version
+func (r *controller) Create(input CreateInput) (*model.SurveyTemplate, error) {
+	if input.Subtype != "" && !input.Subtype.IsValidSurvey() {
+		return nil, ErrInvalidSubtype
+	}
+
+	questions := toQuestions(input.Questions)
+	if err := model.ValidateSurveyTemplateQuestions(questions); err != nil {
+		return nil, err
+	}
+
+	tx, done := r.repo.NewTransaction()
+
+	creator, err := r.store.Employee.One(tx.DB(), input.CreatedBy, false)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, done(ErrCreatorNotFound)
+		}
+		return nil, done(err)
+	}
+
+	template, err := r.store.SurveyTemplate.Create(tx.DB(), &model.SurveyTemplate{
+		Name:          input.Name,
+		Description:   input.Description,
+		Subtype:       input.Subtype,
+		LatestVersion: 1,
+		CreatedBy:     creator.ID,
+	})
+	if err != nil {
+		return nil, done(err)
+	}
+
+	templateVersion, err := r.store.SurveyTemplate.CreateVersion(tx.DB(), &model.SurveyTemplateVersion{
+		TemplateID: template.ID,
+		Version:    1,
+		CreatedBy:  creator.ID,
+		Questions:  questions,
+	})
+	if err != nil {
+		return nil, done(err)
+	}
+
+	template.Creator = creator
+	template.Versions = []*model.SurveyTemplateVersion{templateVersion}
+
+	return template, done(nil)
+}
+
+// toQuestions keeps the input order as the question order
+func toQuestions(inputs []QuestionInput) []*model.SurveyTemplateQuestion {
+	questions := make([]*model.SurveyTemplateQuestion, 0, len(inputs))
+	for i, q := range inputs {
+		questions = append(questions, &model.SurveyTemplateQuestion{
+			Key:       q.Key,
+			Type:      q.Type,
+			Content:   q.Content,
+			Order:     int64(i + 1),
+			Domain:    q.Domain,
+			Required:  q.Required,
+			Options:   q.Options,
+			MinValue:  q.MinValue,
+			MaxValue:  q.MaxValue,
+			ParentKey: q.ParentKey,
+			ShowIf:    q.ShowIf,
+		})
+	}
+
+	return questions
+}
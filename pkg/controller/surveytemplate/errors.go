@@ -0,0 +1,10 @@
+package surveytemplate
+
+import "errors"
+
+var (
+	ErrSurveyTemplateNotFound        = errors.New("survey template not found")
+	ErrSurveyTemplateVersionNotFound = errors.New("survey template version not found")
+	ErrCreatorNotFound               = errors.New("survey template creator not found")
+	ErrInvalidSubtype                = errors.New("invalid survey subtype")
+)
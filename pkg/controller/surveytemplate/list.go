@@ -0,0 +1,38 @@
+package surveytemplate
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/surveytemplate"
+)
+
+type ListInput struct {
+	model.Pagination
+
+	Subtype string
+	Keyword string
+}
+
+func (r *controller) List(input ListInput) ([]*model.SurveyTemplate, int64, error) {
+	filter := surveytemplate.Filter{
+		Subtype: input.Subtype,
+		Keyword: input.Keyword,
+	}
+
+	return r.store.SurveyTemplate.All(r.repo.DB(), filter, input.Pagination)
+}
+
+func (r *controller) Detail(id string) (*model.SurveyTemplate, error) {
+	template, err := r.store.SurveyTemplate.One(r.repo.DB(), id, true)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSurveyTemplateNotFound
+		}
+		return nil, err
+	}
+
+	return template, nil
+}
@@ -0,0 +1,35 @@
+package surveytemplate
+
+import (
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type controller struct {
+	store   *store.Store
+	service *service.Service
+	logger  logger.Logger
+	repo    store.DBRepo
+	config  *config.Config
+}
+
+func New(store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:   store,
+		repo:    repo,
+		service: service,
+		logger:  logger,
+		config:  cfg,
+	}
+}
+
+type IController interface {
+	Create(input CreateInput) (template *model.SurveyTemplate, err error)
+	List(input ListInput) (templates []*model.SurveyTemplate, total int64, err error)
+	Detail(id string) (template *model.SurveyTemplate, err error)
+	CreateVersion(input CreateVersionInput) (templateVersion *model.SurveyTemplateVersion, err error)
+	GetVersion(templateID string, version int) (templateVersion *model.SurveyTemplateVersion, err error)
+}
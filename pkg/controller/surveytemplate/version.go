@@ -0,0 +1,89 @@
+package surveytemplate
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type CreateVersionInput struct {
+	TemplateID string
+	Questions  []QuestionInput
+	CreatedBy  string
+}
+
+// CreateVersion publishes a new version of the template, existing versions are never changed
+// so surveys already sent keep validating answers against their own questions
+func (r *controller) CreateVersion(input CreateVersionInput) (*model.SurveyTemplateVersion, error) {
+	questions := toQuestions(input.Questions)
+	if err := model.ValidateSurveyTemplateQuestions(questions); err != nil {
+		return nil, err
+	}
+
+	tx, done := r.repo.NewTransaction()
+
+	template, err := r.store.SurveyTemplate.One(tx.DB(), input.TemplateID, false)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, done(ErrSurveyTemplateNotFound)
+		}
+		return nil, done(err)
+	}
+
+	creator, err := r.store.Employee.One(tx.DB(), input.CreatedBy, false)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, done(ErrCreatorNotFound)
+		}
+		return nil, done(err)
+	}
+
+	template.LatestVersion++
+
+	// the unique (template_id, version) constraint rejects concurrent publishes of the same version
+	templateVersion, err := r.store.SurveyTemplate.CreateVersion(tx.DB(), &model.SurveyTemplateVersion{
+		TemplateID: template.ID,
+		Version:    template.LatestVersion,
+		CreatedBy:  creator.ID,
+		Questions:  questions,
+	})
+	if err != nil {
+		return nil, done(err)
+	}
+
+	if _, err := r.store.SurveyTemplate.UpdateSelectedFieldsByID(tx.DB(), template.ID.String(), *template, "latest_version"); err != nil {
+		return nil, done(err)
+	}
+
+	templateVersion.Template = template
+
+	return templateVersion, done(nil)
+}
+
+// GetVersion returns a version of the template with its questions, version 0 is the latest version
+func (r *controller) GetVersion(templateID string, version int) (*model.SurveyTemplateVersion, error) {
+	db := r.repo.DB()
+
+	if version == 0 {
+		template, err := r.store.SurveyTemplate.One(db, templateID, false)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, ErrSurveyTemplateNotFound
+			}
+			return nil, err
+		}
+		version = template.LatestVersion
+	}
+
+	templateVersion, err := r.store.SurveyTemplate.OneVersion(db, templateID, version)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSurveyTemplateVersionNotFound
+		}
+		return nil, err
+	}
+
+	return templateVersion, nil
+}
@@ -0,0 +1,369 @@
+package timesheet
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type LogInput struct {
+	// EmployeeID defaults to the user, only users who can edit timesheets log hours for others
+	EmployeeID string
+	// ProjectID is the id or the code of the project
+	ProjectID  string
+	WorkUnitID string
+	Date       time.Time
+	Hours      float64
+	Note       string
+	UserID     string
+	CanEdit    bool
+}
+
+type EntryInput struct {
+	TimesheetID string
+	ID          string
+	WorkUnitID  string
+	Hours       float64
+	Note        string
+	UserID      string
+	CanEdit     bool
+}
+
+type ImportInput struct {
+	Rows    []ImportRow
+	UserID  string
+	CanEdit bool
+}
+
+// ImportRow is a line of an imported timesheet file
+type ImportRow struct {
+	Line int
+	// Email is the team email of the employee, the user when it is empty
+	Email string
+	// Project is the id or the code of the project
+	Project string
+	// WorkUnit is the id or the name of a work unit of the project
+	WorkUnit string
+	Date     time.Time
+	Hours    float64
+	Note     string
+}
+
+// Log records hours of an employee on a project in the timesheet of the week
+func (r *controller) Log(input LogInput) (*model.TimesheetEntry, error) {
+	tx, done := r.repo.NewTransaction()
+
+	entry, err := r.logEntry(tx.DB(), input)
+	if err != nil {
+		return nil, done(err)
+	}
+
+	if err := done(nil); err != nil {
+		return nil, err
+	}
+
+	return r.store.Timesheet.OneEntry(r.repo.DB(), entry.TimesheetID.String(), entry.ID.String())
+}
+
+// UpdateEntry changes the hours, work unit or note of an entry of a timesheet which is not locked
+func (r *controller) UpdateEntry(input EntryInput) (*model.TimesheetEntry, error) {
+	if input.Hours <= 0 || input.Hours > model.MaxTimesheetHoursPerDay {
+		return nil, ErrInvalidHours
+	}
+
+	t, entry, err := r.getEditableEntry(input)
+	if err != nil {
+		return nil, err
+	}
+
+	db := r.repo.DB()
+
+	if err := r.validateWorkUnit(db, t.ProjectID.String(), input.WorkUnitID); err != nil {
+		return nil, err
+	}
+	if err := r.validateDailyHours(db, t.EmployeeID.String(), entry.Date, input.Hours, entry.ID.String()); err != nil {
+		return nil, err
+	}
+
+	tx, done := r.repo.NewTransaction()
+
+	if err := r.reopenRejected(tx.DB(), t); err != nil {
+		return nil, done(err)
+	}
+
+	entry.WorkUnitID = model.UUID{}
+	if input.WorkUnitID != "" {
+		entry.WorkUnitID = model.MustGetUUIDFromString(input.WorkUnitID)
+	}
+	entry.Hours = input.Hours
+	entry.Note = input.Note
+	if _, err := r.store.Timesheet.UpdateEntrySelectedFieldsByID(tx.DB(), entry.ID.String(), *entry, "work_unit_id", "hours", "note"); err != nil {
+		return nil, done(err)
+	}
+
+	if err := done(nil); err != nil {
+		return nil, err
+	}
+
+	return r.store.Timesheet.OneEntry(r.repo.DB(), input.TimesheetID, input.ID)
+}
+
+// DeleteEntry removes an entry of a timesheet which is not locked
+func (r *controller) DeleteEntry(input EntryInput) error {
+	t, entry, err := r.getEditableEntry(input)
+	if err != nil {
+		return err
+	}
+
+	tx, done := r.repo.NewTransaction()
+
+	if err := r.reopenRejected(tx.DB(), t); err != nil {
+		return done(err)
+	}
+
+	return done(r.store.Timesheet.DeleteEntry(tx.DB(), entry.ID.String()))
+}
+
+// Import logs the rows of an imported file, nothing is logged when any row is invalid
+func (r *controller) Import(input ImportInput) (int, error) {
+	if len(input.Rows) == 0 {
+		return 0, ErrEmptyImport
+	}
+
+	tx, done := r.repo.NewTransaction()
+
+	employeeIDs := map[string]string{}
+	workUnitIDs := map[string]map[string]string{}
+
+	for _, row := range input.Rows {
+		logInput := LogInput{
+			ProjectID: row.Project,
+			Date:      row.Date,
+			Hours:     row.Hours,
+			Note:      row.Note,
+			UserID:    input.UserID,
+			CanEdit:   input.CanEdit,
+		}
+
+		if row.Email != "" {
+			employeeID, ok := employeeIDs[row.Email]
+			if !ok {
+				e, err := r.store.Employee.OneByEmail(tx.DB(), row.Email)
+				if err != nil {
+					if errors.Is(err, gorm.ErrRecordNotFound) {
+						err = ErrEmployeeNotFound
+					}
+					return 0, done(fmt.Errorf("line %d: %w", row.Line, err))
+				}
+				employeeID = e.ID.String()
+				employeeIDs[row.Email] = employeeID
+			}
+			logInput.EmployeeID = employeeID
+		}
+
+		if row.WorkUnit != "" {
+			if model.IsUUIDFromString(row.WorkUnit) {
+				logInput.WorkUnitID = row.WorkUnit
+			} else {
+				names, ok := workUnitIDs[row.Project]
+				if !ok {
+					var err error
+					names, err = r.getWorkUnitIDsByName(tx.DB(), row.Project)
+					if err != nil {
+						return 0, done(fmt.Errorf("line %d: %w", row.Line, err))
+					}
+					workUnitIDs[row.Project] = names
+				}
+
+				logInput.WorkUnitID, ok = names[strings.ToLower(row.WorkUnit)]
+				if !ok {
+					return 0, done(fmt.Errorf("line %d: %w", row.Line, ErrWorkUnitNotFound))
+				}
+			}
+		}
+
+		if _, err := r.logEntry(tx.DB(), logInput); err != nil {
+			return 0, done(fmt.Errorf("line %d: %w", row.Line, err))
+		}
+	}
+
+	return len(input.Rows), done(nil)
+}
+
+func (r *controller) logEntry(db *gorm.DB, input LogInput) (*model.TimesheetEntry, error) {
+	if input.EmployeeID == "" {
+		input.EmployeeID = input.UserID
+	}
+	if input.EmployeeID != input.UserID && !input.CanEdit {
+		return nil, ErrNotAllowedToLog
+	}
+	if input.Hours <= 0 || input.Hours > model.MaxTimesheetHoursPerDay {
+		return nil, ErrInvalidHours
+	}
+
+	project, err := r.store.Project.One(db, input.ProjectID, false)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrProjectNotFound
+		}
+		return nil, err
+	}
+
+	date := time.Date(input.Date.Year(), input.Date.Month(), input.Date.Day(), 0, 0, 0, 0, time.UTC)
+
+	members, err := r.store.ProjectMember.GetByEmployeeIDInTimeRange(db, input.EmployeeID, date, date)
+	if err != nil {
+		return nil, err
+	}
+	isMember := false
+	for _, m := range members {
+		if m.ProjectID == project.ID {
+			isMember = true
+			break
+		}
+	}
+	if !isMember {
+		return nil, ErrNotProjectMember
+	}
+
+	if err := r.validateWorkUnit(db, project.ID.String(), input.WorkUnitID); err != nil {
+		return nil, err
+	}
+	if err := r.validateDailyHours(db, input.EmployeeID, date, input.Hours, ""); err != nil {
+		return nil, err
+	}
+
+	weekStart := model.TimesheetWeekStart(date)
+
+	t, err := r.store.Timesheet.OneByWeek(db, input.EmployeeID, project.ID.String(), weekStart)
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		t = &model.Timesheet{
+			BaseModel:  model.BaseModel{ID: model.NewUUID()},
+			EmployeeID: model.MustGetUUIDFromString(input.EmployeeID),
+			ProjectID:  project.ID,
+			WeekStart:  weekStart,
+			Status:     model.TimesheetStatusDraft,
+		}
+		if _, err := r.store.Timesheet.Create(db, t); err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	case t.IsLocked():
+		return nil, ErrTimesheetLocked
+	default:
+		if err := r.reopenRejected(db, t); err != nil {
+			return nil, err
+		}
+	}
+
+	entry := &model.TimesheetEntry{
+		BaseModel:   model.BaseModel{ID: model.NewUUID()},
+		TimesheetID: t.ID,
+		Date:        date,
+		Hours:       input.Hours,
+		Note:        input.Note,
+	}
+	if input.WorkUnitID != "" {
+		entry.WorkUnitID = model.MustGetUUIDFromString(input.WorkUnitID)
+	}
+
+	return r.store.Timesheet.CreateEntry(db, entry)
+}
+
+func (r *controller) getEditableEntry(input EntryInput) (*model.Timesheet, *model.TimesheetEntry, error) {
+	t, err := r.getTimesheet(input.TimesheetID, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if t.EmployeeID.String() != input.UserID && !input.CanEdit {
+		return nil, nil, ErrNotAllowedToLog
+	}
+	if t.IsLocked() {
+		return nil, nil, ErrTimesheetLocked
+	}
+
+	entry, err := r.store.Timesheet.OneEntry(r.repo.DB(), input.TimesheetID, input.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, ErrEntryNotFound
+		}
+		return nil, nil, err
+	}
+
+	return t, entry, nil
+}
+
+// reopenRejected moves a rejected timesheet back to draft once its employee corrects it
+func (r *controller) reopenRejected(db *gorm.DB, t *model.Timesheet) error {
+	if t.Status != model.TimesheetStatusRejected {
+		return nil
+	}
+
+	t.Status = model.TimesheetStatusDraft
+	_, err := r.store.Timesheet.UpdateSelectedFieldsByID(db, t.ID.String(), *t, "status")
+	return err
+}
+
+func (r *controller) validateWorkUnit(db *gorm.DB, projectID string, workUnitID string) error {
+	if workUnitID == "" {
+		return nil
+	}
+
+	wu, err := r.store.WorkUnit.One(db, workUnitID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrWorkUnitNotFound
+		}
+		return err
+	}
+
+	if wu.ProjectID.String() != projectID {
+		return ErrWorkUnitNotFound
+	}
+
+	return nil
+}
+
+func (r *controller) validateDailyHours(db *gorm.DB, employeeID string, date time.Time, hours float64, excludedEntryID string) error {
+	logged, err := r.store.Timesheet.GetLoggedHoursOnDate(db, employeeID, date, excludedEntryID)
+	if err != nil {
+		return err
+	}
+
+	if logged+hours > model.MaxTimesheetHoursPerDay {
+		return ErrDailyHoursExceeded
+	}
+
+	return nil
+}
+
+// getWorkUnitIDsByName returns the ids of the work units of a project by their lowercase name
+func (r *controller) getWorkUnitIDsByName(db *gorm.DB, projectID string) (map[string]string, error) {
+	project, err := r.store.Project.One(db, projectID, false)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrProjectNotFound
+		}
+		return nil, err
+	}
+
+	workUnits, err := r.store.WorkUnit.GetByProjectID(db, project.ID.String(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make(map[string]string, len(workUnits))
+	for _, wu := range workUnits {
+		rs[strings.ToLower(wu.Name)] = wu.ID.String()
+	}
+
+	return rs, nil
+}
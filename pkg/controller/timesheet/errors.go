@@ -0,0 +1,23 @@
+package timesheet
+
+import "errors"
+
+var (
+	ErrTimesheetNotFound      = errors.New("timesheet not found")
+	ErrEntryNotFound          = errors.New("timesheet entry not found")
+	ErrEmployeeNotFound       = errors.New("employee not found")
+	ErrProjectNotFound        = errors.New("project not found")
+	ErrWorkUnitNotFound       = errors.New("work unit not found in the project")
+	ErrNotProjectMember       = errors.New("employee is not a member of the project on this date")
+	ErrInvalidHours           = errors.New("hours must be more than 0 and at most 24")
+	ErrDailyHoursExceeded     = errors.New("hours logged on a day can not be more than 24")
+	ErrInvalidReviewStatus    = errors.New("timesheets can only be approved or rejected")
+	ErrTimesheetLocked        = errors.New("timesheet is submitted or approved and can not be changed")
+	ErrTimesheetNotSubmitted  = errors.New("only submitted timesheets can be reviewed")
+	ErrEmptyTimesheet         = errors.New("timesheet has no hours logged")
+	ErrEmptyImport            = errors.New("import file has no entries")
+	ErrNotAllowedToLog        = errors.New("not allowed to log hours for other employees")
+	ErrNotAllowedToRead       = errors.New("not allowed to read the timesheet")
+	ErrNotAllowedToReview     = errors.New("only the technical leads of the project can review its timesheets")
+	ErrNotAllowedToReviewSelf = errors.New("employees can not review their own timesheets")
+)
@@ -0,0 +1,117 @@
+package timesheet
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// GetInvoiceLineItems returns one invoice line item per member of a time-and-material project with the hours approved
+// between two dates, members are charged by the hour at their monthly rate over the standard working hours of a month.
+// The rate is the one valid in the billed period, a member gets one line item per rate when their rate changes in it
+func (r *controller) GetInvoiceLineItems(projectID string, from, to time.Time) ([]model.InvoiceItem, error) {
+	db := r.repo.DB()
+
+	project, err := r.store.Project.One(db, projectID, false)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrProjectNotFound
+		}
+		return nil, err
+	}
+
+	if project.Type != model.ProjectTypeTimeMaterial {
+		return nil, nil
+	}
+
+	hours, err := r.store.Timesheet.GetApprovedHoursByProjectID(db, project.ID.String(), from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]model.UUID, 0, len(hours))
+	for _, h := range hours {
+		ids = append(ids, h.EmployeeID)
+	}
+
+	employees, err := r.store.Employee.GetByIDs(db, ids)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[model.UUID]string, len(employees))
+	for _, e := range employees {
+		names[e.ID] = e.FullName
+	}
+
+	items := make([]model.InvoiceItem, 0, len(hours))
+	for _, h := range hours {
+		members, err := r.store.ProjectMember.GetByEmployeeIDInTimeRange(db, h.EmployeeID.String(), from, to)
+		if err != nil {
+			return nil, err
+		}
+
+		// the latest assignment in the period sets the rate
+		var member *model.ProjectMember
+		for _, m := range members {
+			if m.ProjectID == project.ID {
+				member = m
+			}
+		}
+		if member == nil {
+			continue
+		}
+
+		rates, err := r.store.ProjectMemberRate.GetByProjectMemberIDs(db, []string{member.ID.String()})
+		if err != nil {
+			return nil, err
+		}
+
+		periods := member.RatePeriods(rates, from, to)
+		for _, p := range periods {
+			// the hours of the period are queried again only when the rate changes in the billed period
+			periodHours := h.Hours
+			if len(periods) > 1 {
+				periodHours, err = r.getApprovedHours(project.ID, h.EmployeeID, p.From, p.To)
+				if err != nil {
+					return nil, err
+				}
+				if periodHours == 0 {
+					continue
+				}
+			}
+
+			rate, _ := p.Rate.Float64()
+			unitCost := math.Round(rate/model.TimesheetHoursPerMonth*100) / 100
+			quantity := math.Round(periodHours*100) / 100
+
+			items = append(items, model.InvoiceItem{
+				Quantity:    quantity,
+				UnitCost:    unitCost,
+				Cost:        math.Round(quantity*unitCost*100) / 100,
+				Description: fmt.Sprintf("%s - %s to %s", names[h.EmployeeID], p.From.Format("02/01/2006"), p.To.Format("02/01/2006")),
+			})
+		}
+	}
+
+	return items, nil
+}
+
+func (r *controller) getApprovedHours(projectID model.UUID, employeeID model.UUID, from, to time.Time) (float64, error) {
+	hours, err := r.store.Timesheet.GetApprovedHoursByProjectID(r.repo.DB(), projectID.String(), from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, h := range hours {
+		if h.EmployeeID == employeeID {
+			return h.Hours, nil
+		}
+	}
+
+	return 0, nil
+}
@@ -0,0 +1,43 @@
+package timesheet
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type controller struct {
+	store   *store.Store
+	service *service.Service
+	logger  logger.Logger
+	repo    store.DBRepo
+	config  *config.Config
+}
+
+func New(store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:   store,
+		repo:    repo,
+		service: service,
+		logger:  logger,
+		config:  cfg,
+	}
+}
+
+type IController interface {
+	List(input ListInput) (timesheets []*model.Timesheet, total int64, err error)
+	Detail(input DetailInput) (timesheet *model.Timesheet, err error)
+	Submit(input DetailInput) (timesheet *model.Timesheet, err error)
+	Review(input ReviewInput) (timesheet *model.Timesheet, err error)
+
+	Log(input LogInput) (entry *model.TimesheetEntry, err error)
+	UpdateEntry(input EntryInput) (entry *model.TimesheetEntry, err error)
+	DeleteEntry(input EntryInput) error
+	Import(input ImportInput) (imported int, err error)
+
+	GetInvoiceLineItems(projectID string, from, to time.Time) (items []model.InvoiceItem, err error)
+}
@@ -0,0 +1,177 @@
+package timesheet
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/timesheet"
+)
+
+type ListInput struct {
+	model.Pagination
+
+	// Role is reviewer to get the timesheets of the projects the user leads, the user's own timesheets otherwise.
+	// Users who can read all timesheets are not limited to either
+	Role       string
+	EmployeeID string
+	ProjectID  string
+	Status     string
+	From       *time.Time
+	To         *time.Time
+	UserID     string
+	CanRead    bool
+}
+
+type DetailInput struct {
+	ID      string
+	UserID  string
+	CanRead bool
+}
+
+type ReviewInput struct {
+	ID         string
+	Status     model.TimesheetStatus
+	Note       string
+	UserID     string
+	CanApprove bool
+}
+
+// List returns the weekly timesheets the user can read, latest week first
+func (r *controller) List(input ListInput) ([]*model.Timesheet, int64, error) {
+	filter := timesheet.Filter{
+		EmployeeID: input.EmployeeID,
+		Status:     input.Status,
+		From:       input.From,
+		To:         input.To,
+	}
+	if input.ProjectID != "" {
+		filter.ProjectIDs = []string{input.ProjectID}
+	}
+
+	switch {
+	case input.Role == "reviewer":
+		filter.ReviewerID = input.UserID
+	case !input.CanRead:
+		filter.EmployeeID = input.UserID
+	}
+
+	return r.store.Timesheet.All(r.repo.DB(), filter, input.Pagination)
+}
+
+// Detail returns a timesheet with its entries, to its employee, the leads of its project and users who can read all timesheets
+func (r *controller) Detail(input DetailInput) (*model.Timesheet, error) {
+	t, err := r.getTimesheet(input.ID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !input.CanRead && t.EmployeeID.String() != input.UserID {
+		isLead, err := r.isProjectLead(t.ProjectID.String(), input.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if !isLead {
+			return nil, ErrNotAllowedToRead
+		}
+	}
+
+	return t, nil
+}
+
+// Submit sends a timesheet of the user to the leads of the project for approval, it is locked until reviewed
+func (r *controller) Submit(input DetailInput) (*model.Timesheet, error) {
+	t, err := r.getTimesheet(input.ID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.EmployeeID.String() != input.UserID {
+		return nil, ErrNotAllowedToLog
+	}
+	if t.IsLocked() {
+		return nil, ErrTimesheetLocked
+	}
+	if len(t.Entries) == 0 {
+		return nil, ErrEmptyTimesheet
+	}
+
+	now := time.Now()
+	t.Status = model.TimesheetStatusSubmitted
+	t.SubmittedAt = &now
+	if _, err := r.store.Timesheet.UpdateSelectedFieldsByID(r.repo.DB(), input.ID, *t, "status", "submitted_at"); err != nil {
+		return nil, err
+	}
+
+	return r.getTimesheet(input.ID, true)
+}
+
+// Review approves or rejects a submitted timesheet. Approved timesheets are locked for good,
+// rejected timesheets go back to the employee to be corrected and submitted again
+func (r *controller) Review(input ReviewInput) (*model.Timesheet, error) {
+	if input.Status != model.TimesheetStatusApproved && input.Status != model.TimesheetStatusRejected {
+		return nil, ErrInvalidReviewStatus
+	}
+
+	t, err := r.getTimesheet(input.ID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.Status != model.TimesheetStatusSubmitted {
+		return nil, ErrTimesheetNotSubmitted
+	}
+
+	// nobody reviews their own timesheet, not even the ones who can approve every timesheet
+	if t.EmployeeID.String() == input.UserID {
+		return nil, ErrNotAllowedToReviewSelf
+	}
+
+	if !input.CanApprove {
+		isLead, err := r.isProjectLead(t.ProjectID.String(), input.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if !isLead {
+			return nil, ErrNotAllowedToReview
+		}
+	}
+
+	now := time.Now()
+	t.Status = input.Status
+	t.ReviewedBy = model.MustGetUUIDFromString(input.UserID)
+	t.ReviewedAt = &now
+	t.ReviewNote = input.Note
+	if _, err := r.store.Timesheet.UpdateSelectedFieldsByID(r.repo.DB(), input.ID, *t, "status", "reviewed_by", "reviewed_at", "review_note"); err != nil {
+		return nil, err
+	}
+
+	return r.getTimesheet(input.ID, true)
+}
+
+func (r *controller) getTimesheet(id string, preload bool) (*model.Timesheet, error) {
+	t, err := r.store.Timesheet.One(r.repo.DB(), id, preload)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTimesheetNotFound
+		}
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// isProjectLead is true when the employee is an active technical lead of the project
+func (r *controller) isProjectLead(projectID string, employeeID string) (bool, error) {
+	head, err := r.store.ProjectHead.One(r.repo.DB(), projectID, employeeID, model.HeadPositionTechnicalLead)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return head.EndDate == nil || head.EndDate.After(time.Now()), nil
+}
@@ -0,0 +1,80 @@
+package timesheet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/store/timesheet"
+)
+
+type fakeRepo struct {
+	store.DBRepo
+}
+
+func (r *fakeRepo) DB() *gorm.DB {
+	return nil
+}
+
+type fakeTimesheetStore struct {
+	timesheet.IStore
+	timesheet *model.Timesheet
+}
+
+func (s *fakeTimesheetStore) One(db *gorm.DB, id string, preload bool) (*model.Timesheet, error) {
+	rs := *s.timesheet
+	return &rs, nil
+}
+
+func (s *fakeTimesheetStore) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.Timesheet, updatedFields ...string) (*model.Timesheet, error) {
+	s.timesheet = &updateModel
+	return s.timesheet, nil
+}
+
+func TestController_Review(t *testing.T) {
+	const (
+		employeeID = "2655832e-f009-4b73-a535-64c3a22e558f"
+		approverID = "ecea9d15-05ba-4a4e-9787-54210e3b98ce"
+	)
+
+	tcs := map[string]struct {
+		input          ReviewInput
+		expectedErr    error
+		expectedStatus model.TimesheetStatus
+	}{
+		"approver approves the timesheet of another employee": {
+			input:          ReviewInput{Status: model.TimesheetStatusApproved, UserID: approverID, CanApprove: true},
+			expectedStatus: model.TimesheetStatusApproved,
+		},
+		"approver can not approve their own timesheet": {
+			input:          ReviewInput{Status: model.TimesheetStatusApproved, UserID: employeeID, CanApprove: true},
+			expectedErr:    ErrNotAllowedToReviewSelf,
+			expectedStatus: model.TimesheetStatusSubmitted,
+		},
+		"employee can not reject their own timesheet": {
+			input:          ReviewInput{Status: model.TimesheetStatusRejected, UserID: employeeID},
+			expectedErr:    ErrNotAllowedToReviewSelf,
+			expectedStatus: model.TimesheetStatusSubmitted,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			cfg := config.LoadTestConfig()
+			timesheetStore := &fakeTimesheetStore{timesheet: &model.Timesheet{
+				EmployeeID: model.MustGetUUIDFromString(employeeID),
+				Status:     model.TimesheetStatusSubmitted,
+			}}
+			ctrl := New(&store.Store{Timesheet: timesheetStore}, &fakeRepo{}, nil, logger.NewLogrusLogger(), &cfg)
+
+			tc.input.ID = "9f1c6a52-3e4b-4d7a-8c2f-5b6e7d8a9c01"
+			_, err := ctrl.Review(tc.input)
+			require.ErrorIs(t, err, tc.expectedErr)
+			require.Equal(t, tc.expectedStatus, timesheetStore.timesheet.Status)
+		})
+	}
+}
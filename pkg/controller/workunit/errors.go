@@ -0,0 +1,12 @@
+package workunit
+
+import "errors"
+
+var (
+	ErrProjectNotFound    = errors.New("project not found")
+	ErrWorkUnitNotFound   = errors.New("work unit not found")
+	ErrInvalidGithubRepo  = errors.New("invalid github repository, must be owner/name or a github.com URL")
+	ErrWorkUnitNotLinked  = errors.New("work unit is not linked to a github repository")
+	ErrWorkUnitArchived   = errors.New("archived work unit can not be synced")
+	ErrGithubRepoNotFound = errors.New("github repository not found")
+)
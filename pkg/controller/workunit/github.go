@@ -0,0 +1,277 @@
+package workunit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service/github"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type LinkGithubInput struct {
+	ProjectID  string
+	WorkUnitID string
+	// Repo is owner/name or the GitHub URL of the repository
+	Repo string
+	Now  time.Time
+}
+
+// LinkGithub links a work unit to a GitHub repository and syncs it right away
+func (r *controller) LinkGithub(input LinkGithubInput) (*model.WorkUnit, error) {
+	owner, name, ok := model.ParseGithubRepo(input.Repo)
+	if !ok {
+		return nil, ErrInvalidGithubRepo
+	}
+
+	workUnit, err := r.getWorkUnit(input.ProjectID, input.WorkUnitID)
+	if err != nil {
+		return nil, err
+	}
+	if workUnit.Status == model.WorkUnitStatusArchived {
+		return nil, ErrWorkUnitArchived
+	}
+
+	metadata := model.GithubSourceMetadata{
+		Provider: model.WorkUnitSourceGithub,
+		GithubRepository: model.GithubRepository{
+			Owner: owner,
+			Name:  name,
+		},
+	}
+
+	return r.sync(workUnit, &metadata, input.Now)
+}
+
+// UnlinkGithub removes the GitHub repository of a work unit, its members and stacks are kept
+func (r *controller) UnlinkGithub(projectID string, workUnitID string) error {
+	workUnit, err := r.getWorkUnit(projectID, workUnitID)
+	if err != nil {
+		return err
+	}
+	if _, ok := workUnit.GetGithubMetadata(); !ok {
+		return ErrWorkUnitNotLinked
+	}
+
+	_, err = r.store.WorkUnit.UpdateSelectedFieldsByID(r.repo.DB(), workUnitID, model.WorkUnit{
+		SourceMetadata: []byte("[]"),
+	}, "source_metadata")
+	return err
+}
+
+// SyncGithub syncs a work unit with its GitHub repository
+func (r *controller) SyncGithub(projectID string, workUnitID string, now time.Time) (*model.WorkUnit, error) {
+	workUnit, err := r.getWorkUnit(projectID, workUnitID)
+	if err != nil {
+		return nil, err
+	}
+	if workUnit.Status == model.WorkUnitStatusArchived {
+		return nil, ErrWorkUnitArchived
+	}
+
+	metadata, ok := workUnit.GetGithubMetadata()
+	if !ok {
+		return nil, ErrWorkUnitNotLinked
+	}
+
+	return r.sync(workUnit, metadata, now)
+}
+
+// SyncAllGithub syncs the active work units linked to a GitHub repository, a failed sync is recorded in the work unit
+func (r *controller) SyncAllGithub(now time.Time) (int, error) {
+	l := r.logger.AddField("method", "SyncAllGithub")
+
+	workUnits, err := r.store.WorkUnit.GetGithubLinked(r.repo.DB())
+	if err != nil {
+		return 0, err
+	}
+
+	synced := 0
+	for _, wu := range workUnits {
+		metadata, ok := wu.GetGithubMetadata()
+		if !ok {
+			continue
+		}
+
+		if _, err := r.sync(wu, metadata, now); err != nil {
+			l.AddField("workUnitID", wu.ID).Error(err, "failed to sync work unit with github")
+			continue
+		}
+		synced++
+	}
+
+	return synced, nil
+}
+
+// sync gets the repository of a work unit from GitHub, adds the contributors who are active in the project as members
+// and the main languages as stacks. Members and stacks are never removed by a sync
+func (r *controller) sync(workUnit *model.WorkUnit, metadata *model.GithubSourceMetadata, now time.Time) (*model.WorkUnit, error) {
+	since := now.AddDate(0, 0, -model.GithubActivityDays)
+	repo, err := r.service.Github.GetRepository(context.Background(), metadata.Owner, metadata.Name, since)
+	if err != nil {
+		if errors.Is(err, github.ErrRepositoryNotFound) {
+			err = ErrGithubRepoNotFound
+		}
+
+		// a linked work unit keeps the data of its last successful sync and records why this one failed
+		if _, linked := workUnit.GetGithubMetadata(); linked {
+			metadata.SyncError = err.Error()
+			if saveErr := r.saveMetadata(r.repo, workUnit, metadata); saveErr != nil {
+				return nil, saveErr
+			}
+		}
+		return nil, err
+	}
+
+	accounts, err := r.store.SocialAccount.GetByType(r.repo.DB(), model.SocialAccountTypeGitHub.String())
+	if err != nil {
+		return nil, err
+	}
+	employeeIDs := make(map[string]model.UUID, len(accounts))
+	for _, a := range accounts {
+		if a.AccountID != "" {
+			employeeIDs[strings.ToLower(a.AccountID)] = a.EmployeeID
+		}
+	}
+	for i := range repo.Contributors {
+		if id, ok := employeeIDs[strings.ToLower(repo.Contributors[i].Login)]; ok {
+			employeeID := id
+			repo.Contributors[i].EmployeeID = &employeeID
+		}
+	}
+
+	_, stacks, err := r.store.Stack.All(r.repo.DB(), "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, done := r.repo.NewTransaction()
+
+	if err := r.addMembers(tx.DB(), workUnit, repo.Contributors, now); err != nil {
+		return nil, done(err)
+	}
+
+	if err := r.addStacks(tx.DB(), workUnit, repo.MainLanguages(model.GithubMinLanguageShare), stacks); err != nil {
+		return nil, done(err)
+	}
+
+	metadata.GithubRepository = *repo
+	metadata.SyncedAt = &now
+	metadata.SyncError = ""
+	if err := r.saveMetadata(tx, workUnit, metadata); err != nil {
+		return nil, done(err)
+	}
+
+	if err := done(nil); err != nil {
+		return nil, err
+	}
+
+	return r.store.WorkUnit.GetDetailByID(r.repo.DB(), workUnit.ID.String())
+}
+
+// addMembers adds the contributors mapped to an employee who is active in the project and not yet a member
+func (r *controller) addMembers(db *gorm.DB, workUnit *model.WorkUnit, contributors []model.GithubContributor, now time.Time) error {
+	members, err := r.store.WorkUnitMember.All(db, workUnit.ID.String())
+	if err != nil {
+		return err
+	}
+	isMember := make(map[model.UUID]bool, len(members))
+	for _, m := range members {
+		isMember[m.EmployeeID] = true
+	}
+
+	for _, c := range contributors {
+		if c.EmployeeID == nil || isMember[*c.EmployeeID] {
+			continue
+		}
+
+		if _, err := r.store.ProjectMember.GetActiveMemberInProject(db, workUnit.ProjectID.String(), c.EmployeeID.String()); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+			return err
+		}
+
+		if err := r.store.WorkUnitMember.Create(db, &model.WorkUnitMember{
+			Status:     model.ProjectMemberStatusActive.String(),
+			StartDate:  now,
+			EmployeeID: *c.EmployeeID,
+			WorkUnitID: workUnit.ID,
+			ProjectID:  workUnit.ProjectID,
+		}); err != nil {
+			return err
+		}
+		isMember[*c.EmployeeID] = true
+	}
+
+	return nil
+}
+
+// addStacks adds the stacks of the languages which the work unit does not have yet
+func (r *controller) addStacks(db *gorm.DB, workUnit *model.WorkUnit, languages []string, stacks []*model.Stack) error {
+	wuStacks, err := r.store.WorkUnitStack.GetByWorkUnitID(db, workUnit.ID.String())
+	if err != nil {
+		return err
+	}
+	hasStack := make(map[model.UUID]bool, len(wuStacks))
+	for _, s := range wuStacks {
+		hasStack[s.StackID] = true
+	}
+
+	for _, lang := range languages {
+		stack := model.FindLanguageStack(lang, stacks)
+		if stack == nil || hasStack[stack.ID] {
+			continue
+		}
+
+		if err := r.store.WorkUnitStack.Create(db, &model.WorkUnitStack{
+			StackID:    stack.ID,
+			WorkUnitID: workUnit.ID,
+		}); err != nil {
+			return err
+		}
+		hasStack[stack.ID] = true
+	}
+
+	return nil
+}
+
+func (r *controller) saveMetadata(repo store.DBRepo, workUnit *model.WorkUnit, metadata *model.GithubSourceMetadata) error {
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+
+	workUnit.SourceURL = model.GithubRepoURL(metadata.Owner, metadata.Name)
+	workUnit.SourceMetadata = data
+	_, err = r.store.WorkUnit.UpdateSelectedFieldsByID(repo.DB(), workUnit.ID.String(), *workUnit, "source_url", "source_metadata")
+	return err
+}
+
+func (r *controller) getWorkUnit(projectID string, workUnitID string) (*model.WorkUnit, error) {
+	project, err := r.store.Project.One(r.repo.DB(), projectID, false)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrProjectNotFound
+		}
+		return nil, err
+	}
+
+	workUnit, err := r.store.WorkUnit.One(r.repo.DB(), workUnitID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrWorkUnitNotFound
+		}
+		return nil, err
+	}
+	if workUnit.ProjectID != project.ID {
+		return nil, ErrWorkUnitNotFound
+	}
+
+	return workUnit, nil
+}
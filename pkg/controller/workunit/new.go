@@ -0,0 +1,36 @@
+package workunit
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type controller struct {
+	store   *store.Store
+	service *service.Service
+	logger  logger.Logger
+	repo    store.DBRepo
+	config  *config.Config
+}
+
+func New(store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:   store,
+		repo:    repo,
+		service: service,
+		logger:  logger,
+		config:  cfg,
+	}
+}
+
+type IController interface {
+	LinkGithub(input LinkGithubInput) (workUnit *model.WorkUnit, err error)
+	UnlinkGithub(projectID string, workUnitID string) error
+	SyncGithub(projectID string, workUnitID string, now time.Time) (workUnit *model.WorkUnit, err error)
+	SyncAllGithub(now time.Time) (synced int, err error)
+}
@@ -0,0 +1,243 @@
+package allocation
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	"github.com/dwarvesf/fortress-api/pkg/controller/allocation"
+	"github.com/dwarvesf/fortress-api/pkg/handler/allocation/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/allocation/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	controller *controller.Controller
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		controller: controller,
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+	}
+}
+
+// List godoc
+// @Summary Get allocations of a project member
+// @Description Get the allocation percentages of a project member by date range. A member without allocations is allocated 100% when official and 0% when shadow
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Project ID"
+// @Param memberID path string true "Project member ID"
+// @Success 200 {object} view.ListProjectMemberAllocationResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /projects/{id}/members/{memberID}/allocations [get]
+func (h *handler) List(c *gin.Context) {
+	projectID, memberID, ok := h.getMemberParams(c)
+	if !ok {
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":   "allocation",
+		"method":    "List",
+		"projectID": projectID,
+		"memberID":  memberID,
+	})
+
+	allocations, err := h.controller.Allocation.List(projectID, memberID)
+	if err != nil {
+		l.Error(err, "failed to get allocations")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToProjectMemberAllocations(allocations), nil, nil, nil, ""))
+}
+
+// Create godoc
+// @Summary Allocate a project member
+// @Description Allocate a percentage of the time of a project member in a date range within the membership, allocations of a member can not overlap
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Project ID"
+// @Param memberID path string true "Project member ID"
+// @Param Body body request.CreateAllocationInput true "Body"
+// @Success 200 {object} view.ProjectMemberAllocationResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /projects/{id}/members/{memberID}/allocations [post]
+func (h *handler) Create(c *gin.Context) {
+	projectID, memberID, ok := h.getMemberParams(c)
+	if !ok {
+		return
+	}
+
+	input := request.CreateAllocationInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":   "allocation",
+		"method":    "Create",
+		"projectID": projectID,
+		"memberID":  memberID,
+		"input":     input,
+	})
+
+	a, err := h.controller.Allocation.Create(allocation.CreateInput{
+		ProjectID:  projectID,
+		MemberID:   memberID,
+		Percentage: input.Percentage,
+		StartDate:  input.GetStartDate(),
+		EndDate:    input.GetEndDate(),
+		Note:       input.Note,
+	})
+	if err != nil {
+		l.Error(err, "failed to create allocation")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToProjectMemberAllocation(a), nil, nil, nil, ""))
+}
+
+// Delete godoc
+// @Summary Delete an allocation of a project member
+// @Description Delete an allocation of a project member
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Project ID"
+// @Param memberID path string true "Project member ID"
+// @Param allocationID path string true "Allocation ID"
+// @Success 200 {object} view.MessageResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /projects/{id}/members/{memberID}/allocations/{allocationID} [delete]
+func (h *handler) Delete(c *gin.Context) {
+	projectID, memberID, ok := h.getMemberParams(c)
+	if !ok {
+		return
+	}
+
+	allocationID := c.Param("allocationID")
+	if allocationID == "" || !model.IsUUIDFromString(allocationID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidAllocationID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":      "allocation",
+		"method":       "Delete",
+		"projectID":    projectID,
+		"memberID":     memberID,
+		"allocationID": allocationID,
+	})
+
+	if err := h.controller.Allocation.Delete(projectID, memberID, allocationID); err != nil {
+		l.Error(err, "failed to delete allocation")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
+}
+
+// GetCapacityPlan godoc
+// @Summary Get the capacity plan of the employees
+// @Description Get the weekly allocation of each employee over the next weeks with the over-allocated weeks flagged, and the project members whose end date falls in those weeks
+// @Tags Dashboard
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param weeks query int false "Number of weeks, 8 by default"
+// @Param page query string false "Page"
+// @Param size query string false "Size"
+// @Success 200 {object} view.CapacityPlanResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /dashboards/resources/capacity [get]
+func (h *handler) GetCapacityPlan(c *gin.Context) {
+	input := request.GetCapacityPlanInput{}
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	input.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "allocation",
+		"method":  "GetCapacityPlan",
+		"input":   input,
+	})
+
+	plan, err := h.controller.Allocation.GetCapacityPlan(allocation.CapacityPlanInput{
+		Pagination: input.Pagination,
+		Weeks:      input.Weeks,
+		Now:        time.Now(),
+	})
+	if err != nil {
+		l.Error(err, "failed to get capacity plan")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToCapacityPlan(plan.Weeks, plan.Employees, plan.FreeingUp),
+		&view.PaginationResponse{Pagination: input.Pagination, Total: plan.Total}, nil, nil, ""))
+}
+
+func (h *handler) getMemberParams(c *gin.Context) (string, string, bool) {
+	projectID := c.Param("id")
+	if projectID == "" || !model.IsUUIDFromString(projectID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidProjectID, nil, ""))
+		return "", "", false
+	}
+
+	memberID := c.Param("memberID")
+	if memberID == "" || !model.IsUUIDFromString(memberID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidMemberID, nil, ""))
+		return "", "", false
+	}
+
+	return projectID, memberID, true
+}
@@ -0,0 +1,44 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/allocation"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidProjectID    = errors.New("invalid project ID")
+	ErrInvalidMemberID     = errors.New("invalid project member ID")
+	ErrInvalidAllocationID = errors.New("invalid allocation ID")
+	ErrInvalidStartDate    = errors.New("invalid start date")
+	ErrInvalidEndDate      = errors.New("invalid end date")
+	ErrInvalidWeeks        = errors.New("weeks must be between 1 and 52")
+)
+
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, allocation.ErrProjectMemberNotFound),
+		errors.Is(err, allocation.ErrAllocationNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, allocation.ErrMemberNotAssigned),
+		errors.Is(err, allocation.ErrInvalidPercentage),
+		errors.Is(err, allocation.ErrInvalidPeriod),
+		errors.Is(err, allocation.ErrAllocationOutsideMembership),
+		errors.Is(err, allocation.ErrAllocationOverlapped):
+		status = http.StatusBadRequest
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
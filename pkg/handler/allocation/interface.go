@@ -0,0 +1,10 @@
+package allocation
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	List(c *gin.Context)
+	Create(c *gin.Context)
+	Delete(c *gin.Context)
+	GetCapacityPlan(c *gin.Context)
+}
@@ -0,0 +1,59 @@
+package request
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/handler/allocation/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// MaxCapacityPlanWeeks is the most weeks a capacity plan covers
+const MaxCapacityPlanWeeks = 52
+
+type CreateAllocationInput struct {
+	Percentage float64 `json:"percentage" binding:"required"`
+	// StartDate and EndDate are dates in YYYY-MM-DD format, the allocation is open-ended when EndDate is empty
+	StartDate string `json:"startDate" binding:"required"`
+	EndDate   string `json:"endDate"`
+	Note      string `json:"note"`
+}
+
+func (i *CreateAllocationInput) Validate() error {
+	if _, err := time.Parse("2006-01-02", i.StartDate); err != nil {
+		return errs.ErrInvalidStartDate
+	}
+	if _, err := time.Parse("2006-01-02", i.EndDate); i.EndDate != "" && err != nil {
+		return errs.ErrInvalidEndDate
+	}
+
+	return nil
+}
+
+func (i *CreateAllocationInput) GetStartDate() time.Time {
+	date, _ := time.Parse("2006-01-02", i.StartDate)
+	return date
+}
+
+func (i *CreateAllocationInput) GetEndDate() *time.Time {
+	date, err := time.Parse("2006-01-02", i.EndDate)
+	if i.EndDate == "" || err != nil {
+		return nil
+	}
+
+	return &date
+}
+
+type GetCapacityPlanInput struct {
+	model.Pagination
+
+	// Weeks is the number of weeks planned from the current week, 8 by default
+	Weeks int `json:"weeks" form:"weeks"`
+}
+
+func (i *GetCapacityPlanInput) Validate() error {
+	if i.Weeks < 0 || i.Weeks > MaxCapacityPlanWeeks {
+		return errs.ErrInvalidWeeks
+	}
+
+	return nil
+}
@@ -0,0 +1,270 @@
+package approval
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	"github.com/dwarvesf/fortress-api/pkg/controller/approval"
+	"github.com/dwarvesf/fortress-api/pkg/handler/approval/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/approval/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	controller *controller.Controller
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		controller: controller,
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+	}
+}
+
+// List godoc
+// @Summary Get list of approval requests
+// @Description Get list of approval requests
+// @Tags ApprovalRequest
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param status query string false "Status: pending, approved, rejected, expired, failed"
+// @Param action query string false "Action"
+// @Param requesterID query string false "Requester ID"
+// @Param page query string false "Page"
+// @Param size query string false "Size"
+// @Success 200 {object} view.ListApprovalRequestResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /approval-requests [get]
+func (h *handler) List(c *gin.Context) {
+	input := request.GetListApprovalRequestInput{}
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	input.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "approval",
+		"method":  "List",
+		"input":   input,
+	})
+
+	listInput := approval.ListInput{
+		Pagination:  input.Pagination,
+		RequesterID: input.RequesterID,
+	}
+	if input.Status != "" {
+		listInput.Statuses = []string{input.Status}
+	}
+	if input.Action != "" {
+		listInput.Actions = []string{input.Action}
+	}
+
+	requests, total, err := h.controller.Approval.List(listInput)
+	if err != nil {
+		l.Error(err, "failed to get list approval requests")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToApprovalRequests(requests),
+		&view.PaginationResponse{Pagination: input.Pagination, Total: total}, nil, nil, ""))
+}
+
+// Detail godoc
+// @Summary Get detail of an approval request
+// @Description Get detail of an approval request including its payload
+// @Tags ApprovalRequest
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Approval request ID"
+// @Success 200 {object} view.ApprovalRequestResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /approval-requests/{id} [get]
+func (h *handler) Detail(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidApprovalRequestID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "approval",
+		"method":  "Detail",
+		"id":      id,
+	})
+
+	rs, err := h.controller.Approval.Detail(id)
+	if err != nil {
+		l.Error(err, "failed to get approval request")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToApprovalRequest(rs), nil, nil, nil, ""))
+}
+
+// Approve godoc
+// @Summary Approve a pending request
+// @Description Approve a pending request and execute the guarded action, the approver must hold the same permission as the action and can not be the requester
+// @Tags ApprovalRequest
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Approval request ID"
+// @Success 200 {object} view.ApprovalRequestResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 403 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 409 {object} view.ErrorResponse
+// @Failure 422 {object} view.ApprovalRequestResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /approval-requests/{id}/approve [post]
+func (h *handler) Approve(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidApprovalRequestID, nil, ""))
+		return
+	}
+
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil || userID == "" {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, approval.ErrMissingPermission, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "approval",
+		"method":  "Approve",
+		"id":      id,
+	})
+
+	rs, err := h.controller.Approval.Approve(id, userID)
+	if errors.Is(err, approval.ErrExecutionFailed) {
+		// the request is approved but the action failed, return the failed request so the reviewer can see why
+		l.Error(err, "failed to execute approved request")
+		c.JSON(http.StatusUnprocessableEntity, view.CreateResponse[any](view.ToApprovalRequest(rs), nil, err, nil, ""))
+		return
+	}
+	if err != nil {
+		l.Error(err, "failed to approve request")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToApprovalRequest(rs), nil, nil, nil, ""))
+}
+
+// Reject godoc
+// @Summary Reject a pending request
+// @Description Reject a pending request, the guarded action is not executed
+// @Tags ApprovalRequest
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Approval request ID"
+// @Param Body body request.RejectInput true "Body"
+// @Success 200 {object} view.ApprovalRequestResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 403 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 409 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /approval-requests/{id}/reject [post]
+func (h *handler) Reject(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidApprovalRequestID, nil, ""))
+		return
+	}
+
+	var input request.RejectInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil || userID == "" {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, approval.ErrMissingPermission, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "approval",
+		"method":  "Reject",
+		"id":      id,
+	})
+
+	rs, err := h.controller.Approval.Reject(id, userID, input.Reason)
+	if err != nil {
+		l.Error(err, "failed to reject request")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToApprovalRequest(rs), nil, nil, nil, ""))
+}
+
+// ExpireOverdue godoc
+// @Summary Expire overdue approval requests
+// @Description Expire pending approval requests which passed their deadline
+// @Tags ApprovalRequest
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Success 200 {object} view.MessageResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /cronjobs/expire-approval-requests [post]
+func (h *handler) ExpireOverdue(c *gin.Context) {
+	l := h.logger.Fields(logger.Fields{
+		"handler": "approval",
+		"method":  "ExpireOverdue",
+	})
+
+	requests, err := h.controller.Approval.ExpireOverdue()
+	if err != nil {
+		l.Error(err, "failed to expire approval requests")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	l.Infof("expired %d approval requests", len(requests))
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
+}
@@ -0,0 +1,46 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/approval"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidApprovalRequestID = errors.New("invalid approval request ID")
+	ErrInvalidStatus            = errors.New("invalid approval request status")
+	ErrInvalidAction            = errors.New("invalid approval action")
+	ErrEmptyReason              = errors.New("reason is required")
+)
+
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, approval.ErrApprovalRequestNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, approval.ErrSelfApproval),
+		errors.Is(err, approval.ErrMissingPermission):
+		status = http.StatusForbidden
+	case errors.Is(err, approval.ErrNotPending),
+		errors.Is(err, approval.ErrExpired):
+		status = http.StatusConflict
+	case errors.Is(err, approval.ErrRequesterRequired),
+		errors.Is(err, approval.ErrInvalidAction):
+		status = http.StatusBadRequest
+	case errors.Is(err, approval.ErrExecutionFailed):
+		status = http.StatusUnprocessableEntity
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
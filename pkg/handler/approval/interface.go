@@ -0,0 +1,11 @@
+package approval
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	List(c *gin.Context)
+	Detail(c *gin.Context)
+	Approve(c *gin.Context)
+	Reject(c *gin.Context)
+	ExpireOverdue(c *gin.Context)
+}
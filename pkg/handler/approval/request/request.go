@@ -0,0 +1,38 @@
+package request
+
+import (
+	"github.com/dwarvesf/fortress-api/pkg/handler/approval/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type GetListApprovalRequestInput struct {
+	model.Pagination
+
+	Status      string `json:"status" form:"status"`
+	Action      string `json:"action" form:"action"`
+	RequesterID string `json:"requesterID" form:"requesterID"`
+}
+
+func (i *GetListApprovalRequestInput) Validate() error {
+	if i.Status != "" && !model.ApprovalRequestStatus(i.Status).IsValid() {
+		return errs.ErrInvalidStatus
+	}
+
+	if i.Action != "" && !model.ApprovalAction(i.Action).IsValid() {
+		return errs.ErrInvalidAction
+	}
+
+	return nil
+}
+
+type RejectInput struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+func (i *RejectInput) Validate() error {
+	if i.Reason == "" {
+		return errs.ErrEmptyReason
+	}
+
+	return nil
+}
@@ -0,0 +1,5 @@
+INSERT INTO public.approval_requests (id, deleted_at, created_at, updated_at, action, status, permission, summary, payload, requester_id, approver_id, expired_at, reviewed_at, executed_at, reject_reason, error_message) VALUES
+('5b2f0c47-7b58-4b5c-9d3a-0e6d0c7f1a01', NULL, '2023-07-07 08:00:00', '2023-07-07 08:00:00', 'invoice.mark-paid', 'pending', 'invoices.edit', 'Mark invoice 2023-FORTRESS-1 as paid', '{}', '2655832e-f009-4b73-a535-64c3a22e558f', NULL, '2099-01-01 00:00:00', NULL, NULL, NULL, NULL),
+('5b2f0c47-7b58-4b5c-9d3a-0e6d0c7f1a02', NULL, '2023-07-07 08:00:00', '2023-07-07 08:00:00', 'invoice.mark-paid', 'pending', 'invoices.edit', 'Mark invoice 2023-FORTRESS-2 as paid', '{}', 'ecea9d15-05ba-4a4e-9787-54210e3b98ce', NULL, '2023-07-08 08:00:00', NULL, NULL, NULL, NULL),
+('5b2f0c47-7b58-4b5c-9d3a-0e6d0c7f1a03', NULL, '2023-07-07 08:00:00', '2023-07-07 08:00:00', 'invoice.mark-paid', 'rejected', 'invoices.edit', 'Mark invoice 2023-FORTRESS-3 as paid', '{}', 'ecea9d15-05ba-4a4e-9787-54210e3b98ce', '8d7c99c0-3253-4286-93a9-e7554cb327ef', '2099-01-01 00:00:00', '2023-07-07 09:00:00', NULL, 'wrong amount', NULL),
+('5b2f0c47-7b58-4b5c-9d3a-0e6d0c7f1a04', NULL, '2023-07-07 08:00:00', '2023-07-07 08:00:00', 'employee.update-base-salary', 'pending', 'employees.baseSalary.edit', 'Update base salary of employee ecea9d15-05ba-4a4e-9787-54210e3b98ce', '{}', 'ecea9d15-05ba-4a4e-9787-54210e3b98ce', NULL, '2099-01-01 00:00:00', NULL, NULL, NULL, NULL);
@@ -0,0 +1,153 @@
+package auditlog
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	"github.com/dwarvesf/fortress-api/pkg/controller/auditlog"
+	"github.com/dwarvesf/fortress-api/pkg/handler/auditlog/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	controller *controller.Controller
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		controller: controller,
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+	}
+}
+
+// List godoc
+// @Summary Get list of audit logs
+// @Description Get list of audit logs
+// @Tags AuditLog
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param actorType query string false "Actor type: employee, api-key, anonymous"
+// @Param actorID query string false "Actor ID"
+// @Param action query string false "Action: create, update, delete"
+// @Param resourceType query string false "Resource type"
+// @Param resourceID query string false "Resource ID"
+// @Param requestID query string false "Request ID"
+// @Param from query string false "From date (YYYY-MM-DD)"
+// @Param to query string false "To date (YYYY-MM-DD)"
+// @Param page query string false "Page"
+// @Param size query string false "Size"
+// @Success 200 {object} view.ListAuditLogResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /audit-logs [get]
+func (h *handler) List(c *gin.Context) {
+	input := request.GetListAuditLogInput{}
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	input.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "auditlog",
+		"method":  "List",
+		"input":   input,
+	})
+
+	from, _ := input.GetFrom()
+	to, _ := input.GetTo()
+
+	logs, total, err := h.controller.AuditLog.List(auditlog.ListInput{
+		Pagination:   input.Pagination,
+		ActorType:    input.ActorType,
+		ActorID:      input.ActorID,
+		Action:       input.Action,
+		ResourceType: input.ResourceType,
+		ResourceID:   input.ResourceID,
+		RequestID:    input.RequestID,
+		From:         from,
+		To:           to,
+	})
+	if err != nil {
+		l.Error(err, "failed to get list audit logs")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToAuditLogs(logs),
+		&view.PaginationResponse{Pagination: input.Pagination, Total: total}, nil, nil, ""))
+}
+
+// ResourceHistory godoc
+// @Summary Get change history of a resource
+// @Description Get change history of a resource
+// @Tags AuditLog
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param type path string true "Resource type, e.g. employees, projects, invoices"
+// @Param id path string true "Resource ID"
+// @Param page query string false "Page"
+// @Param size query string false "Size"
+// @Success 200 {object} view.ListAuditLogResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /audit-logs/resources/{type}/{id} [get]
+func (h *handler) ResourceHistory(c *gin.Context) {
+	input := request.ResourceHistoryInput{
+		Type: c.Param("type"),
+		ID:   c.Param("id"),
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	pagination := model.Pagination{}
+	if err := c.ShouldBindQuery(&pagination); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	pagination.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "auditlog",
+		"method":  "ResourceHistory",
+		"input":   input,
+	})
+
+	logs, total, err := h.controller.AuditLog.ResourceHistory(input.Type, input.ID, pagination)
+	if err != nil {
+		l.Error(err, "failed to get resource history")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToAuditLogs(logs),
+		&view.PaginationResponse{Pagination: pagination, Total: total}, nil, nil, ""))
+}
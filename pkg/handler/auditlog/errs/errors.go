@@ -0,0 +1,13 @@
+package errs
+
+import "errors"
+
+var (
+	ErrInvalidAction       = errors.New("invalid audit log action")
+	ErrInvalidActorType    = errors.New("invalid audit log actor type")
+	ErrInvalidFromDate     = errors.New("invalid from date")
+	ErrInvalidToDate       = errors.New("invalid to date")
+	ErrInvalidDateRange    = errors.New("from date must be before to date")
+	ErrInvalidResourceType = errors.New("invalid resource type")
+	ErrInvalidResourceID   = errors.New("invalid resource ID")
+)
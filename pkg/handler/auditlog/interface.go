@@ -0,0 +1,8 @@
+package auditlog
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	List(c *gin.Context)
+	ResourceHistory(c *gin.Context)
+}
@@ -0,0 +1,96 @@
+package request
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/handler/auditlog/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+const dateLayout = "2006-01-02"
+
+type GetListAuditLogInput struct {
+	model.Pagination
+
+	ActorType    string `json:"actorType" form:"actorType"`
+	ActorID      string `json:"actorID" form:"actorID"`
+	Action       string `json:"action" form:"action"`
+	ResourceType string `json:"resourceType" form:"resourceType"`
+	ResourceID   string `json:"resourceID" form:"resourceID"`
+	RequestID    string `json:"requestID" form:"requestID"`
+	From         string `json:"from" form:"from"`
+	To           string `json:"to" form:"to"`
+}
+
+func (i *GetListAuditLogInput) Validate() error {
+	if i.ActorType != "" && !model.AuditLogActorType(i.ActorType).IsValid() {
+		return errs.ErrInvalidActorType
+	}
+
+	if i.Action != "" && !model.AuditLogAction(i.Action).IsValid() {
+		return errs.ErrInvalidAction
+	}
+
+	from, err := i.GetFrom()
+	if err != nil {
+		return errs.ErrInvalidFromDate
+	}
+
+	to, err := i.GetTo()
+	if err != nil {
+		return errs.ErrInvalidToDate
+	}
+
+	if from != nil && to != nil && from.After(*to) {
+		return errs.ErrInvalidDateRange
+	}
+
+	return nil
+}
+
+// GetFrom returns the beginning of the from date
+func (i *GetListAuditLogInput) GetFrom() (*time.Time, error) {
+	if i.From == "" {
+		return nil, nil
+	}
+
+	from, err := time.Parse(dateLayout, i.From)
+	if err != nil {
+		return nil, err
+	}
+
+	return &from, nil
+}
+
+// GetTo returns the end of the to date
+func (i *GetListAuditLogInput) GetTo() (*time.Time, error) {
+	if i.To == "" {
+		return nil, nil
+	}
+
+	to, err := time.Parse(dateLayout, i.To)
+	if err != nil {
+		return nil, err
+	}
+
+	to = to.AddDate(0, 0, 1).Add(-time.Nanosecond)
+
+	return &to, nil
+}
+
+type ResourceHistoryInput struct {
+	Type string
+	ID   string
+}
+
+func (i *ResourceHistoryInput) Validate() error {
+	if i.Type == "" {
+		return errs.ErrInvalidResourceType
+	}
+
+	if i.ID == "" {
+		return errs.ErrInvalidResourceID
+	}
+
+	return nil
+}
@@ -31,43 +31,49 @@ func New(controller *controller.Controller, logger logger.Logger, cfg *config.Co
 
 // Auth godoc
 // @Summary Authorize user when login
-// @Description Authorize user when login
+// @Description Authorize user when login with one of the enabled providers, google is used when provider is empty
 // @Tags Auth
 // @Accept  json
 // @Produce  json
-// @Param code body string true "Google login code"
-// @Param redirectUrl body string true "Google redirect url"
+// @Param provider body string false "Login provider: google, oidc, magic-link, stub"
+// @Param code body string false "OAuth login code, or the email to log in as with stub provider"
+// @Param redirectUrl body string false "OAuth redirect url"
+// @Param token body string false "Magic link token"
 // @Success 200 {object} view.AuthData
 // @Failure 400 {object} view.ErrorResponse
 // @Failure 404 {object} view.ErrorResponse
 // @Failure 500 {object} view.ErrorResponse
 // @Router /auth [post]
 func (h *handler) Auth(c *gin.Context) {
-	// 1. parse code, redirectUrl from body
-	var req struct {
-		Code        string `json:"code" binding:"required"`
-		RedirectURL string `json:"redirectUrl" binding:"required"`
-	}
+	// 1. parse provider credential from body
+	var req request.AuthInput
 
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, req, ""))
 		return
 	}
 
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, req, ""))
+		return
+	}
+
 	// 1.1 prepare the logger
 	l := h.logger.Fields(logger.Fields{
-		"handler": "auth",
-		"method":  "Auth",
-		"body":    req,
+		"handler":  "auth",
+		"method":   "Auth",
+		"provider": req.Provider,
 	})
 
 	e, jwt, err := h.controller.Auth.Auth(auth.AuthenticationInput{
+		Provider:    req.Provider,
 		Code:        req.Code,
 		RedirectURL: req.RedirectURL,
+		Token:       req.Token,
 	})
 	if err != nil {
-		l.Info("failed to called controller")
-		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, req, ""))
+		l.Error(err, "failed to authenticate")
+		errs.ConvertControllerErr(c, err)
 		return
 	}
 
@@ -75,6 +81,182 @@ func (h *handler) Auth(c *gin.Context) {
 	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToAuthData(jwt, e), nil, nil, nil, ""))
 }
 
+// Providers godoc
+// @Summary Get enabled login providers
+// @Description Get enabled login providers
+// @Tags Auth
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} view.AuthProvidersResponse
+// @Router /auth/providers [get]
+func (h *handler) Providers(c *gin.Context) {
+	c.JSON(http.StatusOK, view.CreateResponse[any](h.controller.Auth.Providers(), nil, nil, nil, ""))
+}
+
+// RequestMagicLink godoc
+// @Summary Send a magic login link
+// @Description Send a one-time login link to the employee email, for members without a team Google account
+// @Tags Auth
+// @Accept  json
+// @Produce  json
+// @Param email body string true "Team or personal email of the employee"
+// @Success 200 {object} view.MessageResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /auth/magic-link [post]
+func (h *handler) RequestMagicLink(c *gin.Context) {
+	var req request.RequestMagicLinkInput
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, req, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "auth",
+		"method":  "RequestMagicLink",
+	})
+
+	if err := h.controller.Auth.RequestMagicLink(req.Email); err != nil {
+		l.Error(err, "failed to send magic link")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "if the email belongs to an active member, a login link has been sent"))
+}
+
+// ListIdentities godoc
+// @Summary Get login identities linked to the logged-in user
+// @Description Get login identities linked to the logged-in user
+// @Tags Auth
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Success 200 {object} view.EmployeeIdentityListResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /auth/identities [get]
+func (h *handler) ListIdentities(c *gin.Context) {
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "auth",
+		"method":  "ListIdentities",
+		"userID":  userID,
+	})
+
+	identities, err := h.controller.Auth.ListIdentities(userID)
+	if err != nil {
+		l.Error(err, "failed to get identities")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToEmployeeIdentities(identities), nil, nil, nil, ""))
+}
+
+// LinkIdentity godoc
+// @Summary Link a login identity to the logged-in user
+// @Description Link a login identity to the logged-in user, the body is the same as login
+// @Tags Auth
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param provider body string true "Login provider: google, oidc, magic-link, stub"
+// @Param code body string false "OAuth login code"
+// @Param redirectUrl body string false "OAuth redirect url"
+// @Param token body string false "Magic link token"
+// @Success 200 {object} view.EmployeeIdentityResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /auth/identities [post]
+func (h *handler) LinkIdentity(c *gin.Context) {
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	var req request.AuthInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, req, ""))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, req, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":  "auth",
+		"method":   "LinkIdentity",
+		"userID":   userID,
+		"provider": req.Provider,
+	})
+
+	identity, err := h.controller.Auth.LinkIdentity(userID, auth.AuthenticationInput{
+		Provider:    req.Provider,
+		Code:        req.Code,
+		RedirectURL: req.RedirectURL,
+		Token:       req.Token,
+	})
+	if err != nil {
+		l.Error(err, "failed to link identity")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToEmployeeIdentity(identity), nil, nil, nil, ""))
+}
+
+// UnlinkIdentity godoc
+// @Summary Unlink a login identity from the logged-in user
+// @Description Unlink a login identity from the logged-in user
+// @Tags Auth
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Identity ID"
+// @Success 200 {object} view.MessageResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /auth/identities/{id} [delete]
+func (h *handler) UnlinkIdentity(c *gin.Context) {
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	input := request.UnlinkIdentityInput{ID: c.Param("id")}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "auth",
+		"method":  "UnlinkIdentity",
+		"userID":  userID,
+		"id":      input.ID,
+	})
+
+	if err := h.controller.Auth.UnlinkIdentity(userID, input.ID); err != nil {
+		l.Error(err, "failed to unlink identity")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
+}
+
 // Me godoc
 // @Summary Get logged-in user data
 // @Description Get logged-in user data
@@ -0,0 +1,238 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	"github.com/dwarvesf/fortress-api/pkg/handler/auth/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	googleauth "github.com/dwarvesf/fortress-api/pkg/service/google"
+	"github.com/dwarvesf/fortress-api/pkg/service/identity"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/testhelper"
+)
+
+// fakeGoogleService keeps the google provider enabled without calling google
+type fakeGoogleService struct {
+	googleauth.IService
+}
+
+// newOIDCServer serves the discovery, token and user info endpoints of an OpenID Connect issuer
+func newOIDCServer(t *testing.T, userInfo map[string]interface{}) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+
+	writeJSON := func(w http.ResponseWriter, v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(v))
+	}
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]string{
+			"issuer":                 srv.URL,
+			"authorization_endpoint": srv.URL + "/authorize",
+			"token_endpoint":         srv.URL + "/token",
+			"userinfo_endpoint":      srv.URL + "/userinfo",
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]interface{}{"access_token": "access-token", "token_type": "Bearer", "expires_in": 3600})
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, userInfo)
+	})
+
+	return srv
+}
+
+func TestHandler_Providers(t *testing.T) {
+	loggerMock := logger.NewLogrusLogger()
+
+	tests := []struct {
+		name             string
+		auth             config.Auth
+		env              string
+		wantCode         int
+		wantResponsePath string
+	}{
+		{
+			name:             "stub_is_ignored_on_dev",
+			env:              "dev",
+			auth:             config.Auth{StubEnabled: true, OIDC: config.OIDC{Issuer: "https://sso.d.foundation", ClientID: "fortress"}},
+			wantCode:         http.StatusOK,
+			wantResponsePath: "testdata/providers/200.json",
+		},
+		{
+			name:             "stub_on_local",
+			env:              "local",
+			auth:             config.Auth{StubEnabled: true},
+			wantCode:         http.StatusOK,
+			wantResponsePath: "testdata/providers/local.json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.LoadTestConfig()
+			cfg.Env = tt.env
+			cfg.Auth = tt.auth
+			serviceMock := &service.Service{Identity: identity.New(&cfg, &fakeGoogleService{}, loggerMock)}
+
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/api/v1/auth/providers", nil)
+			controllerMock := controller.New(nil, nil, serviceMock, nil, loggerMock, &cfg)
+			h := New(controllerMock, loggerMock, &cfg)
+
+			h.Providers(ctx)
+			expRespRaw, err := os.ReadFile(tt.wantResponsePath)
+			require.NoError(t, err)
+
+			require.Equal(t, tt.wantCode, w.Code)
+			require.JSONEq(t, string(expRespRaw), w.Body.String(), "[Handler.Providers] response mismatched")
+		})
+	}
+}
+
+func TestHandler_Auth(t *testing.T) {
+	loggerMock := logger.NewLogrusLogger()
+	storeMock := store.New()
+
+	unverified := newOIDCServer(t, map[string]interface{}{"sub": "1", "email": "thanh@d.foundation", "email_verified": false})
+	defer unverified.Close()
+
+	tests := []struct {
+		name             string
+		env              string
+		auth             config.Auth
+		request          request.AuthInput
+		wantCode         int
+		wantResponsePath string
+		wantEmployeeID   string
+	}{
+		{
+			name:             "failed_stub_on_dev",
+			env:              "dev",
+			auth:             config.Auth{StubEnabled: true},
+			request:          request.AuthInput{Provider: identity.ProviderStub, Code: "thanh@d.foundation"},
+			wantCode:         http.StatusBadRequest,
+			wantResponsePath: "testdata/auth/provider_not_supported.json",
+		},
+		{
+			name:             "failed_stub_disabled_on_local",
+			env:              "local",
+			request:          request.AuthInput{Provider: identity.ProviderStub, Code: "thanh@d.foundation"},
+			wantCode:         http.StatusBadRequest,
+			wantResponsePath: "testdata/auth/provider_not_supported.json",
+		},
+		{
+			name:           "ok_stub_on_local",
+			env:            "local",
+			auth:           config.Auth{StubEnabled: true},
+			request:        request.AuthInput{Provider: identity.ProviderStub, Code: "thanh@d.foundation"},
+			wantCode:       http.StatusOK,
+			wantEmployeeID: "2655832e-f009-4b73-a535-64c3a22e558f",
+		},
+		{
+			name:             "failed_employee_left",
+			env:              "local",
+			auth:             config.Auth{StubEnabled: true},
+			request:          request.AuthInput{Provider: identity.ProviderStub, Code: "toanhq@dwarvesv.com"},
+			wantCode:         http.StatusBadRequest,
+			wantResponsePath: "testdata/auth/user_inactivated.json",
+		},
+		{
+			name:             "failed_unknown_email",
+			env:              "local",
+			auth:             config.Auth{StubEnabled: true},
+			request:          request.AuthInput{Provider: identity.ProviderStub, Code: "someone@gmail.com"},
+			wantCode:         http.StatusBadRequest,
+			wantResponsePath: "testdata/auth/user_inactivated.json",
+		},
+		{
+			name:             "failed_oidc_email_not_verified",
+			env:              "prod",
+			auth:             config.Auth{OIDC: config.OIDC{Issuer: unverified.URL, ClientID: "fortress", ClientSecret: "secret"}},
+			request:          request.AuthInput{Provider: identity.ProviderOIDC, Code: "code", RedirectURL: "http://localhost/callback"},
+			wantCode:         http.StatusBadRequest,
+			wantResponsePath: "testdata/auth/email_not_verified.json",
+		},
+		{
+			name:             "failed_oidc_not_configured",
+			env:              "prod",
+			request:          request.AuthInput{Provider: identity.ProviderOIDC, Code: "code", RedirectURL: "http://localhost/callback"},
+			wantCode:         http.StatusBadRequest,
+			wantResponsePath: "testdata/auth/provider_not_supported.json",
+		},
+		{
+			name:             "failed_magic_link_used",
+			env:              "prod",
+			request:          request.AuthInput{Provider: identity.ProviderMagicLink, Token: "used-token"},
+			wantCode:         http.StatusBadRequest,
+			wantResponsePath: "testdata/auth/invalid_magic_link.json",
+		},
+		{
+			name:             "failed_magic_link_expired",
+			env:              "prod",
+			request:          request.AuthInput{Provider: identity.ProviderMagicLink, Token: "expired-token"},
+			wantCode:         http.StatusBadRequest,
+			wantResponsePath: "testdata/auth/invalid_magic_link.json",
+		},
+	}
+
+	for _, tt := range tests {
+		testhelper.TestWithTxDB(t, func(txRepo store.DBRepo) {
+			testhelper.LoadTestSQLFile(t, txRepo, "./testdata/auth/auth.sql")
+			byteReq, err := json.Marshal(tt.request)
+			require.Nil(t, err)
+
+			t.Run(tt.name, func(t *testing.T) {
+				cfg := config.LoadTestConfig()
+				cfg.Env = tt.env
+				cfg.Auth = tt.auth
+				serviceMock := &service.Service{Identity: identity.New(&cfg, &fakeGoogleService{}, loggerMock)}
+
+				w := httptest.NewRecorder()
+				ctx, _ := gin.CreateTestContext(w)
+				ctx.Request = httptest.NewRequest(http.MethodPost, "/api/v1/auth", strings.NewReader(string(byteReq)))
+				controllerMock := controller.New(storeMock, txRepo, serviceMock, nil, loggerMock, &cfg)
+				h := New(controllerMock, loggerMock, &cfg)
+
+				h.Auth(ctx)
+				require.Equal(t, tt.wantCode, w.Code)
+
+				if tt.wantResponsePath == "" {
+					var res struct {
+						Data struct {
+							Employee struct {
+								ID string `json:"id"`
+							} `json:"employee"`
+							AccessToken string `json:"accessToken"`
+						} `json:"data"`
+					}
+					require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+					require.Equal(t, tt.wantEmployeeID, res.Data.Employee.ID)
+					require.NotEmpty(t, res.Data.AccessToken)
+					return
+				}
+
+				expRespRaw, err := os.ReadFile(tt.wantResponsePath)
+				require.NoError(t, err)
+				require.JSONEq(t, string(expRespRaw), w.Body.String(), "[Handler.Auth] response mismatched")
+			})
+		})
+	}
+}
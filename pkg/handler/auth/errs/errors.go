@@ -1,14 +1,23 @@
 package errs
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 
 	"github.com/dwarvesf/fortress-api/pkg/controller/auth"
+	"github.com/dwarvesf/fortress-api/pkg/service/identity"
 	"github.com/dwarvesf/fortress-api/pkg/view"
 )
 
+var (
+	ErrEmptyCode         = errors.New("code is required")
+	ErrEmptyRedirectURL  = errors.New("redirectUrl is required")
+	ErrEmptyToken        = errors.New("token is required")
+	ErrInvalidIdentityID = errors.New("invalid identity ID")
+)
+
 func ConvertControllerErr(c *gin.Context, err error) {
 	if err == nil {
 		return
@@ -21,7 +30,14 @@ func ConvertControllerErr(c *gin.Context, err error) {
 		status = http.StatusNotFound
 	case auth.ErrUserNotFound:
 		status = http.StatusNotFound
-	case auth.ErrUserInactivated:
+	case auth.ErrIdentityNotFound:
+		status = http.StatusNotFound
+	case auth.ErrUserInactivated,
+		auth.ErrProviderNotSupported,
+		auth.ErrInvalidMagicLink,
+		auth.ErrIdentityLinkedToAnotherUser,
+		identity.ErrEmptyEmail,
+		identity.ErrEmailNotVerified:
 		status = http.StatusBadRequest
 	default:
 		status = http.StatusInternalServerError
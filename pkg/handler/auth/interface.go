@@ -6,4 +6,9 @@ type IHandler interface {
 	Auth(c *gin.Context)
 	Me(c *gin.Context)
 	CreateAPIKey(c *gin.Context)
+	Providers(c *gin.Context)
+	RequestMagicLink(c *gin.Context)
+	ListIdentities(c *gin.Context)
+	LinkIdentity(c *gin.Context)
+	UnlinkIdentity(c *gin.Context)
 }
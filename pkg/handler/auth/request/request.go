@@ -1,5 +1,60 @@
 package request
 
+import (
+	"github.com/dwarvesf/fortress-api/pkg/handler/auth/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service/identity"
+)
+
 type CreateAPIKeyInput struct {
 	RoleID string `json:"roleID" form:"roleID"`
 }
+
+type AuthInput struct {
+	Provider    string `json:"provider"`
+	Code        string `json:"code"`
+	RedirectURL string `json:"redirectUrl"`
+	Token       string `json:"token"`
+}
+
+func (i *AuthInput) Validate() error {
+	if i.Provider == "" {
+		i.Provider = identity.ProviderGoogle
+	}
+
+	switch i.Provider {
+	case identity.ProviderMagicLink:
+		if i.Token == "" {
+			return errs.ErrEmptyToken
+		}
+	case identity.ProviderStub:
+		if i.Code == "" {
+			return errs.ErrEmptyCode
+		}
+	default:
+		if i.Code == "" {
+			return errs.ErrEmptyCode
+		}
+		if i.RedirectURL == "" {
+			return errs.ErrEmptyRedirectURL
+		}
+	}
+
+	return nil
+}
+
+type RequestMagicLinkInput struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+type UnlinkIdentityInput struct {
+	ID string
+}
+
+func (i *UnlinkIdentityInput) Validate() error {
+	if !model.IsUUIDFromString(i.ID) {
+		return errs.ErrInvalidIdentityID
+	}
+
+	return nil
+}
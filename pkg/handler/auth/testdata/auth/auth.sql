@@ -0,0 +1,3 @@
+INSERT INTO public.magic_link_tokens (id, deleted_at, created_at, updated_at, employee_id, email, token_hash, expired_at, used_at) VALUES
+('1f0bd2a4-7a2a-4c55-8e43-8a0f6d7f0a01', NULL, '2023-07-06 10:00:00', '2023-07-06 10:00:00', '2655832e-f009-4b73-a535-64c3a22e558f', 'thanh@d.foundation', '229240ad993e5afe89360f52f424812bb0c8cc5ab5ccacb1745d444de9036b7f', '2099-01-01 00:00:00', '2023-07-06 10:05:00'),
+('1f0bd2a4-7a2a-4c55-8e43-8a0f6d7f0a02', NULL, '2023-07-06 10:00:00', '2023-07-06 10:00:00', '2655832e-f009-4b73-a535-64c3a22e558f', 'thanh@d.foundation', 'b52b3ef2233858ce1156d85f235cf2c41eddfa8ca1eedc924398b9af1db303cb', '2023-07-06 10:15:00', NULL);
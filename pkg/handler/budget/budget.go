@@ -0,0 +1,337 @@
+package budget
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	"github.com/dwarvesf/fortress-api/pkg/controller/budget"
+	"github.com/dwarvesf/fortress-api/pkg/handler/budget/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/budget/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	controller *controller.Controller
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		controller: controller,
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+	}
+}
+
+// Get godoc
+// @Summary Get the budget of a project
+// @Description Get the budget of a fixed-cost project with its phases and its burn from the member cost, commissions and expenses since the budget start
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Project ID"
+// @Success 200 {object} view.ProjectBudgetResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /projects/{id}/budget [get]
+func (h *handler) Get(c *gin.Context) {
+	projectID, ok := h.getProjectID(c)
+	if !ok {
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":   "budget",
+		"method":    "Get",
+		"projectID": projectID,
+	})
+
+	now := time.Now()
+	health, err := h.controller.Budget.GetHealth(projectID, now)
+	if err != nil {
+		l.Error(err, "failed to get project budget")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToProjectBudget(health, now), nil, nil, nil, ""))
+}
+
+// Upsert godoc
+// @Summary Set the budget of a project
+// @Description Set the total contract value, the schedule and the burn alert thresholds of a fixed-cost project
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Project ID"
+// @Param Body body request.UpsertBudgetInput true "Body"
+// @Success 200 {object} view.ProjectBudgetResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /projects/{id}/budget [put]
+func (h *handler) Upsert(c *gin.Context) {
+	projectID, ok := h.getProjectID(c)
+	if !ok {
+		return
+	}
+
+	input := request.UpsertBudgetInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":   "budget",
+		"method":    "Upsert",
+		"projectID": projectID,
+		"input":     input,
+	})
+
+	now := time.Now()
+	startDate, endDate := input.GetPeriod()
+
+	health, err := h.controller.Budget.Upsert(budget.UpsertInput{
+		ProjectID:       projectID,
+		TotalAmount:     input.TotalAmount,
+		StartDate:       startDate,
+		EndDate:         endDate,
+		AlertThresholds: input.AlertThresholds,
+		Note:            strings.TrimSpace(input.Note),
+		Now:             now,
+	})
+	if err != nil {
+		l.Error(err, "failed to set project budget")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToProjectBudget(health, now), nil, nil, nil, ""))
+}
+
+// CreatePhase godoc
+// @Summary Add a phase to the budget of a project
+// @Description Add a milestone to the budget of a project, the phases can not exceed the total amount of the budget
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Project ID"
+// @Param Body body request.PhaseInput true "Body"
+// @Success 200 {object} view.ProjectBudgetPhaseResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /projects/{id}/budget/phases [post]
+func (h *handler) CreatePhase(c *gin.Context) {
+	projectID, ok := h.getProjectID(c)
+	if !ok {
+		return
+	}
+
+	input := request.PhaseInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":   "budget",
+		"method":    "CreatePhase",
+		"projectID": projectID,
+		"input":     input,
+	})
+
+	phase, err := h.controller.Budget.CreatePhase(toPhaseInput(projectID, "", input))
+	if err != nil {
+		l.Error(err, "failed to create budget phase")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToProjectBudgetPhase(phase), nil, nil, nil, ""))
+}
+
+// UpdatePhase godoc
+// @Summary Update a phase of the budget of a project
+// @Description Update a milestone of the budget of a project
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Project ID"
+// @Param phaseID path string true "Budget phase ID"
+// @Param Body body request.PhaseInput true "Body"
+// @Success 200 {object} view.ProjectBudgetPhaseResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /projects/{id}/budget/phases/{phaseID} [put]
+func (h *handler) UpdatePhase(c *gin.Context) {
+	projectID, phaseID, ok := h.getPhaseParams(c)
+	if !ok {
+		return
+	}
+
+	input := request.PhaseInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":   "budget",
+		"method":    "UpdatePhase",
+		"projectID": projectID,
+		"phaseID":   phaseID,
+		"input":     input,
+	})
+
+	phase, err := h.controller.Budget.UpdatePhase(toPhaseInput(projectID, phaseID, input))
+	if err != nil {
+		l.Error(err, "failed to update budget phase")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToProjectBudgetPhase(phase), nil, nil, nil, ""))
+}
+
+// DeletePhase godoc
+// @Summary Delete a phase of the budget of a project
+// @Description Delete a milestone of the budget of a project which has no invoice
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Project ID"
+// @Param phaseID path string true "Budget phase ID"
+// @Success 200 {object} view.MessageResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /projects/{id}/budget/phases/{phaseID} [delete]
+func (h *handler) DeletePhase(c *gin.Context) {
+	projectID, phaseID, ok := h.getPhaseParams(c)
+	if !ok {
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":   "budget",
+		"method":    "DeletePhase",
+		"projectID": projectID,
+		"phaseID":   phaseID,
+	})
+
+	if err := h.controller.Budget.DeletePhase(projectID, phaseID); err != nil {
+		l.Error(err, "failed to delete budget phase")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
+}
+
+// SendBurnAlerts godoc
+// @Summary Alert project heads of budget burn
+// @Description Send a Discord DM to the heads of fixed-cost projects whose budget burn crosses an alert threshold
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Success 200 {object} view.MessageResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /cronjobs/budget-burn-alerts [post]
+func (h *handler) SendBurnAlerts(c *gin.Context) {
+	l := h.logger.Fields(logger.Fields{
+		"handler": "budget",
+		"method":  "SendBurnAlerts",
+	})
+
+	sent, err := h.controller.Budget.SendBurnAlerts(time.Now())
+	if err != nil {
+		l.Error(err, "failed to send budget burn alerts")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	l.Infof("sent %d budget burn alerts", sent)
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
+}
+
+func toPhaseInput(projectID string, phaseID string, input request.PhaseInput) budget.PhaseInput {
+	startDate, endDate := input.GetPeriod()
+
+	return budget.PhaseInput{
+		ProjectID: projectID,
+		PhaseID:   phaseID,
+		Name:      strings.TrimSpace(input.Name),
+		Amount:    input.Amount,
+		StartDate: startDate,
+		EndDate:   endDate,
+		Note:      strings.TrimSpace(input.Note),
+	}
+}
+
+func (h *handler) getProjectID(c *gin.Context) (string, bool) {
+	projectID := c.Param("id")
+	if projectID == "" || !model.IsUUIDFromString(projectID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidProjectID, nil, ""))
+		return "", false
+	}
+
+	return projectID, true
+}
+
+func (h *handler) getPhaseParams(c *gin.Context) (string, string, bool) {
+	projectID, ok := h.getProjectID(c)
+	if !ok {
+		return "", "", false
+	}
+
+	phaseID := c.Param("phaseID")
+	if phaseID == "" || !model.IsUUIDFromString(phaseID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidPhaseID, nil, ""))
+		return "", "", false
+	}
+
+	return projectID, phaseID, true
+}
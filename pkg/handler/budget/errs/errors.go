@@ -0,0 +1,45 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/budget"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidProjectID = errors.New("invalid project ID")
+	ErrInvalidPhaseID   = errors.New("invalid budget phase ID")
+	ErrInvalidStartDate = errors.New("invalid start date")
+	ErrInvalidEndDate   = errors.New("invalid end date")
+)
+
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, budget.ErrProjectNotFound),
+		errors.Is(err, budget.ErrBudgetNotFound),
+		errors.Is(err, budget.ErrPhaseNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, budget.ErrProjectNotFixedCost),
+		errors.Is(err, budget.ErrInvalidAmount),
+		errors.Is(err, budget.ErrInvalidPeriod),
+		errors.Is(err, budget.ErrPhasesExceedBudget),
+		errors.Is(err, budget.ErrPhaseOutsideBudget),
+		errors.Is(err, budget.ErrPhaseInvoiced),
+		errors.Is(err, budget.ErrInvalidAlertThreshold):
+		status = http.StatusBadRequest
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
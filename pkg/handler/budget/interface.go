@@ -0,0 +1,12 @@
+package budget
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	Get(c *gin.Context)
+	Upsert(c *gin.Context)
+	CreatePhase(c *gin.Context)
+	UpdatePhase(c *gin.Context)
+	DeletePhase(c *gin.Context)
+	SendBurnAlerts(c *gin.Context)
+}
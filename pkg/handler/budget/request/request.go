@@ -0,0 +1,66 @@
+package request
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/handler/budget/errs"
+)
+
+type UpsertBudgetInput struct {
+	// TotalAmount is the contract value in VND
+	TotalAmount float64 `json:"totalAmount" binding:"required"`
+	// StartDate and EndDate are dates in YYYY-MM-DD format, the budget is open-ended when EndDate is empty
+	StartDate string `json:"startDate" binding:"required"`
+	EndDate   string `json:"endDate"`
+	// AlertThresholds are the burn percentages at which the project heads are alerted, 50, 75, 90 and 100 by default
+	AlertThresholds []float64 `json:"alertThresholds"`
+	Note            string    `json:"note"`
+}
+
+func (i *UpsertBudgetInput) Validate() error {
+	return validatePeriod(i.StartDate, i.EndDate)
+}
+
+func (i *UpsertBudgetInput) GetPeriod() (time.Time, *time.Time) {
+	return parsePeriod(i.StartDate, i.EndDate)
+}
+
+type PhaseInput struct {
+	Name string `json:"name" binding:"required"`
+	// Amount is in VND
+	Amount float64 `json:"amount" binding:"required"`
+	// StartDate and EndDate are dates in YYYY-MM-DD format
+	StartDate string `json:"startDate" binding:"required"`
+	EndDate   string `json:"endDate"`
+	Note      string `json:"note"`
+}
+
+func (i *PhaseInput) Validate() error {
+	return validatePeriod(i.StartDate, i.EndDate)
+}
+
+func (i *PhaseInput) GetPeriod() (time.Time, *time.Time) {
+	return parsePeriod(i.StartDate, i.EndDate)
+}
+
+func validatePeriod(startDate, endDate string) error {
+	if _, err := time.Parse("2006-01-02", startDate); err != nil {
+		return errs.ErrInvalidStartDate
+	}
+	if _, err := time.Parse("2006-01-02", endDate); endDate != "" && err != nil {
+		return errs.ErrInvalidEndDate
+	}
+
+	return nil
+}
+
+func parsePeriod(startDate, endDate string) (time.Time, *time.Time) {
+	start, _ := time.Parse("2006-01-02", startDate)
+
+	end, err := time.Parse("2006-01-02", endDate)
+	if endDate == "" || err != nil {
+		return start, nil
+	}
+
+	return start, &end
+}
@@ -3,18 +3,21 @@ package client
 import (
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"gorm.io/gorm"
 
 	"github.com/dwarvesf/fortress-api/pkg/config"
 	"github.com/dwarvesf/fortress-api/pkg/controller"
+	"github.com/dwarvesf/fortress-api/pkg/controller/clientportal"
 	"github.com/dwarvesf/fortress-api/pkg/handler/client/errs"
 	"github.com/dwarvesf/fortress-api/pkg/handler/client/request"
 	"github.com/dwarvesf/fortress-api/pkg/logger"
 	"github.com/dwarvesf/fortress-api/pkg/model"
 	"github.com/dwarvesf/fortress-api/pkg/service"
 	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
 	"github.com/dwarvesf/fortress-api/pkg/view"
 )
 
@@ -214,6 +217,115 @@ func (h *handler) Delete(c *gin.Context) {
 	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
 }
 
+// IssuePortalAccess godoc
+// @Summary Give a client contact access to the client portal
+// @Description Give a client contact access to the client portal and email them a login link, issuing the access again reactivates it and sends a new link
+// @Tags Client
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Client ID"
+// @Param contactID path string true "Client contact ID"
+// @Param Body body request.IssuePortalAccessInput false "Body"
+// @Success 200 {object} view.ClientPortalAccessResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /clients/{id}/contacts/{contactID}/portal-access [post]
+func (h *handler) IssuePortalAccess(c *gin.Context) {
+	clientID := c.Param("id")
+	if clientID == "" || !model.IsUUIDFromString(clientID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidClientID, nil, ""))
+		return
+	}
+
+	contactID := c.Param("contactID")
+	if contactID == "" || !model.IsUUIDFromString(contactID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidClientContactID, nil, ""))
+		return
+	}
+
+	input := request.IssuePortalAccessInput{}
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+			return
+		}
+	}
+
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":   "client",
+		"method":    "IssuePortalAccess",
+		"clientID":  clientID,
+		"contactID": contactID,
+		"input":     input,
+	})
+
+	access, err := h.controller.ClientPortal.IssueAccess(clientportal.IssueAccessInput{
+		ClientID:  clientID,
+		ContactID: contactID,
+		Email:     input.Email,
+		IssuedBy:  userID,
+		Now:       time.Now(),
+	})
+	if err != nil {
+		l.Error(err, "failed to issue client portal access")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToClientPortalAccess(access), nil, nil, nil, ""))
+}
+
+// RevokePortalAccess godoc
+// @Summary Revoke the client portal access of a client contact
+// @Description Revoke the client portal access of a client contact, the contact is logged out right away
+// @Tags Client
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Client ID"
+// @Param contactID path string true "Client contact ID"
+// @Success 200 {object} view.MessageResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /clients/{id}/contacts/{contactID}/portal-access [delete]
+func (h *handler) RevokePortalAccess(c *gin.Context) {
+	clientID := c.Param("id")
+	if clientID == "" || !model.IsUUIDFromString(clientID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidClientID, nil, ""))
+		return
+	}
+
+	contactID := c.Param("contactID")
+	if contactID == "" || !model.IsUUIDFromString(contactID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidClientContactID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":   "client",
+		"method":    "RevokePortalAccess",
+		"clientID":  clientID,
+		"contactID": contactID,
+	})
+
+	if err := h.controller.ClientPortal.RevokeAccess(clientID, contactID, time.Now()); err != nil {
+		l.Error(err, "failed to revoke client portal access")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
+}
+
 // PublicList godoc
 // @Summary Get all clients
 // @Description Get all clients
@@ -1,9 +1,43 @@
 package errs
 
-import "errors"
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/clientportal"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
 
 var (
 	ErrInvalidClientID        = errors.New("invalid client id")
 	ErrInvalidClientContactID = errors.New("invalid client contact id")
 	ErrClientNotFound         = errors.New("client not found")
 )
+
+// ConvertControllerErr writes the response of an error of the client portal controller
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, clientportal.ErrClientNotFound),
+		errors.Is(err, clientportal.ErrClientContactNotFound),
+		errors.Is(err, clientportal.ErrAccessNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, clientportal.ErrContactEmailRequired),
+		errors.Is(err, clientportal.ErrInvalidContactEmail):
+		status = http.StatusBadRequest
+	case errors.Is(err, authutils.ErrClientPortalNotConfigured):
+		status = http.StatusServiceUnavailable
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
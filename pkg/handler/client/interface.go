@@ -8,6 +8,8 @@ type IHandler interface {
 	Detail(c *gin.Context)
 	Update(c *gin.Context)
 	Delete(c *gin.Context)
+	IssuePortalAccess(c *gin.Context)
+	RevokePortalAccess(c *gin.Context)
 
 	PublicList(c *gin.Context)
 }
@@ -35,3 +35,8 @@ type UpdateClientContactInput struct {
 	Emails        []string `json:"emails"`
 	IsMainContact bool     `json:"isMainContact"`
 }
+
+type IssuePortalAccessInput struct {
+	// Email is one of the emails of the contact, the first email of the contact by default
+	Email string `json:"email" binding:"omitempty,email"`
+}
@@ -0,0 +1,319 @@
+package clientportal
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	"github.com/dwarvesf/fortress-api/pkg/handler/clientportal/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/clientportal/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	controller *controller.Controller
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		controller: controller,
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+	}
+}
+
+// Login godoc
+// @Summary Log in to the client portal
+// @Description Exchange the token of a login link for a client portal token, a login link can be used only once
+// @Tags ClientPortal
+// @Accept  json
+// @Produce  json
+// @Param Body body request.LoginInput true "Body"
+// @Success 200 {object} view.ClientPortalLoginResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 401 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /client-portal/auth [post]
+func (h *handler) Login(c *gin.Context) {
+	var req request.LoginInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "clientportal",
+		"method":  "Login",
+	})
+
+	accessToken, err := h.controller.ClientPortal.Login(req.Token, time.Now())
+	if err != nil {
+		l.Error(err, "failed to log in to the client portal")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ClientPortalLogin{AccessToken: accessToken}, nil, nil, nil, ""))
+}
+
+// RequestLoginLink godoc
+// @Summary Send a client portal login link
+// @Description Send a one-time login link to a client contact who was given access to the client portal
+// @Tags ClientPortal
+// @Accept  json
+// @Produce  json
+// @Param Body body request.RequestLoginLinkInput true "Body"
+// @Success 200 {object} view.MessageResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /client-portal/auth/login-link [post]
+func (h *handler) RequestLoginLink(c *gin.Context) {
+	var req request.RequestLoginLinkInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, req, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "clientportal",
+		"method":  "RequestLoginLink",
+	})
+
+	if err := h.controller.ClientPortal.RequestLoginLink(req.Email, time.Now()); err != nil {
+		l.Error(err, "failed to send client portal login link")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "if the email has access to the client portal, a login link has been sent"))
+}
+
+// Me godoc
+// @Summary Get the logged-in client contact
+// @Description Get the client contact of the client portal token and their client
+// @Tags ClientPortal
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "client portal token"
+// @Success 200 {object} view.ClientPortalMeResponse
+// @Failure 401 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /client-portal/me [get]
+func (h *handler) Me(c *gin.Context) {
+	claims, ok := h.getClaims(c)
+	if !ok {
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":  "clientportal",
+		"method":   "Me",
+		"accessID": claims.AccessID,
+	})
+
+	me, err := h.controller.ClientPortal.Me(claims.AccessID)
+	if err != nil {
+		l.Error(err, "failed to get client portal contact")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToClientPortalMe(me.Access, me.Client, me.Contact), nil, nil, nil, ""))
+}
+
+// ListProjects godoc
+// @Summary Get the projects of the client
+// @Description Get the projects of the client of the logged-in contact
+// @Tags ClientPortal
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "client portal token"
+// @Success 200 {object} view.ClientPortalProjectsResponse
+// @Failure 401 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /client-portal/projects [get]
+func (h *handler) ListProjects(c *gin.Context) {
+	claims, ok := h.getClaims(c)
+	if !ok {
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":  "clientportal",
+		"method":   "ListProjects",
+		"accessID": claims.AccessID,
+	})
+
+	projects, err := h.controller.ClientPortal.GetProjects(claims.AccessID)
+	if err != nil {
+		l.Error(err, "failed to get client portal projects")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToClientPortalProjects(projects), nil, nil, nil, ""))
+}
+
+// GetProject godoc
+// @Summary Get a project of the client
+// @Description Get a project of the client with its active team members and its milestones
+// @Tags ClientPortal
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "client portal token"
+// @Param id path string true "Project ID"
+// @Success 200 {object} view.ClientPortalProjectDetailResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 401 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /client-portal/projects/{id} [get]
+func (h *handler) GetProject(c *gin.Context) {
+	projectID, ok := h.getProjectID(c)
+	if !ok {
+		return
+	}
+
+	claims, ok := h.getClaims(c)
+	if !ok {
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":   "clientportal",
+		"method":    "GetProject",
+		"accessID":  claims.AccessID,
+		"projectID": projectID,
+	})
+
+	project, err := h.controller.ClientPortal.GetProject(claims.AccessID, projectID)
+	if err != nil {
+		l.Error(err, "failed to get client portal project")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToClientPortalProjectDetail(project.Project, project.Members, project.Milestones, time.Now()), nil, nil, nil, ""))
+}
+
+// ListInvoices godoc
+// @Summary Get the invoices of a project of the client
+// @Description Get the sent invoices of a project of the client with their payment status and PDF link, newest first
+// @Tags ClientPortal
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "client portal token"
+// @Param id path string true "Project ID"
+// @Success 200 {object} view.ClientPortalInvoicesResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 401 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /client-portal/projects/{id}/invoices [get]
+func (h *handler) ListInvoices(c *gin.Context) {
+	projectID, ok := h.getProjectID(c)
+	if !ok {
+		return
+	}
+
+	claims, ok := h.getClaims(c)
+	if !ok {
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":   "clientportal",
+		"method":    "ListInvoices",
+		"accessID":  claims.AccessID,
+		"projectID": projectID,
+	})
+
+	invoices, err := h.controller.ClientPortal.GetInvoices(claims.AccessID, projectID)
+	if err != nil {
+		l.Error(err, "failed to get client portal invoices")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToClientPortalInvoices(invoices), nil, nil, nil, ""))
+}
+
+// ListChangelogs godoc
+// @Summary Get the changelogs of a project of the client
+// @Description Get the changelog emails sent for a project of the client, newest first
+// @Tags ClientPortal
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "client portal token"
+// @Param id path string true "Project ID"
+// @Success 200 {object} view.ClientPortalChangelogsResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 401 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /client-portal/projects/{id}/changelogs [get]
+func (h *handler) ListChangelogs(c *gin.Context) {
+	projectID, ok := h.getProjectID(c)
+	if !ok {
+		return
+	}
+
+	claims, ok := h.getClaims(c)
+	if !ok {
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":   "clientportal",
+		"method":    "ListChangelogs",
+		"accessID":  claims.AccessID,
+		"projectID": projectID,
+	})
+
+	changelogs, err := h.controller.ClientPortal.GetChangelogs(claims.AccessID, projectID)
+	if err != nil {
+		l.Error(err, "failed to get client portal changelogs")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToClientPortalChangelogs(changelogs), nil, nil, nil, ""))
+}
+
+// getClaims gets the claims of the client portal token, the error response is written when false
+func (h *handler) getClaims(c *gin.Context) (*model.ClientPortalClaims, bool) {
+	claims, err := authutils.GetClientPortalClaimsFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return nil, false
+	}
+
+	return claims, true
+}
+
+func (h *handler) getProjectID(c *gin.Context) (string, bool) {
+	projectID := c.Param("id")
+	if projectID == "" || !model.IsUUIDFromString(projectID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidProjectID, nil, ""))
+		return "", false
+	}
+
+	return projectID, true
+}
@@ -0,0 +1,41 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/clientportal"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidProjectID = errors.New("invalid project ID")
+)
+
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, clientportal.ErrProjectNotFound),
+		errors.Is(err, clientportal.ErrClientNotFound),
+		errors.Is(err, clientportal.ErrClientContactNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, clientportal.ErrInvalidLoginLink),
+		errors.Is(err, clientportal.ErrAccessNotFound),
+		errors.Is(err, clientportal.ErrAccessRevoked):
+		status = http.StatusUnauthorized
+	case errors.Is(err, authutils.ErrClientPortalNotConfigured):
+		status = http.StatusServiceUnavailable
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
@@ -0,0 +1,13 @@
+package clientportal
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	Login(c *gin.Context)
+	RequestLoginLink(c *gin.Context)
+	Me(c *gin.Context)
+	ListProjects(c *gin.Context)
+	GetProject(c *gin.Context)
+	ListInvoices(c *gin.Context)
+	ListChangelogs(c *gin.Context)
+}
@@ -0,0 +1,10 @@
+package request
+
+type LoginInput struct {
+	// Token is the token of the login link
+	Token string `json:"token" binding:"required"`
+}
+
+type RequestLoginLinkInput struct {
+	Email string `json:"email" binding:"required,email"`
+}
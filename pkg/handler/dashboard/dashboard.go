@@ -653,6 +653,121 @@ func (h *handler) GetEngagementInfoDetail(c *gin.Context) {
 	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToEngagementDashboardDetails(statistic), nil, nil, nil, ""))
 }
 
+// GetSurveyAnalytics godoc
+// @Summary Get survey analytics
+// @Description Compare likert-scale results, response rates and the sentiment of free-text answers of surveys across periods
+// @Tags Dashboard
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "jwt token"
+// @Param subtype query string true "peer-review/engagement/work"
+// @Param groupBy query string false "question/domain/project/chapter/seniority, default question"
+// @Param from query string false "Surveys starting from this date, YYYY-MM-DD"
+// @Param to query string false "Surveys starting until this date, YYYY-MM-DD"
+// @Param domain query string false "Question domain"
+// @Param projectID query string false "Only answers of members of the project"
+// @Success 200 {object} view.SurveyAnalyticsResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /dashboards/surveys/analytics [get]
+func (h *handler) GetSurveyAnalytics(c *gin.Context) {
+	query := request.GetSurveyAnalyticsInput{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	filter := model.SurveyAnalyticsFilter{
+		Subtype:   model.EventSubtype(query.Subtype),
+		GroupBy:   model.SurveyAnalyticsGroupBy(query.GroupBy),
+		Domain:    model.QuestionDomain(query.Domain),
+		ProjectID: query.ProjectID,
+	}
+	if filter.GroupBy == "" {
+		filter.GroupBy = model.SurveyAnalyticsGroupByQuestion
+	}
+
+	if !filter.Subtype.IsValidSurvey() {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidSurveySubtype, query, ""))
+		return
+	}
+
+	if !filter.GroupBy.IsValid() {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidSurveyAnalyticsGroupBy, query, ""))
+		return
+	}
+
+	if filter.Domain != "" && !filter.Domain.IsValid() && filter.Domain != model.QuestionDomainEngagement {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidQuestionDomain, query, ""))
+		return
+	}
+
+	if query.From != "" {
+		from, err := time.Parse("2006-01-02", query.From)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidFromDate, query, ""))
+			return
+		}
+		filter.From = &from
+	}
+
+	if query.To != "" {
+		to, err := time.Parse("2006-01-02", query.To)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidToDate, query, ""))
+			return
+		}
+		filter.To = &to
+	}
+
+	if filter.From != nil && filter.To != nil && filter.From.After(*filter.To) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidDateRange, query, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "dashboard",
+		"method":  "GetSurveyAnalytics",
+		"query":   query,
+	})
+
+	if filter.ProjectID != "" {
+		if !model.IsUUIDFromString(filter.ProjectID) {
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidProjectID, query, ""))
+			return
+		}
+
+		project, err := h.store.Project.One(h.repo.DB(), filter.ProjectID, false)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, errs.ErrProjectNotFound, query, ""))
+				return
+			}
+			l.Error(err, "failed to get project")
+			c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, query, ""))
+			return
+		}
+		filter.ProjectName = project.Name
+	}
+
+	answers, err := h.store.Dashboard.GetSurveyAnalyticsAnswers(h.repo.DB(), filter)
+	if err != nil {
+		l.Error(err, "failed to get survey analytics answers")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	counts, err := h.store.Dashboard.GetSurveyAnalyticsResponseCounts(h.repo.DB(), filter)
+	if err != nil {
+		l.Error(err, "failed to get survey analytics response counts")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToSurveyAnalytics(filter, answers, counts), nil, nil, nil, ""))
+}
+
 // GetResourceUtilization godoc
 // @Summary Get dashboard resource utilization
 // @Description Get dashboard resource utilization
@@ -539,6 +539,12 @@ func TestHandler_GetEngagementInfoDetail(t *testing.T) {
 			wantResponsePath: "testdata/engagement_info_detail/200.json",
 			query:            "filter=seniority&startDate=2022-10-01",
 		},
+		{
+			name:             "anonymous_survey",
+			wantCode:         http.StatusOK,
+			wantResponsePath: "testdata/engagement_info_detail/200_anonymous_survey.json",
+			query:            "filter=seniority&startDate=2023-04-01",
+		},
 		{
 			name:             "invalid_filter",
 			wantCode:         http.StatusBadRequest,
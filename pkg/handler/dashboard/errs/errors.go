@@ -11,4 +11,10 @@ var (
 	ErrInvalidStartDate                 = errors.New("invalid startDate")
 	ErrInvalidWorkUnitDistributionType  = errors.New("invalid work unit distribution type")
 	ErrInvalidWorkUnitDistributionSort  = errors.New("invalid sort value")
+	ErrInvalidSurveySubtype             = errors.New("invalid survey subtype")
+	ErrInvalidSurveyAnalyticsGroupBy    = errors.New("invalid survey analytics groupBy")
+	ErrInvalidQuestionDomain            = errors.New("invalid question domain")
+	ErrInvalidFromDate                  = errors.New("invalid from date")
+	ErrInvalidToDate                    = errors.New("invalid to date")
+	ErrInvalidDateRange                 = errors.New("from date must not be after to date")
 )
@@ -13,6 +13,7 @@ type IHandler interface {
 	GetResourcesAvailability(c *gin.Context)
 	GetEngagementInfo(c *gin.Context)
 	GetEngagementInfoDetail(c *gin.Context)
+	GetSurveyAnalytics(c *gin.Context)
 	GetResourceUtilization(c *gin.Context)
 	GetWorkUnitDistribution(c *gin.Context)
 	GetWorkUnitDistributionSummary(c *gin.Context)
@@ -23,3 +23,12 @@ type GetResourceWorkSurveySummariesInput struct {
 	model.Pagination
 	Keyword string `json:"keyword" form:"keyword"`
 }
+
+type GetSurveyAnalyticsInput struct {
+	Subtype   string `form:"subtype" json:"subtype"`
+	GroupBy   string `form:"groupBy" json:"groupBy"`
+	From      string `form:"from" json:"from"`
+	To        string `form:"to" json:"to"`
+	Domain    string `form:"domain" json:"domain"`
+	ProjectID string `form:"projectID" json:"projectID"`
+}
@@ -0,0 +1,18 @@
+INSERT INTO "public"."feedback_events" ("id", "deleted_at", "created_at", "updated_at", "title", "type", "subtype", "status", "created_by", "start_date", "end_date", "anonymity", "anonymity_threshold") VALUES
+('3d1f8a52-6c0e-4b7a-9e21-5a8c4f6d2b01', NULL, '2023-07-12 08:03:33.233262', '2023-07-12 08:03:33.233262', 'Q2, 2023', 'survey', 'engagement', 'done', '2655832e-f009-4b73-a535-64c3a22e558f', '2023-04-01 00:00:00', '2023-06-30 23:59:59', 'anonymous', 2);
+
+INSERT INTO "public"."employee_event_topics" ("id", "deleted_at", "created_at", "updated_at", "title", "event_id", "employee_id", "project_id") VALUES
+('3d1f8a52-6c0e-4b7a-9e21-5a8c4f6d2b11', NULL, '2023-07-12 08:03:33.233262', '2023-07-12 08:03:33.233262', 'Engagement Survey: Huy Nguyen - Q2, 2023', '3d1f8a52-6c0e-4b7a-9e21-5a8c4f6d2b01', 'ecea9d15-05ba-4a4e-9787-54210e3b98ce', NULL),
+('3d1f8a52-6c0e-4b7a-9e21-5a8c4f6d2b12', NULL, '2023-07-12 08:03:33.233262', '2023-07-12 08:03:33.233262', 'Engagement Survey: Thanh Pham - Q2, 2023', '3d1f8a52-6c0e-4b7a-9e21-5a8c4f6d2b01', '2655832e-f009-4b73-a535-64c3a22e558f', NULL);
+
+INSERT INTO "public"."employee_event_reviewers" ("id", "deleted_at", "created_at", "updated_at", "event_id", "employee_event_topic_id", "reviewer_id", "relationship", "is_shared", "is_read", "author_status", "reviewer_status", "is_forced_done") VALUES
+('3d1f8a52-6c0e-4b7a-9e21-5a8c4f6d2b21', NULL, '2023-07-12 08:03:33.233262', '2023-07-12 08:03:33.233262', '3d1f8a52-6c0e-4b7a-9e21-5a8c4f6d2b01', '3d1f8a52-6c0e-4b7a-9e21-5a8c4f6d2b11', 'ecea9d15-05ba-4a4e-9787-54210e3b98ce', 'self', 'f', 'f', 'draft', 'done', 'f'),
+('3d1f8a52-6c0e-4b7a-9e21-5a8c4f6d2b22', NULL, '2023-07-12 08:03:33.233262', '2023-07-12 08:03:33.233262', '3d1f8a52-6c0e-4b7a-9e21-5a8c4f6d2b01', '3d1f8a52-6c0e-4b7a-9e21-5a8c4f6d2b12', '2655832e-f009-4b73-a535-64c3a22e558f', 'self', 'f', 'f', 'draft', 'done', 'f');
+
+INSERT INTO "public"."employee_event_questions" ("id", "deleted_at", "created_at", "updated_at", "event_id", "employee_event_reviewer_id", "type", "content", "answer", "note", "question_id", "order", "domain") VALUES
+('3d1f8a52-6c0e-4b7a-9e21-5a8c4f6d2b31', NULL, '2023-07-12 08:03:33.233262', '2023-07-12 08:03:33.233262', '3d1f8a52-6c0e-4b7a-9e21-5a8c4f6d2b01', '3d1f8a52-6c0e-4b7a-9e21-5a8c4f6d2b21', 'likert-scale', 'I know what is expected of me at work.', '', '', '4e71821e-e8d7-4fc9-9f02-7b4de4efa7f8', 1, 'engagement'),
+('3d1f8a52-6c0e-4b7a-9e21-5a8c4f6d2b32', NULL, '2023-07-12 08:03:33.233262', '2023-07-12 08:03:33.233262', '3d1f8a52-6c0e-4b7a-9e21-5a8c4f6d2b01', '3d1f8a52-6c0e-4b7a-9e21-5a8c4f6d2b22', 'likert-scale', 'I know what is expected of me at work.', '', '', '4e71821e-e8d7-4fc9-9f02-7b4de4efa7f8', 1, 'engagement');
+
+INSERT INTO "public"."anonymous_event_answers" ("id", "deleted_at", "created_at", "updated_at", "event_id", "employee_event_topic_id", "response_key", "question_id", "template_question_id", "content", "type", "answer", "note", "order", "domain", "seniority", "chapters", "projects") VALUES
+('3d1f8a52-6c0e-4b7a-9e21-5a8c4f6d2b41', NULL, '2023-07-12 08:03:33.233262', '2023-07-12 08:03:33.233262', '3d1f8a52-6c0e-4b7a-9e21-5a8c4f6d2b01', NULL, '5e2b7c1d9a04', '4e71821e-e8d7-4fc9-9f02-7b4de4efa7f8', NULL, 'I know what is expected of me at work.', 'likert-scale', '4', '', 1, 'engagement', 'Staff', '[]', '[]'),
+('3d1f8a52-6c0e-4b7a-9e21-5a8c4f6d2b42', NULL, '2023-07-12 08:03:33.233262', '2023-07-12 08:03:33.233262', '3d1f8a52-6c0e-4b7a-9e21-5a8c4f6d2b01', NULL, '7a9d3f6b2c18', '4e71821e-e8d7-4fc9-9f02-7b4de4efa7f8', NULL, 'I know what is expected of me at work.', 'likert-scale', '2', '', 1, 'engagement', 'Staff', '[]', '[]');
@@ -0,0 +1,62 @@
+package employee
+
+import (
+	"encoding/json"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/employee"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/utils"
+)
+
+type updateBaseSalaryPayload struct {
+	EmployeeID string                         `json:"employeeID"`
+	Input      employee.UpdateBaseSalaryInput `json:"input"`
+}
+
+// registerApprovalExecutors lets the approval flow update base salaries
+// once a second person approved them
+func (h *handler) registerApprovalExecutors() {
+	if h.controller == nil || h.controller.Approval == nil {
+		return
+	}
+
+	h.controller.Approval.RegisterExecutor(model.ApprovalActionEmployeeBaseSalary, func(request *model.ApprovalRequest) error {
+		var payload updateBaseSalaryPayload
+		if err := json.Unmarshal(request.Payload, &payload); err != nil {
+			return err
+		}
+
+		// changes requested by an api key are logged under the approver, who is the person answering for them
+		employeeID := request.RequesterID.String()
+		if request.IsRequestedByAPIKey() && request.ApproverID != nil {
+			employeeID = request.ApproverID.String()
+		}
+
+		_, err := h.updateBaseSalary(employeeID, payload.EmployeeID, payload.Input)
+		return err
+	})
+}
+
+// updateBaseSalary updates the base salary and logs it to discord
+func (h *handler) updateBaseSalary(requesterID string, employeeID string, input employee.UpdateBaseSalaryInput) (*model.BaseSalary, error) {
+	baseSalary, err := h.controller.Employee.UpdateBaseSalary(h.logger, employeeID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	totalBaseSalary := input.PersonalAccountAmount + input.CompanyAccountAmount
+	// update discord as audit log
+	err = h.controller.Discord.Log(model.LogDiscordInput{
+		Type: "employee_update_base_salary",
+		Data: map[string]interface{}{
+			"employee_id":         requesterID,
+			"updated_employee_id": employeeID,
+			"new_salary":          utils.FormatMoney(float64(totalBaseSalary), "VND"),
+		},
+	})
+	if err != nil {
+		h.logger.Error(err, "failed to logs to discord")
+	}
+
+	return baseSalary, nil
+}
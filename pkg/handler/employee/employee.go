@@ -2,6 +2,7 @@ package employee
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/dwarvesf/fortress-api/pkg/config"
 	"github.com/dwarvesf/fortress-api/pkg/controller"
+	"github.com/dwarvesf/fortress-api/pkg/controller/approval"
 	"github.com/dwarvesf/fortress-api/pkg/controller/employee"
 	"github.com/dwarvesf/fortress-api/pkg/handler/employee/errs"
 	"github.com/dwarvesf/fortress-api/pkg/handler/employee/request"
@@ -16,7 +18,6 @@ import (
 	"github.com/dwarvesf/fortress-api/pkg/model"
 	"github.com/dwarvesf/fortress-api/pkg/service"
 	"github.com/dwarvesf/fortress-api/pkg/store"
-	"github.com/dwarvesf/fortress-api/pkg/utils"
 	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
 	"github.com/dwarvesf/fortress-api/pkg/view"
 )
@@ -32,7 +33,7 @@ type handler struct {
 
 // New returns a handler
 func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
-	return &handler{
+	h := &handler{
 		controller: controller,
 		store:      store,
 		repo:       repo,
@@ -40,6 +41,9 @@ func New(controller *controller.Controller, store *store.Store, repo store.DBRep
 		logger:     logger,
 		config:     cfg,
 	}
+	h.registerApprovalExecutors()
+
+	return h
 }
 
 // List godoc
@@ -682,14 +686,14 @@ func (h *handler) GetLineManagers(c *gin.Context) {
 
 // UpdateBaseSalary godoc
 // @Summary Update employee's base salary by employee and base salary id
-// @Description Update employee's base salary by employee and base salary id
+// @Description Update employee's base salary by employee and base salary id, the change waits for a second person to approve
 // @Tags Employee
 // @Accept  json
 // @Produce  json
 // @Param Authorization header string true "jwt token"
 // @Param id path string true "Employee ID"
 // @Param Body body request.UpdateBaseSalaryInput true "Body"
-// @Success 200 {object} view.UpdateBaseSalaryResponse
+// @Success 202 {object} view.ApprovalRequestResponse
 // @Failure 400 {object} view.ErrorResponse
 // @Failure 404 {object} view.ErrorResponse
 // @Failure 500 {object} view.ErrorResponse
@@ -724,35 +728,41 @@ func (h *handler) UpdateBaseSalary(c *gin.Context) {
 		Batch:                 req.Batch,
 	}
 
-	emp, err := h.controller.Employee.UpdateBaseSalary(h.logger, employeeID, requestBody)
+	requester, err := approval.GetRequester(c, h.config)
 	if err != nil {
-		l.Error(err, "failed to update base salary")
-		errs.ConvertControllerErr(c, err)
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
 		return
 	}
 
-	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	exists, err := h.store.Employee.IsExist(h.repo.DB(), employeeID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		l.Error(err, "failed to check employee existence")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, req, ""))
+		return
+	}
+	if !exists {
+		c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, employee.ErrEmployeeNotFound, req, ""))
 		return
 	}
 
-	totalBaseSalary := req.PersonalAccountAmount + req.CompanyAccountAmount
-	formattedBaseSalary := utils.FormatMoney(float64(totalBaseSalary), "VND")
-	// update discord as audit log
-	err = h.controller.Discord.Log(model.LogDiscordInput{
-		Type: "employee_update_base_salary",
-		Data: map[string]interface{}{
-			"employee_id":         userID,
-			"updated_employee_id": employeeID,
-			"new_salary":          formattedBaseSalary,
+	// base salary changes need a second person to approve
+	approvalRequest, err := h.controller.Approval.Create(approval.CreateInput{
+		Action:     model.ApprovalActionEmployeeBaseSalary,
+		Permission: model.PermissionEmployeesBaseSalaryEdit.String(),
+		Summary:    fmt.Sprintf("Update base salary of employee %s", employeeID),
+		Payload: updateBaseSalaryPayload{
+			EmployeeID: employeeID,
+			Input:      requestBody,
 		},
+		Requester: requester,
 	})
 	if err != nil {
-		l.Error(err, "failed to logs to discord")
+		l.Error(err, "failed to create approval request")
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, req, ""))
+		return
 	}
 
-	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToBaseSalary(emp), nil, nil, nil, ""))
+	c.JSON(http.StatusAccepted, view.CreateResponse[any](view.ToApprovalRequest(approvalRequest), nil, nil, nil, "waiting for approval"))
 }
 
 // PublicList godoc
@@ -781,3 +791,34 @@ func (h *handler) PublicList(c *gin.Context) {
 
 	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToEmployeesWithLocation(employees), nil, nil, nil, ""))
 }
+
+// ReencryptPII godoc
+// @Summary Re-encrypt employee PII
+// @Description Encrypt employee PII which is in plain text or encrypted with an old key, run after rotating the encryption key
+// @Tags Employee
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Success 200 {object} view.MessageResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /cronjobs/reencrypt-employee-pii [post]
+func (h *handler) ReencryptPII(c *gin.Context) {
+	l := h.logger.Fields(logger.Fields{
+		"handler": "employee",
+		"method":  "ReencryptPII",
+	})
+
+	updated, err := h.controller.Employee.ReencryptPII()
+	if err != nil {
+		l.Error(err, "failed to re-encrypt employee PII")
+		if errors.Is(err, employee.ErrEncryptionDisabled) {
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+			return
+		}
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, fmt.Sprintf("re-encrypted %d employees", updated)))
+}
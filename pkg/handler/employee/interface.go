@@ -14,6 +14,7 @@ type IHandler interface {
 	UploadAvatar(c *gin.Context)
 	UpdateRole(c *gin.Context)
 	UpdateBaseSalary(c *gin.Context)
+	ReencryptPII(c *gin.Context)
 
 	PublicList(c *gin.Context)
 }
@@ -0,0 +1,383 @@
+package feedback
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/handler/feedback/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/feedback/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/employeeeventtopic"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+// Send godoc
+// @Summary Send kudos or feedback to a colleague
+// @Description Send kudos or private feedback to an active employee outside of survey cycles, public kudos are announced on Discord
+// @Tags Feedback
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "jwt token"
+// @Param Body body request.SendFeedbackInput true "Body"
+// @Success 200 {object} view.ContinuousFeedbackResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /feedbacks [post]
+func (h *handler) Send(c *gin.Context) {
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	input := request.SendFeedbackInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "feedback",
+		"method":  "Send",
+		"userID":  userID,
+		"input":   input,
+	})
+
+	if input.RecipientID.String() == userID {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrCanNotSendToYourself, input, ""))
+		return
+	}
+
+	sender, err := h.store.Employee.One(h.repo.DB(), userID, false)
+	if err != nil {
+		l.Error(err, "failed to get sender")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	recipient, err := h.store.Employee.One(h.repo.DB(), input.RecipientID.String(), false)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		l.Error(errs.ErrEmployeeNotFound, "recipient not found")
+		c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, errs.ErrEmployeeNotFound, input, ""))
+		return
+	}
+	if err != nil {
+		l.Error(err, "failed to get recipient")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if recipient.WorkingStatus == model.WorkingStatusLeft {
+		l.Info("recipient has left")
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrRecipientNotActive, input, ""))
+		return
+	}
+
+	var project *model.Project
+	if !input.ProjectID.IsZero() {
+		project, err = h.store.Project.One(h.repo.DB(), input.ProjectID.String(), false)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			l.Error(errs.ErrProjectNotFound, "project not found")
+			c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, errs.ErrProjectNotFound, input, ""))
+			return
+		}
+		if err != nil {
+			l.Error(err, "failed to get project")
+			c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, input, ""))
+			return
+		}
+	}
+
+	tx, done := h.repo.NewTransaction()
+
+	topic, err := h.createContinuousFeedback(tx.DB(), sender, recipient, input)
+	if err != nil {
+		l.Error(err, "failed to create feedback")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, done(err), input, ""))
+		return
+	}
+
+	if err := done(nil); err != nil {
+		l.Error(err, "failed to commit feedback")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if topic.Event.Subtype == model.EventSubtypeAppreciation && topic.Event.Visibility == model.FeedbackVisibilityPublic {
+		// the kudos is saved already, a failed announcement is not worth failing the request
+		if err := h.announceKudos(sender, recipient, project, input.Message); err != nil {
+			l.Error(err, "failed to announce kudos on discord")
+		}
+	}
+
+	topic.Event.Employee = *sender
+	topic.Employee = recipient
+	topic.Project = project
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToContinuousFeedback(topic), nil, nil, nil, ""))
+}
+
+// createContinuousFeedback saves the feedback as a feedback event with a single topic about the recipient,
+// the sender is the only reviewer and the message is the answer to its only question
+func (h *handler) createContinuousFeedback(db *gorm.DB, sender *model.Employee, recipient *model.Employee, input request.SendFeedbackInput) (*model.EmployeeEventTopic, error) {
+	now := time.Now()
+
+	title := input.Title
+	if title == "" {
+		kind := "Feedback"
+		if input.Subtype == model.EventSubtypeAppreciation {
+			kind = "Kudos"
+		}
+		title = fmt.Sprintf("%s from %s", kind, sender.DisplayName)
+	}
+
+	event, err := h.store.FeedbackEvent.Create(db, &model.FeedbackEvent{
+		BaseModel: model.BaseModel{
+			ID: model.NewUUID(),
+		},
+		Title:      title,
+		Type:       model.EventTypeFeedback,
+		Subtype:    input.Subtype,
+		Status:     model.EventStatusDone,
+		CreatedBy:  sender.ID,
+		StartDate:  &now,
+		EndDate:    &now,
+		Visibility: input.Visibility,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	topics, err := h.store.EmployeeEventTopic.BatchCreate(db, []model.EmployeeEventTopic{
+		{
+			BaseModel: model.BaseModel{
+				ID: model.NewUUID(),
+			},
+			Title:      title,
+			EventID:    event.ID,
+			EmployeeID: recipient.ID,
+			ProjectID:  input.ProjectID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	topic := &topics[0]
+
+	relationship := model.RelationshipPeer
+	if recipient.LineManagerID == sender.ID {
+		relationship = model.RelationshipLineManager
+	}
+
+	reviewer, err := h.store.EmployeeEventReviewer.Create(db, &model.EmployeeEventReviewer{
+		BaseModel: model.BaseModel{
+			ID: model.NewUUID(),
+		},
+		EmployeeEventTopicID: topic.ID,
+		ReviewerID:           sender.ID,
+		ReviewerStatus:       model.EventReviewerStatusDone,
+		AuthorStatus:         model.EventAuthorStatusDone,
+		Relationship:         relationship,
+		IsShared:             true,
+		EventID:              event.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	question, err := h.store.EmployeeEventQuestion.Create(db, &model.EmployeeEventQuestion{
+		BaseModel: model.BaseModel{
+			ID: model.NewUUID(),
+		},
+		EmployeeEventReviewerID: reviewer.ID,
+		EventID:                 event.ID,
+		Content:                 title,
+		Answer:                  input.Message,
+		Type:                    model.QuestionTypeGeneral.String(),
+		Order:                   1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reviewer.EmployeeEventQuestions = []model.EmployeeEventQuestion{*question}
+	topic.Event = *event
+	topic.EmployeeEventReviewers = []model.EmployeeEventReviewer{*reviewer}
+
+	return topic, nil
+}
+
+// announceKudos posts public kudos to the campfire channel, the recipient is mentioned when their Discord account is known
+func (h *handler) announceKudos(sender *model.Employee, recipient *model.Employee, project *model.Project, message string) error {
+	if h.config.Discord.Webhooks.Campfire == "" {
+		return nil
+	}
+
+	name := recipient.DisplayName
+	if recipient.DiscordAccount != nil && recipient.DiscordAccount.DiscordID != "" {
+		name = fmt.Sprintf("<@%s>", recipient.DiscordAccount.DiscordID)
+	}
+
+	msg := fmt.Sprintf("🎉 Kudos to %s from %s", name, sender.DisplayName)
+	if project != nil {
+		msg += fmt.Sprintf(" for %s", project.Name)
+	}
+	msg += fmt.Sprintf("\n> %s", message)
+
+	_, err := h.service.Discord.SendMessage(msg, h.config.Discord.Webhooks.Campfire)
+	return err
+}
+
+// ListEmployeeFeedback godoc
+// @Summary Get the continuous feedback an employee received
+// @Description Get the kudos and feedback an employee received outside of surveys.
+// @Description Employees read all of theirs, line managers and people reading survey answers read the ones shared with managers, others read public kudos
+// @Tags Feedback
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "jwt token"
+// @Param employeeID path string true "Employee ID"
+// @Param subtype query string false "appreciation or comment"
+// @Param page query string false "Page"
+// @Param size query string false "Size"
+// @Success 200 {object} view.ListContinuousFeedbackResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /feedbacks/employees/{employeeID} [get]
+func (h *handler) ListEmployeeFeedback(c *gin.Context) {
+	employeeID := c.Param("employeeID")
+	if employeeID == "" || !model.IsUUIDFromString(employeeID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidEmployeeID, nil, ""))
+		return
+	}
+
+	input := request.GetContinuousFeedbackInput{}
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	input.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":    "feedback",
+		"method":     "ListEmployeeFeedback",
+		"employeeID": employeeID,
+		"input":      input,
+	})
+
+	userInfo, err := authutils.GetLoggedInUserInfo(c, h.store, h.repo.DB(), h.config)
+	if err != nil {
+		l.Error(err, "failed to get logged in user")
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	employee, err := h.store.Employee.One(h.repo.DB(), employeeID, false)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		l.Error(errs.ErrEmployeeNotFound, "employee not found")
+		c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, errs.ErrEmployeeNotFound, nil, ""))
+		return
+	}
+	if err != nil {
+		l.Error(err, "failed to get employee")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	isManager := employee.LineManagerID.String() == userInfo.UserID ||
+		authutils.HasPermission(userInfo.Permissions, model.PermissionEmployeeEventQuestionsRead)
+
+	h.listContinuousFeedback(c, l, input, employeeeventtopic.GetContinuousFeedbackInput{
+		EmployeeID:   employeeID,
+		Subtype:      model.EventSubtype(input.Subtype),
+		Visibilities: model.VisibleFeedbacks(employeeID == userInfo.UserID, isManager),
+	})
+}
+
+// ListKudos godoc
+// @Summary Get public kudos
+// @Description Get the kudos employees shared publicly, newest first
+// @Tags Feedback
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "jwt token"
+// @Param page query string false "Page"
+// @Param size query string false "Size"
+// @Success 200 {object} view.ListContinuousFeedbackResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /feedbacks/kudos [get]
+func (h *handler) ListKudos(c *gin.Context) {
+	input := request.GetContinuousFeedbackInput{}
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	input.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "feedback",
+		"method":  "ListKudos",
+		"input":   input,
+	})
+
+	h.listContinuousFeedback(c, l, input, employeeeventtopic.GetContinuousFeedbackInput{
+		Subtype:      model.EventSubtypeAppreciation,
+		Visibilities: []model.FeedbackVisibility{model.FeedbackVisibilityPublic},
+	})
+}
+
+func (h *handler) listContinuousFeedback(c *gin.Context, l logger.Logger, input request.GetContinuousFeedbackInput, filter employeeeventtopic.GetContinuousFeedbackInput) {
+	topics, total, err := h.store.EmployeeEventTopic.GetContinuousFeedback(h.repo.DB(), filter, input.Pagination)
+	if err != nil {
+		l.Error(err, "failed to get continuous feedback")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToListContinuousFeedback(topics),
+		&view.PaginationResponse{Pagination: input.Pagination, Total: total}, nil, nil, ""))
+}
+
+// receivedFeedbackReviewer returns the review of the sender of a continuous feedback,
+// survey topics are only readable by their reviewers
+func (h *handler) receivedFeedbackReviewer(eventID string, topicID string) (*model.EmployeeEventReviewer, error) {
+	event, err := h.store.FeedbackEvent.One(h.repo.DB(), eventID, false)
+	if err != nil {
+		return nil, err
+	}
+	if event.Type != model.EventTypeFeedback {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	reviewers, err := h.store.EmployeeEventReviewer.GetByTopicID(h.repo.DB(), topicID)
+	if err != nil {
+		return nil, err
+	}
+	if len(reviewers) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return reviewers[0], nil
+}
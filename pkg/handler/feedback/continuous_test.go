@@ -0,0 +1,223 @@
+package feedback
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/utils/testhelper"
+)
+
+const (
+	huyNguyenID = "ecea9d15-05ba-4a4e-9787-54210e3b98ce"
+	thanhPhamID = "2655832e-f009-4b73-a535-64c3a22e558f"
+	huyTieuID   = "608ea227-45a5-4c8a-af43-6c7280d96340"
+	huyGiangID  = "3f705527-0455-4e67-a585-6c1f23726fff"
+)
+
+// tokenOf signs a short lived token for the employee, the shared test token has expired
+func tokenOf(t *testing.T, cfg *config.Config, employeeID string) string {
+	t.Helper()
+
+	token, err := authutils.GenerateJWTToken(&model.AuthenticationInfo{UserID: employeeID}, time.Now().Add(time.Hour).Unix(), cfg.JWTSecretKey)
+	require.NoError(t, err)
+
+	return "Bearer " + token
+}
+
+func TestHandler_ListEmployeeFeedback(t *testing.T) {
+	cfg := config.LoadTestConfig()
+	loggerMock := logger.NewLogrusLogger()
+	serviceMock := service.New(&cfg, nil, nil)
+	storeMock := store.New()
+
+	tests := []struct {
+		name             string
+		userID           string
+		employeeID       string
+		query            string
+		wantCode         int
+		wantResponsePath string
+	}{
+		{
+			name:             "ok_recipient_reads_all",
+			userID:           huyNguyenID,
+			employeeID:       huyNguyenID,
+			wantCode:         http.StatusOK,
+			wantResponsePath: "testdata/list_employee_feedback/200_recipient.json",
+		},
+		{
+			name:             "ok_line_manager_reads_shared_with_managers",
+			userID:           huyTieuID,
+			employeeID:       huyNguyenID,
+			wantCode:         http.StatusOK,
+			wantResponsePath: "testdata/list_employee_feedback/200_manager.json",
+		},
+		{
+			name:             "ok_survey_reader_reads_shared_with_managers",
+			userID:           thanhPhamID,
+			employeeID:       huyNguyenID,
+			wantCode:         http.StatusOK,
+			wantResponsePath: "testdata/list_employee_feedback/200_manager.json",
+		},
+		{
+			name:             "ok_colleague_reads_public",
+			userID:           huyGiangID,
+			employeeID:       huyNguyenID,
+			wantCode:         http.StatusOK,
+			wantResponsePath: "testdata/list_employee_feedback/200_colleague.json",
+		},
+		{
+			name:             "ok_filter_by_subtype",
+			userID:           huyNguyenID,
+			employeeID:       huyNguyenID,
+			query:            "subtype=comment",
+			wantCode:         http.StatusOK,
+			wantResponsePath: "testdata/list_employee_feedback/200_recipient_comment.json",
+		},
+		{
+			name:             "failed_invalid_subtype",
+			userID:           huyNguyenID,
+			employeeID:       huyNguyenID,
+			query:            "subtype=survey",
+			wantCode:         http.StatusBadRequest,
+			wantResponsePath: "testdata/list_employee_feedback/invalid_subtype.json",
+		},
+		{
+			name:             "failed_invalid_employee_id",
+			userID:           huyNguyenID,
+			employeeID:       "huy",
+			wantCode:         http.StatusBadRequest,
+			wantResponsePath: "testdata/list_employee_feedback/invalid_employee_id.json",
+		},
+		{
+			name:             "failed_employee_not_found",
+			userID:           huyNguyenID,
+			employeeID:       "2655832e-f009-4b73-a535-64c3a22e5590",
+			wantCode:         http.StatusNotFound,
+			wantResponsePath: "testdata/list_employee_feedback/employee_not_found.json",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testhelper.TestWithTxDB(t, func(txRepo store.DBRepo) {
+				testhelper.LoadTestSQLFile(t, txRepo, "./testdata/list_employee_feedback/list_employee_feedback.sql")
+				w := httptest.NewRecorder()
+				ctx, _ := gin.CreateTestContext(w)
+				ctx.Request = httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/feedbacks/employees/%s?%s", tt.employeeID, tt.query), nil)
+				ctx.Request.Header.Set("Authorization", tokenOf(t, &cfg, tt.userID))
+				ctx.AddParam("employeeID", tt.employeeID)
+
+				h := New(storeMock, txRepo, serviceMock, loggerMock, &cfg)
+				h.ListEmployeeFeedback(ctx)
+				require.Equal(t, tt.wantCode, w.Code)
+				expRespRaw, err := os.ReadFile(tt.wantResponsePath)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expRespRaw), w.Body.String(), "[Handler.Feedback.ListEmployeeFeedback] response mismatched")
+			})
+		})
+	}
+}
+
+func TestHandler_ListKudos(t *testing.T) {
+	cfg := config.LoadTestConfig()
+	loggerMock := logger.NewLogrusLogger()
+	serviceMock := service.New(&cfg, nil, nil)
+	storeMock := store.New()
+
+	tests := []struct {
+		name             string
+		wantCode         int
+		wantResponsePath string
+	}{
+		{
+			name:             "ok_public_appreciation_only",
+			wantCode:         http.StatusOK,
+			wantResponsePath: "testdata/list_kudos/200.json",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testhelper.TestWithTxDB(t, func(txRepo store.DBRepo) {
+				testhelper.LoadTestSQLFile(t, txRepo, "./testdata/list_kudos/list_kudos.sql")
+				w := httptest.NewRecorder()
+				ctx, _ := gin.CreateTestContext(w)
+				ctx.Request = httptest.NewRequest(http.MethodGet, "/api/v1/feedbacks/kudos", nil)
+				ctx.Request.Header.Set("Authorization", tokenOf(t, &cfg, huyGiangID))
+
+				h := New(storeMock, txRepo, serviceMock, loggerMock, &cfg)
+				h.ListKudos(ctx)
+				require.Equal(t, tt.wantCode, w.Code)
+				expRespRaw, err := os.ReadFile(tt.wantResponsePath)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expRespRaw), w.Body.String(), "[Handler.Feedback.ListKudos] response mismatched")
+			})
+		})
+	}
+}
+
+func TestHandler_Detail_ContinuousFeedbackReadState(t *testing.T) {
+	cfg := config.LoadTestConfig()
+	loggerMock := logger.NewLogrusLogger()
+	serviceMock := service.New(&cfg, nil, nil)
+	storeMock := store.New()
+
+	const (
+		eventID         = "3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e03"
+		topicID         = "3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e13"
+		eventReviewerID = "3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e23"
+	)
+
+	tests := []struct {
+		name       string
+		userID     string
+		wantIsRead bool
+	}{
+		{
+			name:       "sender_keeps_unread_for_recipient",
+			userID:     thanhPhamID,
+			wantIsRead: false,
+		},
+		{
+			name:       "recipient_marks_read",
+			userID:     huyNguyenID,
+			wantIsRead: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testhelper.TestWithTxDB(t, func(txRepo store.DBRepo) {
+				testhelper.LoadTestSQLFile(t, txRepo, "./testdata/list_employee_feedback/list_employee_feedback.sql")
+				w := httptest.NewRecorder()
+				ctx, _ := gin.CreateTestContext(w)
+				ctx.Request = httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/feedbacks/%s/topics/%s", eventID, topicID), nil)
+				ctx.Request.Header.Set("Authorization", tokenOf(t, &cfg, tt.userID))
+				ctx.AddParam("id", eventID)
+				ctx.AddParam("topicID", topicID)
+
+				h := New(storeMock, txRepo, serviceMock, loggerMock, &cfg)
+				h.Detail(ctx)
+				require.Equal(t, http.StatusOK, w.Code)
+
+				eventReviewer, err := storeMock.EmployeeEventReviewer.One(txRepo.DB(), eventReviewerID)
+				require.NoError(t, err)
+				require.Equal(t, tt.wantIsRead, eventReviewer.IsRead, "[Handler.Feedback.Detail] read state mismatched")
+			})
+		})
+	}
+}
@@ -32,6 +32,15 @@ var (
 	ErrEmployeeNotReady         = errors.New("employee not ready")
 	ErrAlreadySent              = errors.New("surveys already sent to all participants")
 	ErrUnfinishedReviewer       = errors.New("all reviewers have to finish before marked done")
+	ErrTemplateVersionMismatch  = errors.New("answers were sent for another version of the survey template")
+
+	ErrInvalidEmployeeID      = errors.New("invalid employee id")
+	ErrInvalidProjectID       = errors.New("invalid project id")
+	ErrInvalidFeedbackSubtype = errors.New("invalid feedback subtype, must be appreciation or comment")
+	ErrInvalidVisibility      = errors.New("invalid visibility, must be private, manager or public")
+	ErrEmptyMessage           = errors.New("message is required")
+	ErrCanNotSendToYourself   = errors.New("can not send feedback to yourself")
+	ErrRecipientNotActive     = errors.New("recipient is not an active employee")
 )
 
 func ErrEventQuestionNotFound(id string) error {
@@ -1,8 +1,12 @@
 package feedback
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"gorm.io/gorm"
@@ -16,6 +20,7 @@ import (
 	"github.com/dwarvesf/fortress-api/pkg/store"
 	"github.com/dwarvesf/fortress-api/pkg/store/employeeeventquestion"
 	"github.com/dwarvesf/fortress-api/pkg/store/employeeeventtopic"
+	"github.com/dwarvesf/fortress-api/pkg/store/objective"
 	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
 	"github.com/dwarvesf/fortress-api/pkg/view"
 )
@@ -146,6 +151,10 @@ func (h *handler) Detail(c *gin.Context) {
 	}
 
 	eventReviewer, err := h.store.EmployeeEventReviewer.OneByReviewerID(h.repo.DB(), userID, input.TopicID)
+	if errors.Is(err, gorm.ErrRecordNotFound) && topic.EmployeeID.String() == userID {
+		// the recipient of a continuous feedback reads the review of the sender
+		eventReviewer, err = h.receivedFeedbackReviewer(input.EventID, input.TopicID)
+	}
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		l.Error(errs.ErrEmployeeEventReviewerNotFound, "employee event reviewer not found")
 		c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, errs.ErrEmployeeEventReviewerNotFound, nil, ""))
@@ -165,7 +174,7 @@ func (h *handler) Detail(c *gin.Context) {
 		return
 	}
 
-	reviewer, err := h.store.Employee.One(h.repo.DB(), userID, false)
+	reviewer, err := h.store.Employee.One(h.repo.DB(), eventReviewer.ReviewerID.String(), false)
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		l.Error(errs.ErrReviewerNotFound, "reviewer not found")
 		c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, errs.ErrReviewerNotFound, nil, ""))
@@ -178,7 +187,26 @@ func (h *handler) Detail(c *gin.Context) {
 		return
 	}
 
-	if !eventReviewer.IsRead {
+	markRead := !eventReviewer.IsRead
+	if markRead && topic.EmployeeID.String() != userID {
+		// the review of a continuous feedback belongs to the sender but holds the unread state of the recipient
+		event, err := h.store.FeedbackEvent.One(h.repo.DB(), input.EventID, false)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			l.Error(errs.ErrEventNotFound, "event not found")
+			c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, errs.ErrEventNotFound, input, ""))
+			return
+		}
+
+		if err != nil {
+			l.Error(err, "failed to get event")
+			c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, input, ""))
+			return
+		}
+
+		markRead = event.Type != model.EventTypeFeedback
+	}
+
+	if markRead {
 		eventReviewer.IsRead = true
 		if _, err := h.store.EmployeeEventReviewer.UpdateSelectedFieldsByID(h.repo.DB(), eventReviewer.ID.String(), *eventReviewer, "is_read"); err != nil {
 			l.Error(err, "failed to update employee event reviewer")
@@ -203,6 +231,13 @@ func (h *handler) Detail(c *gin.Context) {
 		}
 	}
 
+	goals, err := h.peerReviewGoals(input.EventID, topic.EmployeeID.String())
+	if err != nil {
+		l.Error(err, "failed to get goals of reviewee")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
 	detailInfo := view.FeedbackDetailInfo{
 		Status:       eventReviewer.ReviewerStatus,
 		EmployeeID:   topic.EmployeeID.String(),
@@ -212,6 +247,7 @@ func (h *handler) Detail(c *gin.Context) {
 		Title:        topic.Title,
 		Relationship: eventReviewer.Relationship,
 		Project:      project,
+		Goals:        goals,
 	}
 
 	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToListFeedbackDetails(questions, detailInfo), nil, nil, nil, ""))
@@ -360,14 +396,44 @@ func (h *handler) Submit(c *gin.Context) {
 		return
 	}
 
+	event, err := h.store.FeedbackEvent.One(tx.DB(), input.EventID, false)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		l.Error(errs.ErrEventNotFound, "event not found")
+		c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, done(errs.ErrEventNotFound), input, ""))
+		return
+	}
+
+	if err != nil {
+		l.Error(err, "failed to get event")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, done(err), input, ""))
+		return
+	}
+
+	status, err := h.validateTemplateAnswers(tx.DB(), input, event, eventQuestions)
+	if err != nil {
+		l.Error(err, "invalid answers for survey template")
+		c.JSON(status, view.CreateResponse[any](nil, nil, done(err), input, ""))
+		return
+	}
+
 	if input.Body.Status == model.EventReviewerStatusDone {
 		for _, e := range eventQuestions {
-			if e.Answer == "" {
+			// template questions can be optional or hidden, they are checked by the template
+			if e.Answer == "" && e.TemplateQuestionID.IsZero() {
 				l.Error(errs.ErrUnansweredquestions, "there are some unanswered questions")
 				c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, done(errs.ErrUnansweredquestions), input, ""))
 				return
 			}
 		}
+
+		// answers of anonymous surveys are moved out of the reviewer's questions once submitted
+		if event.IsAnonymous() {
+			if err := h.detachAnswers(tx.DB(), event, topic, userID, eventReviewer.ID.String(), eventQuestions); err != nil {
+				l.Error(err, "failed to detach anonymous answers")
+				c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, done(err), input, ""))
+				return
+			}
+		}
 	}
 
 	reviewer, err := h.store.Employee.One(h.repo.DB(), userID, false)
@@ -395,6 +461,115 @@ func (h *handler) Submit(c *gin.Context) {
 	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToListSubmitFeedback(eventQuestions, detailInfo), nil, nil, done(nil), ""))
 }
 
+// validateTemplateAnswers validates the answers of a survey created from a template against the template version it was sent with
+func (h *handler) validateTemplateAnswers(db *gorm.DB, input request.SubmitInput, event *model.FeedbackEvent, eventQuestions []*model.EmployeeEventQuestion) (int, error) {
+	if event.TemplateVersionID.IsZero() {
+		return http.StatusOK, nil
+	}
+
+	templateVersion, err := h.store.SurveyTemplate.OneVersionByID(db, event.TemplateVersionID.String())
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if input.Body.TemplateVersion != 0 && input.Body.TemplateVersion != templateVersion.Version {
+		return http.StatusConflict, errs.ErrTemplateVersionMismatch
+	}
+
+	keys := map[model.UUID]string{}
+	for _, q := range templateVersion.Questions {
+		keys[q.ID] = q.Key
+	}
+
+	answers := map[string]string{}
+	for _, e := range eventQuestions {
+		if key, ok := keys[e.TemplateQuestionID]; ok {
+			answers[key] = e.Answer
+		}
+	}
+
+	if err := model.ValidateSurveyAnswers(templateVersion.Questions, answers, input.Body.Status == model.EventReviewerStatusDone); err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	return http.StatusOK, nil
+}
+
+// detachAnswers copies the answers of a reviewer to anonymous_event_answers with a snapshot of the reviewer's cohort,
+// then clears them from employee_event_questions so they can not be linked back to the reviewer
+func (h *handler) detachAnswers(db *gorm.DB, event *model.FeedbackEvent, topic *model.EmployeeEventTopic, reviewerID string, eventReviewerID string, eventQuestions []*model.EmployeeEventQuestion) error {
+	reviewer, err := h.store.Employee.One(db, reviewerID, true)
+	if err != nil {
+		return err
+	}
+
+	responseKey := model.NewUUID().String()
+	if event.Anonymity == model.SurveyAnonymityPseudonymous {
+		mac := hmac.New(sha256.New, []byte(h.config.Survey.PseudonymSecret))
+		mac.Write([]byte(reviewerID))
+		responseKey = hex.EncodeToString(mac.Sum(nil))
+	}
+
+	var seniority string
+	if reviewer.Seniority != nil {
+		seniority = reviewer.Seniority.Name
+	}
+
+	chapters := make([]string, 0, len(reviewer.EmployeeChapters))
+	for _, ec := range reviewer.EmployeeChapters {
+		chapters = append(chapters, ec.Chapter.Name)
+	}
+
+	projects := make([]string, 0, len(reviewer.ProjectMembers))
+	for _, pm := range reviewer.ProjectMembers {
+		if pm.Project.Status == model.ProjectStatusActive {
+			projects = append(projects, pm.Project.Name)
+		}
+	}
+
+	// only keep the day so the submission time can not be matched with the reviewer's activity
+	now := time.Now().Truncate(24 * time.Hour)
+
+	// a topic made for one employee, e.g. their engagement survey, would link the answers back to them
+	var topicID *model.UUID
+	if topic.EmployeeID.IsZero() {
+		topicID = &topic.ID
+	}
+
+	answers := make([]model.AnonymousEventAnswer, 0, len(eventQuestions))
+	for _, q := range eventQuestions {
+		answers = append(answers, model.AnonymousEventAnswer{
+			BaseModel: model.BaseModel{
+				ID:        model.NewUUID(),
+				CreatedAt: now,
+				UpdatedAt: &now,
+			},
+			EventID:              event.ID,
+			EmployeeEventTopicID: topicID,
+			ResponseKey:          responseKey,
+			QuestionID:           q.QuestionID,
+			TemplateQuestionID:   q.TemplateQuestionID,
+			Content:              q.Content,
+			Type:                 q.Type,
+			Answer:               q.Answer,
+			Note:                 q.Note,
+			Order:                q.Order,
+			Domain:               q.Domain,
+			Seniority:            seniority,
+			Chapters:             chapters,
+			Projects:             projects,
+		})
+	}
+
+	if len(answers) > 0 {
+		if _, err := h.store.AnonymousEventAnswer.BatchCreate(db, answers); err != nil {
+			return err
+		}
+	}
+
+	return h.store.EmployeeEventQuestion.ClearAnswersByEventReviewerID(db, eventReviewerID)
+}
+
 // CountUnreadFeedback godoc
 // @Summary Get number of unread inbox for user
 // @Description Get number of unread inbox for user
@@ -429,3 +604,40 @@ func (h *handler) CountUnreadFeedback(c *gin.Context) {
 
 	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToUnreadFeedbackCountData(userID, count), nil, nil, nil, ""))
 }
+
+// peerReviewGoals returns the individual goals of the reviewee of a peer-review in the period of the review,
+// so reviewers can review the employee against them
+func (h *handler) peerReviewGoals(eventID string, employeeID string) ([]*model.Objective, error) {
+	event, err := h.store.FeedbackEvent.One(h.repo.DB(), eventID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if event.Subtype != model.EventSubtypePeerReview {
+		return nil, nil
+	}
+
+	objectives, _, err := h.store.Objective.All(h.repo.DB(), objective.Filter{
+		Level:      model.ObjectiveLevelIndividual.String(),
+		EmployeeID: employeeID,
+		From:       event.StartDate,
+		To:         event.EndDate,
+	}, model.Pagination{})
+	if err != nil {
+		return nil, err
+	}
+
+	progress := model.RollUpObjectiveProgress(objectives)
+
+	var goals []*model.Objective
+	for _, o := range objectives {
+		if o.Status == model.ObjectiveStatusCancelled {
+			continue
+		}
+
+		o.Progress = progress[o.ID]
+		goals = append(goals, o)
+	}
+
+	return goals, nil
+}
@@ -393,6 +393,93 @@ func TestHandler_Submit(t *testing.T) {
 	}
 }
 
+func TestHandler_SubmitAnonymous(t *testing.T) {
+	cfg := config.LoadTestConfig()
+	loggerMock := logger.NewLogrusLogger()
+	serviceMock := service.New(&cfg, nil, nil)
+	storeMock := store.New()
+
+	const (
+		eventID         = "6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f01"
+		topicID         = "6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f11"
+		eventReviewerID = "6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f21"
+	)
+
+	tests := []struct {
+		name                 string
+		status               model.EventReviewerStatus
+		wantCode             int
+		wantResponsePath     string
+		wantAnonymousAnswers int64
+		wantLinkedAnswers    int64
+	}{
+		{
+			name:                 "ok_done_answers_are_detached",
+			status:               model.EventReviewerStatusDone,
+			wantCode:             http.StatusOK,
+			wantResponsePath:     "testdata/submit_anonymous/200_done.json",
+			wantAnonymousAnswers: 2,
+			wantLinkedAnswers:    0,
+		},
+		{
+			name:                 "ok_draft_answers_stay_with_reviewer",
+			status:               model.EventReviewerStatusDraft,
+			wantCode:             http.StatusOK,
+			wantResponsePath:     "testdata/submit_anonymous/200_draft.json",
+			wantAnonymousAnswers: 0,
+			wantLinkedAnswers:    2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testhelper.TestWithTxDB(t, func(txRepo store.DBRepo) {
+				testhelper.LoadTestSQLFile(t, txRepo, "./testdata/submit_anonymous/submit_anonymous.sql")
+				w := httptest.NewRecorder()
+				ctx, _ := gin.CreateTestContext(w)
+				byteReq, _ := json.Marshal(request.SubmitBody{
+					Answers: []request.BasicEventQuestionInput{
+						{
+							EventQuestionID: model.MustGetUUIDFromString("6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f31"),
+							Answer:          model.AgreementLevelAgree.String(),
+							Note:            "ok",
+						},
+						{
+							EventQuestionID: model.MustGetUUIDFromString("6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f32"),
+							Answer:          model.AgreementLevelMixed.String(),
+						},
+					},
+					Status: tt.status,
+				})
+				ctx.Params = gin.Params{gin.Param{Key: "id", Value: eventID}, gin.Param{Key: "topicID", Value: topicID}}
+
+				ctx.Request = httptest.NewRequest(http.MethodPut, fmt.Sprintf("/api/v1/feedbacks/%s/topics/%s/submit", eventID, topicID), strings.NewReader(string(byteReq)))
+				ctx.Request.Header.Set("Authorization", testToken)
+
+				h := New(storeMock, txRepo, serviceMock, loggerMock, &cfg)
+				h.Submit(ctx)
+				require.Equal(t, tt.wantCode, w.Code)
+				expRespRaw, err := os.ReadFile(tt.wantResponsePath)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expRespRaw), w.Body.String(), "[Handler.Feedback.SubmitAnonymous] response mismatched")
+
+				var anonymousAnswers int64
+				require.NoError(t, txRepo.DB().Model(&model.AnonymousEventAnswer{}).Where("event_id = ?", eventID).Count(&anonymousAnswers).Error)
+				require.Equal(t, tt.wantAnonymousAnswers, anonymousAnswers)
+
+				// the topic of an engagement survey belongs to the reviewer, keeping it would link the answers back to them
+				var linkedTopics int64
+				require.NoError(t, txRepo.DB().Model(&model.AnonymousEventAnswer{}).Where("event_id = ? AND employee_event_topic_id IS NOT NULL", eventID).Count(&linkedTopics).Error)
+				require.Zero(t, linkedTopics)
+
+				var linkedAnswers int64
+				require.NoError(t, txRepo.DB().Model(&model.EmployeeEventQuestion{}).Where("employee_event_reviewer_id = ? AND answer <> ''", eventReviewerID).Count(&linkedAnswers).Error)
+				require.Equal(t, tt.wantLinkedAnswers, linkedAnswers)
+			})
+		})
+	}
+}
+
 func TestHandler_UnreadInbox(t *testing.T) {
 	cfg := config.LoadTestConfig()
 	loggerMock := logger.NewLogrusLogger()
@@ -7,4 +7,7 @@ type IHandler interface {
 	Detail(c *gin.Context)
 	Submit(c *gin.Context)
 	CountUnreadFeedback(c *gin.Context)
+	Send(c *gin.Context)
+	ListEmployeeFeedback(c *gin.Context)
+	ListKudos(c *gin.Context)
 }
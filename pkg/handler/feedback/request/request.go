@@ -1,6 +1,8 @@
 package request
 
 import (
+	"strings"
+
 	"github.com/dwarvesf/fortress-api/pkg/handler/feedback/errs"
 	"github.com/dwarvesf/fortress-api/pkg/model"
 )
@@ -45,6 +47,8 @@ type BasicEventQuestionInput struct {
 type SubmitBody struct {
 	Answers []BasicEventQuestionInput `json:"answers" form:"answers" binding:"required"`
 	Status  model.EventReviewerStatus `json:"status" form:"status" binding:"required"`
+	// TemplateVersion is the survey template version the form was rendered with, optional
+	TemplateVersion int `json:"templateVersion" form:"templateVersion"`
 }
 
 func (i *SubmitBody) Validate() error {
@@ -72,3 +76,51 @@ func (i *SubmitInput) Validate() error {
 
 	return i.Body.Validate()
 }
+
+type SendFeedbackInput struct {
+	RecipientID model.UUID `json:"recipientID" binding:"required"`
+	// Subtype is appreciation for kudos or comment for private feedback
+	Subtype    model.EventSubtype       `json:"subtype" binding:"required"`
+	Title      string                   `json:"title"`
+	Message    string                   `json:"message" binding:"required"`
+	ProjectID  model.UUID               `json:"projectID"`
+	Visibility model.FeedbackVisibility `json:"visibility"`
+}
+
+func (i *SendFeedbackInput) Validate() error {
+	if i.RecipientID.IsZero() {
+		return errs.ErrInvalidEmployeeID
+	}
+
+	if !i.Subtype.IsFeedbackValid() {
+		return errs.ErrInvalidFeedbackSubtype
+	}
+
+	i.Message = strings.TrimSpace(i.Message)
+	if i.Message == "" {
+		return errs.ErrEmptyMessage
+	}
+
+	if i.Visibility == "" {
+		i.Visibility = model.FeedbackVisibilityPrivate
+	}
+	if !i.Visibility.IsValid() {
+		return errs.ErrInvalidVisibility
+	}
+
+	return nil
+}
+
+type GetContinuousFeedbackInput struct {
+	model.Pagination
+
+	Subtype string `json:"subtype" form:"subtype"`
+}
+
+func (i *GetContinuousFeedbackInput) Validate() error {
+	if i.Subtype != "" && !model.EventSubtype(i.Subtype).IsFeedbackValid() {
+		return errs.ErrInvalidFeedbackSubtype
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+UPDATE public.employees SET line_manager_id = '608ea227-45a5-4c8a-af43-6c7280d96340' WHERE id = 'ecea9d15-05ba-4a4e-9787-54210e3b98ce';
+
+INSERT INTO public.feedback_events (id, deleted_at, created_at, updated_at, title, type, subtype, status, created_by, start_date, end_date, visibility) VALUES
+('3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e01', NULL, '2023-07-16 10:00:00', '2023-07-16 10:00:00', 'Kudos from Thanh Pham', 'feedback', 'appreciation', 'done', '2655832e-f009-4b73-a535-64c3a22e558f', '2023-07-16 10:00:00', '2023-07-16 10:00:00', 'public'),
+('3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e02', NULL, '2023-07-16 11:00:00', '2023-07-16 11:00:00', 'Feedback from Nam Nguyen', 'feedback', 'comment', 'done', '8d7c99c0-3253-4286-93a9-e7554cb327ef', '2023-07-16 11:00:00', '2023-07-16 11:00:00', 'manager'),
+('3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e03', NULL, '2023-07-16 12:00:00', '2023-07-16 12:00:00', 'Feedback from Thanh Pham', 'feedback', 'comment', 'done', '2655832e-f009-4b73-a535-64c3a22e558f', '2023-07-16 12:00:00', '2023-07-16 12:00:00', 'private'),
+('3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e04', NULL, '2023-07-16 13:00:00', '2023-07-16 13:00:00', 'Kudos from Huy Nguyen', 'feedback', 'appreciation', 'done', 'ecea9d15-05ba-4a4e-9787-54210e3b98ce', '2023-07-16 13:00:00', '2023-07-16 13:00:00', 'public'),
+('3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e05', NULL, '2023-07-16 14:00:00', '2023-07-16 14:00:00', 'Kudos from Thanh Pham', 'feedback', 'appreciation', 'done', '2655832e-f009-4b73-a535-64c3a22e558f', '2023-07-16 14:00:00', '2023-07-16 14:00:00', 'private');
+
+INSERT INTO public.employee_event_topics (id, deleted_at, created_at, updated_at, title, event_id, employee_id, project_id) VALUES
+('3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e11', NULL, '2023-07-16 10:00:00', '2023-07-16 10:00:00', 'Kudos from Thanh Pham', '3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e01', 'ecea9d15-05ba-4a4e-9787-54210e3b98ce', NULL),
+('3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e12', NULL, '2023-07-16 11:00:00', '2023-07-16 11:00:00', 'Feedback from Nam Nguyen', '3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e02', 'ecea9d15-05ba-4a4e-9787-54210e3b98ce', NULL),
+('3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e13', NULL, '2023-07-16 12:00:00', '2023-07-16 12:00:00', 'Feedback from Thanh Pham', '3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e03', 'ecea9d15-05ba-4a4e-9787-54210e3b98ce', NULL),
+('3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e14', NULL, '2023-07-16 13:00:00', '2023-07-16 13:00:00', 'Kudos from Huy Nguyen', '3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e04', '8d7c99c0-3253-4286-93a9-e7554cb327ef', NULL),
+('3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e15', NULL, '2023-07-16 14:00:00', '2023-07-16 14:00:00', 'Kudos from Thanh Pham', '3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e05', '8d7c99c0-3253-4286-93a9-e7554cb327ef', NULL);
+
+INSERT INTO public.employee_event_reviewers (id, deleted_at, created_at, updated_at, event_id, employee_event_topic_id, reviewer_id, relationship, is_shared, is_read, author_status, reviewer_status) VALUES
+('3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e21', NULL, '2023-07-16 10:00:00', '2023-07-16 10:00:00', '3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e01', '3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e11', '2655832e-f009-4b73-a535-64c3a22e558f', 'peer', true, true, 'done', 'done'),
+('3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e22', NULL, '2023-07-16 11:00:00', '2023-07-16 11:00:00', '3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e02', '3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e12', '8d7c99c0-3253-4286-93a9-e7554cb327ef', 'peer', true, false, 'done', 'done'),
+('3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e23', NULL, '2023-07-16 12:00:00', '2023-07-16 12:00:00', '3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e03', '3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e13', '2655832e-f009-4b73-a535-64c3a22e558f', 'peer', true, false, 'done', 'done'),
+('3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e24', NULL, '2023-07-16 13:00:00', '2023-07-16 13:00:00', '3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e04', '3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e14', 'ecea9d15-05ba-4a4e-9787-54210e3b98ce', 'peer', true, false, 'done', 'done'),
+('3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e25', NULL, '2023-07-16 14:00:00', '2023-07-16 14:00:00', '3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e05', '3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e15', '2655832e-f009-4b73-a535-64c3a22e558f', 'peer', true, false, 'done', 'done');
+
+INSERT INTO public.employee_event_questions (id, deleted_at, created_at, updated_at, event_id, employee_event_reviewer_id, type, content, answer, note, question_id, "order", domain) VALUES
+('3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e31', NULL, '2023-07-16 10:00:00', '2023-07-16 10:00:00', '3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e01', '3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e21', 'general', 'Kudos from Thanh Pham', 'Thanks for the quick hotfix', '', NULL, 1, NULL),
+('3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e32', NULL, '2023-07-16 11:00:00', '2023-07-16 11:00:00', '3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e02', '3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e22', 'general', 'Feedback from Nam Nguyen', 'Pull requests are too large to review', '', NULL, 1, NULL),
+('3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e33', NULL, '2023-07-16 12:00:00', '2023-07-16 12:00:00', '3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e03', '3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e23', 'general', 'Feedback from Thanh Pham', 'Please join the standup on time', '', NULL, 1, NULL),
+('3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e34', NULL, '2023-07-16 13:00:00', '2023-07-16 13:00:00', '3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e04', '3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e24', 'general', 'Kudos from Huy Nguyen', 'Great demo on Friday', '', NULL, 1, NULL),
+('3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e35', NULL, '2023-07-16 14:00:00', '2023-07-16 14:00:00', '3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e05', '3e8b9c1d-5f2a-4b6c-9d7e-1a2b3c4d5e25', 'general', 'Kudos from Thanh Pham', 'Thanks for the mentoring', '', NULL, 1, NULL);
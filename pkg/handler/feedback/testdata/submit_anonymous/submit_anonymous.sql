@@ -0,0 +1,12 @@
+INSERT INTO public.feedback_events (id, deleted_at, created_at, updated_at, title, type, subtype, status, created_by, start_date, end_date, anonymity, anonymity_threshold) VALUES
+('6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f01', NULL, '2023-07-12 08:03:33.233262', '2023-07-12 08:03:33.233262', 'Q3 2023', 'survey', 'engagement', 'in-progress', 'ecea9d15-05ba-4a4e-9787-54210e3b98ce', NULL, NULL, 'anonymous', 3);
+
+INSERT INTO public.employee_event_topics (id, deleted_at, created_at, updated_at, title, event_id, employee_id, project_id) VALUES
+('6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f11', NULL, '2023-07-12 08:03:33.233262', '2023-07-12 08:03:33.233262', 'Engagement Survey for Thanh - Q3, 2023', '6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f01', '2655832e-f009-4b73-a535-64c3a22e558f', NULL);
+
+INSERT INTO public.employee_event_reviewers (id, deleted_at, created_at, updated_at, event_id, employee_event_topic_id, reviewer_id, relationship, is_shared, is_read, author_status, reviewer_status) VALUES
+('6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f21', NULL, '2023-07-12 08:03:33.233262', '2023-07-12 08:03:33.233262', '6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f01', '6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f11', '2655832e-f009-4b73-a535-64c3a22e558f', 'self', false, false, 'sent', 'draft');
+
+INSERT INTO public.employee_event_questions (id, deleted_at, created_at, updated_at, event_id, employee_event_reviewer_id, type, content, answer, note, question_id, "order", domain) VALUES
+('6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f31', NULL, '2023-07-12 08:03:33.233262', '2023-07-12 08:03:33.233262', '6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f01', '6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f21', 'likert-scale', 'I know what is expected of me at work.', '', '', '4e71821e-e8d7-4fc9-9f02-7b4de4efa7f8', 1, 'engagement'),
+('6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f32', NULL, '2023-07-12 08:03:33.233262', '2023-07-12 08:03:33.233262', '6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f01', '6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f21', 'likert-scale', 'I have the materials and equipment I need to do my work right.', '', '', 'ba00c397-f8ad-4cbd-ba63-02ac903d0886', 2, 'engagement');
@@ -4,12 +4,17 @@ import (
 	"github.com/dwarvesf/fortress-api/pkg/config"
 	"github.com/dwarvesf/fortress-api/pkg/controller"
 	"github.com/dwarvesf/fortress-api/pkg/handler/accounting"
+	"github.com/dwarvesf/fortress-api/pkg/handler/allocation"
+	"github.com/dwarvesf/fortress-api/pkg/handler/approval"
 	"github.com/dwarvesf/fortress-api/pkg/handler/asset"
 	"github.com/dwarvesf/fortress-api/pkg/handler/audit"
+	"github.com/dwarvesf/fortress-api/pkg/handler/auditlog"
 	"github.com/dwarvesf/fortress-api/pkg/handler/auth"
 	"github.com/dwarvesf/fortress-api/pkg/handler/bankaccount"
 	"github.com/dwarvesf/fortress-api/pkg/handler/brainerylogs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/budget"
 	"github.com/dwarvesf/fortress-api/pkg/handler/client"
+	"github.com/dwarvesf/fortress-api/pkg/handler/clientportal"
 	"github.com/dwarvesf/fortress-api/pkg/handler/dashboard"
 	"github.com/dwarvesf/fortress-api/pkg/handler/dashboard/util"
 	"github.com/dwarvesf/fortress-api/pkg/handler/discord"
@@ -20,10 +25,20 @@ import (
 	"github.com/dwarvesf/fortress-api/pkg/handler/invoice"
 	"github.com/dwarvesf/fortress-api/pkg/handler/metadata"
 	"github.com/dwarvesf/fortress-api/pkg/handler/notion"
+	"github.com/dwarvesf/fortress-api/pkg/handler/objective"
+	"github.com/dwarvesf/fortress-api/pkg/handler/oneonone"
 	"github.com/dwarvesf/fortress-api/pkg/handler/payroll"
+	"github.com/dwarvesf/fortress-api/pkg/handler/performancereview"
 	"github.com/dwarvesf/fortress-api/pkg/handler/profile"
+	"github.com/dwarvesf/fortress-api/pkg/handler/profitloss"
 	"github.com/dwarvesf/fortress-api/pkg/handler/project"
+	"github.com/dwarvesf/fortress-api/pkg/handler/projecthealth"
+	"github.com/dwarvesf/fortress-api/pkg/handler/ratecard"
+	"github.com/dwarvesf/fortress-api/pkg/handler/staffing"
 	"github.com/dwarvesf/fortress-api/pkg/handler/survey"
+	"github.com/dwarvesf/fortress-api/pkg/handler/surveycampaign"
+	"github.com/dwarvesf/fortress-api/pkg/handler/surveytemplate"
+	"github.com/dwarvesf/fortress-api/pkg/handler/timesheet"
 	"github.com/dwarvesf/fortress-api/pkg/handler/valuation"
 	"github.com/dwarvesf/fortress-api/pkg/handler/vault"
 	"github.com/dwarvesf/fortress-api/pkg/handler/webhook"
@@ -34,55 +49,85 @@ import (
 )
 
 type Handler struct {
-	Accounting  accounting.IHandler
-	Asset       asset.IHandler
-	Audit       audit.IHandler
-	Auth        auth.IHandler
-	BankAccount bankaccount.IHandler
-	BraineryLog brainerylogs.IHandler
-	Client      client.IHandler
-	Dashboard   dashboard.IHandler
-	Discord     discord.IHandler
-	Employee    employee.IHandler
-	Engagement  engagement.IHandler
-	Feedback    feedback.IHandler
-	Healthcheck healthz.IHandler
-	Invoice     invoice.IHandler
-	Metadata    metadata.IHandler
-	Notion      notion.IHandler
-	Payroll     payroll.IHandler
-	Profile     profile.IHandler
-	Project     project.IHandler
-	Survey      survey.IHandler
-	Valuation   valuation.IHandler
-	Webhook     webhook.IHandler
-	Vault       vault.IHandler
+	Accounting        accounting.IHandler
+	Allocation        allocation.IHandler
+	Approval          approval.IHandler
+	Asset             asset.IHandler
+	Audit             audit.IHandler
+	AuditLog          auditlog.IHandler
+	Auth              auth.IHandler
+	BankAccount       bankaccount.IHandler
+	BraineryLog       brainerylogs.IHandler
+	Budget            budget.IHandler
+	Client            client.IHandler
+	ClientPortal      clientportal.IHandler
+	Dashboard         dashboard.IHandler
+	Discord           discord.IHandler
+	Employee          employee.IHandler
+	Engagement        engagement.IHandler
+	Feedback          feedback.IHandler
+	Healthcheck       healthz.IHandler
+	Invoice           invoice.IHandler
+	Metadata          metadata.IHandler
+	Notion            notion.IHandler
+	Objective         objective.IHandler
+	OneOnOne          oneonone.IHandler
+	Payroll           payroll.IHandler
+	PerformanceReview performancereview.IHandler
+	Profile           profile.IHandler
+	ProfitLoss        profitloss.IHandler
+	Project           project.IHandler
+	ProjectHealth     projecthealth.IHandler
+	RateCard          ratecard.IHandler
+	Staffing          staffing.IHandler
+	Survey            survey.IHandler
+	SurveyCampaign    surveycampaign.IHandler
+	SurveyTemplate    surveytemplate.IHandler
+	Timesheet         timesheet.IHandler
+	Valuation         valuation.IHandler
+	Webhook           webhook.IHandler
+	Vault             vault.IHandler
 }
 
 func New(store *store.Store, repo store.DBRepo, service *service.Service, ctrl *controller.Controller, worker *worker.Worker, logger logger.Logger, cfg *config.Config) *Handler {
 	return &Handler{
-		Accounting:  accounting.New(store, repo, service, logger, cfg),
-		Asset:       asset.New(store, repo, service, logger, cfg),
-		Audit:       audit.New(store, repo, service, logger, cfg),
-		Auth:        auth.New(ctrl, logger, cfg),
-		BankAccount: bankaccount.New(store, repo, service, logger, cfg),
-		BraineryLog: brainerylogs.New(ctrl, store, repo, service, logger, cfg),
-		Client:      client.New(ctrl, store, repo, service, logger, cfg),
-		Dashboard:   dashboard.New(store, repo, service, logger, cfg, util.New()),
-		Discord:     discord.New(ctrl, store, repo, service, logger, cfg),
-		Employee:    employee.New(ctrl, store, repo, service, logger, cfg),
-		Engagement:  engagement.New(ctrl, store, repo, service, logger, cfg),
-		Feedback:    feedback.New(store, repo, service, logger, cfg),
-		Healthcheck: healthz.New(),
-		Invoice:     invoice.New(ctrl, store, repo, service, worker, logger, cfg),
-		Metadata:    metadata.New(store, repo, service, logger, cfg),
-		Notion:      notion.New(store, repo, service, logger, cfg),
-		Payroll:     payroll.New(ctrl, store, repo, service, worker, logger, cfg),
-		Profile:     profile.New(ctrl, store, repo, service, logger, cfg),
-		Project:     project.New(ctrl, store, repo, service, logger, cfg),
-		Survey:      survey.New(store, repo, service, logger, cfg),
-		Valuation:   valuation.New(store, repo, service, logger, cfg),
-		Webhook:     webhook.New(ctrl, store, repo, service, logger, cfg, worker),
-		Vault:       vault.New(store, repo, service, logger, cfg),
+		Accounting:        accounting.New(store, repo, service, logger, cfg),
+		Allocation:        allocation.New(ctrl, store, repo, service, logger, cfg),
+		Approval:          approval.New(ctrl, store, repo, service, logger, cfg),
+		Asset:             asset.New(store, repo, service, logger, cfg),
+		Audit:             audit.New(store, repo, service, logger, cfg),
+		AuditLog:          auditlog.New(ctrl, store, repo, service, logger, cfg),
+		Auth:              auth.New(ctrl, logger, cfg),
+		BankAccount:       bankaccount.New(store, repo, service, logger, cfg),
+		BraineryLog:       brainerylogs.New(ctrl, store, repo, service, logger, cfg),
+		Budget:            budget.New(ctrl, store, repo, service, logger, cfg),
+		Client:            client.New(ctrl, store, repo, service, logger, cfg),
+		ClientPortal:      clientportal.New(ctrl, store, repo, service, logger, cfg),
+		Dashboard:         dashboard.New(store, repo, service, logger, cfg, util.New()),
+		Discord:           discord.New(ctrl, store, repo, service, logger, cfg),
+		Employee:          employee.New(ctrl, store, repo, service, logger, cfg),
+		Engagement:        engagement.New(ctrl, store, repo, service, logger, cfg),
+		Feedback:          feedback.New(store, repo, service, logger, cfg),
+		Healthcheck:       healthz.New(),
+		Invoice:           invoice.New(ctrl, store, repo, service, worker, logger, cfg),
+		Metadata:          metadata.New(store, repo, service, logger, cfg),
+		Notion:            notion.New(store, repo, service, logger, cfg),
+		Objective:         objective.New(ctrl, store, repo, service, logger, cfg),
+		OneOnOne:          oneonone.New(ctrl, store, repo, service, logger, cfg),
+		Payroll:           payroll.New(ctrl, store, repo, service, worker, logger, cfg),
+		PerformanceReview: performancereview.New(ctrl, store, repo, service, logger, cfg),
+		Profile:           profile.New(ctrl, store, repo, service, logger, cfg),
+		ProfitLoss:        profitloss.New(ctrl, store, repo, service, logger, cfg),
+		Project:           project.New(ctrl, store, repo, service, logger, cfg),
+		ProjectHealth:     projecthealth.New(ctrl, store, repo, service, logger, cfg),
+		RateCard:          ratecard.New(ctrl, store, repo, service, logger, cfg),
+		Staffing:          staffing.New(ctrl, store, repo, service, logger, cfg),
+		Survey:            survey.New(store, repo, service, logger, cfg),
+		SurveyCampaign:    surveycampaign.New(ctrl, store, repo, service, logger, cfg),
+		SurveyTemplate:    surveytemplate.New(ctrl, store, repo, service, logger, cfg),
+		Timesheet:         timesheet.New(ctrl, store, repo, service, logger, cfg),
+		Valuation:         valuation.New(store, repo, service, logger, cfg),
+		Webhook:           webhook.New(ctrl, store, repo, service, logger, cfg, worker),
+		Vault:             vault.New(store, repo, service, logger, cfg),
 	}
 }
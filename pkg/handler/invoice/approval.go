@@ -0,0 +1,26 @@
+package invoice
+
+import (
+	"encoding/json"
+
+	invoiceCtrl "github.com/dwarvesf/fortress-api/pkg/controller/invoice"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// registerApprovalExecutors lets the approval flow mark invoices as paid
+// once a second person approved them
+func (h *handler) registerApprovalExecutors() {
+	if h.controller == nil || h.controller.Approval == nil {
+		return
+	}
+
+	h.controller.Approval.RegisterExecutor(model.ApprovalActionInvoiceMarkPaid, func(request *model.ApprovalRequest) error {
+		var payload invoiceCtrl.UpdateStatusInput
+		if err := json.Unmarshal(request.Payload, &payload); err != nil {
+			return err
+		}
+
+		_, err := h.controller.Invoice.UpdateStatus(payload)
+		return err
+	})
+}
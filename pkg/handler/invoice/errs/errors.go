@@ -11,15 +11,16 @@ import (
 )
 
 var (
-	ErrInvalidDueAt          = errors.New("invalid due at")
-	ErrInvalidPaidAt         = errors.New("invalid paid at")
-	ErrInvalidInvoiceStatus  = errors.New("invalid invoice status")
-	ErrInvalidInvoiceID      = errors.New("invalid invoice id")
-	ErrInvalidProjectID      = errors.New("invalid project id")
-	ErrInvalidDeveloperEmail = errors.New("invalid developer email in dev mode")
-	ErrSenderNotFound        = errors.New("sender not found")
-	ErrBankAccountNotFound   = errors.New("bank account not found")
-	ErrProjectNotFound       = errors.New("project not found")
+	ErrInvalidDueAt           = errors.New("invalid due at")
+	ErrInvalidPaidAt          = errors.New("invalid paid at")
+	ErrInvalidInvoiceStatus   = errors.New("invalid invoice status")
+	ErrInvalidInvoiceID       = errors.New("invalid invoice id")
+	ErrInvalidProjectID       = errors.New("invalid project id")
+	ErrInvalidDeveloperEmail  = errors.New("invalid developer email in dev mode")
+	ErrSenderNotFound         = errors.New("sender not found")
+	ErrBankAccountNotFound    = errors.New("bank account not found")
+	ErrProjectNotFound        = errors.New("project not found")
+	ErrInvalidTimesheetPeriod = errors.New("invalid timesheet period")
 )
 
 func ConvertControllerErr(c *gin.Context, err error) {
@@ -39,6 +40,8 @@ func ConvertControllerErr(c *gin.Context, err error) {
 		status = http.StatusNotFound
 	case invoice.ErrBankAccountNotFound:
 		status = http.StatusNotFound
+	case invoice.ErrBudgetPhaseNotFound:
+		status = http.StatusNotFound
 	case invoice.ErrInvoiceStatusAlready:
 		status = http.StatusInternalServerError
 
@@ -1,13 +1,17 @@
 package invoice
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
 
 	"github.com/dwarvesf/fortress-api/pkg/config"
 	"github.com/dwarvesf/fortress-api/pkg/controller"
+	"github.com/dwarvesf/fortress-api/pkg/controller/approval"
 	invoiceCtrl "github.com/dwarvesf/fortress-api/pkg/controller/invoice"
 	"github.com/dwarvesf/fortress-api/pkg/handler/invoice/errs"
 	"github.com/dwarvesf/fortress-api/pkg/handler/invoice/request"
@@ -15,6 +19,7 @@ import (
 	"github.com/dwarvesf/fortress-api/pkg/model"
 	"github.com/dwarvesf/fortress-api/pkg/service"
 	"github.com/dwarvesf/fortress-api/pkg/store"
+	sInvoice "github.com/dwarvesf/fortress-api/pkg/store/invoice"
 	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
 	"github.com/dwarvesf/fortress-api/pkg/view"
 	"github.com/dwarvesf/fortress-api/pkg/worker"
@@ -32,7 +37,7 @@ type handler struct {
 
 // New returns a handler
 func New(ctrl *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, worker *worker.Worker, logger logger.Logger, cfg *config.Config) IHandler {
-	return &handler{
+	h := &handler{
 		controller: ctrl,
 		store:      store,
 		repo:       repo,
@@ -41,6 +46,9 @@ func New(ctrl *controller.Controller, store *store.Store, repo store.DBRepo, ser
 		logger:     logger,
 		config:     cfg,
 	}
+	h.registerApprovalExecutors()
+
+	return h
 }
 
 // List godoc
@@ -106,6 +114,8 @@ func (h *handler) List(c *gin.Context) {
 // @Produce json
 // @Param Authorization header string true "jwt token"
 // @Param projectID query string true "projectID"
+// @Param from query string false "Start date of the invoiced timesheet period"
+// @Param to query string false "End date of the invoiced timesheet period"
 // @Success 200 {object} view.InvoiceTemplateResponse
 // @Failure 400 {object} view.ErrorResponse
 // @Failure 404 {object} view.ErrorResponse
@@ -124,6 +134,12 @@ func (h *handler) GetTemplate(c *gin.Context) {
 		return
 	}
 
+	from, to, err := input.GetTimesheetPeriod(now)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
 	l := h.logger.Fields(logger.Fields{
 		"handler": "invoice",
 		"method":  "GetTemplate",
@@ -147,6 +163,14 @@ func (h *handler) GetTemplate(c *gin.Context) {
 		return
 	}
 
+	timesheetItems, err := h.controller.Timesheet.GetInvoiceLineItems(input.ProjectID, from, to)
+	if err != nil {
+		l.Error(err, "failed to get timesheet invoice items")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+	rs.TimesheetItems = view.ToTimesheetInvoiceItems(timesheetItems)
+
 	c.JSON(http.StatusOK, view.CreateResponse[any](rs, nil, nil, nil, ""))
 }
 
@@ -222,12 +246,13 @@ func (h *handler) Send(c *gin.Context) {
 
 // UpdateStatus godoc
 // @Summary Update status for invoice
-// @Description Update status for invoice
+// @Description Update status for invoice, marking as paid creates an approval request
 // @Tags Invoice
 // @Accept json
 // @Produce json
 // @Param Authorization header string true "jwt token"
 // @Success 200 {object} view.MessageResponse
+// @Success 202 {object} view.ApprovalRequestResponse
 // @Failure 404 {object} view.ErrorResponse
 // @Failure 400 {object} view.ErrorResponse
 // @Failure 500 {object} view.ErrorResponse
@@ -257,12 +282,20 @@ func (h *handler) UpdateStatus(c *gin.Context) {
 		return
 	}
 
-	// check invoice existence
-	_, err := h.controller.Invoice.UpdateStatus(invoiceCtrl.UpdateStatusInput{
+	input := invoiceCtrl.UpdateStatusInput{
 		InvoiceID:         invoiceID,
 		Status:            req.Status,
 		SendThankYouEmail: req.SendThankYouEmail,
-	})
+	}
+
+	// marking an invoice as paid needs a second person to approve
+	if req.Status == model.InvoiceStatusPaid {
+		h.requestMarkPaidApproval(c, l, input)
+		return
+	}
+
+	// check invoice existence
+	_, err := h.controller.Invoice.UpdateStatus(input)
 	if err != nil {
 		l.Error(err, "failed to update invoice status")
 		errs.ConvertControllerErr(c, err)
@@ -271,3 +304,38 @@ func (h *handler) UpdateStatus(c *gin.Context) {
 
 	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
 }
+
+func (h *handler) requestMarkPaidApproval(c *gin.Context, l logger.Logger, input invoiceCtrl.UpdateStatusInput) {
+	requester, err := approval.GetRequester(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	// check invoice existence
+	invoice, err := h.store.Invoice.One(h.repo.DB(), &sInvoice.Query{ID: input.InvoiceID})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			errs.ConvertControllerErr(c, invoiceCtrl.ErrInvoiceNotFound)
+			return
+		}
+		l.Error(err, "failed to get invoice")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	request, err := h.controller.Approval.Create(approval.CreateInput{
+		Action:     model.ApprovalActionInvoiceMarkPaid,
+		Permission: model.PermissionInvoiceEdit.String(),
+		Summary:    fmt.Sprintf("Mark invoice %s as paid", invoice.Number),
+		Payload:    input,
+		Requester:  requester,
+	})
+	if err != nil {
+		l.Error(err, "failed to create approval request")
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	c.JSON(http.StatusAccepted, view.CreateResponse[any](view.ToApprovalRequest(request), nil, nil, nil, "waiting for approval"))
+}
@@ -30,6 +30,33 @@ func (r *UpdateStatusRequest) Validate() error {
 
 type GetInvoiceInput struct {
 	ProjectID string `json:"projectID" form:"projectID"`
+	// From and To are dates in YYYY-MM-DD format of the period whose approved timesheet hours are invoiced,
+	// the previous month when they are not set
+	From string `json:"from" form:"from"`
+	To   string `json:"to" form:"to"`
+}
+
+// GetTimesheetPeriod returns the period whose approved timesheet hours are invoiced
+func (i *GetInvoiceInput) GetTimesheetPeriod(now time.Time) (time.Time, time.Time, error) {
+	firstOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	from, to := firstOfMonth.AddDate(0, -1, 0), firstOfMonth.AddDate(0, 0, -1)
+
+	var err error
+	if i.From != "" {
+		if from, err = time.Parse("2006-01-02", i.From); err != nil {
+			return from, to, errs.ErrInvalidTimesheetPeriod
+		}
+	}
+	if i.To != "" {
+		if to, err = time.Parse("2006-01-02", i.To); err != nil {
+			return from, to, errs.ErrInvalidTimesheetPeriod
+		}
+	}
+	if to.Before(from) {
+		return from, to, errs.ErrInvalidTimesheetPeriod
+	}
+
+	return from, to, nil
 }
 
 type GetListInvoiceInput struct {
@@ -79,8 +106,10 @@ type SendInvoiceRequest struct {
 	DueDate     string        `json:"dueDate" binding:"required"`
 	Month       int           `json:"invoiceMonth" binding:"gte=0,lte=11"`
 	Year        int           `json:"invoiceYear" binding:"gte=0"`
-	SentByID    *model.UUID
-	Number      string
+	// BudgetPhaseID links a milestone invoice of a fixed-cost project to its budget phase
+	BudgetPhaseID *model.UUID `json:"budgetPhaseID"`
+	SentByID      *model.UUID
+	Number        string
 }
 
 type InvoiceItem struct {
@@ -184,5 +213,7 @@ func (i *SendInvoiceRequest) ToInvoiceModel() (*model.Invoice, error) {
 		SentBy:      i.SentByID,
 		DueAt:       &dueAt,
 		InvoicedAt:  &invoiceAt,
+
+		BudgetPhaseID: i.BudgetPhaseID,
 	}, nil
 }
@@ -2,6 +2,7 @@ package notion
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -13,11 +14,13 @@ import (
 
 	"github.com/Boostport/mjml-go"
 	nt "github.com/dstotijn/go-notion"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
 	"github.com/dwarvesf/fortress-api/pkg/model"
 	"github.com/dwarvesf/fortress-api/pkg/service/notion"
 	"github.com/dwarvesf/fortress-api/pkg/view"
 	"github.com/gin-gonic/gin"
 	"github.com/sendgrid/sendgrid-go/helpers/mail"
+	"gorm.io/gorm"
 )
 
 const (
@@ -118,7 +121,7 @@ func (h *handler) sendSingleProjectChangelog(
 	categories []string,
 	isPreview bool,
 ) error {
-	m, _, err := h.generateEmailChangelog(id, values, from, categories, isPreview)
+	m, p, err := h.generateEmailChangelog(id, values, from, categories, isPreview)
 	if err != nil {
 		return err
 	}
@@ -128,9 +131,43 @@ func (h *handler) sendSingleProjectChangelog(
 	}
 
 	h.logger.Info(fmt.Sprintf("Send %s successfully", m.Subject))
+
+	if !isPreview && p != nil {
+		h.saveProjectChangelog(id, p.Name, m)
+	}
 	return nil
 }
 
+// saveProjectChangelog keeps a sent changelog for the client portal, the changelog is already sent
+// so a failure is only logged
+func (h *handler) saveProjectChangelog(id string, projectName string, m *model.Email) {
+	l := h.logger.Fields(logger.Fields{
+		"handler": "notion",
+		"method":  "saveProjectChangelog",
+		"project": projectName,
+	})
+
+	project, err := h.store.Project.OneByName(h.repo.DB(), projectName)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			l.Info("no project matches the changelog, the changelog is not saved")
+			return
+		}
+		l.Error(err, "failed to get project of changelog")
+		return
+	}
+
+	if _, err := h.store.ProjectChangelog.Create(h.repo.DB(), &model.ProjectChangelog{
+		ProjectID:    project.ID,
+		NotionPageID: id,
+		Title:        m.Subject,
+		Content:      m.HTMLContent,
+		SentAt:       time.Now(),
+	}); err != nil {
+		l.Error(err, "failed to save project changelog")
+	}
+}
+
 func (h *handler) generateEmailChangelog(
 	id string,
 	values nt.DatabasePageProperties,
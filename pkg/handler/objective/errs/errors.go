@@ -0,0 +1,65 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/objective"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidObjectiveID = errors.New("invalid objective ID")
+	ErrInvalidKeyResultID = errors.New("invalid key result ID")
+	ErrInvalidEmployeeID  = errors.New("invalid employee ID")
+	ErrInvalidOwnerID     = errors.New("invalid owner ID")
+	ErrInvalidParentID    = errors.New("invalid parent objective ID")
+	ErrInvalidProjectID   = errors.New("invalid project ID")
+	ErrInvalidChapterID   = errors.New("invalid chapter ID")
+	ErrInvalidLevel       = errors.New("invalid objective level")
+	ErrInvalidStatus      = errors.New("invalid objective status")
+	ErrInvalidStartDate   = errors.New("invalid start date")
+	ErrInvalidEndDate     = errors.New("invalid end date")
+	ErrInvalidFrom        = errors.New("invalid from date")
+	ErrInvalidTo          = errors.New("invalid to date")
+	ErrEmptyTitle         = errors.New("title is required")
+	ErrEmptyValue         = errors.New("value is required")
+)
+
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, objective.ErrObjectiveNotFound),
+		errors.Is(err, objective.ErrParentNotFound),
+		errors.Is(err, objective.ErrKeyResultNotFound),
+		errors.Is(err, objective.ErrOwnerNotFound),
+		errors.Is(err, objective.ErrEmployeeNotFound),
+		errors.Is(err, objective.ErrProjectNotFound),
+		errors.Is(err, objective.ErrChapterNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, objective.ErrNotAllowedToCreate),
+		errors.Is(err, objective.ErrNotAllowedToEdit),
+		errors.Is(err, objective.ErrNotAllowedToCheckIn):
+		status = http.StatusForbidden
+	case errors.Is(err, objective.ErrInvalidLevel),
+		errors.Is(err, objective.ErrInvalidStatus),
+		errors.Is(err, objective.ErrMissingLevelTarget),
+		errors.Is(err, objective.ErrInvalidPeriod),
+		errors.Is(err, objective.ErrInvalidCheckInInterval),
+		errors.Is(err, objective.ErrInvalidParent),
+		errors.Is(err, objective.ErrObjectiveNotActive),
+		errors.Is(err, objective.ErrInvalidConfidence):
+		status = http.StatusBadRequest
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
@@ -0,0 +1,16 @@
+package objective
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	List(c *gin.Context)
+	Detail(c *gin.Context)
+	Create(c *gin.Context)
+	Update(c *gin.Context)
+	CreateKeyResult(c *gin.Context)
+	UpdateKeyResult(c *gin.Context)
+	CheckIn(c *gin.Context)
+	ListCheckIns(c *gin.Context)
+	EmployeeGoals(c *gin.Context)
+	SendCheckInReminders(c *gin.Context)
+}
@@ -0,0 +1,580 @@
+package objective
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	"github.com/dwarvesf/fortress-api/pkg/controller/objective"
+	"github.com/dwarvesf/fortress-api/pkg/handler/objective/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/objective/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	controller *controller.Controller
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		controller: controller,
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+	}
+}
+
+// List godoc
+// @Summary Get list of objectives
+// @Description Get list of objectives with their key results and rolled-up progress
+// @Tags Objective
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param level query string false "Level"
+// @Param status query string false "Status"
+// @Param parentID query string false "Parent objective ID"
+// @Param ownerID query string false "Owner ID"
+// @Param projectID query string false "Project ID"
+// @Param chapterID query string false "Chapter ID"
+// @Param employeeID query string false "Employee ID"
+// @Param from query string false "From date"
+// @Param to query string false "To date"
+// @Param page query string false "Page"
+// @Param size query string false "Size"
+// @Success 200 {object} view.ListObjectiveResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /objectives [get]
+func (h *handler) List(c *gin.Context) {
+	input := request.GetListObjectiveInput{}
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	input.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "objective",
+		"method":  "List",
+		"input":   input,
+	})
+
+	from, to := input.GetPeriod()
+
+	objectives, total, err := h.controller.Objective.List(objective.ListInput{
+		Pagination: input.Pagination,
+		Level:      input.Level,
+		Status:     input.Status,
+		ParentID:   input.ParentID,
+		OwnerID:    input.OwnerID,
+		ProjectID:  input.ProjectID,
+		ChapterID:  input.ChapterID,
+		EmployeeID: input.EmployeeID,
+		From:       from,
+		To:         to,
+	})
+	if err != nil {
+		l.Error(err, "failed to get list objectives")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToObjectives(objectives),
+		&view.PaginationResponse{Pagination: input.Pagination, Total: total}, nil, nil, ""))
+}
+
+// Detail godoc
+// @Summary Get detail of an objective
+// @Description Get detail of an objective with its key results and progress rolled up from the objectives aligned to it
+// @Tags Objective
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Objective ID"
+// @Success 200 {object} view.ObjectiveResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /objectives/{id} [get]
+func (h *handler) Detail(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidObjectiveID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "objective",
+		"method":  "Detail",
+		"id":      id,
+	})
+
+	o, err := h.controller.Objective.Detail(id)
+	if err != nil {
+		l.Error(err, "failed to get objective")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToObjective(o), nil, nil, nil, ""))
+}
+
+// Create godoc
+// @Summary Create an objective
+// @Description Create a company, chapter, project or individual objective. Employees can create their own individual objectives
+// @Tags Objective
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param Body body request.ObjectiveInput true "Body"
+// @Success 200 {object} view.ObjectiveResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 403 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /objectives [post]
+func (h *handler) Create(c *gin.Context) {
+	input := request.ObjectiveInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "objective",
+		"method":  "Create",
+		"input":   input,
+	})
+
+	userInfo, err := authutils.GetLoggedInUserInfo(c, h.store, h.repo.DB(), h.config)
+	if err != nil {
+		l.Error(err, "failed to get logged in user")
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	o, err := h.controller.Objective.Create(objective.CreateInput{
+		ObjectiveInput: toObjectiveInput(input),
+		UserID:         userInfo.UserID,
+		CanCreate:      authutils.HasPermission(userInfo.Permissions, model.PermissionObjectivesCreate),
+	})
+	if err != nil {
+		l.Error(err, "failed to create objective")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToObjective(o), nil, nil, nil, ""))
+}
+
+// Update godoc
+// @Summary Update an objective
+// @Description Update an objective, owners can update the objectives they own
+// @Tags Objective
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Objective ID"
+// @Param Body body request.UpdateObjectiveInput true "Body"
+// @Success 200 {object} view.ObjectiveResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 403 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /objectives/{id} [put]
+func (h *handler) Update(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidObjectiveID, nil, ""))
+		return
+	}
+
+	input := request.UpdateObjectiveInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "objective",
+		"method":  "Update",
+		"id":      id,
+		"input":   input,
+	})
+
+	userInfo, err := authutils.GetLoggedInUserInfo(c, h.store, h.repo.DB(), h.config)
+	if err != nil {
+		l.Error(err, "failed to get logged in user")
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	o, err := h.controller.Objective.Update(objective.UpdateInput{
+		ObjectiveInput: toObjectiveInput(input.ObjectiveInput),
+		ID:             id,
+		Status:         model.ObjectiveStatus(input.Status),
+		UserID:         userInfo.UserID,
+		CanEdit:        authutils.HasPermission(userInfo.Permissions, model.PermissionObjectivesEdit),
+	})
+	if err != nil {
+		l.Error(err, "failed to update objective")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToObjective(o), nil, nil, nil, ""))
+}
+
+// CreateKeyResult godoc
+// @Summary Add a key result to an objective
+// @Description Add a measurable target to an objective, owners can add key results to the objectives they own
+// @Tags Objective
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Objective ID"
+// @Param Body body request.KeyResultInput true "Body"
+// @Success 200 {object} view.KeyResultResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 403 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /objectives/{id}/key-results [post]
+func (h *handler) CreateKeyResult(c *gin.Context) {
+	h.saveKeyResult(c, "CreateKeyResult", h.controller.Objective.CreateKeyResult)
+}
+
+// UpdateKeyResult godoc
+// @Summary Update a key result of an objective
+// @Description Update the definition of a key result, its current value changes by check-ins
+// @Tags Objective
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Objective ID"
+// @Param keyResultID path string true "Key result ID"
+// @Param Body body request.KeyResultInput true "Body"
+// @Success 200 {object} view.KeyResultResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 403 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /objectives/{id}/key-results/{keyResultID} [put]
+func (h *handler) UpdateKeyResult(c *gin.Context) {
+	h.saveKeyResult(c, "UpdateKeyResult", h.controller.Objective.UpdateKeyResult)
+}
+
+func (h *handler) saveKeyResult(c *gin.Context, method string, save func(objective.KeyResultInput) (*model.KeyResult, error)) {
+	objectiveID := c.Param("id")
+	if objectiveID == "" || !model.IsUUIDFromString(objectiveID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidObjectiveID, nil, ""))
+		return
+	}
+
+	keyResultID := c.Param("keyResultID")
+	if keyResultID != "" && !model.IsUUIDFromString(keyResultID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidKeyResultID, nil, ""))
+		return
+	}
+
+	input := request.KeyResultInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":     "objective",
+		"method":      method,
+		"objectiveID": objectiveID,
+		"keyResultID": keyResultID,
+		"input":       input,
+	})
+
+	userInfo, err := authutils.GetLoggedInUserInfo(c, h.store, h.repo.DB(), h.config)
+	if err != nil {
+		l.Error(err, "failed to get logged in user")
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	kr, err := save(objective.KeyResultInput{
+		ObjectiveID: objectiveID,
+		ID:          keyResultID,
+		Title:       strings.TrimSpace(input.Title),
+		OwnerID:     input.OwnerID,
+		Unit:        input.Unit,
+		StartValue:  input.StartValue,
+		TargetValue: input.TargetValue,
+		UserID:      userInfo.UserID,
+		CanEdit:     authutils.HasPermission(userInfo.Permissions, model.PermissionObjectivesEdit),
+	})
+	if err != nil {
+		l.Error(err, "failed to save key result")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToKeyResult(kr), nil, nil, nil, ""))
+}
+
+// CheckIn godoc
+// @Summary Check in on a key result
+// @Description Record the current value of a key result, the owners of the key result and of the objective can check in
+// @Tags Objective
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Objective ID"
+// @Param keyResultID path string true "Key result ID"
+// @Param Body body request.CheckInInput true "Body"
+// @Success 200 {object} view.KeyResultCheckInResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 403 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /objectives/{id}/key-results/{keyResultID}/check-ins [post]
+func (h *handler) CheckIn(c *gin.Context) {
+	objectiveID := c.Param("id")
+	if objectiveID == "" || !model.IsUUIDFromString(objectiveID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidObjectiveID, nil, ""))
+		return
+	}
+
+	keyResultID := c.Param("keyResultID")
+	if keyResultID == "" || !model.IsUUIDFromString(keyResultID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidKeyResultID, nil, ""))
+		return
+	}
+
+	input := request.CheckInInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":     "objective",
+		"method":      "CheckIn",
+		"objectiveID": objectiveID,
+		"keyResultID": keyResultID,
+		"input":       input,
+	})
+
+	userInfo, err := authutils.GetLoggedInUserInfo(c, h.store, h.repo.DB(), h.config)
+	if err != nil {
+		l.Error(err, "failed to get logged in user")
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	checkIn, err := h.controller.Objective.CheckIn(objective.CheckInInput{
+		ObjectiveID: objectiveID,
+		KeyResultID: keyResultID,
+		Value:       *input.Value,
+		Confidence:  input.Confidence,
+		Note:        strings.TrimSpace(input.Note),
+		UserID:      userInfo.UserID,
+		CanEdit:     authutils.HasPermission(userInfo.Permissions, model.PermissionObjectivesEdit),
+	})
+	if err != nil {
+		l.Error(err, "failed to check in key result")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToKeyResultCheckIn(checkIn), nil, nil, nil, ""))
+}
+
+// ListCheckIns godoc
+// @Summary Get progress history of a key result
+// @Description Get the check-ins of a key result, latest first
+// @Tags Objective
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Objective ID"
+// @Param keyResultID path string true "Key result ID"
+// @Param page query string false "Page"
+// @Param size query string false "Size"
+// @Success 200 {object} view.ListKeyResultCheckInResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /objectives/{id}/key-results/{keyResultID}/check-ins [get]
+func (h *handler) ListCheckIns(c *gin.Context) {
+	objectiveID := c.Param("id")
+	if objectiveID == "" || !model.IsUUIDFromString(objectiveID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidObjectiveID, nil, ""))
+		return
+	}
+
+	keyResultID := c.Param("keyResultID")
+	if keyResultID == "" || !model.IsUUIDFromString(keyResultID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidKeyResultID, nil, ""))
+		return
+	}
+
+	pagination := model.Pagination{}
+	if err := c.ShouldBindQuery(&pagination); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+	pagination.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":     "objective",
+		"method":      "ListCheckIns",
+		"objectiveID": objectiveID,
+		"keyResultID": keyResultID,
+	})
+
+	checkIns, total, err := h.controller.Objective.ListCheckIns(objectiveID, keyResultID, pagination)
+	if err != nil {
+		l.Error(err, "failed to get key result check-ins")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToKeyResultCheckIns(checkIns),
+		&view.PaginationResponse{Pagination: pagination, Total: total}, nil, nil, ""))
+}
+
+// EmployeeGoals godoc
+// @Summary Get goals of an employee
+// @Description Get the individual objectives of an employee which are not cancelled, with their key results and progress
+// @Tags Employee
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Employee ID"
+// @Param from query string false "From date"
+// @Param to query string false "To date"
+// @Success 200 {object} view.ListObjectiveResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /employees/{id}/objectives [get]
+func (h *handler) EmployeeGoals(c *gin.Context) {
+	employeeID := c.Param("id")
+	if employeeID == "" || !model.IsUUIDFromString(employeeID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidEmployeeID, nil, ""))
+		return
+	}
+
+	input := request.GetListObjectiveInput{}
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":    "objective",
+		"method":     "EmployeeGoals",
+		"employeeID": employeeID,
+	})
+
+	from, to := input.GetPeriod()
+
+	objectives, err := h.controller.Objective.EmployeeGoals(employeeID, from, to)
+	if err != nil {
+		l.Error(err, "failed to get employee goals")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToObjectives(objectives), nil, nil, nil, ""))
+}
+
+// SendCheckInReminders godoc
+// @Summary Remind owners of key results to check in
+// @Description Send a Discord DM to owners of key results of active objectives whose check-in is due
+// @Tags Objective
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Success 200 {object} view.MessageResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /cronjobs/objective-check-in-reminders [post]
+func (h *handler) SendCheckInReminders(c *gin.Context) {
+	l := h.logger.Fields(logger.Fields{
+		"handler": "objective",
+		"method":  "SendCheckInReminders",
+	})
+
+	sent, err := h.controller.Objective.SendCheckInReminders()
+	if err != nil {
+		l.Error(err, "failed to send check-in reminders")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	l.Infof("sent %d check-in reminders", sent)
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
+}
+
+func toObjectiveInput(input request.ObjectiveInput) objective.ObjectiveInput {
+	startDate, endDate := input.GetPeriod()
+
+	return objective.ObjectiveInput{
+		Title:               strings.TrimSpace(input.Title),
+		Description:         strings.TrimSpace(input.Description),
+		Level:               model.ObjectiveLevel(input.Level),
+		ParentID:            input.ParentID,
+		OwnerID:             input.OwnerID,
+		ProjectID:           input.ProjectID,
+		ChapterID:           input.ChapterID,
+		EmployeeID:          input.EmployeeID,
+		StartDate:           startDate,
+		EndDate:             endDate,
+		CheckInIntervalDays: input.CheckInIntervalDays,
+	}
+}
@@ -0,0 +1,178 @@
+package request
+
+import (
+	"strings"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/handler/objective/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type GetListObjectiveInput struct {
+	model.Pagination
+
+	Level      string `json:"level" form:"level"`
+	Status     string `json:"status" form:"status"`
+	ParentID   string `json:"parentID" form:"parentID"`
+	OwnerID    string `json:"ownerID" form:"ownerID"`
+	ProjectID  string `json:"projectID" form:"projectID"`
+	ChapterID  string `json:"chapterID" form:"chapterID"`
+	EmployeeID string `json:"employeeID" form:"employeeID"`
+	// From and To are dates in YYYY-MM-DD format, objectives whose period overlaps them are returned
+	From string `json:"from" form:"from"`
+	To   string `json:"to" form:"to"`
+}
+
+func (i *GetListObjectiveInput) Validate() error {
+	if i.Level != "" && !model.ObjectiveLevel(i.Level).IsValid() {
+		return errs.ErrInvalidLevel
+	}
+	if i.Status != "" && !model.ObjectiveStatus(i.Status).IsValid() {
+		return errs.ErrInvalidStatus
+	}
+
+	if err := validateIDs(i.ParentID, i.OwnerID, i.ProjectID, i.ChapterID, i.EmployeeID); err != nil {
+		return err
+	}
+
+	if _, err := parseOptionalDate(i.From); err != nil {
+		return errs.ErrInvalidFrom
+	}
+	if _, err := parseOptionalDate(i.To); err != nil {
+		return errs.ErrInvalidTo
+	}
+
+	return nil
+}
+
+// GetPeriod returns the from and to dates, nil when they are not set
+func (i *GetListObjectiveInput) GetPeriod() (*time.Time, *time.Time) {
+	from, _ := parseOptionalDate(i.From)
+	to, _ := parseOptionalDate(i.To)
+	return from, to
+}
+
+type ObjectiveInput struct {
+	Title       string `json:"title" binding:"required"`
+	Description string `json:"description"`
+	Level       string `json:"level" binding:"required"`
+	ParentID    string `json:"parentID"`
+	// OwnerID defaults to the logged-in user
+	OwnerID    string `json:"ownerID"`
+	ProjectID  string `json:"projectID"`
+	ChapterID  string `json:"chapterID"`
+	EmployeeID string `json:"employeeID"`
+	// StartDate and EndDate are dates in YYYY-MM-DD format
+	StartDate           string `json:"startDate" binding:"required"`
+	EndDate             string `json:"endDate" binding:"required"`
+	CheckInIntervalDays int    `json:"checkInIntervalDays"`
+}
+
+func (i *ObjectiveInput) Validate() error {
+	if strings.TrimSpace(i.Title) == "" {
+		return errs.ErrEmptyTitle
+	}
+	if !model.ObjectiveLevel(i.Level).IsValid() {
+		return errs.ErrInvalidLevel
+	}
+
+	if err := validateIDs(i.ParentID, i.OwnerID, i.ProjectID, i.ChapterID, i.EmployeeID); err != nil {
+		return err
+	}
+
+	if _, err := time.Parse("2006-01-02", i.StartDate); err != nil {
+		return errs.ErrInvalidStartDate
+	}
+	if _, err := time.Parse("2006-01-02", i.EndDate); err != nil {
+		return errs.ErrInvalidEndDate
+	}
+
+	return nil
+}
+
+// GetPeriod returns the start and end date of the objective
+func (i *ObjectiveInput) GetPeriod() (time.Time, time.Time) {
+	startDate, _ := time.Parse("2006-01-02", i.StartDate)
+	endDate, _ := time.Parse("2006-01-02", i.EndDate)
+	return startDate, endDate
+}
+
+type UpdateObjectiveInput struct {
+	ObjectiveInput
+
+	Status string `json:"status" binding:"required"`
+}
+
+func (i *UpdateObjectiveInput) Validate() error {
+	if !model.ObjectiveStatus(i.Status).IsValid() {
+		return errs.ErrInvalidStatus
+	}
+
+	return i.ObjectiveInput.Validate()
+}
+
+type KeyResultInput struct {
+	Title string `json:"title" binding:"required"`
+	// OwnerID defaults to the owner of the objective
+	OwnerID     string  `json:"ownerID"`
+	Unit        string  `json:"unit"`
+	StartValue  float64 `json:"startValue"`
+	TargetValue float64 `json:"targetValue"`
+}
+
+func (i *KeyResultInput) Validate() error {
+	if strings.TrimSpace(i.Title) == "" {
+		return errs.ErrEmptyTitle
+	}
+	if i.OwnerID != "" && !model.IsUUIDFromString(i.OwnerID) {
+		return errs.ErrInvalidOwnerID
+	}
+
+	return nil
+}
+
+type CheckInInput struct {
+	Value *float64 `json:"value"`
+	// Confidence is how confident the owner is to reach the target, from 1 to 10
+	Confidence int    `json:"confidence"`
+	Note       string `json:"note"`
+}
+
+func (i *CheckInInput) Validate() error {
+	if i.Value == nil {
+		return errs.ErrEmptyValue
+	}
+
+	return nil
+}
+
+// validateIDs checks the optional parent, owner, project, chapter and employee IDs of an objective
+func validateIDs(parentID, ownerID, projectID, chapterID, employeeID string) error {
+	switch {
+	case parentID != "" && !model.IsUUIDFromString(parentID):
+		return errs.ErrInvalidParentID
+	case ownerID != "" && !model.IsUUIDFromString(ownerID):
+		return errs.ErrInvalidOwnerID
+	case projectID != "" && !model.IsUUIDFromString(projectID):
+		return errs.ErrInvalidProjectID
+	case chapterID != "" && !model.IsUUIDFromString(chapterID):
+		return errs.ErrInvalidChapterID
+	case employeeID != "" && !model.IsUUIDFromString(employeeID):
+		return errs.ErrInvalidEmployeeID
+	}
+
+	return nil
+}
+
+func parseOptionalDate(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
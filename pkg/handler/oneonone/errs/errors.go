@@ -0,0 +1,58 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/oneonone"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidMeetingID        = errors.New("invalid 1:1 meeting ID")
+	ErrInvalidAgendaItemID     = errors.New("invalid agenda item ID")
+	ErrInvalidActionItemID     = errors.New("invalid action item ID")
+	ErrInvalidReportID         = errors.New("invalid report ID")
+	ErrInvalidEmployeeID       = errors.New("invalid employee ID")
+	ErrInvalidOwnerID          = errors.New("invalid owner ID")
+	ErrInvalidRole             = errors.New("role must be manager or report")
+	ErrInvalidStatus           = errors.New("invalid 1:1 meeting status")
+	ErrInvalidActionItemStatus = errors.New("invalid action item status")
+	ErrInvalidDueDate          = errors.New("invalid due date")
+	ErrInvalidFrom             = errors.New("invalid from date")
+	ErrInvalidTo               = errors.New("invalid to date")
+	ErrEmptyContent            = errors.New("content is required")
+)
+
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, oneonone.ErrMeetingNotFound),
+		errors.Is(err, oneonone.ErrReportNotFound),
+		errors.Is(err, oneonone.ErrAgendaItemNotFound),
+		errors.Is(err, oneonone.ErrActionItemNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, oneonone.ErrNotLineManager),
+		errors.Is(err, oneonone.ErrNotManager),
+		errors.Is(err, oneonone.ErrNotParticipant),
+		errors.Is(err, oneonone.ErrNotAuthor):
+		status = http.StatusForbidden
+	case errors.Is(err, oneonone.ErrInvalidPeriod),
+		errors.Is(err, oneonone.ErrInvalidStatus),
+		errors.Is(err, oneonone.ErrInvalidActionItemStatus),
+		errors.Is(err, oneonone.ErrMeetingCancelled),
+		errors.Is(err, oneonone.ErrOwnerNotParticipant):
+		status = http.StatusBadRequest
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
@@ -0,0 +1,15 @@
+package oneonone
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	List(c *gin.Context)
+	Detail(c *gin.Context)
+	Create(c *gin.Context)
+	Update(c *gin.Context)
+	UpdateManagerNote(c *gin.Context)
+	CreateAgendaItem(c *gin.Context)
+	UpdateAgendaItem(c *gin.Context)
+	CreateActionItem(c *gin.Context)
+	UpdateActionItem(c *gin.Context)
+}
@@ -0,0 +1,516 @@
+package oneonone
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	"github.com/dwarvesf/fortress-api/pkg/controller/oneonone"
+	"github.com/dwarvesf/fortress-api/pkg/handler/oneonone/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/oneonone/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	controller *controller.Controller
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		controller: controller,
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+	}
+}
+
+// List godoc
+// @Summary Get list of 1:1 meetings
+// @Description Get the 1:1 meetings of the logged-in user as a manager or as a report, latest first
+// @Tags OneOnOne
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param role query string false "manager or report"
+// @Param employeeID query string false "Meetings with this employee"
+// @Param status query string false "Status"
+// @Param from query string false "From date"
+// @Param to query string false "To date"
+// @Param page query string false "Page"
+// @Param size query string false "Size"
+// @Success 200 {object} view.ListOneOnOneMeetingResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /one-on-ones [get]
+func (h *handler) List(c *gin.Context) {
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	input := request.GetListMeetingInput{}
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	input.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "oneonone",
+		"method":  "List",
+		"input":   input,
+	})
+
+	from, to := input.GetPeriod()
+
+	meetings, total, err := h.controller.OneOnOne.List(oneonone.ListInput{
+		Pagination: input.Pagination,
+		UserID:     userID,
+		Role:       input.Role,
+		EmployeeID: input.EmployeeID,
+		Status:     input.Status,
+		From:       from,
+		To:         to,
+	})
+	if err != nil {
+		l.Error(err, "failed to get list 1:1 meetings")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToOneOnOneMeetings(meetings),
+		&view.PaginationResponse{Pagination: input.Pagination, Total: total}, nil, nil, ""))
+}
+
+// Detail godoc
+// @Summary Get detail of a 1:1 meeting
+// @Description Get a 1:1 meeting with its agenda, action items and the open items of the earlier meetings. The manager note is only shown to the manager
+// @Tags OneOnOne
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "1:1 meeting ID"
+// @Success 200 {object} view.OneOnOneMeetingResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 403 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /one-on-ones/{id} [get]
+func (h *handler) Detail(c *gin.Context) {
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidMeetingID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "oneonone",
+		"method":  "Detail",
+		"id":      id,
+	})
+
+	meeting, err := h.controller.OneOnOne.Detail(id, userID)
+	if err != nil {
+		l.Error(err, "failed to get 1:1 meeting")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToOneOnOneMeeting(meeting), nil, nil, nil, ""))
+}
+
+// Create godoc
+// @Summary Schedule a 1:1 meeting
+// @Description Schedule a 1:1 meeting of the logged-in line manager with one of their reports
+// @Tags OneOnOne
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param Body body request.CreateMeetingInput true "Body"
+// @Success 200 {object} view.OneOnOneMeetingResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 403 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /one-on-ones [post]
+func (h *handler) Create(c *gin.Context) {
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	input := request.CreateMeetingInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "oneonone",
+		"method":  "Create",
+		"input":   input,
+	})
+
+	meeting, err := h.controller.OneOnOne.Create(oneonone.CreateInput{
+		ScheduleInput: toScheduleInput(input.MeetingInput),
+		ReportID:      input.ReportID,
+		UserID:        userID,
+	})
+	if err != nil {
+		l.Error(err, "failed to create 1:1 meeting")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToOneOnOneMeeting(meeting), nil, nil, nil, ""))
+}
+
+// Update godoc
+// @Summary Update a 1:1 meeting
+// @Description Reschedule a 1:1 meeting, change its status or link it to a google calendar event. Only the manager of the meeting can update it
+// @Tags OneOnOne
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "1:1 meeting ID"
+// @Param Body body request.UpdateMeetingInput true "Body"
+// @Success 200 {object} view.OneOnOneMeetingResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 403 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /one-on-ones/{id} [put]
+func (h *handler) Update(c *gin.Context) {
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidMeetingID, nil, ""))
+		return
+	}
+
+	input := request.UpdateMeetingInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "oneonone",
+		"method":  "Update",
+		"id":      id,
+		"input":   input,
+	})
+
+	meeting, err := h.controller.OneOnOne.Update(oneonone.UpdateInput{
+		ScheduleInput: toScheduleInput(input.MeetingInput),
+		ID:            id,
+		Status:        model.OneOnOneStatus(input.Status),
+		UserID:        userID,
+	})
+	if err != nil {
+		l.Error(err, "failed to update 1:1 meeting")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToOneOnOneMeeting(meeting), nil, nil, nil, ""))
+}
+
+// UpdateManagerNote godoc
+// @Summary Update the private note of the manager
+// @Description Update the note of the manager of a 1:1 meeting, the report never sees it
+// @Tags OneOnOne
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "1:1 meeting ID"
+// @Param Body body request.ManagerNoteInput true "Body"
+// @Success 200 {object} view.OneOnOneMeetingResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 403 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /one-on-ones/{id}/manager-note [put]
+func (h *handler) UpdateManagerNote(c *gin.Context) {
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidMeetingID, nil, ""))
+		return
+	}
+
+	input := request.ManagerNoteInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "oneonone",
+		"method":  "UpdateManagerNote",
+		"id":      id,
+	})
+
+	meeting, err := h.controller.OneOnOne.UpdateManagerNote(id, userID, strings.TrimSpace(input.Note))
+	if err != nil {
+		l.Error(err, "failed to update manager note")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToOneOnOneMeeting(meeting), nil, nil, nil, ""))
+}
+
+// CreateAgendaItem godoc
+// @Summary Add an agenda item to a 1:1 meeting
+// @Description Add a topic to the agenda shared by the manager and the report
+// @Tags OneOnOne
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "1:1 meeting ID"
+// @Param Body body request.AgendaItemInput true "Body"
+// @Success 200 {object} view.OneOnOneAgendaItemResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 403 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /one-on-ones/{id}/agenda-items [post]
+func (h *handler) CreateAgendaItem(c *gin.Context) {
+	h.saveAgendaItem(c, "CreateAgendaItem", h.controller.OneOnOne.CreateAgendaItem)
+}
+
+// UpdateAgendaItem godoc
+// @Summary Update an agenda item of a 1:1 meeting
+// @Description Mark an agenda item as discussed, only its author can change its content
+// @Tags OneOnOne
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "1:1 meeting ID"
+// @Param itemID path string true "Agenda item ID"
+// @Param Body body request.AgendaItemInput true "Body"
+// @Success 200 {object} view.OneOnOneAgendaItemResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 403 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /one-on-ones/{id}/agenda-items/{itemID} [put]
+func (h *handler) UpdateAgendaItem(c *gin.Context) {
+	h.saveAgendaItem(c, "UpdateAgendaItem", h.controller.OneOnOne.UpdateAgendaItem)
+}
+
+func (h *handler) saveAgendaItem(c *gin.Context, method string, save func(oneonone.AgendaItemInput) (*model.OneOnOneAgendaItem, error)) {
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	meetingID := c.Param("id")
+	if meetingID == "" || !model.IsUUIDFromString(meetingID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidMeetingID, nil, ""))
+		return
+	}
+
+	itemID := c.Param("itemID")
+	if itemID != "" && !model.IsUUIDFromString(itemID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidAgendaItemID, nil, ""))
+		return
+	}
+
+	input := request.AgendaItemInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":   "oneonone",
+		"method":    method,
+		"meetingID": meetingID,
+		"itemID":    itemID,
+		"input":     input,
+	})
+
+	item, err := save(oneonone.AgendaItemInput{
+		MeetingID:   meetingID,
+		ID:          itemID,
+		Content:     strings.TrimSpace(input.Content),
+		IsDiscussed: input.IsDiscussed,
+		UserID:      userID,
+	})
+	if err != nil {
+		l.Error(err, "failed to save agenda item")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToOneOnOneAgendaItem(item), nil, nil, nil, ""))
+}
+
+// CreateActionItem godoc
+// @Summary Add an action item to a 1:1 meeting
+// @Description Add a follow-up owned by the manager or the report, it is carried over to the next meetings until it is done
+// @Tags OneOnOne
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "1:1 meeting ID"
+// @Param Body body request.ActionItemInput true "Body"
+// @Success 200 {object} view.OneOnOneActionItemResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 403 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /one-on-ones/{id}/action-items [post]
+func (h *handler) CreateActionItem(c *gin.Context) {
+	h.saveActionItem(c, "CreateActionItem", h.controller.OneOnOne.CreateActionItem)
+}
+
+// UpdateActionItem godoc
+// @Summary Update an action item of a 1:1 meeting
+// @Description Update an action item of the meeting it was raised in, either participant can mark it as done
+// @Tags OneOnOne
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "1:1 meeting ID"
+// @Param itemID path string true "Action item ID"
+// @Param Body body request.ActionItemInput true "Body"
+// @Success 200 {object} view.OneOnOneActionItemResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 403 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /one-on-ones/{id}/action-items/{itemID} [put]
+func (h *handler) UpdateActionItem(c *gin.Context) {
+	h.saveActionItem(c, "UpdateActionItem", h.controller.OneOnOne.UpdateActionItem)
+}
+
+func (h *handler) saveActionItem(c *gin.Context, method string, save func(oneonone.ActionItemInput) (*model.OneOnOneActionItem, error)) {
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	meetingID := c.Param("id")
+	if meetingID == "" || !model.IsUUIDFromString(meetingID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidMeetingID, nil, ""))
+		return
+	}
+
+	itemID := c.Param("itemID")
+	if itemID != "" && !model.IsUUIDFromString(itemID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidActionItemID, nil, ""))
+		return
+	}
+
+	input := request.ActionItemInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":   "oneonone",
+		"method":    method,
+		"meetingID": meetingID,
+		"itemID":    itemID,
+		"input":     input,
+	})
+
+	status := model.OneOnOneActionItemStatus(input.Status)
+	if status == "" {
+		status = model.OneOnOneActionItemStatusOpen
+	}
+
+	item, err := save(oneonone.ActionItemInput{
+		MeetingID: meetingID,
+		ID:        itemID,
+		Content:   strings.TrimSpace(input.Content),
+		OwnerID:   input.OwnerID,
+		DueDate:   input.GetDueDate(),
+		Status:    status,
+		UserID:    userID,
+	})
+	if err != nil {
+		l.Error(err, "failed to save action item")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToOneOnOneActionItem(item), nil, nil, nil, ""))
+}
+
+func toScheduleInput(input request.MeetingInput) oneonone.ScheduleInput {
+	return oneonone.ScheduleInput{
+		Title:            strings.TrimSpace(input.Title),
+		Description:      strings.TrimSpace(input.Description),
+		StartTime:        input.StartTime,
+		EndTime:          input.EndTime,
+		GoogleCalendarID: strings.TrimSpace(input.GoogleCalendarID),
+		HangoutLink:      strings.TrimSpace(input.HangoutLink),
+	}
+}
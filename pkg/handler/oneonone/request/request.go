@@ -0,0 +1,155 @@
+package request
+
+import (
+	"strings"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/handler/oneonone/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type GetListMeetingInput struct {
+	model.Pagination
+
+	// Role is manager or report, meetings of both roles are returned when it is empty
+	Role string `json:"role" form:"role"`
+	// EmployeeID keeps the meetings with this employee
+	EmployeeID string `json:"employeeID" form:"employeeID"`
+	Status     string `json:"status" form:"status"`
+	// From and To are dates in YYYY-MM-DD format, meetings starting between them are returned
+	From string `json:"from" form:"from"`
+	To   string `json:"to" form:"to"`
+}
+
+func (i *GetListMeetingInput) Validate() error {
+	if i.Role != "" && i.Role != "manager" && i.Role != "report" {
+		return errs.ErrInvalidRole
+	}
+	if i.EmployeeID != "" && !model.IsUUIDFromString(i.EmployeeID) {
+		return errs.ErrInvalidEmployeeID
+	}
+	if i.Status != "" && !model.OneOnOneStatus(i.Status).IsValid() {
+		return errs.ErrInvalidStatus
+	}
+	if _, err := parseOptionalDate(i.From); err != nil {
+		return errs.ErrInvalidFrom
+	}
+	if _, err := parseOptionalDate(i.To); err != nil {
+		return errs.ErrInvalidTo
+	}
+
+	return nil
+}
+
+// GetPeriod returns the from and to dates, to covers the whole day, nil when they are not set
+func (i *GetListMeetingInput) GetPeriod() (*time.Time, *time.Time) {
+	from, _ := parseOptionalDate(i.From)
+	to, _ := parseOptionalDate(i.To)
+	if to != nil {
+		endOfDay := to.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		to = &endOfDay
+	}
+	return from, to
+}
+
+type MeetingInput struct {
+	// Title defaults to the names of the manager and the report
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	StartTime   time.Time `json:"startTime" binding:"required"`
+	EndTime     time.Time `json:"endTime" binding:"required"`
+	// GoogleCalendarID is the id of the google calendar event of the meeting, updates of the event are synced to the meeting
+	GoogleCalendarID string `json:"googleCalendarID"`
+	HangoutLink      string `json:"hangoutLink"`
+}
+
+type CreateMeetingInput struct {
+	MeetingInput
+
+	ReportID string `json:"reportID" binding:"required"`
+}
+
+func (i *CreateMeetingInput) Validate() error {
+	if !model.IsUUIDFromString(i.ReportID) {
+		return errs.ErrInvalidReportID
+	}
+
+	return nil
+}
+
+type UpdateMeetingInput struct {
+	MeetingInput
+
+	Status string `json:"status" binding:"required"`
+}
+
+func (i *UpdateMeetingInput) Validate() error {
+	if !model.OneOnOneStatus(i.Status).IsValid() {
+		return errs.ErrInvalidStatus
+	}
+
+	return nil
+}
+
+type ManagerNoteInput struct {
+	Note string `json:"note"`
+}
+
+type AgendaItemInput struct {
+	Content     string `json:"content" binding:"required"`
+	IsDiscussed bool   `json:"isDiscussed"`
+}
+
+func (i *AgendaItemInput) Validate() error {
+	if strings.TrimSpace(i.Content) == "" {
+		return errs.ErrEmptyContent
+	}
+
+	return nil
+}
+
+type ActionItemInput struct {
+	Content string `json:"content" binding:"required"`
+	// OwnerID is the manager or the report, defaults to the logged-in user
+	OwnerID string `json:"ownerID"`
+	// DueDate is a date in YYYY-MM-DD format
+	DueDate string `json:"dueDate"`
+	// Status is open or done, new action items are always open
+	Status string `json:"status"`
+}
+
+func (i *ActionItemInput) Validate() error {
+	if strings.TrimSpace(i.Content) == "" {
+		return errs.ErrEmptyContent
+	}
+	if i.OwnerID != "" && !model.IsUUIDFromString(i.OwnerID) {
+		return errs.ErrInvalidOwnerID
+	}
+	if _, err := parseOptionalDate(i.DueDate); err != nil {
+		return errs.ErrInvalidDueDate
+	}
+	if i.Status != "" && !model.OneOnOneActionItemStatus(i.Status).IsValid() {
+		return errs.ErrInvalidActionItemStatus
+	}
+
+	return nil
+}
+
+// GetDueDate returns the due date, nil when it is not set
+func (i *ActionItemInput) GetDueDate() *time.Time {
+	dueDate, _ := parseOptionalDate(i.DueDate)
+	return dueDate
+}
+
+func parseOptionalDate(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
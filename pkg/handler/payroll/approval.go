@@ -0,0 +1,67 @@
+package payroll
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type markPayrollAsPaidPayload struct {
+	IDs []string `json:"ids"`
+}
+
+type commitPayrollPayload struct {
+	Month int    `json:"month"`
+	Year  int    `json:"year"`
+	Batch int    `json:"batch"`
+	Email string `json:"email"`
+}
+
+// registerApprovalExecutors lets the approval flow run payroll actions
+// once a second person approved them
+func (h *handler) registerApprovalExecutors() {
+	if h.controller == nil || h.controller.Approval == nil {
+		return
+	}
+
+	h.controller.Approval.RegisterExecutor(model.ApprovalActionPayrollMarkPaid, func(request *model.ApprovalRequest) error {
+		var payload markPayrollAsPaidPayload
+		if err := json.Unmarshal(request.Payload, &payload); err != nil {
+			return err
+		}
+
+		return h.markPayrollAsPaid(payload.IDs)
+	})
+
+	h.controller.Approval.RegisterExecutor(model.ApprovalActionPayrollCommit, func(request *model.ApprovalRequest) error {
+		var payload commitPayrollPayload
+		if err := json.Unmarshal(request.Payload, &payload); err != nil {
+			return err
+		}
+
+		return h.commitPayroll(payload)
+	})
+}
+
+// commitPayroll commits the payroll batch and logs it to discord
+func (h *handler) commitPayroll(payload commitPayrollPayload) error {
+	if err := h.commitPayrollHandler(payload.Month, payload.Year, payload.Batch, payload.Email); err != nil {
+		return err
+	}
+
+	err := h.controller.Discord.Log(model.LogDiscordInput{
+		Type: "payroll_commit",
+		Data: map[string]interface{}{
+			"batch_number": strconv.Itoa(payload.Batch),
+			"month":        time.Month(payload.Month).String(),
+			"year":         payload.Year,
+		},
+	})
+	if err != nil {
+		h.logger.Error(err, "failed to logs to discord")
+	}
+
+	return nil
+}
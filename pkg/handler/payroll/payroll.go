@@ -16,6 +16,7 @@ import (
 
 	"github.com/dwarvesf/fortress-api/pkg/config"
 	"github.com/dwarvesf/fortress-api/pkg/controller"
+	"github.com/dwarvesf/fortress-api/pkg/controller/approval"
 	"github.com/dwarvesf/fortress-api/pkg/handler/payroll/errs"
 	"github.com/dwarvesf/fortress-api/pkg/logger"
 	"github.com/dwarvesf/fortress-api/pkg/model"
@@ -45,7 +46,7 @@ type handler struct {
 
 // New returns a handler
 func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, worker *worker.Worker, logger logger.Logger, cfg *config.Config) IHandler {
-	return &handler{
+	h := &handler{
 		controller: controller,
 		store:      store,
 		repo:       repo,
@@ -54,6 +55,9 @@ func New(controller *controller.Controller, store *store.Store, repo store.DBRep
 		logger:     logger,
 		config:     cfg,
 	}
+	h.registerApprovalExecutors()
+
+	return h
 }
 
 // GetPayrollsByMonth godoc
@@ -672,28 +676,35 @@ func (h *handler) CommitPayroll(c *gin.Context) {
 		return
 	}
 
-	email := c.Query("email")
+	payload := commitPayrollPayload{
+		Month: int(month),
+		Year:  int(year),
+		Batch: int(batch),
+		Email: c.Query("email"),
+	}
 
-	err = h.commitPayrollHandler(int(month), int(year), int(batch), email)
+	requester, err := approval.GetRequester(c, h.config)
 	if err != nil {
-		l.Errorf(err, "failed to parse date", "date", batch)
+		l.Error(err, "failed to get requester")
 		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
 		return
 	}
 
-	err = h.controller.Discord.Log(model.LogDiscordInput{
-		Type: "payroll_commit",
-		Data: map[string]interface{}{
-			"batch_number": strconv.Itoa(int(batch)),
-			"month":        time.Month(month).String(),
-			"year":         year,
-		},
+	// committing a payroll needs a second person to approve
+	request, err := h.controller.Approval.Create(approval.CreateInput{
+		Action:     model.ApprovalActionPayrollCommit,
+		Permission: model.PermissionPayrollsCreate.String(),
+		Summary:    fmt.Sprintf("Commit payroll batch %d of %s %d", batch, time.Month(month).String(), year),
+		Payload:    payload,
+		Requester:  requester,
 	})
 	if err != nil {
-		l.Error(err, "failed to logs to discord")
+		l.Error(err, "failed to create approval request")
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
 	}
 
-	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, ""))
+	c.JSON(http.StatusAccepted, view.CreateResponse[any](view.ToApprovalRequest(request), nil, nil, nil, "waiting for approval"))
 }
 
 func (h *handler) commitPayrollHandler(month, year, batch int, email string) error {
@@ -832,16 +843,38 @@ func (h *handler) markBonusAsDone(p *model.Payroll) error {
 }
 
 func (h *handler) MarkPayrollAsPaid(c *gin.Context) {
+	l := h.logger.Fields(logger.Fields{
+		"handler": "payroll",
+		"method":  "MarkPayrollAsPaid",
+	})
+
 	var ids []string
 	if err := c.Bind(&ids); err != nil {
 		return
 	}
 
-	err := h.markPayrollAsPaid(ids)
+	requester, err := approval.GetRequester(c, h.config)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		l.Error(err, "failed to get requester")
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
 	}
-	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "marking the payroll as paid was successful"))
+
+	// marking payrolls as paid needs a second person to approve
+	request, err := h.controller.Approval.Create(approval.CreateInput{
+		Action:     model.ApprovalActionPayrollMarkPaid,
+		Permission: model.PermissionPayrollsEdit.String(),
+		Summary:    fmt.Sprintf("Mark %d payroll(s) as paid", len(ids)),
+		Payload:    markPayrollAsPaidPayload{IDs: ids},
+		Requester:  requester,
+	})
+	if err != nil {
+		l.Error(err, "failed to create approval request")
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	c.JSON(http.StatusAccepted, view.CreateResponse[any](view.ToApprovalRequest(request), nil, nil, nil, "waiting for approval"))
 }
 
 // markPayrollAsPaid from selected payroll row in database
@@ -0,0 +1,61 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/performancereview"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidCycleID          = errors.New("invalid performance review cycle ID")
+	ErrInvalidReviewID         = errors.New("invalid performance review ID")
+	ErrInvalidEmployeeID       = errors.New("invalid employee ID")
+	ErrInvalidPeerReviewID     = errors.New("invalid peer-review survey ID")
+	ErrInvalidStartDate        = errors.New("invalid start date")
+	ErrInvalidEndDate          = errors.New("invalid end date")
+	ErrInvalidStatus           = errors.New("invalid performance review cycle status")
+	ErrInvalidReviewStatus     = errors.New("invalid performance review status")
+	ErrInvalidRating           = errors.New("invalid performance rating")
+	ErrEmptyName               = errors.New("name is required")
+	ErrNotAllowedToViewHistory = errors.New("not allowed to view the performance review history of the employee")
+)
+
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, performancereview.ErrCycleNotFound),
+		errors.Is(err, performancereview.ErrReviewNotFound),
+		errors.Is(err, performancereview.ErrCreatorNotFound),
+		errors.Is(err, performancereview.ErrEmployeeNotFound),
+		errors.Is(err, performancereview.ErrPeerReviewNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, performancereview.ErrNotAllowedToAssess),
+		errors.Is(err, performancereview.ErrNotAllowedToView):
+		status = http.StatusForbidden
+	case errors.Is(err, performancereview.ErrInvalidPeerReview),
+		errors.Is(err, performancereview.ErrInvalidPeriod),
+		errors.Is(err, performancereview.ErrNoEmployeeToReview),
+		errors.Is(err, performancereview.ErrInvalidStatusTransition),
+		errors.Is(err, performancereview.ErrPendingAssessments),
+		errors.Is(err, performancereview.ErrNotInAssessment),
+		errors.Is(err, performancereview.ErrNotInCalibration),
+		errors.Is(err, performancereview.ErrInvalidRating),
+		errors.Is(err, model.ErrInvalidAssessmentCriterion),
+		errors.Is(err, model.ErrInvalidAssessmentRating):
+		status = http.StatusBadRequest
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
@@ -0,0 +1,15 @@
+package performancereview
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	List(c *gin.Context)
+	Detail(c *gin.Context)
+	Create(c *gin.Context)
+	UpdateStatus(c *gin.Context)
+	ListReviews(c *gin.Context)
+	Packet(c *gin.Context)
+	SubmitAssessment(c *gin.Context)
+	Calibrate(c *gin.Context)
+	EmployeeHistory(c *gin.Context)
+}
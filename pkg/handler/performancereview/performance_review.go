@@ -0,0 +1,515 @@
+package performancereview
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	"github.com/dwarvesf/fortress-api/pkg/controller/performancereview"
+	"github.com/dwarvesf/fortress-api/pkg/handler/performancereview/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/performancereview/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	controller *controller.Controller
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		controller: controller,
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+	}
+}
+
+// List godoc
+// @Summary Get list of performance review cycles
+// @Description Get list of performance review cycles, latest first
+// @Tags PerformanceReview
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param status query string false "Status"
+// @Param keyword query string false "Keyword"
+// @Param page query string false "Page"
+// @Param size query string false "Size"
+// @Success 200 {object} view.ListPerformanceReviewCycleResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /performance-review-cycles [get]
+func (h *handler) List(c *gin.Context) {
+	input := request.GetListCycleInput{}
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	input.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "performancereview",
+		"method":  "List",
+		"input":   input,
+	})
+
+	cycles, total, err := h.controller.PerformanceReview.List(performancereview.ListInput{
+		Pagination: input.Pagination,
+		Status:     input.Status,
+		Keyword:    input.Keyword,
+	})
+	if err != nil {
+		l.Error(err, "failed to get list performance review cycles")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToPerformanceReviewCycles(cycles),
+		&view.PaginationResponse{Pagination: input.Pagination, Total: total}, nil, nil, ""))
+}
+
+// Detail godoc
+// @Summary Get detail of a performance review cycle
+// @Description Get detail of a performance review cycle
+// @Tags PerformanceReview
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Performance review cycle ID"
+// @Success 200 {object} view.PerformanceReviewCycleResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /performance-review-cycles/{id} [get]
+func (h *handler) Detail(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidCycleID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "performancereview",
+		"method":  "Detail",
+		"id":      id,
+	})
+
+	cycle, err := h.controller.PerformanceReview.Detail(id)
+	if err != nil {
+		l.Error(err, "failed to get performance review cycle")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToPerformanceReviewCycle(cycle), nil, nil, nil, ""))
+}
+
+// Create godoc
+// @Summary Create a performance review cycle
+// @Description Create a performance review cycle with a review for every full-time and probation employee
+// @Tags PerformanceReview
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param Body body request.CreateCycleInput true "Body"
+// @Success 200 {object} view.PerformanceReviewCycleResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /performance-review-cycles [post]
+func (h *handler) Create(c *gin.Context) {
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	input := request.CreateCycleInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "performancereview",
+		"method":  "Create",
+		"input":   input,
+	})
+
+	startDate, endDate := input.GetPeriod()
+
+	cycle, err := h.controller.PerformanceReview.Create(performancereview.CreateInput{
+		Name:              strings.TrimSpace(input.Name),
+		StartDate:         startDate,
+		EndDate:           endDate,
+		PeerReviewEventID: input.PeerReviewEventID,
+		CreatedBy:         userID,
+	})
+	if err != nil {
+		l.Error(err, "failed to create performance review cycle")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToPerformanceReviewCycle(cycle), nil, nil, nil, ""))
+}
+
+// UpdateStatus godoc
+// @Summary Move a performance review cycle to its next stage
+// @Description Move a performance review cycle from assessment to calibration, then to finalized. Finalizing stores the final ratings in the history of the employees
+// @Tags PerformanceReview
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Performance review cycle ID"
+// @Param Body body request.UpdateCycleStatusInput true "Body"
+// @Success 200 {object} view.PerformanceReviewCycleResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /performance-review-cycles/{id}/status [put]
+func (h *handler) UpdateStatus(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidCycleID, nil, ""))
+		return
+	}
+
+	input := request.UpdateCycleStatusInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "performancereview",
+		"method":  "UpdateStatus",
+		"id":      id,
+		"input":   input,
+	})
+
+	cycle, err := h.controller.PerformanceReview.UpdateStatus(id, input.Status)
+	if err != nil {
+		l.Error(err, "failed to update performance review cycle status")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToPerformanceReviewCycle(cycle), nil, nil, nil, ""))
+}
+
+// ListReviews godoc
+// @Summary Get reviews of a performance review cycle
+// @Description Get reviews of a performance review cycle
+// @Tags PerformanceReview
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Performance review cycle ID"
+// @Param reviewerID query string false "Reviewer ID"
+// @Param status query string false "Status"
+// @Param page query string false "Page"
+// @Param size query string false "Size"
+// @Success 200 {object} view.ListPerformanceReviewResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /performance-review-cycles/{id}/reviews [get]
+func (h *handler) ListReviews(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidCycleID, nil, ""))
+		return
+	}
+
+	input := request.GetListReviewInput{}
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	input.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "performancereview",
+		"method":  "ListReviews",
+		"id":      id,
+		"input":   input,
+	})
+
+	reviews, total, err := h.controller.PerformanceReview.ListReviews(performancereview.ListReviewsInput{
+		Pagination: input.Pagination,
+		CycleID:    id,
+		ReviewerID: input.ReviewerID,
+		Status:     input.Status,
+	})
+	if err != nil {
+		l.Error(err, "failed to get performance reviews")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToPerformanceReviews(reviews),
+		&view.PaginationResponse{Pagination: input.Pagination, Total: total}, nil, nil, ""))
+}
+
+// Packet godoc
+// @Summary Get the packet of a performance review
+// @Description Get the peer-review and self-review answers, assessment, projects, work units, brainery logs and engagement of the reviewed employee.
+// @Description Reviewers can get the packets of the reviews they are assigned to
+// @Tags PerformanceReview
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Performance review ID"
+// @Success 200 {object} view.PerformanceReviewPacketResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 403 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /performance-reviews/{id} [get]
+func (h *handler) Packet(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidReviewID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "performancereview",
+		"method":  "Packet",
+		"id":      id,
+	})
+
+	userInfo, err := authutils.GetLoggedInUserInfo(c, h.store, h.repo.DB(), h.config)
+	if err != nil {
+		l.Error(err, "failed to get logged in user")
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	packet, err := h.controller.PerformanceReview.Packet(performancereview.PacketInput{
+		ReviewID: id,
+		UserID:   userInfo.UserID,
+		CanRead:  authutils.HasPermission(userInfo.Permissions, model.PermissionPerformanceReviewsRead),
+	})
+	if err != nil {
+		l.Error(err, "failed to get performance review packet")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToPerformanceReviewPacket(packet), nil, nil, nil, ""))
+}
+
+// SubmitAssessment godoc
+// @Summary Submit the line manager assessment of a performance review
+// @Description Submit the assessment and proposed rating of a performance review during the assessment stage
+// @Tags PerformanceReview
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Performance review ID"
+// @Param Body body request.SubmitAssessmentInput true "Body"
+// @Success 200 {object} view.PerformanceReviewResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 403 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /performance-reviews/{id}/assessment [put]
+func (h *handler) SubmitAssessment(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidReviewID, nil, ""))
+		return
+	}
+
+	input := request.SubmitAssessmentInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "performancereview",
+		"method":  "SubmitAssessment",
+		"id":      id,
+		"input":   input,
+	})
+
+	userInfo, err := authutils.GetLoggedInUserInfo(c, h.store, h.repo.DB(), h.config)
+	if err != nil {
+		l.Error(err, "failed to get logged in user")
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	review, err := h.controller.PerformanceReview.SubmitAssessment(performancereview.SubmitAssessmentInput{
+		ReviewID:       id,
+		UserID:         userInfo.UserID,
+		CanEdit:        authutils.HasPermission(userInfo.Permissions, model.PermissionPerformanceReviewsEdit),
+		Assessment:     input.Assessment,
+		ProposedRating: input.ProposedRating,
+	})
+	if err != nil {
+		l.Error(err, "failed to submit performance review assessment")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToPerformanceReview(review), nil, nil, nil, ""))
+}
+
+// Calibrate godoc
+// @Summary Calibrate the rating of a performance review
+// @Description Override the proposed rating of a performance review during the calibration stage
+// @Tags PerformanceReview
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Performance review ID"
+// @Param Body body request.CalibrateInput true "Body"
+// @Success 200 {object} view.PerformanceReviewResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /performance-reviews/{id}/calibration [put]
+func (h *handler) Calibrate(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidReviewID, nil, ""))
+		return
+	}
+
+	input := request.CalibrateInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "performancereview",
+		"method":  "Calibrate",
+		"id":      id,
+		"input":   input,
+	})
+
+	review, err := h.controller.PerformanceReview.Calibrate(performancereview.CalibrateInput{
+		ReviewID: id,
+		Rating:   input.Rating,
+		Note:     strings.TrimSpace(input.Note),
+	})
+	if err != nil {
+		l.Error(err, "failed to calibrate performance review")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToPerformanceReview(review), nil, nil, nil, ""))
+}
+
+// EmployeeHistory godoc
+// @Summary Get the performance review history of an employee
+// @Description Get the finalized performance reviews of an employee, latest cycle first. Employees can get their own history
+// @Tags Employee
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Employee ID"
+// @Param page query string false "Page"
+// @Param size query string false "Size"
+// @Success 200 {object} view.ListPerformanceReviewResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 403 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /employees/{id}/performance-reviews [get]
+func (h *handler) EmployeeHistory(c *gin.Context) {
+	employeeID := c.Param("id")
+	if employeeID == "" || !model.IsUUIDFromString(employeeID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidEmployeeID, nil, ""))
+		return
+	}
+
+	pagination := model.Pagination{}
+	if err := c.ShouldBindQuery(&pagination); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+	pagination.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":    "performancereview",
+		"method":     "EmployeeHistory",
+		"employeeID": employeeID,
+	})
+
+	userInfo, err := authutils.GetLoggedInUserInfo(c, h.store, h.repo.DB(), h.config)
+	if err != nil {
+		l.Error(err, "failed to get logged in user")
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	if userInfo.UserID != employeeID && !authutils.HasPermission(userInfo.Permissions, model.PermissionPerformanceReviewsRead) {
+		c.JSON(http.StatusForbidden, view.CreateResponse[any](nil, nil, errs.ErrNotAllowedToViewHistory, nil, ""))
+		return
+	}
+
+	reviews, total, err := h.controller.PerformanceReview.EmployeeHistory(employeeID, pagination)
+	if err != nil {
+		l.Error(err, "failed to get performance review history")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToPerformanceReviews(reviews),
+		&view.PaginationResponse{Pagination: pagination, Total: total}, nil, nil, ""))
+}
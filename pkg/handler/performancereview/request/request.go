@@ -0,0 +1,116 @@
+package request
+
+import (
+	"strings"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/handler/performancereview/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type GetListCycleInput struct {
+	model.Pagination
+
+	Status  string `json:"status" form:"status"`
+	Keyword string `json:"keyword" form:"keyword"`
+}
+
+func (i *GetListCycleInput) Validate() error {
+	if i.Status != "" && !model.PerformanceReviewCycleStatus(i.Status).IsValid() {
+		return errs.ErrInvalidStatus
+	}
+
+	return nil
+}
+
+type CreateCycleInput struct {
+	Name string `json:"name" binding:"required"`
+	// StartDate and EndDate are dates in YYYY-MM-DD format, the period the employees are reviewed for
+	StartDate         string `json:"startDate" binding:"required"`
+	EndDate           string `json:"endDate" binding:"required"`
+	PeerReviewEventID string `json:"peerReviewEventID"`
+}
+
+func (i *CreateCycleInput) Validate() error {
+	if strings.TrimSpace(i.Name) == "" {
+		return errs.ErrEmptyName
+	}
+
+	if _, err := time.Parse("2006-01-02", i.StartDate); err != nil {
+		return errs.ErrInvalidStartDate
+	}
+
+	if _, err := time.Parse("2006-01-02", i.EndDate); err != nil {
+		return errs.ErrInvalidEndDate
+	}
+
+	if i.PeerReviewEventID != "" && !model.IsUUIDFromString(i.PeerReviewEventID) {
+		return errs.ErrInvalidPeerReviewID
+	}
+
+	return nil
+}
+
+// GetPeriod returns the start and end date of the cycle
+func (i *CreateCycleInput) GetPeriod() (time.Time, time.Time) {
+	startDate, _ := time.Parse("2006-01-02", i.StartDate)
+	endDate, _ := time.Parse("2006-01-02", i.EndDate)
+	return startDate, endDate
+}
+
+type UpdateCycleStatusInput struct {
+	Status model.PerformanceReviewCycleStatus `json:"status" binding:"required"`
+}
+
+func (i *UpdateCycleStatusInput) Validate() error {
+	if !i.Status.IsValid() {
+		return errs.ErrInvalidStatus
+	}
+
+	return nil
+}
+
+type GetListReviewInput struct {
+	model.Pagination
+
+	ReviewerID string `json:"reviewerID" form:"reviewerID"`
+	Status     string `json:"status" form:"status"`
+}
+
+func (i *GetListReviewInput) Validate() error {
+	if i.ReviewerID != "" && !model.IsUUIDFromString(i.ReviewerID) {
+		return errs.ErrInvalidEmployeeID
+	}
+
+	if i.Status != "" && !model.PerformanceReviewStatus(i.Status).IsValid() {
+		return errs.ErrInvalidReviewStatus
+	}
+
+	return nil
+}
+
+type SubmitAssessmentInput struct {
+	Assessment     []model.PerformanceAssessmentItem `json:"assessment" binding:"required"`
+	ProposedRating model.PerformanceRating           `json:"proposedRating" binding:"required"`
+}
+
+func (i *SubmitAssessmentInput) Validate() error {
+	if !i.ProposedRating.IsValid() {
+		return errs.ErrInvalidRating
+	}
+
+	return model.ValidateAssessment(i.Assessment)
+}
+
+type CalibrateInput struct {
+	Rating model.PerformanceRating `json:"rating" binding:"required"`
+	Note   string                  `json:"note"`
+}
+
+func (i *CalibrateInput) Validate() error {
+	if !i.Rating.IsValid() {
+		return errs.ErrInvalidRating
+	}
+
+	return nil
+}
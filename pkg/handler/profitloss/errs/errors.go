@@ -0,0 +1,37 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/profitloss"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidProjectID = errors.New("invalid project ID")
+	ErrInvalidFrom      = errors.New("invalid from month, must be in YYYY-MM format")
+	ErrInvalidTo        = errors.New("invalid to month, must be in YYYY-MM format")
+	ErrPeriodTooLong    = errors.New("period must not be longer than 24 months")
+)
+
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, profitloss.ErrProjectNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, profitloss.ErrInvalidPeriod):
+		status = http.StatusBadRequest
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
@@ -0,0 +1,8 @@
+package profitloss
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	GetProjectProfitLoss(c *gin.Context)
+	GetCompanyProfitLoss(c *gin.Context)
+}
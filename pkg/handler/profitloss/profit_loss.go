@@ -0,0 +1,141 @@
+package profitloss
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	"github.com/dwarvesf/fortress-api/pkg/controller/profitloss"
+	"github.com/dwarvesf/fortress-api/pkg/handler/profitloss/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/profitloss/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	controller *controller.Controller
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		controller: controller,
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+	}
+}
+
+// GetProjectProfitLoss godoc
+// @Summary Get the profit and loss of a project
+// @Description Get the invoiced and collected revenue, the payroll cost allocated by member allocation, the commissions and the project-tagged expenses of a project by month, with the gross margin. Amounts are in VND
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Project ID or code"
+// @Param from query string false "From month in YYYY-MM format"
+// @Param to query string false "To month in YYYY-MM format"
+// @Success 200 {object} view.ProjectProfitLossResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /projects/{id}/profit-loss [get]
+func (h *handler) GetProjectProfitLoss(c *gin.Context) {
+	projectID := c.Param("id")
+	if projectID == "" {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidProjectID, nil, ""))
+		return
+	}
+
+	input := request.GetProfitLossInput{}
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":   "profitloss",
+		"method":    "GetProjectProfitLoss",
+		"projectID": projectID,
+		"input":     input,
+	})
+
+	from, to := input.GetPeriod(time.Now())
+
+	project, months, err := h.controller.ProfitLoss.GetProjectProfitLoss(profitloss.ProjectInput{
+		ProjectID: projectID,
+		From:      from,
+		To:        to,
+	})
+	if err != nil {
+		l.Error(err, "failed to get project profit and loss")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToProjectProfitLoss(project, months), nil, nil, nil, ""))
+}
+
+// GetCompanyProfitLoss godoc
+// @Summary Get the profit and loss of the company
+// @Description Get the profit and loss of the company by month, rolled up by organization and project type. Amounts are in VND
+// @Tags Dashboard
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param from query string false "From month in YYYY-MM format"
+// @Param to query string false "To month in YYYY-MM format"
+// @Success 200 {object} view.CompanyProfitLossResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /dashboards/profit-loss [get]
+func (h *handler) GetCompanyProfitLoss(c *gin.Context) {
+	input := request.GetProfitLossInput{}
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "profitloss",
+		"method":  "GetCompanyProfitLoss",
+		"input":   input,
+	})
+
+	from, to := input.GetPeriod(time.Now())
+
+	months, err := h.controller.ProfitLoss.GetCompanyProfitLoss(profitloss.CompanyInput{
+		From: from,
+		To:   to,
+	})
+	if err != nil {
+		l.Error(err, "failed to get company profit and loss")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToCompanyProfitLoss(months), nil, nil, nil, ""))
+}
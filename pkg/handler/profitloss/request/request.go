@@ -0,0 +1,51 @@
+package request
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/handler/profitloss/errs"
+)
+
+const (
+	// DefaultPeriodMonths is the number of months returned when the period is not set
+	DefaultPeriodMonths = 6
+	// MaxPeriodMonths is the most months returned at once
+	MaxPeriodMonths = 24
+)
+
+type GetProfitLossInput struct {
+	// From and To are months in YYYY-MM format, the last 6 months up to the current one by default
+	From string `json:"from" form:"from"`
+	To   string `json:"to" form:"to"`
+}
+
+func (i *GetProfitLossInput) Validate() error {
+	if _, err := time.Parse("2006-01", i.From); i.From != "" && err != nil {
+		return errs.ErrInvalidFrom
+	}
+	if _, err := time.Parse("2006-01", i.To); i.To != "" && err != nil {
+		return errs.ErrInvalidTo
+	}
+
+	from, to := i.GetPeriod(time.Now())
+	if !to.Before(from.AddDate(0, MaxPeriodMonths, 0)) {
+		return errs.ErrPeriodTooLong
+	}
+
+	return nil
+}
+
+// GetPeriod returns the first days of the first and last months
+func (i *GetProfitLossInput) GetPeriod(now time.Time) (time.Time, time.Time) {
+	to := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if i.To != "" {
+		to, _ = time.Parse("2006-01", i.To)
+	}
+
+	from := to.AddDate(0, 1-DefaultPeriodMonths, 0)
+	if i.From != "" {
+		from, _ = time.Parse("2006-01", i.From)
+	}
+
+	return from, to
+}
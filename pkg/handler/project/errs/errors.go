@@ -3,6 +3,13 @@ package errs
 import (
 	"errors"
 	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/project"
+	"github.com/dwarvesf/fortress-api/pkg/controller/workunit"
+	"github.com/dwarvesf/fortress-api/pkg/view"
 )
 
 var (
@@ -13,6 +20,7 @@ var (
 	ErrInvalidDeploymentType        = errors.New("invalid deployment type")
 	ErrInvalidStartDate             = errors.New("invalid start date")
 	ErrInvalidEndDate               = errors.New("invalid end date")
+	ErrInvalidAllocationPercentage  = errors.New("allocation percentage must be between 0 and 100")
 	ErrInvalidMemberID              = errors.New("invalid member ID")
 	ErrInvalidSlotID                = errors.New("invalid slot ID")
 	ErrInvalidWorkUnitID            = errors.New("invalid work unit ID")
@@ -69,3 +77,35 @@ func ErrPositionNotFoundWithID(id string) error {
 func ErrStackNotFoundWithID(id string) error {
 	return fmt.Errorf("stack not found: %v", id)
 }
+
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, project.ErrProjectNotFound),
+		errors.Is(err, workunit.ErrProjectNotFound),
+		errors.Is(err, workunit.ErrWorkUnitNotFound),
+		errors.Is(err, workunit.ErrGithubRepoNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, project.ErrInvalidProjectStatus),
+		errors.Is(err, project.ErrSameProjectStatus),
+		errors.Is(err, project.ErrTransitionNotAllowed),
+		errors.Is(err, project.ErrReasonRequired),
+		errors.Is(err, project.ErrStartDateRequired),
+		errors.Is(err, project.ErrEndDateRequired),
+		errors.Is(err, project.ErrInvalidEndDate),
+		errors.Is(err, project.ErrFinalInvoiceRequired),
+		errors.Is(err, workunit.ErrInvalidGithubRepo),
+		errors.Is(err, workunit.ErrWorkUnitNotLinked),
+		errors.Is(err, workunit.ErrWorkUnitArchived):
+		status = http.StatusBadRequest
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
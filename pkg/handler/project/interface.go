@@ -20,8 +20,13 @@ type IHandler interface {
 	UpdateGeneralInfo(c *gin.Context)
 	UpdateMember(c *gin.Context)
 	UpdateProjectStatus(c *gin.Context)
+	GetStatusTransitions(c *gin.Context)
 	UpdateSendingSurveyState(c *gin.Context)
 	UpdateWorkUnit(c *gin.Context)
 	UploadAvatar(c *gin.Context)
 	IcyWeeklyDistribution(c *gin.Context)
+	LinkWorkUnitGithub(c *gin.Context)
+	UnlinkWorkUnitGithub(c *gin.Context)
+	SyncWorkUnitGithub(c *gin.Context)
+	SyncGithubWorkUnits(c *gin.Context)
 }
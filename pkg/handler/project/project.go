@@ -15,6 +15,10 @@ import (
 
 	"github.com/dwarvesf/fortress-api/pkg/config"
 	"github.com/dwarvesf/fortress-api/pkg/controller"
+	"github.com/dwarvesf/fortress-api/pkg/controller/budget"
+	projectctrl "github.com/dwarvesf/fortress-api/pkg/controller/project"
+	"github.com/dwarvesf/fortress-api/pkg/controller/ratecard"
+	"github.com/dwarvesf/fortress-api/pkg/controller/workunit"
 	"github.com/dwarvesf/fortress-api/pkg/handler/project/errs"
 	"github.com/dwarvesf/fortress-api/pkg/handler/project/request"
 	"github.com/dwarvesf/fortress-api/pkg/logger"
@@ -135,13 +139,14 @@ func (h *handler) List(c *gin.Context) {
 
 // UpdateProjectStatus godoc
 // @Summary Update status for project by id
-// @Description Update status for project by id
+// @Description Move a project to another status of its lifecycle. Pausing and closing require a reason, closing requires an end date and the final invoice.
+// @Description Closing a project inactivates its members and work units and stops its surveys, pausing a project notifies its heads
 // @Tags Project
 // @Accept  json
 // @Produce  json
 // @Param Authorization header string true "jwt token"
 // @Param id path string true "Project ID"
-// @Param status body model.ProjectStatus true "Project Status"
+// @Param Body body request.UpdateAccountStatusBody true "Body"
 // @Success 200 {object} view.UpdateProjectStatusResponse
 // @Failure 400 {object} view.ErrorResponse
 // @Failure 404 {object} view.ErrorResponse
@@ -156,10 +161,8 @@ func (h *handler) UpdateProjectStatus(c *gin.Context) {
 
 	var body request.UpdateAccountStatusBody
 	if err := c.ShouldBindJSON(&body); err != nil {
-		if err != nil {
-			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, body, ""))
-			return
-		}
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, body, ""))
+		return
 	}
 
 	l := h.logger.Fields(logger.Fields{
@@ -168,81 +171,73 @@ func (h *handler) UpdateProjectStatus(c *gin.Context) {
 		"body":    body,
 	})
 
-	if !body.ProjectStatus.IsValid() {
-		l.Error(errs.ErrInvalidProjectStatus, "invalid value for ProjectStatus")
-		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidProjectStatus, body, ""))
+	if err := body.Validate(); err != nil {
+		l.Error(err, "invalid input")
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, body, ""))
 		return
 	}
 
-	p, err := h.store.Project.One(h.repo.DB(), projectID, false)
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			l.Error(err, "project not found")
-			c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, errs.ErrProjectNotFound, projectID, ""))
-			return
-		}
-
-		l.Error(err, "failed to get project")
-		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, projectID, ""))
+		l.Error(err, "failed to get user id from context")
+		c.JSON(http.StatusUnauthorized, view.CreateResponse[any](nil, nil, err, nil, ""))
 		return
 	}
 
-	tx, done := h.repo.NewTransaction()
+	p, err := h.controller.Project.UpdateStatus(projectctrl.UpdateStatusInput{
+		ProjectID: projectID,
+		Status:    body.ProjectStatus,
+		Reason:    strings.TrimSpace(body.Reason),
+		StartDate: body.GetStartDate(),
+		EndDate:   body.GetEndDate(),
+		ActorID:   userID,
+		Now:       time.Now(),
+	})
+	if err != nil {
+		l.Error(err, "failed to update project status")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
 
-	p.Status = body.ProjectStatus
-	p.EndDate = nil
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToUpdateProjectStatusResponse(p), nil, nil, nil, ""))
+}
 
-	if body.ProjectStatus == model.ProjectStatusClosed {
-		p.EndDate = new(time.Time)
-		*p.EndDate = time.Now()
+// GetStatusTransitions godoc
+// @Summary Get the status history of a project
+// @Description Get the status transitions of a project with their actor and reason, newest first
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Project ID"
+// @Success 200 {object} view.ListProjectStatusTransitionResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /projects/{id}/status-transitions [get]
+func (h *handler) GetStatusTransitions(c *gin.Context) {
+	projectID := c.Param("id")
+	if projectID == "" || !model.IsUUIDFromString(projectID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidProjectID, nil, ""))
+		return
 	}
 
-	_, err = h.store.Project.UpdateSelectedFieldsByID(tx.DB(), projectID, *p, "status", "end_date")
+	l := h.logger.Fields(logger.Fields{
+		"handler":   "project",
+		"method":    "GetStatusTransitions",
+		"projectID": projectID,
+	})
+
+	transitions, err := h.controller.Project.GetStatusTransitions(projectID)
 	if err != nil {
-		l.Error(err, "failed to update project status")
-		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, done(err), body, ""))
+		l.Error(err, "failed to get project status transitions")
+		errs.ConvertControllerErr(c, err)
 		return
 	}
 
-	// TODO: we can open it when needing automation flow to inactivated project member
-	//if body.ProjectStatus == model.ProjectStatusClosed {
-	//	if err := h.closeProject(tx.DB(), projectID); err != nil {
-	//		l.Error(err, "failed to close project")
-	//		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, done(err), body, ""))
-	//		return
-	//	}
-	//}
-
-	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToUpdateProjectStatusResponse(p), nil, done(nil), nil, ""))
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToProjectStatusTransitions(transitions), nil, nil, nil, ""))
 }
 
-// func (h *handler) closeProject(db *gorm.DB, projectID string) error {
-// 	err := h.store.ProjectMember.UpdateEndDateByProjectID(db, projectID)
-// 	if err != nil {
-// 		h.logger.Error(err, "failed to update end_date by project_id")
-// 		return err
-// 	}
-
-// 	err = h.store.ProjectMember.UpdateSelectedFieldByProjectID(db, projectID,
-// 		model.ProjectMember{Status: model.ProjectMemberStatusInactive},
-// 		"status")
-// 	if err != nil {
-// 		h.logger.Error(err, "failed to update status of project_member by project_id")
-// 		return err
-// 	}
-
-// 	err = h.store.ProjectSlot.UpdateSelectedFieldByProjectID(db, projectID,
-// 		model.ProjectSlot{Status: model.ProjectMemberStatusInactive},
-// 		"status",
-// 	)
-// 	if err != nil {
-// 		h.logger.Error(err, "failed to update status of project_slot by project_id")
-// 		return err
-// 	}
-
-// 	return nil
-// }
-
 // Create godoc
 // @Summary	Create new project
 // @Description	Create new project
@@ -1130,12 +1125,16 @@ func (h *handler) updateProjectMember(db *gorm.DB, p *model.Project, slotID stri
 		}
 
 		updateRate := false
+		updateDiscount := false
 		if authutils.HasPermission(userInfo.Permissions, model.PermissionProjectMembersRateEdit) {
 			if !member.Rate.Equal(input.Rate) {
 				member.Rate = input.Rate
 				updateRate = true
 			}
-			member.Discount = input.Discount
+			if !member.Discount.Equal(input.Discount) {
+				member.Discount = input.Discount
+				updateDiscount = true
+			}
 		}
 
 		_, err = h.store.ProjectMember.UpdateSelectedFieldsByID(db, input.ProjectMemberID.String(), *member,
@@ -1155,6 +1154,15 @@ func (h *handler) updateProjectMember(db *gorm.DB, p *model.Project, slotID stri
 			return nil, err
 		}
 
+		// rate changes from the member form take effect today, effective-dated changes go through the rate history
+		if updateRate || updateDiscount {
+			now := time.Now()
+			if err := h.createMemberRate(db, member, nil, userInfo.UserID, &now); err != nil {
+				h.logger.Fields(logger.Fields{"member": member}).Error(err, "failed to create project member rate")
+				return nil, err
+			}
+		}
+
 		if updateStatus {
 			err = h.controller.Discord.Log(model.LogDiscordInput{
 				Type: "project_member_update_status",
@@ -1262,6 +1270,16 @@ func (h *handler) updateProjectMember(db *gorm.DB, p *model.Project, slotID stri
 				return nil, err
 			}
 
+			if err := h.createMemberRate(db, member, nil, userInfo.UserID, member.StartDate); err != nil {
+				h.logger.Fields(logger.Fields{"member": member}).Error(err, "failed to create project member rate")
+				return nil, err
+			}
+
+			if err := h.createMemberAllocation(db, member, input.AllocationPercentage); err != nil {
+				h.logger.Fields(logger.Fields{"member": member}).Error(err, "failed to create project member allocation")
+				return nil, err
+			}
+
 			err = h.controller.Discord.Log(model.LogDiscordInput{
 				Type: "project_member_add",
 				Data: map[string]interface{}{
@@ -1473,6 +1491,21 @@ func (h *handler) createSlotsAndAssignMembers(db *gorm.DB, p *model.Project, req
 		slot.Discount = req.Discount
 	}
 
+	// slots without rate are charged at the rate of the project rate card
+	var rateCardID *model.UUID
+	if slot.Rate.IsZero() && slot.DeploymentType == model.MemberDeploymentTypeOfficial {
+		startDate := time.Now()
+		if req.GetStartDate() != nil {
+			startDate = *req.GetStartDate()
+		}
+
+		if card, rate := h.getRateCardRate(p.ID.String(), req.SeniorityID, req.Positions, startDate); rate != nil {
+			slot.Rate = rate.Rate
+			slot.Discount = rate.Discount
+			rateCardID = &card.ID
+		}
+	}
+
 	if err := h.store.ProjectSlot.Create(db, slot); err != nil {
 		l.Error(err, "failed to create project slot")
 		return nil, http.StatusInternalServerError, err
@@ -1551,11 +1584,26 @@ func (h *handler) createSlotsAndAssignMembers(db *gorm.DB, p *model.Project, req
 			member.Discount = req.Discount
 		}
 
+		if rateCardID != nil {
+			member.Rate = slot.Rate
+			member.Discount = slot.Discount
+		}
+
 		if err = h.store.ProjectMember.Create(db, member); err != nil {
 			l.Error(err, "failed to create project member")
 			return nil, http.StatusInternalServerError, err
 		}
 
+		if err := h.createMemberRate(db, member, rateCardID, userInfo.UserID, member.StartDate); err != nil {
+			l.Error(err, "failed to create project member rate")
+			return nil, http.StatusInternalServerError, err
+		}
+
+		if err := h.createMemberAllocation(db, member, req.AllocationPercentage); err != nil {
+			l.Error(err, "failed to create project member allocation")
+			return nil, http.StatusInternalServerError, err
+		}
+
 		member.UpsellPerson = upsellPerson
 		slot.ProjectMember = *member
 
@@ -1609,6 +1657,68 @@ func (h *handler) createSlotsAndAssignMembers(db *gorm.DB, p *model.Project, req
 	return slot, http.StatusOK, nil
 }
 
+// createMemberAllocation allocates a new member from its start date until its end date,
+// members without allocations are allocated by their deployment type
+func (h *handler) createMemberAllocation(db *gorm.DB, member *model.ProjectMember, percentage float64) error {
+	if percentage <= 0 || member.EmployeeID.IsZero() {
+		return nil
+	}
+
+	startDate := time.Now()
+	if member.StartDate != nil {
+		startDate = *member.StartDate
+	}
+
+	_, err := h.store.ProjectMemberAllocation.Create(db, &model.ProjectMemberAllocation{
+		ProjectMemberID: member.ID,
+		Percentage:      percentage,
+		StartDate:       startDate,
+		EndDate:         member.EndDate,
+	})
+
+	return err
+}
+
+// createMemberRate records the rate of a member in its pricing history from a date, the start date of the member
+// when it is not set
+func (h *handler) createMemberRate(db *gorm.DB, member *model.ProjectMember, rateCardID *model.UUID, createdBy string, effectiveFrom *time.Time) error {
+	if member.EmployeeID.IsZero() {
+		return nil
+	}
+
+	rate := &model.ProjectMemberRate{
+		ProjectMemberID: member.ID,
+		RateCardID:      rateCardID,
+		Rate:            member.Rate,
+		Discount:        member.Discount,
+		EffectiveFrom:   time.Now(),
+	}
+	if effectiveFrom != nil {
+		rate.EffectiveFrom = *effectiveFrom
+	}
+	if model.IsUUIDFromString(createdBy) {
+		id := model.MustGetUUIDFromString(createdBy)
+		rate.CreatedBy = &id
+	}
+
+	_, err := h.store.ProjectMemberRate.Create(db, rate)
+	return err
+}
+
+// getRateCardRate returns the rate of the project rate card valid at a date for a seniority and positions,
+// nil when the project has no rate card covering them
+func (h *handler) getRateCardRate(projectID string, seniorityID model.UUID, positionIDs []model.UUID, date time.Time) (*model.RateCard, *model.RateCardRate) {
+	card, rate, err := h.controller.RateCard.GetRate(projectID, seniorityID, positionIDs, date)
+	if err != nil {
+		if !errors.Is(err, ratecard.ErrRateNotFound) {
+			h.logger.Fields(logger.Fields{"projectID": projectID}).Error(err, "failed to get rate card rate")
+		}
+		return nil, nil
+	}
+
+	return card, rate
+}
+
 // Details godoc
 // @Summary Get details of a project
 // @Description Get details of a project
@@ -1667,7 +1777,20 @@ func (h *handler) Details(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, view.CreateResponse(view.ToProjectData(rs, userInfo), nil, nil, nil, ""))
+	data := view.ToProjectData(rs, userInfo)
+
+	if rs.Type == model.ProjectTypeFixedCost && authutils.HasPermission(userInfo.Permissions, model.PermissionProjectsBudgetsRead) {
+		now := time.Now()
+		health, err := h.controller.Budget.GetHealth(rs.ID.String(), now)
+		if err != nil && !errors.Is(err, budget.ErrBudgetNotFound) {
+			l.Error(err, "failed to get project budget")
+		}
+		if health != nil {
+			data.Budget = view.ToProjectBudget(health, now)
+		}
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse(data, nil, nil, nil, ""))
 }
 
 // UpdateGeneralInfo godoc
@@ -3103,3 +3226,216 @@ func (h *handler) SyncProjectMemberStatus(c *gin.Context) {
 	pp.Println("synced project member status")
 	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
 }
+
+// LinkWorkUnitGithub godoc
+// @Summary Link a work unit to a GitHub repository
+// @Description Link a work unit to a GitHub repository and sync it right away. The contributors who are active in the project
+// @Description are added to the work unit members by their GitHub account and the main languages to its stacks
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Project ID"
+// @Param workUnitID path string true "Work Unit ID"
+// @Param Body body request.LinkWorkUnitGithubBody true "Body"
+// @Success 200 {object} view.WorkUnitResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /projects/{id}/work-units/{workUnitID}/github [put]
+func (h *handler) LinkWorkUnitGithub(c *gin.Context) {
+	input := request.ArchiveWorkUnitInput{
+		ProjectID:  c.Param("id"),
+		WorkUnitID: c.Param("workUnitID"),
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	var body request.LinkWorkUnitGithubBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, body, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "project",
+		"method":  "LinkWorkUnitGithub",
+		"input":   input,
+		"body":    body,
+	})
+
+	p, ok := h.getEditableWorkUnitProject(c, input.ProjectID)
+	if !ok {
+		return
+	}
+
+	workUnit, err := h.controller.WorkUnit.LinkGithub(workunit.LinkGithubInput{
+		ProjectID:  input.ProjectID,
+		WorkUnitID: input.WorkUnitID,
+		Repo:       body.Repo,
+		Now:        time.Now(),
+	})
+	if err != nil {
+		l.Error(err, "failed to link work unit to github")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToWorkUnit(workUnit, p.Code), nil, nil, nil, ""))
+}
+
+// UnlinkWorkUnitGithub godoc
+// @Summary Unlink a work unit from its GitHub repository
+// @Description Unlink a work unit from its GitHub repository, the members and stacks added by the syncs are kept
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Project ID"
+// @Param workUnitID path string true "Work Unit ID"
+// @Success 200 {object} view.MessageResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /projects/{id}/work-units/{workUnitID}/github [delete]
+func (h *handler) UnlinkWorkUnitGithub(c *gin.Context) {
+	input := request.ArchiveWorkUnitInput{
+		ProjectID:  c.Param("id"),
+		WorkUnitID: c.Param("workUnitID"),
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "project",
+		"method":  "UnlinkWorkUnitGithub",
+		"input":   input,
+	})
+
+	if _, ok := h.getEditableWorkUnitProject(c, input.ProjectID); !ok {
+		return
+	}
+
+	if err := h.controller.WorkUnit.UnlinkGithub(input.ProjectID, input.WorkUnitID); err != nil {
+		l.Error(err, "failed to unlink work unit from github")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
+}
+
+// SyncWorkUnitGithub godoc
+// @Summary Sync a work unit with its GitHub repository
+// @Description Sync the contributors, the commit and pull request activity and the languages of the GitHub repository of a work unit
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Project ID"
+// @Param workUnitID path string true "Work Unit ID"
+// @Success 200 {object} view.WorkUnitResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /projects/{id}/work-units/{workUnitID}/github/sync [post]
+func (h *handler) SyncWorkUnitGithub(c *gin.Context) {
+	input := request.ArchiveWorkUnitInput{
+		ProjectID:  c.Param("id"),
+		WorkUnitID: c.Param("workUnitID"),
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "project",
+		"method":  "SyncWorkUnitGithub",
+		"input":   input,
+	})
+
+	p, ok := h.getEditableWorkUnitProject(c, input.ProjectID)
+	if !ok {
+		return
+	}
+
+	workUnit, err := h.controller.WorkUnit.SyncGithub(input.ProjectID, input.WorkUnitID, time.Now())
+	if err != nil {
+		l.Error(err, "failed to sync work unit with github")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToWorkUnit(workUnit, p.Code), nil, nil, nil, ""))
+}
+
+// SyncGithubWorkUnits godoc
+// @Summary Sync the work units linked to GitHub
+// @Description Sync the active work units of the on-boarding and active projects with their GitHub repository
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Success 200 {object} view.MessageResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /cronjobs/sync-github-work-units [post]
+func (h *handler) SyncGithubWorkUnits(c *gin.Context) {
+	l := h.logger.Fields(logger.Fields{
+		"handler": "project",
+		"method":  "SyncGithubWorkUnits",
+	})
+
+	synced, err := h.controller.WorkUnit.SyncAllGithub(time.Now())
+	if err != nil {
+		l.Error(err, "failed to sync work units with github")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	l.Infof("synced %d work units with github", synced)
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
+}
+
+// getEditableWorkUnitProject gets a project whose work units the logged in user can edit, the error response is written when false
+func (h *handler) getEditableWorkUnitProject(c *gin.Context, projectID string) (*model.Project, bool) {
+	userInfo, err := authutils.GetLoggedInUserInfo(c, h.store, h.repo.DB(), h.config)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return nil, false
+	}
+
+	p, err := h.store.Project.One(h.repo.DB(), projectID, true)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, errs.ErrProjectNotFound, nil, ""))
+			return nil, false
+		}
+
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return nil, false
+	}
+
+	if authutils.HasPermission(userInfo.Permissions, model.PermissionProjectWorkUnitsEditFullAccess) {
+		return p, true
+	}
+
+	if _, ok := userInfo.Projects[p.ID]; !ok || !model.IsUserActiveInProject(userInfo.UserID, p.ProjectMembers) {
+		c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, errs.ErrProjectNotFound, nil, ""))
+		return nil, false
+	}
+
+	for _, v := range p.Heads {
+		if v.IsLead() && v.EmployeeID.String() == userInfo.UserID {
+			return p, true
+		}
+	}
+
+	c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, errs.ErrMemberIsNotProjectLead, nil, ""))
+	return nil, false
+}
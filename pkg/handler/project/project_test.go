@@ -174,7 +174,6 @@ func TestHandler_UpdateProjectStatus(t *testing.T) {
 	loggerMock := logger.NewLogrusLogger()
 	serviceMock := service.New(&cfg, nil, nil)
 	storeMock := store.New()
-	controllerMock := controller.New(nil, nil, nil, nil, nil, nil)
 
 	tests := []struct {
 		name             string
@@ -190,7 +189,29 @@ func TestHandler_UpdateProjectStatus(t *testing.T) {
 			wantErr:          false,
 			wantResponsePath: "testdata/update_project_status/200.json",
 			request: request.UpdateAccountStatusBody{
-				ProjectStatus: "active",
+				ProjectStatus: "paused",
+				Reason:        "client budget is on hold",
+			},
+			id: "8dc3be2e-19a4-4942-8a79-56db391a0b15",
+		},
+		{
+			name:             "failed_transition_not_allowed",
+			wantCode:         http.StatusBadRequest,
+			wantErr:          true,
+			wantResponsePath: "testdata/update_project_status/transition_not_allowed.json",
+			request: request.UpdateAccountStatusBody{
+				ProjectStatus: "on-boarding",
+			},
+			id: "8dc3be2e-19a4-4942-8a79-56db391a0b15",
+		},
+		{
+			name:             "failed_reason_required",
+			wantCode:         http.StatusBadRequest,
+			wantErr:          true,
+			wantResponsePath: "testdata/update_project_status/reason_required.json",
+			request: request.UpdateAccountStatusBody{
+				ProjectStatus: "closed",
+				EndDate:       "2023-07-31",
 			},
 			id: "8dc3be2e-19a4-4942-8a79-56db391a0b15",
 		},
@@ -249,6 +270,7 @@ func TestHandler_UpdateProjectStatus(t *testing.T) {
 				ctx.Params = gin.Params{gin.Param{Key: "id", Value: tt.id}}
 				ctx.Request = httptest.NewRequest("POST", fmt.Sprintf("/api/v1/projects/%s/status", tt.id), bodyReader)
 				ctx.Request.Header.Set("Authorization", testToken)
+				controllerMock := controller.New(storeMock, txRepo, serviceMock, nil, loggerMock, &cfg)
 				metadataHandler := New(controllerMock, storeMock, txRepo, serviceMock, loggerMock, &cfg)
 
 				metadataHandler.UpdateProjectStatus(ctx)
@@ -61,6 +61,44 @@ func (i UpdateProjectGeneralInfoInput) Validate() error {
 
 type UpdateAccountStatusBody struct {
 	ProjectStatus model.ProjectStatus `json:"status"`
+	// Reason is required to pause or close a project
+	Reason string `json:"reason"`
+	// StartDate and EndDate are dates in YYYY-MM-DD format, StartDate is required to activate a project
+	// without start date and EndDate to close a project without end date
+	StartDate string `json:"startDate"`
+	EndDate   string `json:"endDate"`
+}
+
+func (i *UpdateAccountStatusBody) Validate() error {
+	if !i.ProjectStatus.IsValid() {
+		return errs.ErrInvalidProjectStatus
+	}
+	if _, err := time.Parse("2006-01-02", i.StartDate); i.StartDate != "" && err != nil {
+		return errs.ErrInvalidStartDate
+	}
+	if _, err := time.Parse("2006-01-02", i.EndDate); i.EndDate != "" && err != nil {
+		return errs.ErrInvalidEndDate
+	}
+
+	return nil
+}
+
+func (i *UpdateAccountStatusBody) GetStartDate() *time.Time {
+	date, err := time.Parse("2006-01-02", i.StartDate)
+	if i.StartDate == "" || err != nil {
+		return nil
+	}
+
+	return &date
+}
+
+func (i *UpdateAccountStatusBody) GetEndDate() *time.Time {
+	date, err := time.Parse("2006-01-02", i.EndDate)
+	if i.EndDate == "" || err != nil {
+		return nil
+	}
+
+	return &date
 }
 
 func (i *GetListProjectInput) StandardizeInput() {
@@ -191,9 +229,15 @@ type UpdateMemberInput struct {
 	Discount             decimal.Decimal `form:"discount" json:"discount"`
 	IsLead               bool            `form:"isLead" json:"isLead"`
 	Note                 string          `form:"note" json:"note"`
+	// AllocationPercentage is the share of the employee's time the new membership takes, by deployment type when it is 0
+	AllocationPercentage float64 `form:"allocationPercentage" json:"allocationPercentage"`
 }
 
 func (i *UpdateMemberInput) Validate() error {
+	if i.AllocationPercentage < 0 || i.AllocationPercentage > model.MaxAllocationPercentage {
+		return errs.ErrInvalidAllocationPercentage
+	}
+
 	if i.DeploymentType != "" && !model.DeploymentType(i.DeploymentType).IsValid() {
 		return errs.ErrInvalidDeploymentType
 	}
@@ -258,9 +302,15 @@ type AssignMemberInput struct {
 	UpsellPersonID       model.UUID      `form:"upsellPersonID" json:"upsellPersonID"`
 	UpsellCommissionRate decimal.Decimal `form:"upsellCommissionRate" json:"upsellCommissionRate"`
 	Note                 string          `form:"note" json:"note"`
+	// AllocationPercentage is the share of the employee's time the new membership takes, by deployment type when it is 0
+	AllocationPercentage float64 `form:"allocationPercentage" json:"allocationPercentage"`
 }
 
 func (i *AssignMemberInput) Validate() error {
+	if i.AllocationPercentage < 0 || i.AllocationPercentage > model.MaxAllocationPercentage {
+		return errs.ErrInvalidAllocationPercentage
+	}
+
 	if i.DeploymentType == "" || !model.DeploymentType(i.DeploymentType).IsValid() {
 		return errs.ErrInvalidDeploymentType
 	}
@@ -509,6 +559,11 @@ func (i *ArchiveWorkUnitInput) Validate() error {
 	return nil
 }
 
+type LinkWorkUnitGithubBody struct {
+	// Repo is owner/name or the GitHub URL of the repository
+	Repo string `json:"repo" form:"repo" binding:"required"`
+}
+
 type GetListWorkUnitInput struct {
 	ProjectID string
 	Query     GetListWorkUnitQuery
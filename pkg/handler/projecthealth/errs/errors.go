@@ -0,0 +1,40 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/projecthealth"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidProjectID = errors.New("invalid project ID")
+	ErrInvalidWeek      = errors.New("invalid week, must be a date in YYYY-MM-DD format")
+	ErrInvalidStatus    = errors.New("invalid project health status")
+	ErrInvalidWeeks     = errors.New("weeks must be between 1 and 52")
+	ErrEmptyWeights     = errors.New("weights must not be empty")
+)
+
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, projecthealth.ErrProjectNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, projecthealth.ErrInvalidSignal),
+		errors.Is(err, projecthealth.ErrInvalidWeight),
+		errors.Is(err, projecthealth.ErrNoWeight):
+		status = http.StatusBadRequest
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
@@ -0,0 +1,11 @@
+package projecthealth
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	GetPortfolio(c *gin.Context)
+	GetProjectHealth(c *gin.Context)
+	GetWeights(c *gin.Context)
+	UpdateWeights(c *gin.Context)
+	ComputeWeeklyScores(c *gin.Context)
+}
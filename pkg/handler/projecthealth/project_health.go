@@ -0,0 +1,234 @@
+package projecthealth
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	"github.com/dwarvesf/fortress-api/pkg/controller/projecthealth"
+	"github.com/dwarvesf/fortress-api/pkg/handler/projecthealth/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/projecthealth/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	controller *controller.Controller
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		controller: controller,
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+	}
+}
+
+// GetPortfolio godoc
+// @Summary Get the health of all projects
+// @Description Get the weekly health scores of the projects, the least healthy first, with the score of the week before and the breakdown by signal
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param week query string false "Any day of the week in YYYY-MM-DD format, the latest scored week by default"
+// @Param status query string false "Health status: green, yellow or red"
+// @Success 200 {object} view.ProjectHealthPortfolioResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /project-health [get]
+func (h *handler) GetPortfolio(c *gin.Context) {
+	input := request.GetPortfolioInput{}
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "projecthealth",
+		"method":  "GetPortfolio",
+		"input":   input,
+	})
+
+	portfolio, err := h.controller.ProjectHealth.GetPortfolio(projecthealth.PortfolioInput{
+		Week:   input.GetWeek(),
+		Status: input.Status,
+	})
+	if err != nil {
+		l.Error(err, "failed to get project health portfolio")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToProjectHealthPortfolio(portfolio), nil, nil, nil, ""))
+}
+
+// GetProjectHealth godoc
+// @Summary Get the health of a project
+// @Description Get the weekly health scores of a project, the most recent week first, with how each signal contributes to the score
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Project ID or code"
+// @Param weeks query int false "Number of weeks, 12 by default"
+// @Success 200 {object} view.ProjectHealthResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /projects/{id}/health [get]
+func (h *handler) GetProjectHealth(c *gin.Context) {
+	projectID := c.Param("id")
+	if projectID == "" {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidProjectID, nil, ""))
+		return
+	}
+
+	input := request.GetProjectHealthInput{}
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":   "projecthealth",
+		"method":    "GetProjectHealth",
+		"projectID": projectID,
+		"input":     input,
+	})
+
+	project, scores, err := h.controller.ProjectHealth.GetProjectHealth(projectID, input.GetWeeks())
+	if err != nil {
+		l.Error(err, "failed to get project health")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToProjectHealth(project, scores), nil, nil, nil, ""))
+}
+
+// GetWeights godoc
+// @Summary Get the weights of the project health signals
+// @Description Get the weight of every signal in the project health score
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Success 200 {object} view.ProjectHealthWeightsResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /project-health/weights [get]
+func (h *handler) GetWeights(c *gin.Context) {
+	l := h.logger.Fields(logger.Fields{
+		"handler": "projecthealth",
+		"method":  "GetWeights",
+	})
+
+	weights, err := h.controller.ProjectHealth.GetWeights()
+	if err != nil {
+		l.Error(err, "failed to get project health weights")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToProjectHealthWeights(weights), nil, nil, nil, ""))
+}
+
+// UpdateWeights godoc
+// @Summary Update the weights of the project health signals
+// @Description Update the weights of some signals, the weights are relative to each other and apply from the next computed scores
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param Body body request.UpdateWeightsInput true "Body"
+// @Success 200 {object} view.ProjectHealthWeightsResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /project-health/weights [put]
+func (h *handler) UpdateWeights(c *gin.Context) {
+	input := request.UpdateWeightsInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "projecthealth",
+		"method":  "UpdateWeights",
+		"input":   input,
+	})
+
+	weights, err := h.controller.ProjectHealth.UpdateWeights(input.ToMap())
+	if err != nil {
+		l.Error(err, "failed to update project health weights")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToProjectHealthWeights(weights), nil, nil, nil, ""))
+}
+
+// ComputeWeeklyScores godoc
+// @Summary Compute the weekly project health scores
+// @Description Score the active projects for the current week and post the projects which turned red to Discord
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Success 200 {object} view.MessageResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /cronjobs/project-health-scores [post]
+func (h *handler) ComputeWeeklyScores(c *gin.Context) {
+	l := h.logger.Fields(logger.Fields{
+		"handler": "projecthealth",
+		"method":  "ComputeWeeklyScores",
+	})
+
+	now := time.Now()
+	scores, err := h.controller.ProjectHealth.ComputeWeeklyScores(now)
+	if err != nil {
+		l.Error(err, "failed to compute project health scores")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	turnedRed, err := h.controller.ProjectHealth.SendRedDigest(now)
+	if err != nil {
+		l.Error(err, "failed to send project health digest")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	l.Infof("scored %d projects, %d turned red", len(scores), turnedRed)
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
+}
@@ -0,0 +1,88 @@
+package request
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/handler/projecthealth/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+const (
+	// DefaultWeeks is the number of weeks of the drill-down when it is not set
+	DefaultWeeks = 12
+	// MaxWeeks is the most weeks of the drill-down returned at once
+	MaxWeeks = 52
+)
+
+type GetPortfolioInput struct {
+	// Week is any day of the week in YYYY-MM-DD format, the latest scored week by default
+	Week   string                    `json:"week" form:"week"`
+	Status model.ProjectHealthStatus `json:"status" form:"status"`
+}
+
+func (i *GetPortfolioInput) Validate() error {
+	if _, err := time.Parse("2006-01-02", i.Week); i.Week != "" && err != nil {
+		return errs.ErrInvalidWeek
+	}
+	if i.Status != "" && !i.Status.IsValid() {
+		return errs.ErrInvalidStatus
+	}
+
+	return nil
+}
+
+func (i *GetPortfolioInput) GetWeek() *time.Time {
+	if i.Week == "" {
+		return nil
+	}
+
+	week, _ := time.Parse("2006-01-02", i.Week)
+	return &week
+}
+
+type GetProjectHealthInput struct {
+	Weeks int `json:"weeks" form:"weeks"`
+}
+
+func (i *GetProjectHealthInput) Validate() error {
+	if i.Weeks < 0 || i.Weeks > MaxWeeks {
+		return errs.ErrInvalidWeeks
+	}
+
+	return nil
+}
+
+func (i *GetProjectHealthInput) GetWeeks() int {
+	if i.Weeks == 0 {
+		return DefaultWeeks
+	}
+
+	return i.Weeks
+}
+
+type UpdateWeightsInput struct {
+	Weights []WeightInput `json:"weights" binding:"required,dive"`
+}
+
+type WeightInput struct {
+	Signal model.ProjectHealthSignal `json:"signal" binding:"required"`
+	// Weight is relative to the weights of the other signals, 0 leaves the signal out of the score
+	Weight float64 `json:"weight" binding:"min=0"`
+}
+
+func (i *UpdateWeightsInput) Validate() error {
+	if len(i.Weights) == 0 {
+		return errs.ErrEmptyWeights
+	}
+
+	return nil
+}
+
+func (i *UpdateWeightsInput) ToMap() map[model.ProjectHealthSignal]float64 {
+	rs := make(map[model.ProjectHealthSignal]float64, len(i.Weights))
+	for _, w := range i.Weights {
+		rs[w.Signal] = w.Weight
+	}
+
+	return rs
+}
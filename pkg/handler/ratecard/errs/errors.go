@@ -0,0 +1,56 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/ratecard"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidRateCardID      = errors.New("invalid rate card ID")
+	ErrInvalidProjectID       = errors.New("invalid project ID")
+	ErrInvalidMemberID        = errors.New("invalid project member ID")
+	ErrInvalidRateID          = errors.New("invalid rate change ID")
+	ErrInvalidClientID        = errors.New("invalid client ID")
+	ErrInvalidPositionID      = errors.New("invalid position ID")
+	ErrInvalidSeniorityID     = errors.New("invalid seniority ID")
+	ErrInvalidStartDate       = errors.New("invalid start date")
+	ErrInvalidEndDate         = errors.New("invalid end date")
+	ErrInvalidEffectiveFrom   = errors.New("invalid effective date")
+	ErrInvalidDays            = errors.New("days must be between 1 and 365")
+	ErrEmptyRateCardName      = errors.New("rate card name is required")
+	ErrRateRequiredOrRateCard = errors.New("rate is required unless the rate card is used")
+)
+
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, ratecard.ErrRateCardNotFound),
+		errors.Is(err, ratecard.ErrRateNotFound),
+		errors.Is(err, ratecard.ErrClientNotFound),
+		errors.Is(err, ratecard.ErrProjectNotFound),
+		errors.Is(err, ratecard.ErrProjectMemberNotFound),
+		errors.Is(err, ratecard.ErrMemberRateNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, ratecard.ErrInvalidOwner),
+		errors.Is(err, ratecard.ErrInvalidPeriod),
+		errors.Is(err, ratecard.ErrInvalidRate),
+		errors.Is(err, ratecard.ErrDuplicatedRate),
+		errors.Is(err, ratecard.ErrRateOutsideMembership),
+		errors.Is(err, ratecard.ErrMemberRateApplied):
+		status = http.StatusBadRequest
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
@@ -0,0 +1,17 @@
+package ratecard
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	List(c *gin.Context)
+	Get(c *gin.Context)
+	Create(c *gin.Context)
+	Update(c *gin.Context)
+	Delete(c *gin.Context)
+	GetRenewals(c *gin.Context)
+
+	ListMemberRates(c *gin.Context)
+	ChangeMemberRate(c *gin.Context)
+	DeleteMemberRate(c *gin.Context)
+	ApplyMemberRates(c *gin.Context)
+}
@@ -0,0 +1,520 @@
+package ratecard
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	"github.com/dwarvesf/fortress-api/pkg/controller/ratecard"
+	"github.com/dwarvesf/fortress-api/pkg/handler/ratecard/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/ratecard/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	ratecardstore "github.com/dwarvesf/fortress-api/pkg/store/ratecard"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	controller *controller.Controller
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		controller: controller,
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+	}
+}
+
+// List godoc
+// @Summary Get list of rate cards
+// @Description Get the rate cards of a client or a project, newest first
+// @Tags Rate Card
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param clientID query string false "Client ID"
+// @Param projectID query string false "Project ID"
+// @Success 200 {object} view.ListRateCardResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /rate-cards [get]
+func (h *handler) List(c *gin.Context) {
+	input := request.GetListRateCardInput{}
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "ratecard",
+		"method":  "List",
+		"input":   input,
+	})
+
+	cards, err := h.controller.RateCard.List(ratecardstore.GetListFilter{
+		ClientID:  input.ClientID,
+		ProjectID: input.ProjectID,
+	})
+	if err != nil {
+		l.Error(err, "failed to get rate cards")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToRateCards(cards), nil, nil, nil, ""))
+}
+
+// Get godoc
+// @Summary Get a rate card
+// @Description Get a rate card with its rates by seniority and position
+// @Tags Rate Card
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Rate card ID"
+// @Success 200 {object} view.RateCardResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /rate-cards/{id} [get]
+func (h *handler) Get(c *gin.Context) {
+	id, ok := h.getRateCardID(c)
+	if !ok {
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "ratecard",
+		"method":  "Get",
+		"id":      id,
+	})
+
+	card, err := h.controller.RateCard.Get(id)
+	if err != nil {
+		l.Error(err, "failed to get rate card")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToRateCard(card), nil, nil, nil, ""))
+}
+
+// Create godoc
+// @Summary Create a rate card
+// @Description Create the rates of a client or a project by seniority and position, a rate without position applies to all positions
+// @Tags Rate Card
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param Body body request.RateCardInput true "Body"
+// @Success 200 {object} view.RateCardResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /rate-cards [post]
+func (h *handler) Create(c *gin.Context) {
+	input := request.RateCardInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "ratecard",
+		"method":  "Create",
+		"input":   input,
+	})
+
+	card, err := h.controller.RateCard.Create(toRateCardInput(input))
+	if err != nil {
+		l.Error(err, "failed to create rate card")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToRateCard(card), nil, nil, nil, ""))
+}
+
+// Update godoc
+// @Summary Update a rate card
+// @Description Replace a rate card and its rates, the rates already charged to project members are kept in their pricing history
+// @Tags Rate Card
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Rate card ID"
+// @Param Body body request.RateCardInput true "Body"
+// @Success 200 {object} view.RateCardResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /rate-cards/{id} [put]
+func (h *handler) Update(c *gin.Context) {
+	id, ok := h.getRateCardID(c)
+	if !ok {
+		return
+	}
+
+	input := request.RateCardInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "ratecard",
+		"method":  "Update",
+		"id":      id,
+		"input":   input,
+	})
+
+	card, err := h.controller.RateCard.Update(id, toRateCardInput(input))
+	if err != nil {
+		l.Error(err, "failed to update rate card")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToRateCard(card), nil, nil, nil, ""))
+}
+
+// Delete godoc
+// @Summary Delete a rate card
+// @Description Delete a rate card
+// @Tags Rate Card
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Rate card ID"
+// @Success 200 {object} view.MessageResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /rate-cards/{id} [delete]
+func (h *handler) Delete(c *gin.Context) {
+	id, ok := h.getRateCardID(c)
+	if !ok {
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "ratecard",
+		"method":  "Delete",
+		"id":      id,
+	})
+
+	if err := h.controller.RateCard.Delete(id); err != nil {
+		l.Error(err, "failed to delete rate card")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
+}
+
+// GetRenewals godoc
+// @Summary Get upcoming rate card renewals
+// @Description Get the rate cards whose contract rates end in the next days, ordered by end date
+// @Tags Rate Card
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param days query int false "Number of days looked ahead, 60 by default"
+// @Success 200 {object} view.ListRateCardRenewalResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /rate-cards/renewals [get]
+func (h *handler) GetRenewals(c *gin.Context) {
+	input := request.GetRenewalsInput{}
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "ratecard",
+		"method":  "GetRenewals",
+		"input":   input,
+	})
+
+	now := time.Now()
+	cards, err := h.controller.RateCard.GetRenewals(ratecard.RenewalInput{
+		Days: input.GetDays(),
+		Now:  now,
+	})
+	if err != nil {
+		l.Error(err, "failed to get rate card renewals")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToRateCardRenewals(cards, now), nil, nil, nil, ""))
+}
+
+// ListMemberRates godoc
+// @Summary Get the pricing history of a project member
+// @Description Get the rate changes of a project member ordered by effective date
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Project ID"
+// @Param memberID path string true "Project member ID"
+// @Success 200 {object} view.ListProjectMemberRateResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /projects/{id}/members/{memberID}/rates [get]
+func (h *handler) ListMemberRates(c *gin.Context) {
+	projectID, memberID, ok := h.getMemberParams(c)
+	if !ok {
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":   "ratecard",
+		"method":    "ListMemberRates",
+		"projectID": projectID,
+		"memberID":  memberID,
+	})
+
+	rates, err := h.controller.RateCard.ListMemberRates(projectID, memberID)
+	if err != nil {
+		l.Error(err, "failed to get project member rates")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToProjectMemberRates(rates), nil, nil, nil, ""))
+}
+
+// ChangeMemberRate godoc
+// @Summary Change the rate of a project member
+// @Description Change the rate of a project member from a date, the rate can be taken from the project rate card. Past rates are kept to explain the invoices
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Project ID"
+// @Param memberID path string true "Project member ID"
+// @Param Body body request.ChangeMemberRateInput true "Body"
+// @Success 200 {object} view.ProjectMemberRateResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /projects/{id}/members/{memberID}/rates [post]
+func (h *handler) ChangeMemberRate(c *gin.Context) {
+	projectID, memberID, ok := h.getMemberParams(c)
+	if !ok {
+		return
+	}
+
+	input := request.ChangeMemberRateInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":   "ratecard",
+		"method":    "ChangeMemberRate",
+		"projectID": projectID,
+		"memberID":  memberID,
+		"input":     input,
+	})
+
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		l.Error(err, "failed to get user id from context")
+		c.JSON(http.StatusUnauthorized, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	var createdBy *model.UUID
+	if model.IsUUIDFromString(userID) {
+		id := model.MustGetUUIDFromString(userID)
+		createdBy = &id
+	}
+
+	rate, err := h.controller.RateCard.ChangeMemberRate(ratecard.MemberRateInput{
+		ProjectID:     projectID,
+		MemberID:      memberID,
+		Rate:          input.Rate,
+		Discount:      input.Discount,
+		UseRateCard:   input.UseRateCard,
+		EffectiveFrom: input.GetEffectiveFrom(),
+		Note:          strings.TrimSpace(input.Note),
+		CreatedBy:     createdBy,
+		Now:           time.Now(),
+	})
+	if err != nil {
+		l.Error(err, "failed to change project member rate")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToProjectMemberRate(rate), nil, nil, nil, ""))
+}
+
+// DeleteMemberRate godoc
+// @Summary Cancel a rate change of a project member
+// @Description Cancel a rate change of a project member which has not taken effect yet
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Project ID"
+// @Param memberID path string true "Project member ID"
+// @Param rateID path string true "Rate change ID"
+// @Success 200 {object} view.MessageResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /projects/{id}/members/{memberID}/rates/{rateID} [delete]
+func (h *handler) DeleteMemberRate(c *gin.Context) {
+	projectID, memberID, ok := h.getMemberParams(c)
+	if !ok {
+		return
+	}
+
+	rateID := c.Param("rateID")
+	if rateID == "" || !model.IsUUIDFromString(rateID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidRateID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":   "ratecard",
+		"method":    "DeleteMemberRate",
+		"projectID": projectID,
+		"memberID":  memberID,
+		"rateID":    rateID,
+	})
+
+	if err := h.controller.RateCard.DeleteMemberRate(projectID, memberID, rateID, time.Now()); err != nil {
+		l.Error(err, "failed to delete project member rate")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
+}
+
+// ApplyMemberRates godoc
+// @Summary Apply the rate changes of project members
+// @Description Set the current rate of the project members to their rate change in effect today
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Success 200 {object} view.MessageResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /cronjobs/apply-member-rates [post]
+func (h *handler) ApplyMemberRates(c *gin.Context) {
+	l := h.logger.Fields(logger.Fields{
+		"handler": "ratecard",
+		"method":  "ApplyMemberRates",
+	})
+
+	applied, err := h.controller.RateCard.ApplyMemberRates(time.Now())
+	if err != nil {
+		l.Error(err, "failed to apply project member rates")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	l.Infof("applied %d project member rate changes", applied)
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
+}
+
+func toRateCardInput(input request.RateCardInput) ratecard.RateCardInput {
+	rs := ratecard.RateCardInput{
+		ClientID:  input.GetClientID(),
+		ProjectID: input.GetProjectID(),
+		Name:      strings.TrimSpace(input.Name),
+		StartDate: input.GetStartDate(),
+		EndDate:   input.GetEndDate(),
+		Note:      strings.TrimSpace(input.Note),
+		Rates:     make([]ratecard.RateInput, 0, len(input.Rates)),
+	}
+
+	for _, r := range input.Rates {
+		rs.Rates = append(rs.Rates, ratecard.RateInput{
+			SeniorityID: model.MustGetUUIDFromString(r.SeniorityID),
+			PositionID:  r.GetPositionID(),
+			Rate:        r.Rate,
+			Discount:    r.Discount,
+		})
+	}
+
+	return rs
+}
+
+func (h *handler) getRateCardID(c *gin.Context) (string, bool) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidRateCardID, nil, ""))
+		return "", false
+	}
+
+	return id, true
+}
+
+func (h *handler) getMemberParams(c *gin.Context) (string, string, bool) {
+	projectID := c.Param("id")
+	if projectID == "" || !model.IsUUIDFromString(projectID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidProjectID, nil, ""))
+		return "", "", false
+	}
+
+	memberID := c.Param("memberID")
+	if memberID == "" || !model.IsUUIDFromString(memberID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidMemberID, nil, ""))
+		return "", "", false
+	}
+
+	return projectID, memberID, true
+}
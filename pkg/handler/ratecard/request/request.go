@@ -0,0 +1,165 @@
+package request
+
+import (
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dwarvesf/fortress-api/pkg/handler/ratecard/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+const (
+	// DefaultRenewalDays is the number of days looked ahead for rate card renewals
+	DefaultRenewalDays = 60
+	// MaxRenewalDays is the most days looked ahead for rate card renewals
+	MaxRenewalDays = 365
+)
+
+type GetListRateCardInput struct {
+	ClientID  string `json:"clientID" form:"clientID"`
+	ProjectID string `json:"projectID" form:"projectID"`
+}
+
+func (i *GetListRateCardInput) Validate() error {
+	if i.ClientID != "" && !model.IsUUIDFromString(i.ClientID) {
+		return errs.ErrInvalidClientID
+	}
+	if i.ProjectID != "" && !model.IsUUIDFromString(i.ProjectID) {
+		return errs.ErrInvalidProjectID
+	}
+
+	return nil
+}
+
+type RateCardInput struct {
+	// ClientID or ProjectID is the owner of the rate card
+	ClientID  string `json:"clientID"`
+	ProjectID string `json:"projectID"`
+	Name      string `json:"name" binding:"required"`
+	// StartDate and EndDate are dates in YYYY-MM-DD format, EndDate is the renewal date of the contract rates
+	StartDate string      `json:"startDate" binding:"required"`
+	EndDate   string      `json:"endDate"`
+	Note      string      `json:"note"`
+	Rates     []RateInput `json:"rates"`
+}
+
+type RateInput struct {
+	SeniorityID string `json:"seniorityID" binding:"required"`
+	// PositionID is empty when the rate applies to all positions
+	PositionID string          `json:"positionID"`
+	Rate       decimal.Decimal `json:"rate"`
+	Discount   decimal.Decimal `json:"discount"`
+}
+
+func (i *RateCardInput) Validate() error {
+	if strings.TrimSpace(i.Name) == "" {
+		return errs.ErrEmptyRateCardName
+	}
+	if i.ClientID != "" && !model.IsUUIDFromString(i.ClientID) {
+		return errs.ErrInvalidClientID
+	}
+	if i.ProjectID != "" && !model.IsUUIDFromString(i.ProjectID) {
+		return errs.ErrInvalidProjectID
+	}
+	if _, err := time.Parse("2006-01-02", i.StartDate); err != nil {
+		return errs.ErrInvalidStartDate
+	}
+	if _, err := time.Parse("2006-01-02", i.EndDate); i.EndDate != "" && err != nil {
+		return errs.ErrInvalidEndDate
+	}
+
+	for _, r := range i.Rates {
+		if !model.IsUUIDFromString(r.SeniorityID) {
+			return errs.ErrInvalidSeniorityID
+		}
+		if r.PositionID != "" && !model.IsUUIDFromString(r.PositionID) {
+			return errs.ErrInvalidPositionID
+		}
+	}
+
+	return nil
+}
+
+func (i *RateCardInput) GetClientID() *model.UUID {
+	return toUUID(i.ClientID)
+}
+
+func (i *RateCardInput) GetProjectID() *model.UUID {
+	return toUUID(i.ProjectID)
+}
+
+func (i *RateCardInput) GetStartDate() time.Time {
+	date, _ := time.Parse("2006-01-02", i.StartDate)
+	return date
+}
+
+func (i *RateCardInput) GetEndDate() *time.Time {
+	date, err := time.Parse("2006-01-02", i.EndDate)
+	if i.EndDate == "" || err != nil {
+		return nil
+	}
+
+	return &date
+}
+
+func (r *RateInput) GetPositionID() *model.UUID {
+	return toUUID(r.PositionID)
+}
+
+type GetRenewalsInput struct {
+	// Days is the number of days looked ahead, 60 by default
+	Days int `json:"days" form:"days"`
+}
+
+func (i *GetRenewalsInput) Validate() error {
+	if i.Days < 0 || i.Days > MaxRenewalDays {
+		return errs.ErrInvalidDays
+	}
+
+	return nil
+}
+
+func (i *GetRenewalsInput) GetDays() int {
+	if i.Days == 0 {
+		return DefaultRenewalDays
+	}
+
+	return i.Days
+}
+
+type ChangeMemberRateInput struct {
+	Rate     decimal.Decimal `json:"rate"`
+	Discount decimal.Decimal `json:"discount"`
+	// UseRateCard takes the rate and the discount from the project rate card
+	UseRateCard bool `json:"useRateCard"`
+	// EffectiveFrom is a date in YYYY-MM-DD format
+	EffectiveFrom string `json:"effectiveFrom" binding:"required"`
+	Note          string `json:"note"`
+}
+
+func (i *ChangeMemberRateInput) Validate() error {
+	if _, err := time.Parse("2006-01-02", i.EffectiveFrom); err != nil {
+		return errs.ErrInvalidEffectiveFrom
+	}
+	if !i.UseRateCard && !i.Rate.IsPositive() {
+		return errs.ErrRateRequiredOrRateCard
+	}
+
+	return nil
+}
+
+func (i *ChangeMemberRateInput) GetEffectiveFrom() time.Time {
+	date, _ := time.Parse("2006-01-02", i.EffectiveFrom)
+	return date
+}
+
+func toUUID(s string) *model.UUID {
+	if s == "" || !model.IsUUIDFromString(s) {
+		return nil
+	}
+
+	id := model.MustGetUUIDFromString(s)
+	return &id
+}
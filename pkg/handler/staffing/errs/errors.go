@@ -0,0 +1,53 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/staffing"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidStaffingRequestID = errors.New("invalid staffing request ID")
+	ErrInvalidCandidateID       = errors.New("invalid candidate ID")
+	ErrInvalidProjectID         = errors.New("invalid project ID")
+	ErrInvalidProjectSlotID     = errors.New("invalid project slot ID")
+	ErrInvalidSeniorityID       = errors.New("invalid seniority ID")
+	ErrInvalidStackID           = errors.New("invalid stack ID")
+	ErrInvalidStartDate         = errors.New("invalid start date")
+	ErrInvalidStatus            = errors.New("invalid status")
+	ErrInvalidLimit             = errors.New("limit must be between 1 and 20")
+)
+
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, staffing.ErrStaffingRequestNotFound),
+		errors.Is(err, staffing.ErrCandidateNotFound),
+		errors.Is(err, staffing.ErrProjectSlotNotFound),
+		errors.Is(err, staffing.ErrSeniorityNotFound),
+		errors.Is(err, staffing.ErrStackNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, staffing.ErrSlotNotPending),
+		errors.Is(err, staffing.ErrSlotAlreadyRequested),
+		errors.Is(err, staffing.ErrInvalidAllocationPercentage),
+		errors.Is(err, staffing.ErrRequestClosed),
+		errors.Is(err, staffing.ErrRequestNotConfirmed),
+		errors.Is(err, staffing.ErrRequestAlreadyConfirmed),
+		errors.Is(err, staffing.ErrInvalidCandidateStatus),
+		errors.Is(err, staffing.ErrEmployeeAlreadyInProject):
+		status = http.StatusBadRequest
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
@@ -0,0 +1,13 @@
+package staffing
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	List(c *gin.Context)
+	Detail(c *gin.Context)
+	Create(c *gin.Context)
+	Cancel(c *gin.Context)
+	Fill(c *gin.Context)
+	Propose(c *gin.Context)
+	UpdateCandidateStatus(c *gin.Context)
+}
@@ -0,0 +1,93 @@
+package request
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/handler/staffing/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// MaxProposedCandidates is the most candidates proposed at once
+const MaxProposedCandidates = 20
+
+type GetListStaffingRequestInput struct {
+	model.Pagination
+
+	ProjectID string   `json:"projectID" form:"projectID"`
+	Status    []string `json:"status" form:"status"`
+}
+
+func (i *GetListStaffingRequestInput) Validate() error {
+	if i.ProjectID != "" && !model.IsUUIDFromString(i.ProjectID) {
+		return errs.ErrInvalidProjectID
+	}
+	for _, s := range i.Status {
+		if !model.StaffingRequestStatus(s).IsValid() {
+			return errs.ErrInvalidStatus
+		}
+	}
+
+	return nil
+}
+
+type CreateStaffingRequestInput struct {
+	ProjectSlotID string `json:"projectSlotID" binding:"required"`
+	// SeniorityID defaults to the seniority of the slot
+	SeniorityID string   `json:"seniorityID"`
+	StackIDs    []string `json:"stackIDs"`
+	// StartDate is a date in YYYY-MM-DD format
+	StartDate string `json:"startDate" binding:"required"`
+	// AllocationPercentage defaults to 100 for official slots and 0 for shadow slots
+	AllocationPercentage *float64 `json:"allocationPercentage"`
+	Note                 string   `json:"note"`
+}
+
+func (i *CreateStaffingRequestInput) Validate() error {
+	if !model.IsUUIDFromString(i.ProjectSlotID) {
+		return errs.ErrInvalidProjectSlotID
+	}
+	if i.SeniorityID != "" && !model.IsUUIDFromString(i.SeniorityID) {
+		return errs.ErrInvalidSeniorityID
+	}
+	for _, id := range i.StackIDs {
+		if !model.IsUUIDFromString(id) {
+			return errs.ErrInvalidStackID
+		}
+	}
+	if _, err := time.Parse("2006-01-02", i.StartDate); err != nil {
+		return errs.ErrInvalidStartDate
+	}
+
+	return nil
+}
+
+func (i *CreateStaffingRequestInput) GetStartDate() time.Time {
+	date, _ := time.Parse("2006-01-02", i.StartDate)
+	return date
+}
+
+type ProposeCandidatesInput struct {
+	// Limit is the number of candidates proposed, 5 by default
+	Limit int `json:"limit"`
+}
+
+func (i *ProposeCandidatesInput) Validate() error {
+	if i.Limit < 0 || i.Limit > MaxProposedCandidates {
+		return errs.ErrInvalidLimit
+	}
+
+	return nil
+}
+
+type UpdateCandidateStatusInput struct {
+	Status model.StaffingCandidateStatus `json:"status" binding:"required"`
+	Note   string                        `json:"note"`
+}
+
+func (i *UpdateCandidateStatusInput) Validate() error {
+	if !i.Status.IsValid() || i.Status == model.StaffingCandidateStatusProposed {
+		return errs.ErrInvalidStatus
+	}
+
+	return nil
+}
@@ -0,0 +1,382 @@
+package staffing
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	"github.com/dwarvesf/fortress-api/pkg/controller/staffing"
+	"github.com/dwarvesf/fortress-api/pkg/handler/staffing/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/staffing/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	controller *controller.Controller
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		controller: controller,
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+	}
+}
+
+// List godoc
+// @Summary Get list of staffing requests
+// @Description Get list of staffing requests, soonest start date first
+// @Tags Staffing
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param projectID query string false "Project ID"
+// @Param status query []string false "Statuses: open, proposed, interviewing, confirmed, filled, cancelled"
+// @Param page query string false "Page"
+// @Param size query string false "Size"
+// @Success 200 {object} view.ListStaffingRequestResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /staffing-requests [get]
+func (h *handler) List(c *gin.Context) {
+	input := request.GetListStaffingRequestInput{}
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	input.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "staffing",
+		"method":  "List",
+		"input":   input,
+	})
+
+	requests, total, err := h.controller.Staffing.List(staffing.ListInput{
+		Pagination: input.Pagination,
+		ProjectID:  input.ProjectID,
+		Statuses:   input.Status,
+	})
+	if err != nil {
+		l.Error(err, "failed to get list staffing requests")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToStaffingRequests(requests),
+		&view.PaginationResponse{Pagination: input.Pagination, Total: total}, nil, nil, ""))
+}
+
+// Detail godoc
+// @Summary Get a staffing request
+// @Description Get a staffing request with its slot, required stacks and ranked candidates
+// @Tags Staffing
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Staffing request ID"
+// @Success 200 {object} view.StaffingRequestResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /staffing-requests/{id} [get]
+func (h *handler) Detail(c *gin.Context) {
+	id, ok := h.getRequestID(c)
+	if !ok {
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "staffing",
+		"method":  "Detail",
+		"id":      id,
+	})
+
+	r, err := h.controller.Staffing.Detail(id)
+	if err != nil {
+		l.Error(err, "failed to get staffing request")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToStaffingRequest(r), nil, nil, nil, ""))
+}
+
+// Create godoc
+// @Summary Create a staffing request
+// @Description Turn a pending project slot into a staffing request with the required seniority, stacks and start date, the positions are the ones of the slot
+// @Tags Staffing
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param Body body request.CreateStaffingRequestInput true "Body"
+// @Success 200 {object} view.StaffingRequestResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /staffing-requests [post]
+func (h *handler) Create(c *gin.Context) {
+	input := request.CreateStaffingRequestInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "staffing",
+		"method":  "Create",
+		"input":   input,
+	})
+
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		l.Error(err, "failed to get user id from context")
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	r, err := h.controller.Staffing.Create(staffing.CreateInput{
+		ProjectSlotID:        input.ProjectSlotID,
+		SeniorityID:          input.SeniorityID,
+		StackIDs:             input.StackIDs,
+		StartDate:            input.GetStartDate(),
+		AllocationPercentage: input.AllocationPercentage,
+		Note:                 input.Note,
+		UserID:               userID,
+	})
+	if err != nil {
+		l.Error(err, "failed to create staffing request")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToStaffingRequest(r), nil, nil, nil, ""))
+}
+
+// Cancel godoc
+// @Summary Cancel a staffing request
+// @Description Cancel a staffing request which is not filled yet
+// @Tags Staffing
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Staffing request ID"
+// @Success 200 {object} view.StaffingRequestResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /staffing-requests/{id}/cancel [post]
+func (h *handler) Cancel(c *gin.Context) {
+	id, ok := h.getRequestID(c)
+	if !ok {
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "staffing",
+		"method":  "Cancel",
+		"id":      id,
+	})
+
+	r, err := h.controller.Staffing.Cancel(id)
+	if err != nil {
+		l.Error(err, "failed to cancel staffing request")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToStaffingRequest(r), nil, nil, nil, ""))
+}
+
+// Fill godoc
+// @Summary Fill a staffing request
+// @Description Assign the confirmed candidate to the project slot of a staffing request
+// @Tags Staffing
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Staffing request ID"
+// @Success 200 {object} view.StaffingRequestResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /staffing-requests/{id}/fill [post]
+func (h *handler) Fill(c *gin.Context) {
+	id, ok := h.getRequestID(c)
+	if !ok {
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "staffing",
+		"method":  "Fill",
+		"id":      id,
+	})
+
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		l.Error(err, "failed to get user id from context")
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	r, err := h.controller.Staffing.Fill(id, userID)
+	if err != nil {
+		l.Error(err, "failed to fill staffing request")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToStaffingRequest(r), nil, nil, nil, ""))
+}
+
+// Propose godoc
+// @Summary Propose candidates for a staffing request
+// @Description Rank the available and soon-available employees by stacks, positions, seniority and allocation, and propose the best ones
+// @Tags Staffing
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Staffing request ID"
+// @Param Body body request.ProposeCandidatesInput false "Body"
+// @Success 200 {object} view.StaffingRequestResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /staffing-requests/{id}/propose [post]
+func (h *handler) Propose(c *gin.Context) {
+	id, ok := h.getRequestID(c)
+	if !ok {
+		return
+	}
+
+	input := request.ProposeCandidatesInput{}
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+			return
+		}
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "staffing",
+		"method":  "Propose",
+		"id":      id,
+		"input":   input,
+	})
+
+	r, err := h.controller.Staffing.Propose(staffing.ProposeInput{
+		ID:    id,
+		Limit: input.Limit,
+	})
+	if err != nil {
+		l.Error(err, "failed to propose candidates")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToStaffingRequest(r), nil, nil, nil, ""))
+}
+
+// UpdateCandidateStatus godoc
+// @Summary Update the status of a candidate
+// @Description Move a candidate to interviewing, confirmed or rejected, the staffing request follows its candidates
+// @Tags Staffing
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Staffing request ID"
+// @Param candidateID path string true "Candidate ID"
+// @Param Body body request.UpdateCandidateStatusInput true "Body"
+// @Success 200 {object} view.StaffingRequestResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /staffing-requests/{id}/candidates/{candidateID}/status [put]
+func (h *handler) UpdateCandidateStatus(c *gin.Context) {
+	id, ok := h.getRequestID(c)
+	if !ok {
+		return
+	}
+
+	candidateID := c.Param("candidateID")
+	if candidateID == "" || !model.IsUUIDFromString(candidateID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidCandidateID, nil, ""))
+		return
+	}
+
+	input := request.UpdateCandidateStatusInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":     "staffing",
+		"method":      "UpdateCandidateStatus",
+		"id":          id,
+		"candidateID": candidateID,
+		"input":       input,
+	})
+
+	r, err := h.controller.Staffing.UpdateCandidateStatus(staffing.UpdateCandidateStatusInput{
+		RequestID:   id,
+		CandidateID: candidateID,
+		Status:      input.Status,
+		Note:        input.Note,
+	})
+	if err != nil {
+		l.Error(err, "failed to update candidate status")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToStaffingRequest(r), nil, nil, nil, ""))
+}
+
+func (h *handler) getRequestID(c *gin.Context) (string, bool) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidStaffingRequestID, nil, ""))
+		return "", false
+	}
+
+	return id, true
+}
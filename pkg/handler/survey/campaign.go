@@ -0,0 +1,259 @@
+package survey
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/handler/survey/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/survey/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/employeeeventtopic"
+	"github.com/dwarvesf/fortress-api/pkg/store/project"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+// campaignRunTimeout is how long a run can stay pending before it is considered stuck and retried,
+// the survey of a stuck run is rolled back with its transaction, a survey created by hand meanwhile skips the retry
+const campaignRunTimeout = time.Hour
+
+// RunCampaigns godoc
+// @Summary Create and send the surveys of recurring campaigns
+// @Description Create and send the survey of every active campaign whose period has reached its run date, missed runs of the previous period are caught up
+// @Tags Survey
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "jwt token"
+// @Success 200 {object} view.MessageResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /cronjobs/survey-campaigns [post]
+func (h *handler) RunCampaigns(c *gin.Context) {
+	l := h.logger.Fields(logger.Fields{
+		"handler": "survey",
+		"method":  "RunCampaigns",
+	})
+
+	campaigns, err := h.store.SurveyCampaign.GetActive(h.repo.DB())
+	if err != nil {
+		l.Error(err, "failed to get active survey campaigns")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	now := time.Now()
+	sent := 0
+	for _, campaign := range campaigns {
+		for _, period := range campaign.DuePeriods(now) {
+			run, ok, err := h.claimCampaignRun(campaign, period, now)
+			if err != nil {
+				l.AddField("campaignID", campaign.ID).Error(err, "failed to claim survey campaign run")
+				continue
+			}
+			// the period is already sent, skipped or being sent by another run
+			if !ok {
+				continue
+			}
+
+			result := h.runCampaign(l, campaign, period, now)
+			if _, err := h.store.SurveyCampaign.UpdateRunSelectedFieldsByID(h.repo.DB(), run.ID.String(), *result, "event_id", "status", "error"); err != nil {
+				l.AddField("campaignID", campaign.ID).Error(err, "failed to save survey campaign run")
+				continue
+			}
+			if result.Status == model.SurveyCampaignRunStatusSent {
+				sent++
+			}
+		}
+	}
+
+	l.Infof("sent %d campaign surveys", sent)
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
+}
+
+// runCampaign creates the survey of the period and sends it to all of its reviewers in one transaction
+func (h *handler) runCampaign(l logger.Logger, campaign *model.SurveyCampaign, period model.SurveyPeriod, now time.Time) *model.SurveyCampaignRun {
+	l = l.Fields(logger.Fields{
+		"campaignID":  campaign.ID,
+		"periodStart": period.Start,
+	})
+
+	run := &model.SurveyCampaignRun{
+		CampaignID:  campaign.ID,
+		PeriodStart: period.Start,
+		PeriodEnd:   period.End,
+	}
+
+	req := campaignSurveyInput(campaign, period, now)
+
+	tx, done := h.repo.NewTransaction()
+	event, err := h.createCampaignSurvey(tx.DB(), l, campaign, req)
+	if err = done(err); err != nil {
+		if errors.Is(err, errs.ErrEventAlreadyExisted) {
+			// the survey of the period was created by hand
+			run.Status = model.SurveyCampaignRunStatusSkipped
+			return run
+		}
+
+		l.Error(err, "failed to run survey campaign")
+		run.Status = model.SurveyCampaignRunStatusFailed
+		run.Error = err.Error()
+		return run
+	}
+
+	run.Status = model.SurveyCampaignRunStatusSent
+	run.EventID = event.ID
+
+	return run
+}
+
+func (h *handler) createCampaignSurvey(db *gorm.DB, l logger.Logger, campaign *model.SurveyCampaign, req request.CreateSurveyFeedbackInput) (*model.FeedbackEvent, error) {
+	templateVersion, _, err := h.getTemplateVersion(db, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var event *model.FeedbackEvent
+	switch campaign.Subtype {
+	case model.EventSubtypePeerReview:
+		event, _, err = h.createPeerReview(db, req, campaign.CreatedBy.String(), templateVersion)
+	case model.EventSubtypeEngagement:
+		event, _, err = h.createEngagement(db, req, campaign.CreatedBy.String(), templateVersion)
+	case model.EventSubtypeWork:
+		event, _, err = h.createWorkEvent(db, req, campaign.CreatedBy.String(), templateVersion)
+	default:
+		return nil, errs.ErrInvalidEventSubType
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	topics, _, err := h.store.EmployeeEventTopic.All(db, employeeeventtopic.GetByEventIDInput{EventID: event.ID.String()}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, topic := range topics {
+		if _, err := h.updateEventReviewer(db, l, topic.ID, event.ID.String()); err != nil {
+			return nil, err
+		}
+	}
+
+	return event, nil
+}
+
+// campaignSurveyInput builds the create survey input of the campaign for the period
+func campaignSurveyInput(campaign *model.SurveyCampaign, period model.SurveyPeriod, now time.Time) request.CreateSurveyFeedbackInput {
+	req := request.CreateSurveyFeedbackInput{
+		Type:               campaign.Subtype.String(),
+		Year:               period.Start.Year(),
+		Anonymity:          campaign.Anonymity.String(),
+		AnonymityThreshold: campaign.AnonymityThreshold,
+		Participants:       campaign.ParticipantRule,
+	}
+
+	if !campaign.TemplateID.IsZero() {
+		req.TemplateID = campaign.TemplateID.String()
+	}
+
+	quarter := (int(period.Start.Month())-1)/3 + 1
+	switch campaign.Subtype {
+	case model.EventSubtypePeerReview:
+		req.Quarter = "q1,q2"
+		if quarter > 2 {
+			req.Quarter = "q3,q4"
+		}
+	case model.EventSubtypeEngagement:
+		req.Quarter = fmt.Sprintf("q%d", quarter)
+	case model.EventSubtypeWork:
+		req.FromDate = period.Start.Format("2006-01-02")
+		req.ToDate = period.End.Format("2006-01-02")
+	}
+
+	if campaign.DeadlineDays > 0 {
+		// a caught up run gets the full answering time from today
+		from := campaign.RunDate(period)
+		if today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC); from.Before(today) {
+			from = today
+		}
+
+		req.SurveySchedule = request.SurveySchedule{
+			Deadline:     from.AddDate(0, 0, campaign.DeadlineDays).Format("2006-01-02"),
+			ReminderDays: campaign.ReminderDays,
+			AutoClose:    campaign.AutoClose,
+		}
+	}
+
+	return req
+}
+
+// claimCampaignRun saves the run of the period as pending before its survey is created, so concurrent runs
+// can not send the same survey twice, failed runs and runs pending for longer than campaignRunTimeout are retried
+func (h *handler) claimCampaignRun(campaign *model.SurveyCampaign, period model.SurveyPeriod, now time.Time) (*model.SurveyCampaignRun, bool, error) {
+	run, err := h.store.SurveyCampaign.OneRunByPeriod(h.repo.DB(), campaign.ID.String(), period)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, err
+	}
+
+	if err == nil {
+		staleBefore := now.Add(-campaignRunTimeout)
+		if !run.CanRetry(staleBefore) {
+			return run, false, nil
+		}
+
+		ok, err := h.store.SurveyCampaign.ReclaimRun(h.repo.DB(), run.ID.String(), staleBefore)
+		return run, ok, err
+	}
+
+	run = &model.SurveyCampaignRun{
+		BaseModel:   model.BaseModel{ID: model.NewUUID()},
+		CampaignID:  campaign.ID,
+		PeriodStart: period.Start,
+		PeriodEnd:   period.End,
+		Status:      model.SurveyCampaignRunStatusPending,
+	}
+	ok, err := h.store.SurveyCampaign.ClaimRun(h.repo.DB(), run)
+	return run, ok, err
+}
+
+// getParticipants returns the IDs of the employees allowed by the participant rule, nil when the rule does not restrict participants
+func (h *handler) getParticipants(db *gorm.DB, rule model.SurveyParticipantRule) (map[model.UUID]bool, error) {
+	if !rule.IsRestricted() {
+		return nil, nil
+	}
+
+	projectIDs := append([]string{}, rule.ProjectIDs...)
+	if rule.SurveyProjectsOnly {
+		projects, _, err := h.store.Project.All(db, project.GetListProjectInput{
+			Statuses:            []string{model.ProjectStatusActive.String()},
+			AllowsSendingSurvey: true,
+		}, model.Pagination{})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range projects {
+			projectIDs = append(projectIDs, p.ID.String())
+		}
+	}
+
+	participants := map[model.UUID]bool{}
+	if len(projectIDs) == 0 {
+		return participants, nil
+	}
+
+	members, err := h.store.ProjectMember.GetActiveByProjectIDs(db, projectIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range members {
+		participants[m.EmployeeID] = true
+	}
+
+	return participants, nil
+}
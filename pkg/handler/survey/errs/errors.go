@@ -13,6 +13,9 @@ var (
 	ErrEmployeeNotFound      = errors.New("employee not found")
 	ErrEventReviewerNotFound = errors.New("employee event reviewer not found")
 
+	ErrSurveyTemplateNotFound        = errors.New("survey template not found")
+	ErrSurveyTemplateVersionNotFound = errors.New("survey template version not found")
+
 	// invalid errors
 	ErrInvalidEventID      = errors.New("invalid event id")
 	ErrInvalidEventType    = errors.New("invalid event type")
@@ -24,6 +27,15 @@ var (
 	ErrInvalidYear         = errors.New("invalid year")
 	ErrInvalidDate         = errors.New("invalid date")
 	ErrInvalidDateRange    = errors.New("invalid date range")
+	ErrInvalidTemplateID   = errors.New("invalid survey template id")
+	ErrInvalidVersion      = errors.New("invalid survey template version")
+	ErrInvalidAnonymity    = errors.New("invalid anonymity mode")
+	ErrInvalidThreshold    = errors.New("anonymity threshold must be at least 2")
+	ErrInvalidDeadline     = errors.New("invalid deadline")
+	ErrInvalidReminderDays = errors.New("reminder days must be unique and not negative")
+	ErrInvalidProjectID    = errors.New("invalid project id")
+	ErrInvalidEmployeeID   = errors.New("invalid employee id")
+	ErrInvalidExportFormat = errors.New("invalid export format, must be csv, xlsx or pdf")
 
 	// other errors
 	ErrEventAlreadyExisted      = errors.New("event already existed")
@@ -32,6 +44,17 @@ var (
 	ErrCanNotUpdateParticipants = errors.New("can not update participants")
 	ErrEventHasBeenDone         = errors.New("event has been done")
 	ErrNoValidProjectForEvent   = errors.New("no valid project for event")
+	ErrTemplateSubtypeMismatch  = errors.New("survey template is not made for this survey type")
+
+	ErrAnonymityNotSupported        = errors.New("only engagement surveys can be anonymous")
+	ErrPseudonymSecretNotConfigured = errors.New("pseudonymous surveys need SURVEY_PSEUDONYM_SECRET to be configured")
+	ErrAnonymousSurvey              = errors.New("answers of anonymous surveys are not linked to reviewers")
+	ErrNotAnonymousSurvey           = errors.New("survey is not anonymous")
+	ErrNotEnoughResponses           = errors.New("not enough responses to export the anonymous survey")
+	ErrNotAllowedToExport           = errors.New("not allowed to export the review packet")
+
+	ErrDeadlineRequired  = errors.New("reminders and auto-close need a deadline")
+	ErrNoReminderChannel = errors.New("reviewer has neither a discord account nor a team email")
 )
 
 func ErrEventQuestionNotFound(id string) error {
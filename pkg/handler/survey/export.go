@@ -0,0 +1,354 @@
+package survey
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/handler/survey/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/survey/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/employeeeventreviewer"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/utils/reportutils"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+// ExportSurvey godoc
+// @Summary Export the answers of a survey
+// @Description Export the answers of a survey to a CSV, XLSX or PDF file, only shared reviews are exported for callers who can not read all answers
+// @Tags Survey
+// @Accept json
+// @Produce octet-stream
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Feedback Event ID"
+// @Param format query string false "csv, xlsx or pdf, default csv"
+// @Success 200 {file} file
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /surveys/{id}/export [get]
+func (h *handler) ExportSurvey(c *gin.Context) {
+	eventID := c.Param("id")
+	if eventID == "" || !model.IsUUIDFromString(eventID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidEventID, nil, ""))
+		return
+	}
+
+	input := request.ExportSurveyInput{}
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "survey",
+		"method":  "ExportSurvey",
+		"eventID": eventID,
+		"format":  input.Format,
+	})
+
+	userInfo, err := authutils.GetLoggedInUserInfo(c, h.store, h.repo.DB(), h.config)
+	if err != nil {
+		l.Error(err, "failed to get logged in user")
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	event, err := h.store.FeedbackEvent.One(h.repo.DB(), eventID, false)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		l.Error(err, "event not found")
+		c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, errs.ErrEventNotFound, eventID, ""))
+		return
+	}
+	if err != nil {
+		l.Error(err, "failed to get feedback event")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, eventID, ""))
+		return
+	}
+
+	var report reportutils.Report
+	if event.IsAnonymous() {
+		// detached answers are only exported once there are enough respondents to hide who answered what
+		respondents, err := h.store.AnonymousEventAnswer.CountRespondentsByEventID(h.repo.DB(), eventID)
+		if err != nil {
+			l.Error(err, "failed to count respondents")
+			c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, eventID, ""))
+			return
+		}
+
+		if respondents < int64(event.AnonymityThreshold) {
+			l.Info("not enough respondents to export the survey")
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrNotEnoughResponses, eventID, ""))
+			return
+		}
+
+		answers, err := h.store.AnonymousEventAnswer.GetByEventID(h.repo.DB(), eventID)
+		if err != nil {
+			l.Error(err, "failed to get anonymous answers")
+			c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, eventID, ""))
+			return
+		}
+
+		report = view.ToAnonymousSurveyReport(event, answers)
+	} else {
+		reviews, err := h.store.EmployeeEventReviewer.GetAnswered(h.repo.DB(), employeeeventreviewer.GetAnsweredInput{
+			EventID:    eventID,
+			SharedOnly: !authutils.HasPermission(userInfo.Permissions, model.PermissionEmployeeEventQuestionsRead),
+		})
+		if err != nil {
+			l.Error(err, "failed to get answered reviews")
+			c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, eventID, ""))
+			return
+		}
+
+		report = view.ToSurveyReport(event, reviews)
+	}
+
+	h.sendReport(c, l, model.ExportFormat(input.Format), fmt.Sprintf("survey-%s", eventID), report)
+}
+
+// ExportSurveyTopic godoc
+// @Summary Export the answers of a survey topic
+// @Description Export the answers of a survey topic to a CSV, XLSX or PDF file, answers of peer reviews are grouped by relationship
+// @Tags Survey
+// @Accept json
+// @Produce octet-stream
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Feedback Event ID"
+// @Param topicID path string true "Employee Event Topic ID"
+// @Param format query string false "csv, xlsx or pdf, default csv"
+// @Success 200 {file} file
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 403 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /surveys/{id}/topics/{topicID}/export [get]
+func (h *handler) ExportSurveyTopic(c *gin.Context) {
+	eventID := c.Param("id")
+	if eventID == "" || !model.IsUUIDFromString(eventID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidEventID, nil, ""))
+		return
+	}
+
+	topicID := c.Param("topicID")
+	if topicID == "" || !model.IsUUIDFromString(topicID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidTopicID, nil, ""))
+		return
+	}
+
+	input := request.ExportSurveyInput{}
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "survey",
+		"method":  "ExportSurveyTopic",
+		"eventID": eventID,
+		"topicID": topicID,
+		"format":  input.Format,
+	})
+
+	userInfo, err := authutils.GetLoggedInUserInfo(c, h.store, h.repo.DB(), h.config)
+	if err != nil {
+		l.Error(err, "failed to get logged in user")
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	topic, err := h.store.EmployeeEventTopic.One(h.repo.DB(), topicID, eventID, true)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			l.Info("topic not found")
+			c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, errs.ErrTopicNotFound, nil, ""))
+			return
+		}
+		l.Error(err, "failed when getting topic")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	if topic.Event.IsAnonymous() {
+		l.Info("answers of anonymous surveys are not linked to reviewers")
+		c.JSON(http.StatusForbidden, view.CreateResponse[any](nil, nil, errs.ErrAnonymousSurvey, nil, ""))
+		return
+	}
+
+	reviews, err := h.store.EmployeeEventReviewer.GetAnswered(h.repo.DB(), employeeeventreviewer.GetAnsweredInput{
+		EventID:    eventID,
+		TopicID:    topicID,
+		SharedOnly: !authutils.HasPermission(userInfo.Permissions, model.PermissionEmployeeEventQuestionsRead),
+	})
+	if err != nil {
+		l.Error(err, "failed to get answered reviews")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	h.sendReport(c, l, model.ExportFormat(input.Format), fmt.Sprintf("topic-%s", topicID), view.ToSurveyTopicReport(topic, reviews))
+}
+
+// ExportReviewPacket godoc
+// @Summary Export the review packet of an employee
+// @Description Export the reviews an employee received in a survey to a CSV, XLSX or PDF file, grouped by relationship.
+// @Description Employees can export their own packet, which only has the reviews shared with them
+// @Tags Survey
+// @Accept json
+// @Produce octet-stream
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Feedback Event ID"
+// @Param employeeID path string true "Employee ID"
+// @Param format query string false "csv, xlsx or pdf, default csv"
+// @Success 200 {file} file
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 403 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /surveys/{id}/employees/{employeeID}/review-packet [get]
+func (h *handler) ExportReviewPacket(c *gin.Context) {
+	eventID := c.Param("id")
+	if eventID == "" || !model.IsUUIDFromString(eventID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidEventID, nil, ""))
+		return
+	}
+
+	employeeID := c.Param("employeeID")
+	if employeeID == "" || !model.IsUUIDFromString(employeeID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidEmployeeID, nil, ""))
+		return
+	}
+
+	input := request.ExportSurveyInput{}
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":    "survey",
+		"method":     "ExportReviewPacket",
+		"eventID":    eventID,
+		"employeeID": employeeID,
+		"format":     input.Format,
+	})
+
+	userInfo, err := authutils.GetLoggedInUserInfo(c, h.store, h.repo.DB(), h.config)
+	if err != nil {
+		l.Error(err, "failed to get logged in user")
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	isOwner := userInfo.UserID == employeeID
+	if !isOwner && !authutils.HasPermission(userInfo.Permissions, model.PermissionSurveysRead) {
+		l.Info("caller is not allowed to export the review packet")
+		c.JSON(http.StatusForbidden, view.CreateResponse[any](nil, nil, errs.ErrNotAllowedToExport, nil, ""))
+		return
+	}
+
+	event, err := h.store.FeedbackEvent.One(h.repo.DB(), eventID, false)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		l.Error(err, "event not found")
+		c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, errs.ErrEventNotFound, eventID, ""))
+		return
+	}
+	if err != nil {
+		l.Error(err, "failed to get feedback event")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, eventID, ""))
+		return
+	}
+
+	if event.IsAnonymous() {
+		l.Info("answers of anonymous surveys are not linked to reviewers")
+		c.JSON(http.StatusForbidden, view.CreateResponse[any](nil, nil, errs.ErrAnonymousSurvey, nil, ""))
+		return
+	}
+
+	employee, err := h.store.Employee.One(h.repo.DB(), employeeID, false)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		l.Info("employee not found")
+		c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, errs.ErrEmployeeNotFound, nil, ""))
+		return
+	}
+	if err != nil {
+		l.Error(err, "failed to get employee")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	// the reviewed employee only sees the reviews shared with them, even with the permission to read all answers
+	reviews, err := h.store.EmployeeEventReviewer.GetAnswered(h.repo.DB(), employeeeventreviewer.GetAnsweredInput{
+		EventID:    eventID,
+		EmployeeID: employeeID,
+		SharedOnly: isOwner || !authutils.HasPermission(userInfo.Permissions, model.PermissionEmployeeEventQuestionsRead),
+	})
+	if err != nil {
+		l.Error(err, "failed to get answered reviews")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	h.sendReport(c, l, model.ExportFormat(input.Format), fmt.Sprintf("review-packet-%s", employeeID), view.ToReviewPacket(event, employee, reviews))
+}
+
+// sendReport renders the report to the format and sends it as an attachment
+func (h *handler) sendReport(c *gin.Context, l logger.Logger, format model.ExportFormat, name string, report reportutils.Report) {
+	var (
+		data []byte
+		err  error
+	)
+
+	switch format {
+	case model.ExportFormatCSV:
+		data, err = reportutils.ToCSV(report)
+	case model.ExportFormatXLSX:
+		data, err = reportutils.ToXLSX(report)
+	case model.ExportFormatPDF:
+		data, err = reportutils.ToPDF(report, h.templatePath())
+	}
+	if err != nil {
+		l.Error(err, "failed to render report")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s.%s", name, format))
+	c.Data(http.StatusOK, format.ContentType(), data)
+}
+
+// templatePath returns the folder of the PDF templates, the same folder as the invoice templates
+func (h *handler) templatePath() string {
+	path := h.config.Invoice.TemplatePath
+	if h.config.Env == "local" || path == "" {
+		pwd, err := os.Getwd()
+		if err != nil {
+			pwd = os.Getenv("GOPATH") + "/src/github.com/dwarvesf/fortress-api"
+		}
+		path = filepath.Join(pwd, "pkg/templates")
+	}
+
+	return path
+}
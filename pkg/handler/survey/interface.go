@@ -5,6 +5,7 @@ import "github.com/gin-gonic/gin"
 type IHandler interface {
 	ListSurvey(c *gin.Context)
 	GetSurveyDetail(c *gin.Context)
+	ListSurveyResponses(c *gin.Context)
 	GetSurveyReviewDetail(c *gin.Context)
 	SendSurvey(c *gin.Context)
 	CreateSurvey(c *gin.Context)
@@ -14,4 +15,12 @@ type IHandler interface {
 	UpdateTopicReviewers(c *gin.Context)
 	MarkDone(c *gin.Context)
 	DeleteTopicReviewers(c *gin.Context)
+	UpdateSchedule(c *gin.Context)
+	SendReminders(c *gin.Context)
+	SendDigests(c *gin.Context)
+	CloseOverdue(c *gin.Context)
+	RunCampaigns(c *gin.Context)
+	ExportSurvey(c *gin.Context)
+	ExportSurveyTopic(c *gin.Context)
+	ExportReviewPacket(c *gin.Context)
 }
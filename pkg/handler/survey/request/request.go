@@ -49,6 +49,21 @@ func (i *GetSurveyDetailInput) Validate() error {
 	return nil
 }
 
+type ExportSurveyInput struct {
+	Format string `json:"format" form:"format"`
+}
+
+func (i *ExportSurveyInput) Validate() error {
+	if i.Format == "" {
+		i.Format = model.ExportFormatCSV.String()
+	}
+	if !model.ExportFormat(i.Format).IsValid() {
+		return errs.ErrInvalidExportFormat
+	}
+
+	return nil
+}
+
 type SendSurveyInput struct {
 	Type     string       `json:"type" form:"type" binding:"required"`
 	TopicIDs []model.UUID `json:"topicIDs" form:"topicIDs"`
@@ -61,6 +76,20 @@ type CreateSurveyFeedbackInput struct {
 	Type     string `json:"type" binding:"required"`
 	FromDate string `json:"fromDate"`
 	ToDate   string `json:"toDate"`
+
+	// TemplateID replaces the seeded questions with the questions of a survey template,
+	// TemplateVersion defaults to the latest version
+	TemplateID      string `json:"templateID"`
+	TemplateVersion int    `json:"templateVersion"`
+
+	// Anonymity is identified, pseudonymous or anonymous, AnonymityThreshold defaults to SURVEY_ANONYMITY_THRESHOLD
+	Anonymity          string `json:"anonymity"`
+	AnonymityThreshold int    `json:"anonymityThreshold"`
+
+	// Participants narrows down who is asked, the default participants are kept when empty
+	Participants model.SurveyParticipantRule `json:"participants"`
+
+	SurveySchedule
 }
 
 // Validate input for create survey feedback
@@ -69,6 +98,39 @@ func (i *CreateSurveyFeedbackInput) Validate() error {
 		return errs.ErrInvalidEventSubType
 	}
 
+	if i.TemplateID != "" && !model.IsUUIDFromString(i.TemplateID) {
+		return errs.ErrInvalidTemplateID
+	}
+
+	if i.TemplateVersion < 0 || (i.TemplateID == "" && i.TemplateVersion != 0) {
+		return errs.ErrInvalidVersion
+	}
+
+	if i.Anonymity != "" && !model.SurveyAnonymity(i.Anonymity).IsValid() {
+		return errs.ErrInvalidAnonymity
+	}
+
+	if model.SurveyAnonymity(i.Anonymity).IsDetached() {
+		if i.Type != model.EventSubtypeEngagement.String() {
+			return errs.ErrAnonymityNotSupported
+		}
+
+		// a group of one is never anonymous
+		if i.AnonymityThreshold != 0 && i.AnonymityThreshold < 2 {
+			return errs.ErrInvalidThreshold
+		}
+	}
+
+	for _, id := range i.Participants.ProjectIDs {
+		if !model.IsUUIDFromString(id) {
+			return errs.ErrInvalidProjectID
+		}
+	}
+
+	if err := i.SurveySchedule.Validate(); err != nil {
+		return err
+	}
+
 	if i.Type == model.EventSubtypeWork.String() {
 		fromDate, err := time.Parse("2006-01-02", i.FromDate)
 		if err != nil {
@@ -88,6 +150,70 @@ func (i *CreateSurveyFeedbackInput) Validate() error {
 	return nil
 }
 
+// SurveySchedule is the deadline of a survey and when pending reviewers are reminded
+type SurveySchedule struct {
+	// Deadline is a date in YYYY-MM-DD format, reviewers have until the end of that day
+	Deadline string `json:"deadline"`
+	// ReminderDays are the number of days before the deadline to remind pending reviewers, i.e. [3, 1]
+	ReminderDays []int `json:"reminderDays"`
+	// AutoClose marks the survey done at the deadline and records pending reviewers as non-response
+	AutoClose bool `json:"autoClose"`
+}
+
+// Validate input for survey schedule
+func (i *SurveySchedule) Validate() error {
+	if i.Deadline == "" {
+		if len(i.ReminderDays) > 0 || i.AutoClose {
+			return errs.ErrDeadlineRequired
+		}
+		return nil
+	}
+
+	deadline, err := i.ParseDeadline()
+	if err != nil || deadline.Before(time.Now()) {
+		return errs.ErrInvalidDeadline
+	}
+
+	seen := map[int]bool{}
+	for _, d := range i.ReminderDays {
+		if d < 0 || seen[d] {
+			return errs.ErrInvalidReminderDays
+		}
+		seen[d] = true
+	}
+
+	return nil
+}
+
+// ParseDeadline returns the end of the deadline date, nil when the survey has no deadline
+func (i *SurveySchedule) ParseDeadline() (*time.Time, error) {
+	if i.Deadline == "" {
+		return nil, nil
+	}
+
+	date, err := time.Parse("2006-01-02", i.Deadline)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := date.AddDate(0, 0, 1).Add(-time.Second)
+	return &deadline, nil
+}
+
+type UpdateSurveyScheduleInput struct {
+	EventID string
+	Body    SurveySchedule
+}
+
+// Validate input for update survey schedule
+func (i *UpdateSurveyScheduleInput) Validate() error {
+	if i.EventID == "" || !model.IsUUIDFromString(i.EventID) {
+		return errs.ErrInvalidEventID
+	}
+
+	return i.Body.Validate()
+}
+
 type PeerReviewDetailInput struct {
 	EventID string
 	TopicID string
@@ -0,0 +1,382 @@
+package survey
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/handler/survey/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/survey/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+// UpdateSchedule godoc
+// @Summary Update deadline and reminders of a survey
+// @Description Update deadline and reminders of a survey, reminders already sent are reset when the deadline changes
+// @Tags Survey
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Feedback Event ID"
+// @Param Body body request.SurveySchedule true "Body"
+// @Success 200 {object} view.MessageResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /surveys/{id}/schedule [put]
+func (h *handler) UpdateSchedule(c *gin.Context) {
+	input := request.UpdateSurveyScheduleInput{
+		EventID: c.Param("id"),
+	}
+	if err := c.ShouldBindJSON(&input.Body); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "survey",
+		"method":  "UpdateSchedule",
+		"input":   input,
+	})
+
+	event, err := h.store.FeedbackEvent.One(h.repo.DB(), input.EventID, false)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		l.Error(err, "event not found")
+		c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, errs.ErrEventNotFound, input, ""))
+		return
+	}
+	if err != nil {
+		l.Error(err, "failed to get feedback event")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if event.Status == model.EventStatusDone {
+		l.Info("event has been done")
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrEventHasBeenDone, input, ""))
+		return
+	}
+
+	deadline, err := input.Body.ParseDeadline()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidDeadline, input, ""))
+		return
+	}
+
+	deadlineChanged := event.Deadline == nil || deadline == nil || !event.Deadline.Equal(*deadline)
+
+	event.Deadline = deadline
+	event.ReminderDays = input.Body.ReminderDays
+	event.AutoClose = input.Body.AutoClose
+
+	tx, done := h.repo.NewTransaction()
+
+	_, err = h.store.FeedbackEvent.UpdateSelectedFieldsByID(tx.DB(), input.EventID, *event, "deadline", "reminder_days", "auto_close")
+	if err != nil {
+		l.Error(err, "failed to update feedback event")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, done(err), input, ""))
+		return
+	}
+
+	// reminders count backward from the deadline, they have to be sent again for the new one
+	if deadlineChanged {
+		if err := h.store.SurveyReminder.DeleteByEventID(tx.DB(), input.EventID); err != nil {
+			l.Error(err, "failed to reset survey reminders")
+			c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, done(err), input, ""))
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, done(nil), nil, "ok"))
+}
+
+// SendReminders godoc
+// @Summary Remind pending reviewers of surveys
+// @Description Send a Discord DM, or an email when the reviewer has no Discord account, to reviewers who have not finished a survey before its deadline
+// @Tags Survey
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "jwt token"
+// @Success 200 {object} view.MessageResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /cronjobs/survey-reminders [post]
+func (h *handler) SendReminders(c *gin.Context) {
+	l := h.logger.Fields(logger.Fields{
+		"handler": "survey",
+		"method":  "SendReminders",
+	})
+
+	events, err := h.store.FeedbackEvent.GetInProgressWithDeadline(h.repo.DB())
+	if err != nil {
+		l.Error(err, "failed to get in-progress surveys")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	now := time.Now()
+	sent := 0
+	for _, event := range events {
+		days, ok := event.DueReminderDays(now)
+		if !ok {
+			continue
+		}
+
+		// one survey failing should not stop the reminders of the others
+		n, err := h.remindPendingReviewers(l, event, days)
+		if err != nil {
+			l.AddField("eventID", event.ID).Error(err, "failed to remind pending reviewers")
+		}
+		sent += n
+	}
+
+	l.Infof("sent %d survey reminders", sent)
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
+}
+
+// remindPendingReviewers sends the reminder of the given day to pending reviewers who have not received it yet,
+// reviewers with several pending topics get a single message
+func (h *handler) remindPendingReviewers(l logger.Logger, event *model.FeedbackEvent, days int) (int, error) {
+	db := h.repo.DB()
+
+	pending, err := h.store.EmployeeEventReviewer.GetPendingByEventID(db, event.ID.String())
+	if err != nil {
+		return 0, err
+	}
+
+	reminders, err := h.store.SurveyReminder.GetByEventID(db, event.ID.String())
+	if err != nil {
+		return 0, err
+	}
+
+	reminded := map[model.UUID]bool{}
+	for _, r := range reminders {
+		if r.DaysBefore == days {
+			reminded[r.EmployeeEventReviewerID] = true
+		}
+	}
+
+	byReviewer := map[model.UUID][]*model.EmployeeEventReviewer{}
+	var reviewerIDs []model.UUID
+	for _, eer := range pending {
+		if reminded[eer.ID] || eer.Reviewer == nil {
+			continue
+		}
+		if _, ok := byReviewer[eer.ReviewerID]; !ok {
+			reviewerIDs = append(reviewerIDs, eer.ReviewerID)
+		}
+		byReviewer[eer.ReviewerID] = append(byReviewer[eer.ReviewerID], eer)
+	}
+
+	sent := 0
+	for _, reviewerID := range reviewerIDs {
+		eers := byReviewer[reviewerID]
+
+		channel, err := h.sendReminder(event, eers[0].Reviewer, len(eers))
+		if err != nil {
+			l.AddField("reviewerID", reviewerID).Error(err, "failed to send survey reminder")
+			continue
+		}
+
+		now := time.Now()
+		for _, eer := range eers {
+			_, err := h.store.SurveyReminder.Create(db, &model.SurveyReminder{
+				EventID:                 event.ID,
+				EmployeeEventReviewerID: eer.ID,
+				ReviewerID:              reviewerID,
+				DaysBefore:              days,
+				Channel:                 channel,
+				SentAt:                  &now,
+			})
+			if err != nil {
+				return sent, err
+			}
+		}
+		sent++
+	}
+
+	return sent, nil
+}
+
+// sendReminder reminds a reviewer by Discord DM, falling back to email when the DM can not be sent
+func (h *handler) sendReminder(event *model.FeedbackEvent, reviewer *model.Employee, pending int) (model.SurveyReminderChannel, error) {
+	deadline := event.Deadline.Format("Jan 02, 2006")
+	link := fmt.Sprintf("%s/feedbacks/inbox", h.config.FortressURL)
+
+	if reviewer.DiscordAccount != nil && reviewer.DiscordAccount.DiscordID != "" {
+		msg := fmt.Sprintf("Hi %s, you have %d pending review(s) in **%s**. Please submit them before %s: %s",
+			reviewer.DisplayName, pending, event.Title, deadline, link)
+
+		if _, err := h.service.Discord.SendDirectMessage(reviewer.DiscordAccount.DiscordID, msg); err == nil {
+			return model.SurveyReminderChannelDiscord, nil
+		}
+	}
+
+	if reviewer.TeamEmail == "" {
+		return "", errs.ErrNoReminderChannel
+	}
+
+	err := h.service.GoogleMail.SendSurveyReminderMail(&model.SurveyReminderEmail{
+		Email:    reviewer.TeamEmail,
+		Name:     reviewer.DisplayName,
+		Survey:   event.Title,
+		Deadline: deadline,
+		Link:     link,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return model.SurveyReminderChannelEmail, nil
+}
+
+// SendDigests godoc
+// @Summary Send survey completion digests to project leads
+// @Description Send the completion rate of in-progress surveys of their project members to project leads by Discord DM
+// @Tags Survey
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "jwt token"
+// @Success 200 {object} view.MessageResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /cronjobs/survey-digests [post]
+func (h *handler) SendDigests(c *gin.Context) {
+	l := h.logger.Fields(logger.Fields{
+		"handler": "survey",
+		"method":  "SendDigests",
+	})
+
+	events, err := h.store.FeedbackEvent.GetInProgressWithDeadline(h.repo.DB())
+	if err != nil {
+		l.Error(err, "failed to get in-progress surveys")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	// lines of the digest of each lead
+	digests := map[model.UUID][]string{}
+	leads := map[model.UUID]*model.Employee{}
+	projectLeads := map[model.UUID][]*model.ProjectHead{}
+
+	for _, event := range events {
+		completions, err := h.store.EmployeeEventReviewer.CountCompletionByProject(h.repo.DB(), event.ID.String())
+		if err != nil {
+			l.AddField("eventID", event.ID).Error(err, "failed to count survey completion by project")
+			c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+			return
+		}
+
+		for _, completion := range completions {
+			heads, ok := projectLeads[completion.ProjectID]
+			if !ok {
+				heads, err = h.store.ProjectHead.GetActiveLeadsByProjectID(h.repo.DB(), completion.ProjectID.String())
+				if err != nil {
+					l.AddField("projectID", completion.ProjectID).Error(err, "failed to get project leads")
+					c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+					return
+				}
+				projectLeads[completion.ProjectID] = heads
+			}
+
+			line := fmt.Sprintf("• **%s** - %s: %d/%d answered (%.0f%%), due %s",
+				event.Title, completion.ProjectName, completion.Done, completion.Total,
+				completion.CompletionRate(), event.Deadline.Format("Jan 02"))
+
+			for _, head := range heads {
+				if !head.IsLead() {
+					continue
+				}
+				if _, ok := leads[head.EmployeeID]; !ok {
+					lead, err := h.store.Employee.One(h.repo.DB(), head.EmployeeID.String(), false)
+					if err != nil {
+						l.AddField("employeeID", head.EmployeeID).Error(err, "failed to get project lead")
+						continue
+					}
+					leads[head.EmployeeID] = lead
+				}
+				digests[head.EmployeeID] = append(digests[head.EmployeeID], line)
+			}
+		}
+	}
+
+	sent := 0
+	for leadID, lines := range digests {
+		lead := leads[leadID]
+		if lead == nil || lead.DiscordAccount == nil || lead.DiscordAccount.DiscordID == "" {
+			l.AddField("employeeID", leadID).Info("project lead has no discord account, skip survey digest")
+			continue
+		}
+
+		sort.Strings(lines)
+		msg := fmt.Sprintf("Hi %s, here is the survey progress of your projects:\n%s", lead.DisplayName, strings.Join(lines, "\n"))
+		if _, err := h.service.Discord.SendDirectMessage(lead.DiscordAccount.DiscordID, msg); err != nil {
+			l.AddField("employeeID", leadID).Error(err, "failed to send survey digest")
+			continue
+		}
+		sent++
+	}
+
+	l.Infof("sent %d survey digests", sent)
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
+}
+
+// CloseOverdue godoc
+// @Summary Close surveys which passed their deadline
+// @Description Mark in-progress surveys with auto-close done once their deadline passes, pending reviewers are recorded as non-response
+// @Tags Survey
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "jwt token"
+// @Success 200 {object} view.MessageResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /cronjobs/close-overdue-surveys [post]
+func (h *handler) CloseOverdue(c *gin.Context) {
+	l := h.logger.Fields(logger.Fields{
+		"handler": "survey",
+		"method":  "CloseOverdue",
+	})
+
+	events, err := h.store.FeedbackEvent.GetInProgressWithDeadline(h.repo.DB())
+	if err != nil {
+		l.Error(err, "failed to get in-progress surveys")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	now := time.Now()
+	closed := 0
+	for _, event := range events {
+		if !event.AutoClose || !event.IsOverdue(now) {
+			continue
+		}
+
+		tx, done := h.repo.NewTransaction()
+		if _, err := h.closeEvent(tx.DB(), l, event, true); err != nil {
+			l.AddField("eventID", event.ID).Error(done(err), "failed to close overdue survey")
+			continue
+		}
+		if err := done(nil); err != nil {
+			l.AddField("eventID", event.ID).Error(err, "failed to commit closing overdue survey")
+			continue
+		}
+		closed++
+	}
+
+	l.Infof("closed %d overdue surveys", closed)
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
+}
@@ -0,0 +1,260 @@
+package survey
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/gin-gonic/gin"
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/handler/survey/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/service/discord"
+	"github.com/dwarvesf/fortress-api/pkg/service/googlemail"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/testhelper"
+)
+
+// fakeDiscordService records the direct messages instead of sending them
+type fakeDiscordService struct {
+	discord.IService
+	messages map[string]string
+}
+
+func (s *fakeDiscordService) SendDirectMessage(discordID string, msg string) (*discordgo.Message, error) {
+	s.messages[discordID] = msg
+	return &discordgo.Message{Content: msg}, nil
+}
+
+// fakeMailService records the reminder emails instead of sending them
+type fakeMailService struct {
+	googlemail.IService
+	reminders []*model.SurveyReminderEmail
+}
+
+func (s *fakeMailService) SendSurveyReminderMail(r *model.SurveyReminderEmail) error {
+	s.reminders = append(s.reminders, r)
+	return nil
+}
+
+func TestHandler_UpdateSchedule(t *testing.T) {
+	cfg := config.LoadTestConfig()
+	loggerMock := logger.NewLogrusLogger()
+	serviceMock := service.New(&cfg, nil, nil)
+	storeMock := store.New()
+
+	tests := []struct {
+		name             string
+		id               string
+		body             request.SurveySchedule
+		wantCode         int
+		wantResponsePath string
+		// wantReminders is the number of reminders of the in-progress survey left after the update
+		wantReminders int64
+	}{
+		{
+			name:             "ok_deadline_changed",
+			id:               "7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e01",
+			body:             request.SurveySchedule{Deadline: "2099-01-15", ReminderDays: []int{3, 1}, AutoClose: true},
+			wantCode:         http.StatusOK,
+			wantResponsePath: "testdata/update_schedule/200.json",
+			wantReminders:    0,
+		},
+		{
+			name:             "ok_same_deadline",
+			id:               "7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e01",
+			body:             request.SurveySchedule{Deadline: "2099-01-01", ReminderDays: []int{3, 1}, AutoClose: true},
+			wantCode:         http.StatusOK,
+			wantResponsePath: "testdata/update_schedule/200.json",
+			wantReminders:    1,
+		},
+		{
+			name:             "failed_event_done",
+			id:               "7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e02",
+			body:             request.SurveySchedule{Deadline: "2099-01-15"},
+			wantCode:         http.StatusBadRequest,
+			wantResponsePath: "testdata/update_schedule/event_done.json",
+			wantReminders:    1,
+		},
+		{
+			name:             "failed_past_deadline",
+			id:               "7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e01",
+			body:             request.SurveySchedule{Deadline: "2020-01-01"},
+			wantCode:         http.StatusBadRequest,
+			wantResponsePath: "testdata/update_schedule/invalid_deadline.json",
+			wantReminders:    1,
+		},
+		{
+			name:             "failed_reminders_without_deadline",
+			id:               "7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e01",
+			body:             request.SurveySchedule{ReminderDays: []int{1}},
+			wantCode:         http.StatusBadRequest,
+			wantResponsePath: "testdata/update_schedule/deadline_required.json",
+			wantReminders:    1,
+		},
+		{
+			name:             "failed_duplicated_reminder_days",
+			id:               "7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e01",
+			body:             request.SurveySchedule{Deadline: "2099-01-15", ReminderDays: []int{1, 1}},
+			wantCode:         http.StatusBadRequest,
+			wantResponsePath: "testdata/update_schedule/invalid_reminder_days.json",
+			wantReminders:    1,
+		},
+		{
+			name:             "event_id_not_found",
+			id:               "7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e09",
+			body:             request.SurveySchedule{Deadline: "2099-01-15"},
+			wantCode:         http.StatusNotFound,
+			wantResponsePath: "testdata/update_schedule/404.json",
+			wantReminders:    1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testhelper.TestWithTxDB(t, func(txRepo store.DBRepo) {
+				testhelper.LoadTestSQLFile(t, txRepo, "./testdata/update_schedule/update_schedule.sql")
+				byteReq, err := json.Marshal(tt.body)
+				require.NoError(t, err)
+
+				w := httptest.NewRecorder()
+				ctx, _ := gin.CreateTestContext(w)
+				ctx.Request = httptest.NewRequest(http.MethodPut, fmt.Sprintf("/api/v1/surveys/%s/schedule", tt.id), strings.NewReader(string(byteReq)))
+				ctx.Request.Header.Set("Authorization", testToken)
+				ctx.AddParam("id", tt.id)
+
+				h := New(storeMock, txRepo, serviceMock, loggerMock, &cfg)
+				h.UpdateSchedule(ctx)
+				require.Equal(t, tt.wantCode, w.Code)
+				expRespRaw, err := os.ReadFile(tt.wantResponsePath)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expRespRaw), w.Body.String(), "[Handler.Survey.UpdateSchedule] response mismatched")
+
+				var reminders int64
+				require.NoError(t, txRepo.DB().Model(&model.SurveyReminder{}).Where("event_id = ?", "7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e01").Count(&reminders).Error)
+				require.Equal(t, tt.wantReminders, reminders)
+			})
+		})
+	}
+}
+
+func TestHandler_SendReminders(t *testing.T) {
+	cfg := config.LoadTestConfig()
+	loggerMock := logger.NewLogrusLogger()
+	storeMock := store.New()
+
+	testhelper.TestWithTxDB(t, func(txRepo store.DBRepo) {
+		testhelper.LoadTestSQLFile(t, txRepo, "./testdata/send_reminders/send_reminders.sql")
+		discordMock := &fakeDiscordService{messages: map[string]string{}}
+		mailMock := &fakeMailService{}
+		serviceMock := &service.Service{Discord: discordMock, GoogleMail: mailMock}
+
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		ctx.Request = httptest.NewRequest(http.MethodPost, "/api/v1/cronjobs/survey-reminders", nil)
+		ctx.Request.Header.Set("Authorization", testToken)
+
+		h := New(storeMock, txRepo, serviceMock, loggerMock, &cfg)
+		h.SendReminders(ctx)
+		require.Equal(t, http.StatusOK, w.Code)
+		expRespRaw, err := os.ReadFile("testdata/send_reminders/200.json")
+		require.NoError(t, err)
+		require.JSONEq(t, string(expRespRaw), w.Body.String(), "[Handler.Survey.SendReminders] response mismatched")
+
+		// Thanh has 2 pending reviews and gets a single direct message
+		require.Len(t, discordMock.messages, 1)
+		require.Contains(t, discordMock.messages["790170208228212766"], "2 pending review(s) in **Q3 2023**")
+
+		// Huy has no discord account, Nam was already reminded and the other survey is not due yet
+		require.Len(t, mailMock.reminders, 1)
+		require.Equal(t, "huynh@d.foundation", mailMock.reminders[0].Email)
+		require.Equal(t, "Q3 2023", mailMock.reminders[0].Survey)
+
+		var reminders []model.SurveyReminder
+		require.NoError(t, txRepo.DB().Where("event_id = ?", "7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e01").Order("employee_event_reviewer_id").Find(&reminders).Error)
+		require.Len(t, reminders, 4)
+
+		channels := map[string]model.SurveyReminderChannel{}
+		for _, r := range reminders {
+			require.Equal(t, 5, r.DaysBefore)
+			channels[r.EmployeeEventReviewerID.String()] = r.Channel
+		}
+		require.Equal(t, map[string]model.SurveyReminderChannel{
+			"7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e21": model.SurveyReminderChannelDiscord,
+			"7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e22": model.SurveyReminderChannelDiscord,
+			"7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e23": model.SurveyReminderChannelEmail,
+			"7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e24": model.SurveyReminderChannelEmail,
+		}, channels)
+
+		var notDue int64
+		require.NoError(t, txRepo.DB().Model(&model.SurveyReminder{}).Where("event_id = ?", "7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e02").Count(&notDue).Error)
+		require.Zero(t, notDue)
+	})
+}
+
+func TestHandler_CloseOverdue(t *testing.T) {
+	cfg := config.LoadTestConfig()
+	loggerMock := logger.NewLogrusLogger()
+	serviceMock := service.New(&cfg, nil, nil)
+	storeMock := store.New()
+
+	testhelper.TestWithTxDB(t, func(txRepo store.DBRepo) {
+		testhelper.LoadTestSQLFile(t, txRepo, "./testdata/close_overdue/close_overdue.sql")
+
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		ctx.Request = httptest.NewRequest(http.MethodPost, "/api/v1/cronjobs/close-overdue-surveys", nil)
+		ctx.Request.Header.Set("Authorization", testToken)
+
+		h := New(storeMock, txRepo, serviceMock, loggerMock, &cfg)
+		h.CloseOverdue(ctx)
+		require.Equal(t, http.StatusOK, w.Code)
+		expRespRaw, err := os.ReadFile("testdata/close_overdue/200.json")
+		require.NoError(t, err)
+		require.JSONEq(t, string(expRespRaw), w.Body.String(), "[Handler.Survey.CloseOverdue] response mismatched")
+
+		closed, err := storeMock.FeedbackEvent.One(txRepo.DB(), "7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e01", false)
+		require.NoError(t, err)
+		require.Equal(t, model.EventStatusDone, closed.Status)
+		require.NotNil(t, closed.ClosedAt)
+
+		// surveys without auto-close wait for someone to mark them done
+		open, err := storeMock.FeedbackEvent.One(txRepo.DB(), "7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e02", false)
+		require.NoError(t, err)
+		require.Equal(t, model.EventStatusInProgress, open.Status)
+		require.Nil(t, open.ClosedAt)
+
+		tests := []struct {
+			id              string
+			wantStatus      model.EventReviewerStatus
+			wantForcedDone  bool
+			wantNonResponse bool
+		}{
+			// pending reviewer missed the deadline
+			{id: "7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e21", wantStatus: model.EventReviewerStatusDone, wantForcedDone: true, wantNonResponse: true},
+			// the survey was never sent to this reviewer
+			{id: "7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e22", wantStatus: model.EventReviewerStatusDone, wantForcedDone: true},
+			// reviewer answered in time
+			{id: "7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e23", wantStatus: model.EventReviewerStatusDone},
+			// reviewer of the survey without auto-close
+			{id: "7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e24", wantStatus: model.EventReviewerStatusDraft},
+		}
+		for _, tt := range tests {
+			reviewer, err := storeMock.EmployeeEventReviewer.One(txRepo.DB(), tt.id)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantStatus, reviewer.ReviewerStatus, tt.id)
+			require.Equal(t, tt.wantForcedDone, reviewer.IsForcedDone, tt.id)
+			require.Equal(t, tt.wantNonResponse, reviewer.IsNonResponse, tt.id)
+		}
+	})
+}
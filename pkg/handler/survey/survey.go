@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"golang.org/x/exp/slices"
 	"gorm.io/gorm"
 
 	"github.com/dwarvesf/fortress-api/pkg/config"
@@ -223,6 +224,70 @@ func (h *handler) GetSurveyDetail(c *gin.Context) {
 		&view.PaginationResponse{Pagination: input.Query.Pagination, Total: total}, nil, nil, ""))
 }
 
+// ListSurveyResponses godoc
+// @Summary Get responses of an anonymous survey
+// @Description Get responses of an anonymous survey, responses are hidden until the number of respondents reaches the threshold
+// @Tags Survey
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Feedback Event ID"
+// @Success 200 {object} view.AnonymousSurveyResponsesResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /surveys/{id}/responses [get]
+func (h *handler) ListSurveyResponses(c *gin.Context) {
+	eventID := c.Param("id")
+	if eventID == "" || !model.IsUUIDFromString(eventID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidEventID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "survey",
+		"method":  "ListSurveyResponses",
+		"eventID": eventID,
+	})
+
+	event, err := h.store.FeedbackEvent.One(h.repo.DB(), eventID, false)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		l.Error(err, "event not found")
+		c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, errs.ErrEventNotFound, eventID, ""))
+		return
+	}
+	if err != nil {
+		l.Error(err, "failed to get feedback event")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, eventID, ""))
+		return
+	}
+
+	if !event.IsAnonymous() {
+		l.Info("survey is not anonymous")
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrNotAnonymousSurvey, eventID, ""))
+		return
+	}
+
+	respondents, err := h.store.AnonymousEventAnswer.CountRespondentsByEventID(h.repo.DB(), eventID)
+	if err != nil {
+		l.Error(err, "failed to count respondents")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, eventID, ""))
+		return
+	}
+
+	var answers []*model.AnonymousEventAnswer
+	if respondents >= int64(event.AnonymityThreshold) {
+		answers, err = h.store.AnonymousEventAnswer.GetByEventID(h.repo.DB(), eventID)
+		if err != nil {
+			l.Error(err, "failed to get anonymous answers")
+			c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, eventID, ""))
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToAnonymousSurveyResponses(event, respondents, answers), nil, nil, nil, ""))
+}
+
 // CreateSurvey godoc
 // @Summary Create new survey
 // @Description Create new survey
@@ -264,23 +329,30 @@ func (h *handler) CreateSurvey(c *gin.Context) {
 
 	tx, done := h.repo.NewTransaction()
 
+	templateVersion, status, err := h.getTemplateVersion(tx.DB(), req)
+	if err != nil {
+		l.Error(err, "failed to get survey template version")
+		c.JSON(status, view.CreateResponse[any](nil, nil, done(err), req, ""))
+		return
+	}
+
 	switch model.EventSubtype(req.Type) {
 	case model.EventSubtypePeerReview:
-		status, err := h.createPeerReview(tx.DB(), req, userID)
+		_, status, err := h.createPeerReview(tx.DB(), req, userID, templateVersion)
 		if err != nil {
 			l.Error(err, "failed to create new survey peer review")
 			c.JSON(status, view.CreateResponse[any](nil, nil, done(err), nil, ""))
 			return
 		}
 	case model.EventSubtypeEngagement:
-		status, err := h.createEngagement(tx.DB(), req, userID)
+		_, status, err := h.createEngagement(tx.DB(), req, userID, templateVersion)
 		if err != nil {
 			l.Error(err, "failed to create new survey engagement")
 			c.JSON(status, view.CreateResponse[any](nil, nil, done(err), nil, ""))
 			return
 		}
 	case model.EventSubtypeWork:
-		status, err := h.createWorkEvent(tx.DB(), req, userID)
+		_, status, err := h.createWorkEvent(tx.DB(), req, userID, templateVersion)
 		if err != nil {
 			l.Error(err, "failed to create new survey work")
 			c.JSON(status, view.CreateResponse[any](nil, nil, done(err), nil, ""))
@@ -291,13 +363,13 @@ func (h *handler) CreateSurvey(c *gin.Context) {
 	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, done(nil), nil, "ok"))
 }
 
-func (h *handler) createPeerReview(db *gorm.DB, req request.CreateSurveyFeedbackInput, userID string) (int, error) {
+func (h *handler) createPeerReview(db *gorm.DB, req request.CreateSurveyFeedbackInput, userID string, templateVersion *model.SurveyTemplateVersion) (*model.FeedbackEvent, int, error) {
 	//1. convert data
 	var startTime, endTime time.Time
 	var title string
 
 	if req.Year < time.Now().Year()-1 {
-		return http.StatusBadRequest, errs.ErrInvalidYear
+		return nil, http.StatusBadRequest, errs.ErrInvalidYear
 	}
 
 	switch strings.ToLower(strings.ReplaceAll(req.Quarter, " ", "")) {
@@ -310,16 +382,16 @@ func (h *handler) createPeerReview(db *gorm.DB, req request.CreateSurveyFeedback
 		endTime = time.Date(req.Year, 12, 31, 23, 59, 59, 59, time.UTC)
 		title = fmt.Sprintf("Q3/Q4, %d", req.Year)
 	default:
-		return http.StatusBadRequest, errs.ErrInvalidQuarter
+		return nil, http.StatusBadRequest, errs.ErrInvalidQuarter
 	}
 
 	//1.2 check event existed
 	_, err := h.store.FeedbackEvent.OneByTypeInTimeRange(db, model.EventTypeSurvey, model.EventSubtypePeerReview, &startTime, &endTime)
 	if err == nil {
-		return http.StatusBadRequest, errs.ErrEventAlreadyExisted
+		return nil, http.StatusBadRequest, errs.ErrEventAlreadyExisted
 	} else {
 		if !errors.Is(err, gorm.ErrRecordNotFound) {
-			return http.StatusInternalServerError, err
+			return nil, http.StatusInternalServerError, err
 		}
 	}
 
@@ -327,9 +399,14 @@ func (h *handler) createPeerReview(db *gorm.DB, req request.CreateSurveyFeedback
 	createdBy, err := h.store.Employee.One(db, userID, false)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return http.StatusNotFound, errs.ErrEmployeeNotFound
+			return nil, http.StatusNotFound, errs.ErrEmployeeNotFound
 		}
-		return http.StatusInternalServerError, err
+		return nil, http.StatusInternalServerError, err
+	}
+
+	deadline, err := req.ParseDeadline()
+	if err != nil {
+		return nil, http.StatusBadRequest, errs.ErrInvalidDeadline
 	}
 
 	//2. Create FeedbackEvent
@@ -344,15 +421,25 @@ func (h *handler) createPeerReview(db *gorm.DB, req request.CreateSurveyFeedback
 		CreatedBy: createdBy.ID,
 		StartDate: &startTime,
 		EndDate:   &endTime,
+
+		TemplateVersionID: templateVersionID(templateVersion),
+		Deadline:          deadline,
+		ReminderDays:      req.ReminderDays,
+		AutoClose:         req.AutoClose,
 	})
 	if err != nil {
-		return http.StatusInternalServerError, err
+		return nil, http.StatusInternalServerError, err
 	}
 
 	//3. create EmployeeEventTopic
 	employees, err := h.store.Employee.GetByWorkingStatus(db, model.WorkingStatusFullTime)
 	if err != nil {
-		return http.StatusInternalServerError, err
+		return nil, http.StatusInternalServerError, err
+	}
+
+	participants, err := h.getParticipants(db, req.Participants)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
 	}
 
 	eets := make([]model.EmployeeEventTopic, 0)
@@ -364,7 +451,7 @@ func (h *handler) createPeerReview(db *gorm.DB, req request.CreateSurveyFeedback
 				break
 			}
 		}
-		if isDwarves {
+		if isDwarves && (participants == nil || participants[e.ID]) {
 			topicTitle := fmt.Sprintf("Peer Performance Review: %s - %s", e.DisplayName, title)
 			eets = append(eets, model.EmployeeEventTopic{
 				BaseModel: model.BaseModel{
@@ -386,7 +473,7 @@ func (h *handler) createPeerReview(db *gorm.DB, req request.CreateSurveyFeedback
 		}
 		_, err = h.store.EmployeeEventTopic.BatchCreate(db, eets[i:to])
 		if err != nil {
-			return http.StatusInternalServerError, err
+			return nil, http.StatusInternalServerError, err
 		}
 		i = to
 	}
@@ -402,12 +489,12 @@ func (h *handler) createPeerReview(db *gorm.DB, req request.CreateSurveyFeedback
 	//TODO: will reused this function later
 	// peers, err := h.store.WorkUnitMember.GetPeerReviewerInTimeRange(db, &startTime, &endTime)
 	// if err != nil {
-	// 	return http.StatusInternalServerError, err
+	// 	return nil, http.StatusInternalServerError, err
 	// }
 
 	peers, err := h.store.WorkUnitMember.GetActivePeerReviewer(db)
 	if err != nil {
-		return http.StatusInternalServerError, err
+		return nil, http.StatusInternalServerError, err
 	}
 
 	reviewerMap := make(map[model.UUID]model.UUID)
@@ -438,7 +525,7 @@ func (h *handler) createPeerReview(db *gorm.DB, req request.CreateSurveyFeedback
 	}
 
 	for _, e := range eets {
-		if !e.Employee.LineManagerID.IsZero() {
+		if !req.Participants.ExcludeLineManagers && !e.Employee.LineManagerID.IsZero() {
 			_, ok := reviewerMap[e.Employee.LineManagerID]
 			if ok {
 				continue
@@ -468,34 +555,15 @@ func (h *handler) createPeerReview(db *gorm.DB, req request.CreateSurveyFeedback
 		}
 		_, err = h.store.EmployeeEventReviewer.BatchCreate(db, reviewers[i:to])
 		if err != nil {
-			return http.StatusInternalServerError, err
+			return nil, http.StatusInternalServerError, err
 		}
 		i = to
 	}
 
 	//4. create EmployeeEventQuestion
-	questions, err := h.store.Question.AllByCategory(db, model.EventTypeSurvey, model.EventSubtypePeerReview)
+	eventQuestions, err := h.newEventQuestions(db, model.EventSubtypePeerReview, templateVersion, reviewers)
 	if err != nil {
-		return http.StatusInternalServerError, err
-	}
-
-	eventQuestions := make([]model.EmployeeEventQuestion, 0)
-
-	for _, r := range reviewers {
-		for _, q := range questions {
-			eventQuestions = append(eventQuestions, model.EmployeeEventQuestion{
-				BaseModel: model.BaseModel{
-					ID: model.NewUUID(),
-				},
-				EmployeeEventReviewerID: r.ID,
-				QuestionID:              q.ID,
-				EventID:                 r.EventID,
-				Content:                 q.Content,
-				Type:                    q.Type.String(),
-				Order:                   q.Order,
-				Domain:                  q.Domain,
-			})
-		}
+		return nil, http.StatusInternalServerError, err
 	}
 
 	i = 0
@@ -506,21 +574,21 @@ func (h *handler) createPeerReview(db *gorm.DB, req request.CreateSurveyFeedback
 		}
 		_, err = h.store.EmployeeEventQuestion.BatchCreate(db, eventQuestions[i:to])
 		if err != nil {
-			return http.StatusInternalServerError, err
+			return nil, http.StatusInternalServerError, err
 		}
 		i = to
 	}
 
-	return http.StatusOK, nil
+	return event, http.StatusOK, nil
 }
 
-func (h *handler) createEngagement(db *gorm.DB, req request.CreateSurveyFeedbackInput, userID string) (int, error) {
+func (h *handler) createEngagement(db *gorm.DB, req request.CreateSurveyFeedbackInput, userID string, templateVersion *model.SurveyTemplateVersion) (*model.FeedbackEvent, int, error) {
 	//1. convert data
 	var startTime, endTime time.Time
 	var title string
 
 	if req.Year < time.Now().Year()-1 {
-		return http.StatusBadRequest, errs.ErrInvalidYear
+		return nil, http.StatusBadRequest, errs.ErrInvalidYear
 	}
 
 	switch strings.ToLower(strings.ReplaceAll(req.Quarter, " ", "")) {
@@ -541,16 +609,16 @@ func (h *handler) createEngagement(db *gorm.DB, req request.CreateSurveyFeedback
 		endTime = time.Date(req.Year, 12, 31, 23, 59, 59, 59, time.UTC)
 		title = fmt.Sprintf("Q4, %d", req.Year)
 	default:
-		return http.StatusBadRequest, errs.ErrInvalidQuarter
+		return nil, http.StatusBadRequest, errs.ErrInvalidQuarter
 	}
 
 	//1.2 check event existed
 	_, err := h.store.FeedbackEvent.OneByTypeInTimeRange(db, model.EventTypeSurvey, model.EventSubtypeEngagement, &startTime, &endTime)
 	if err == nil {
-		return http.StatusBadRequest, errs.ErrEventAlreadyExisted
+		return nil, http.StatusBadRequest, errs.ErrEventAlreadyExisted
 	} else {
 		if !errors.Is(err, gorm.ErrRecordNotFound) {
-			return http.StatusInternalServerError, err
+			return nil, http.StatusInternalServerError, err
 		}
 	}
 
@@ -558,9 +626,20 @@ func (h *handler) createEngagement(db *gorm.DB, req request.CreateSurveyFeedback
 	createdBy, err := h.store.Employee.One(db, userID, false)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return http.StatusNotFound, errs.ErrEmployeeNotFound
+			return nil, http.StatusNotFound, errs.ErrEmployeeNotFound
 		}
-		return http.StatusInternalServerError, err
+		return nil, http.StatusInternalServerError, err
+	}
+
+	//1.4 get anonymity of the survey
+	anonymity, threshold, err := h.getAnonymity(req)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	deadline, err := req.ParseDeadline()
+	if err != nil {
+		return nil, http.StatusBadRequest, errs.ErrInvalidDeadline
 	}
 
 	//2. Create FeedbackEvent
@@ -575,15 +654,27 @@ func (h *handler) createEngagement(db *gorm.DB, req request.CreateSurveyFeedback
 		CreatedBy: createdBy.ID,
 		StartDate: &startTime,
 		EndDate:   &endTime,
+
+		TemplateVersionID:  templateVersionID(templateVersion),
+		Anonymity:          anonymity,
+		AnonymityThreshold: threshold,
+		Deadline:           deadline,
+		ReminderDays:       req.ReminderDays,
+		AutoClose:          req.AutoClose,
 	})
 	if err != nil {
-		return http.StatusInternalServerError, err
+		return nil, http.StatusInternalServerError, err
 	}
 
 	//3. create EmployeeEventTopic
 	employees, err := h.store.Employee.GetByWorkingStatus(db, model.WorkingStatusFullTime)
 	if err != nil {
-		return http.StatusInternalServerError, err
+		return nil, http.StatusInternalServerError, err
+	}
+
+	participants, err := h.getParticipants(db, req.Participants)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
 	}
 
 	eets := make([]model.EmployeeEventTopic, 0)
@@ -595,7 +686,7 @@ func (h *handler) createEngagement(db *gorm.DB, req request.CreateSurveyFeedback
 				break
 			}
 		}
-		if isDwarves {
+		if isDwarves && (participants == nil || participants[e.ID]) {
 			topicTitle := fmt.Sprintf("Engagement Survey: %s - %s", e.DisplayName, title)
 			eets = append(eets, model.EmployeeEventTopic{
 				BaseModel: model.BaseModel{
@@ -616,7 +707,7 @@ func (h *handler) createEngagement(db *gorm.DB, req request.CreateSurveyFeedback
 		}
 		_, err = h.store.EmployeeEventTopic.BatchCreate(db, eets[i:to])
 		if err != nil {
-			return http.StatusInternalServerError, err
+			return nil, http.StatusInternalServerError, err
 		}
 		i = to
 	}
@@ -653,34 +744,15 @@ func (h *handler) createEngagement(db *gorm.DB, req request.CreateSurveyFeedback
 		}
 		_, err = h.store.EmployeeEventReviewer.BatchCreate(db, reviewers[i:to])
 		if err != nil {
-			return http.StatusInternalServerError, err
+			return nil, http.StatusInternalServerError, err
 		}
 		i = to
 	}
 
 	//5. create EmployeeEventQuestion
-	questions, err := h.store.Question.AllByCategory(db, model.EventTypeSurvey, model.EventSubtypeEngagement)
+	eventQuestions, err := h.newEventQuestions(db, model.EventSubtypeEngagement, templateVersion, reviewers)
 	if err != nil {
-		return http.StatusInternalServerError, err
-	}
-
-	eventQuestions := make([]model.EmployeeEventQuestion, 0)
-
-	for _, r := range reviewers {
-		for _, q := range questions {
-			eventQuestions = append(eventQuestions, model.EmployeeEventQuestion{
-				BaseModel: model.BaseModel{
-					ID: model.NewUUID(),
-				},
-				EmployeeEventReviewerID: r.ID,
-				QuestionID:              q.ID,
-				EventID:                 r.EventID,
-				Content:                 q.Content,
-				Type:                    q.Type.String(),
-				Order:                   q.Order,
-				Domain:                  q.Domain,
-			})
-		}
+		return nil, http.StatusInternalServerError, err
 	}
 
 	i = 0
@@ -691,26 +763,26 @@ func (h *handler) createEngagement(db *gorm.DB, req request.CreateSurveyFeedback
 		}
 		_, err = h.store.EmployeeEventQuestion.BatchCreate(db, eventQuestions[i:to])
 		if err != nil {
-			return http.StatusInternalServerError, err
+			return nil, http.StatusInternalServerError, err
 		}
 		i = to
 	}
 
-	return 200, nil
+	return event, http.StatusOK, nil
 }
 
-func (h *handler) createWorkEvent(db *gorm.DB, req request.CreateSurveyFeedbackInput, userID string) (int, error) {
+func (h *handler) createWorkEvent(db *gorm.DB, req request.CreateSurveyFeedbackInput, userID string, templateVersion *model.SurveyTemplateVersion) (*model.FeedbackEvent, int, error) {
 	//1.1 convert data
 	fromDate, err := time.Parse("2006-01-02", req.FromDate)
 	if err != nil {
-		return http.StatusBadRequest, errs.ErrInvalidDate
+		return nil, http.StatusBadRequest, errs.ErrInvalidDate
 	}
 	toDate, err := time.Parse("2006-01-02", req.ToDate)
 	if err != nil {
-		return http.StatusBadRequest, errs.ErrInvalidDate
+		return nil, http.StatusBadRequest, errs.ErrInvalidDate
 	}
 	if fromDate.Add(time.Hour * 24 * 14).Before(toDate) {
-		return http.StatusBadRequest, errs.ErrInvalidDateRange
+		return nil, http.StatusBadRequest, errs.ErrInvalidDateRange
 	}
 
 	title := fromDate.Format("Jan 02, 2006") + " - " + toDate.Format("Jan 02, 2006")
@@ -718,10 +790,10 @@ func (h *handler) createWorkEvent(db *gorm.DB, req request.CreateSurveyFeedbackI
 	//1.2 check event existed
 	_, err = h.store.FeedbackEvent.OneByTypeInTimeRange(db, model.EventTypeSurvey, model.EventSubtypeWork, &fromDate, &toDate)
 	if err == nil {
-		return http.StatusBadRequest, errs.ErrEventAlreadyExisted
+		return nil, http.StatusBadRequest, errs.ErrEventAlreadyExisted
 	} else {
 		if !errors.Is(err, gorm.ErrRecordNotFound) {
-			return http.StatusInternalServerError, err
+			return nil, http.StatusInternalServerError, err
 		}
 	}
 
@@ -729,9 +801,14 @@ func (h *handler) createWorkEvent(db *gorm.DB, req request.CreateSurveyFeedbackI
 	createdBy, err := h.store.Employee.One(db, userID, false)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return http.StatusNotFound, errs.ErrEmployeeNotFound
+			return nil, http.StatusNotFound, errs.ErrEmployeeNotFound
 		}
-		return http.StatusInternalServerError, err
+		return nil, http.StatusInternalServerError, err
+	}
+
+	deadline, err := req.ParseDeadline()
+	if err != nil {
+		return nil, http.StatusBadRequest, errs.ErrInvalidDeadline
 	}
 
 	//2. Create FeedbackEvent
@@ -746,9 +823,14 @@ func (h *handler) createWorkEvent(db *gorm.DB, req request.CreateSurveyFeedbackI
 		CreatedBy: createdBy.ID,
 		StartDate: &fromDate,
 		EndDate:   &toDate,
+
+		TemplateVersionID: templateVersionID(templateVersion),
+		Deadline:          deadline,
+		ReminderDays:      req.ReminderDays,
+		AutoClose:         req.AutoClose,
 	})
 	if err != nil {
-		return http.StatusInternalServerError, err
+		return nil, http.StatusInternalServerError, err
 	}
 
 	projects, _, err := h.store.Project.All(db, project.GetListProjectInput{
@@ -756,22 +838,29 @@ func (h *handler) createWorkEvent(db *gorm.DB, req request.CreateSurveyFeedbackI
 		AllowsSendingSurvey: true,
 	}, model.Pagination{})
 	if err != nil {
-		return http.StatusInternalServerError, err
+		return nil, http.StatusInternalServerError, err
 	}
 
 	if len(projects) < 1 {
-		return http.StatusNotFound, errs.ErrNoValidProjectForEvent
+		return nil, http.StatusNotFound, errs.ErrNoValidProjectForEvent
 	}
 
 	projectIDs := make([]string, 0)
 	for _, p := range projects {
+		if len(req.Participants.ProjectIDs) > 0 && !slices.Contains(req.Participants.ProjectIDs, p.ID.String()) {
+			continue
+		}
 		projectIDs = append(projectIDs, p.ID.String())
 	}
 
+	if len(projectIDs) < 1 {
+		return nil, http.StatusNotFound, errs.ErrNoValidProjectForEvent
+	}
+
 	//3. create EmployeeEventTopic
 	employees, err := h.store.ProjectMember.GetActiveByProjectIDs(db, projectIDs)
 	if err != nil {
-		return http.StatusInternalServerError, err
+		return nil, http.StatusInternalServerError, err
 	}
 
 	eets := make([]model.EmployeeEventTopic, 0)
@@ -779,9 +868,9 @@ func (h *handler) createWorkEvent(db *gorm.DB, req request.CreateSurveyFeedbackI
 		p, err := h.store.Project.One(db, e.ProjectID.String(), false)
 		if err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return http.StatusNotFound, errs.ErrProjectNotFound
+				return nil, http.StatusNotFound, errs.ErrProjectNotFound
 			}
-			return http.StatusInternalServerError, err
+			return nil, http.StatusInternalServerError, err
 		}
 
 		eets = append(eets, model.EmployeeEventTopic{
@@ -803,7 +892,7 @@ func (h *handler) createWorkEvent(db *gorm.DB, req request.CreateSurveyFeedbackI
 		}
 		_, err = h.store.EmployeeEventTopic.BatchCreate(db, eets[i:to])
 		if err != nil {
-			return http.StatusInternalServerError, err
+			return nil, http.StatusInternalServerError, err
 		}
 		i = to
 	}
@@ -840,34 +929,15 @@ func (h *handler) createWorkEvent(db *gorm.DB, req request.CreateSurveyFeedbackI
 		}
 		_, err = h.store.EmployeeEventReviewer.BatchCreate(db, reviewers[i:to])
 		if err != nil {
-			return http.StatusInternalServerError, err
+			return nil, http.StatusInternalServerError, err
 		}
 		i = to
 	}
 
 	//5. create EmployeeEventQuestion
-	questions, err := h.store.Question.AllByCategory(db, model.EventTypeSurvey, model.EventSubtypeWork)
+	eventQuestions, err := h.newEventQuestions(db, model.EventSubtypeWork, templateVersion, reviewers)
 	if err != nil {
-		return http.StatusInternalServerError, err
-	}
-
-	eventQuestions := make([]model.EmployeeEventQuestion, 0)
-
-	for _, r := range reviewers {
-		for _, q := range questions {
-			eventQuestions = append(eventQuestions, model.EmployeeEventQuestion{
-				BaseModel: model.BaseModel{
-					ID: model.NewUUID(),
-				},
-				EmployeeEventReviewerID: r.ID,
-				QuestionID:              q.ID,
-				EventID:                 r.EventID,
-				Content:                 q.Content,
-				Type:                    q.Type.String(),
-				Order:                   q.Order,
-				Domain:                  q.Domain,
-			})
-		}
+		return nil, http.StatusInternalServerError, err
 	}
 
 	i = 0
@@ -878,12 +948,12 @@ func (h *handler) createWorkEvent(db *gorm.DB, req request.CreateSurveyFeedbackI
 		}
 		_, err = h.store.EmployeeEventQuestion.BatchCreate(db, eventQuestions[i:to])
 		if err != nil {
-			return http.StatusInternalServerError, err
+			return nil, http.StatusInternalServerError, err
 		}
 		i = to
 	}
 
-	return http.StatusOK, nil
+	return event, http.StatusOK, nil
 }
 
 // SendSurvey godoc
@@ -1084,6 +1154,16 @@ func (h *handler) doSurveyDelete(db *gorm.DB, eventID string) (int, error) {
 		return http.StatusInternalServerError, err
 	}
 
+	if err := h.store.SurveyReminder.DeleteByEventID(db, eventID); err != nil {
+		l.Error(err, "failed to delete survey reminders")
+		return http.StatusInternalServerError, err
+	}
+
+	if err := h.store.AnonymousEventAnswer.DeleteByEventID(db, eventID); err != nil {
+		l.Error(err, "failed to delete anonymous answers")
+		return http.StatusInternalServerError, err
+	}
+
 	if err := h.store.EmployeeEventReviewer.DeleteByEventID(db, eventID); err != nil {
 		l.Error(err, "failed to delete event reviewers")
 		return http.StatusInternalServerError, err
@@ -1168,6 +1248,12 @@ func (h *handler) GetSurveyReviewDetail(c *gin.Context) {
 		return
 	}
 
+	if topic.Event.IsAnonymous() {
+		l.Info("answers of anonymous surveys are not linked to reviewers")
+		c.JSON(http.StatusForbidden, view.CreateResponse[any](nil, nil, errs.ErrAnonymousSurvey, nil, ""))
+		return
+	}
+
 	questions, err := h.store.EmployeeEventQuestion.GetByEventReviewerID(h.repo.DB(), review.ID.String())
 	if err != nil {
 		l.Error(err, "failed when getting questions")
@@ -1487,7 +1573,7 @@ func (h *handler) updateTopicReviewer(db *gorm.DB, eventID string, topicID strin
 		}
 	}
 
-	if err := h.createEventQuestions(db, model.EventTypeSurvey, model.EventSubtypePeerReview, newEventReviewers); err != nil {
+	if err := h.createEventQuestions(db, eventID, model.EventSubtypePeerReview, newEventReviewers); err != nil {
 		l.Error(err, "failed to create event questions")
 		return http.StatusInternalServerError, err
 	}
@@ -1495,22 +1581,142 @@ func (h *handler) updateTopicReviewer(db *gorm.DB, eventID string, topicID strin
 	return http.StatusOK, nil
 }
 
-func (h *handler) createEventQuestions(db *gorm.DB, eventType model.EventType, eventSubtype model.EventSubtype, reviewers []model.EmployeeEventReviewer) error {
+func (h *handler) createEventQuestions(db *gorm.DB, eventID string, eventSubtype model.EventSubtype, reviewers []model.EmployeeEventReviewer) error {
 	l := h.logger.Fields(logger.Fields{
 		"handler":      "survey",
 		"method":       "createEventQuestions",
-		"eventType":    eventType,
+		"eventID":      eventID,
 		"eventSubtype": eventSubtype,
 	})
 
-	questions, err := h.store.Question.AllByCategory(db, eventType, eventSubtype)
+	event, err := h.store.FeedbackEvent.One(db, eventID, false)
 	if err != nil {
-		l.Error(err, "failed to get all questions by category")
+		l.Error(err, "failed to get event")
 		return err
 	}
 
+	// new reviewers get the same template version as the rest of the survey
+	var templateVersion *model.SurveyTemplateVersion
+	if !event.TemplateVersionID.IsZero() {
+		templateVersion, err = h.store.SurveyTemplate.OneVersionByID(db, event.TemplateVersionID.String())
+		if err != nil {
+			l.Error(err, "failed to get survey template version")
+			return err
+		}
+	}
+
+	eventQuestions, err := h.newEventQuestions(db, eventSubtype, templateVersion, reviewers)
+	if err != nil {
+		l.Error(err, "failed to get event questions")
+		return err
+	}
+
+	i := 0
+	for i < len(eventQuestions) {
+		to := i + 100
+		if to > len(eventQuestions) {
+			to = len(eventQuestions)
+		}
+		_, err = h.store.EmployeeEventQuestion.BatchCreate(db, eventQuestions[i:to])
+		if err != nil {
+			l.Error(err, "failed to batch create event questions")
+			return err
+		}
+		i = to
+	}
+
+	return nil
+}
+
+// defaultAnonymityThreshold is used when neither the request nor the config sets a threshold
+const defaultAnonymityThreshold = 5
+
+// getAnonymity returns the anonymity mode of a new survey and the minimum group size of its results
+func (h *handler) getAnonymity(req request.CreateSurveyFeedbackInput) (model.SurveyAnonymity, int, error) {
+	anonymity := model.SurveyAnonymity(req.Anonymity)
+	if !anonymity.IsDetached() {
+		return model.SurveyAnonymityIdentified, 0, nil
+	}
+
+	if anonymity == model.SurveyAnonymityPseudonymous && (h.config == nil || h.config.Survey.PseudonymSecret == "") {
+		return "", 0, errs.ErrPseudonymSecretNotConfigured
+	}
+
+	threshold := req.AnonymityThreshold
+	if threshold == 0 && h.config != nil {
+		threshold = h.config.Survey.AnonymityThreshold
+	}
+	if threshold == 0 {
+		threshold = defaultAnonymityThreshold
+	}
+
+	return anonymity, threshold, nil
+}
+
+// getTemplateVersion returns the template version attached to the new survey, nil when the survey uses the seeded questions
+func (h *handler) getTemplateVersion(db *gorm.DB, req request.CreateSurveyFeedbackInput) (*model.SurveyTemplateVersion, int, error) {
+	if req.TemplateID == "" {
+		return nil, http.StatusOK, nil
+	}
+
+	template, err := h.store.SurveyTemplate.One(db, req.TemplateID, false)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, http.StatusNotFound, errs.ErrSurveyTemplateNotFound
+		}
+		return nil, http.StatusInternalServerError, err
+	}
+
+	if template.Subtype != "" && template.Subtype != model.EventSubtype(req.Type) {
+		return nil, http.StatusBadRequest, errs.ErrTemplateSubtypeMismatch
+	}
+
+	version := req.TemplateVersion
+	if version == 0 {
+		version = template.LatestVersion
+	}
+
+	templateVersion, err := h.store.SurveyTemplate.OneVersion(db, req.TemplateID, version)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, http.StatusNotFound, errs.ErrSurveyTemplateVersionNotFound
+		}
+		return nil, http.StatusInternalServerError, err
+	}
+
+	return templateVersion, http.StatusOK, nil
+}
+
+// newEventQuestions copies the questions of the template version, or the seeded questions of the subtype, for each reviewer
+func (h *handler) newEventQuestions(db *gorm.DB, eventSubtype model.EventSubtype, templateVersion *model.SurveyTemplateVersion, reviewers []model.EmployeeEventReviewer) ([]model.EmployeeEventQuestion, error) {
 	eventQuestions := make([]model.EmployeeEventQuestion, 0)
 
+	if templateVersion != nil {
+		for _, r := range reviewers {
+			for _, q := range templateVersion.Questions {
+				eventQuestions = append(eventQuestions, model.EmployeeEventQuestion{
+					BaseModel: model.BaseModel{
+						ID: model.NewUUID(),
+					},
+					EmployeeEventReviewerID: r.ID,
+					TemplateQuestionID:      q.ID,
+					EventID:                 r.EventID,
+					Content:                 q.Content,
+					Type:                    q.Type.String(),
+					Order:                   q.Order,
+					Domain:                  q.Domain,
+				})
+			}
+		}
+
+		return eventQuestions, nil
+	}
+
+	questions, err := h.store.Question.AllByCategory(db, model.EventTypeSurvey, eventSubtype)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, r := range reviewers {
 		for _, q := range questions {
 			eventQuestions = append(eventQuestions, model.EmployeeEventQuestion{
@@ -1523,25 +1729,19 @@ func (h *handler) createEventQuestions(db *gorm.DB, eventType model.EventType, e
 				Content:                 q.Content,
 				Type:                    q.Type.String(),
 				Order:                   q.Order,
+				Domain:                  q.Domain,
 			})
 		}
 	}
 
-	i := 0
-	for i < len(eventQuestions) {
-		to := i + 100
-		if to > len(eventQuestions) {
-			to = len(eventQuestions)
-		}
-		_, err = h.store.EmployeeEventQuestion.BatchCreate(db, eventQuestions[i:to])
-		if err != nil {
-			l.Error(err, "failed to batch create event questions")
-			return err
-		}
-		i = to
-	}
+	return eventQuestions, nil
+}
 
-	return nil
+func templateVersionID(templateVersion *model.SurveyTemplateVersion) model.UUID {
+	if templateVersion == nil {
+		return model.UUID{}
+	}
+	return templateVersion.ID
 }
 
 // MarkDone godoc
@@ -1606,36 +1806,48 @@ func (h *handler) MarkDone(c *gin.Context) {
 		return
 	}
 
+	if code, err := h.closeEvent(tx.DB(), l, event, false); err != nil {
+		c.JSON(code, view.CreateResponse[any](nil, nil, done(err), eventID, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, done(nil), nil, "ok"))
+}
+
+// closeEvent marks the event done and forces the remaining reviewers to done,
+// nonResponse records them as not having answered before the deadline
+func (h *handler) closeEvent(db *gorm.DB, l logger.Logger, event *model.FeedbackEvent, nonResponse bool) (int, error) {
+	eventID := event.ID.String()
+	now := time.Now()
+
 	event.Status = model.EventStatusDone
+	event.ClosedAt = &now
 
-	_, err = h.store.FeedbackEvent.UpdateSelectedFieldsByID(tx.DB(), eventID, *event, "status")
+	_, err := h.store.FeedbackEvent.UpdateSelectedFieldsByID(db, eventID, *event, "status", "closed_at")
 	if err != nil {
 		l.Error(err, "failed to update feedback event")
-		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, eventID, ""))
-		return
+		return http.StatusInternalServerError, err
 	}
 
 	// Get all topics
-	topics, _, err := h.store.EmployeeEventTopic.All(tx.DB(), employeeeventtopic.GetByEventIDInput{EventID: eventID}, nil)
+	topics, _, err := h.store.EmployeeEventTopic.All(db, employeeeventtopic.GetByEventIDInput{EventID: eventID}, nil)
 	if err != nil {
 		l.Error(err, "failed to get all topics")
-		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, done(err), eventID, ""))
-		return
+		return http.StatusInternalServerError, err
 	}
 
 	// Check done for each topic: all user have to done
 	for _, topic := range topics {
-		if code, err := h.forceEventReviewersToDone(tx.DB(), l, topic.ID.String()); err != nil {
+		if code, err := h.forceEventReviewersToDone(db, l, topic.ID.String(), event.IsAnonymous(), nonResponse); err != nil {
 			l.Errorf(err, "failed to force event reviewers of topic %s to done", topic.ID.String())
-			c.JSON(code, view.CreateResponse[any](nil, nil, done(err), eventID, ""))
-			return
+			return code, err
 		}
 	}
 
-	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, done(nil), nil, "ok"))
+	return http.StatusOK, nil
 }
 
-func (h *handler) forceEventReviewersToDone(db *gorm.DB, l logger.Logger, topicID string) (int, error) {
+func (h *handler) forceEventReviewersToDone(db *gorm.DB, l logger.Logger, topicID string, clearDrafts bool, nonResponse bool) (int, error) {
 	// Get all reviewers
 	reviewers, err := h.store.EmployeeEventReviewer.GetByTopicID(db, topicID)
 	if err != nil {
@@ -1646,15 +1858,26 @@ func (h *handler) forceEventReviewersToDone(db *gorm.DB, l logger.Logger, topicI
 	for _, reviewer := range reviewers {
 		if reviewer.AuthorStatus != model.EventAuthorStatusDone ||
 			reviewer.ReviewerStatus != model.EventReviewerStatusDone {
+			// reviewers who never got the survey did not miss the deadline
+			reviewer.IsNonResponse = nonResponse && reviewer.ReviewerStatus != model.EventReviewerStatusNone
 			reviewer.AuthorStatus = model.EventAuthorStatusDone
 			reviewer.ReviewerStatus = model.EventReviewerStatusDone
 			reviewer.IsForcedDone = true
+
+			// unfinished drafts of anonymous surveys are still linked to the reviewer, drop them
+			if clearDrafts {
+				if err := h.store.EmployeeEventQuestion.ClearAnswersByEventReviewerID(db, reviewer.ID.String()); err != nil {
+					l.AddField("eventReviewerID", reviewer.ID).Error(err, "failed to clear draft answers")
+					return http.StatusInternalServerError, err
+				}
+			}
 		}
 
 		_, err := h.store.EmployeeEventReviewer.UpdateSelectedFieldsByID(db, reviewer.ID.String(), *reviewer,
 			"author_status",
 			"reviewer_status",
-			"is_forced_done")
+			"is_forced_done",
+			"is_non_response")
 		if err != nil {
 			l.AddField("eventReviewerID", reviewer.ID).Error(err, "failed to update event reviewer status")
 			return http.StatusInternalServerError, err
@@ -142,6 +142,71 @@ func TestHandler_GetSurveyDetail(t *testing.T) {
 	}
 }
 
+func TestHandler_ListSurveyResponses(t *testing.T) {
+	cfg := config.LoadTestConfig()
+	loggerMock := logger.NewLogrusLogger()
+	serviceMock := service.New(&cfg, nil, nil)
+	storeMock := store.New()
+
+	tests := []struct {
+		name             string
+		id               string
+		wantCode         int
+		wantResponsePath string
+	}{
+		{
+			name:             "happy_case",
+			id:               "6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f02",
+			wantCode:         http.StatusOK,
+			wantResponsePath: "testdata/list_survey_responses/200_happy_case.json",
+		},
+		{
+			name:             "ok_hidden_below_threshold",
+			id:               "6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f01",
+			wantCode:         http.StatusOK,
+			wantResponsePath: "testdata/list_survey_responses/200_hidden.json",
+		},
+		{
+			name:             "failed_identified_survey",
+			id:               "6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f03",
+			wantCode:         http.StatusBadRequest,
+			wantResponsePath: "testdata/list_survey_responses/not_anonymous.json",
+		},
+		{
+			name:             "event_id_not_found",
+			id:               "6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f09",
+			wantCode:         http.StatusNotFound,
+			wantResponsePath: "testdata/list_survey_responses/404.json",
+		},
+		{
+			name:             "invalid_event_id_format",
+			id:               "6f0c2d3e-8a41-4b7e-9d52",
+			wantCode:         http.StatusBadRequest,
+			wantResponsePath: "testdata/list_survey_responses/invalid_event_id.json",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testhelper.TestWithTxDB(t, func(txRepo store.DBRepo) {
+				testhelper.LoadTestSQLFile(t, txRepo, "./testdata/list_survey_responses/list_survey_responses.sql")
+				w := httptest.NewRecorder()
+				ctx, _ := gin.CreateTestContext(w)
+				ctx.Request = httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/surveys/%s/responses", tt.id), nil)
+				ctx.Request.Header.Set("Authorization", testToken)
+				ctx.AddParam("id", tt.id)
+
+				h := New(storeMock, txRepo, serviceMock, loggerMock, &cfg)
+				h.ListSurveyResponses(ctx)
+				require.Equal(t, tt.wantCode, w.Code)
+				expRespRaw, err := os.ReadFile(tt.wantResponsePath)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expRespRaw), w.Body.String(), "[Handler.Survey.ListSurveyResponses] response mismatched")
+			})
+		})
+	}
+}
+
 func TestHandler_SendPerformanceReview(t *testing.T) {
 	cfg := config.LoadTestConfig()
 	loggerMock := logger.NewLogrusLogger()
@@ -750,6 +815,14 @@ func TestHandler_GetSurveyReviewDetail(t *testing.T) {
 			wantCode:         http.StatusOK,
 			wantResponsePath: "testdata/get_survey_review_detail/200_work.json",
 		},
+		{
+			name:             "failed_anonymous_survey",
+			eventID:          "6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f01",
+			topicID:          "6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f11",
+			reviewerID:       "6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f21",
+			wantCode:         http.StatusForbidden,
+			wantResponsePath: "testdata/get_survey_review_detail/403_anonymous.json",
+		},
 		{
 			name:             "empty_event_id",
 			eventID:          "",
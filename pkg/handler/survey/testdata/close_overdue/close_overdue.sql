@@ -0,0 +1,14 @@
+-- both surveys passed their deadline, only the first one closes by itself
+INSERT INTO public.feedback_events (id, deleted_at, created_at, updated_at, title, type, subtype, status, created_by, start_date, end_date, deadline, reminder_days, auto_close) VALUES
+('7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e01', NULL, '2023-07-13 09:22:14.000000', '2023-07-13 09:22:14.000000', 'Q3 2023', 'survey', 'engagement', 'in-progress', 'ecea9d15-05ba-4a4e-9787-54210e3b98ce', NULL, NULL, now() - interval '1 day', '[3]', true),
+('7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e02', NULL, '2023-07-13 09:22:14.000000', '2023-07-13 09:22:14.000000', 'Q2 2023', 'survey', 'engagement', 'in-progress', 'ecea9d15-05ba-4a4e-9787-54210e3b98ce', NULL, NULL, now() - interval '1 day', '[3]', false);
+
+INSERT INTO public.employee_event_topics (id, deleted_at, created_at, updated_at, title, event_id, employee_id, project_id) VALUES
+('7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e11', NULL, '2023-07-13 09:22:14.000000', '2023-07-13 09:22:14.000000', 'Engagement Survey for Huy - Q3, 2023', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e01', 'ecea9d15-05ba-4a4e-9787-54210e3b98ce', NULL),
+('7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e12', NULL, '2023-07-13 09:22:14.000000', '2023-07-13 09:22:14.000000', 'Engagement Survey for Huy - Q2, 2023', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e02', 'ecea9d15-05ba-4a4e-9787-54210e3b98ce', NULL);
+
+INSERT INTO public.employee_event_reviewers (id, deleted_at, created_at, updated_at, event_id, employee_event_topic_id, reviewer_id, relationship, is_shared, is_read, author_status, reviewer_status) VALUES
+('7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e21', NULL, '2023-07-13 09:22:14.000000', '2023-07-13 09:22:14.000000', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e01', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e11', 'ecea9d15-05ba-4a4e-9787-54210e3b98ce', 'self', false, false, 'sent', 'draft'),
+('7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e22', NULL, '2023-07-13 09:22:14.000000', '2023-07-13 09:22:14.000000', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e01', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e11', '2655832e-f009-4b73-a535-64c3a22e558f', 'peer', false, false, 'draft', 'none'),
+('7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e23', NULL, '2023-07-13 09:22:14.000000', '2023-07-13 09:22:14.000000', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e01', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e11', '8d7c99c0-3253-4286-93a9-e7554cb327ef', 'peer', false, false, 'done', 'done'),
+('7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e24', NULL, '2023-07-13 09:22:14.000000', '2023-07-13 09:22:14.000000', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e02', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e12', 'ecea9d15-05ba-4a4e-9787-54210e3b98ce', 'self', false, false, 'sent', 'draft');
@@ -342,3 +342,12 @@ INSERT INTO public.employee_event_questions (id, deleted_at, created_at, updated
 ('3784e437-c7d6-4142-9007-82a7f18f7d50', null, '2022-12-05 16:33:28.085352', '2022-12-05 16:33:28.085352', 'd97ee823-f7d5-418b-b281-711cb1d8e947', '789f1163-f157-4df3-9764-8100277cacba', 'likert-scale', 'How do you feel about your workload?', '1', '', 'e703b6ee-e71a-4897-8716-f3811963ffab', 1, 'workload'),
 ('25ae4b9c-4d44-4946-8034-29ccec02a005', null, '2022-12-05 16:33:28.085352', '2022-12-05 16:33:28.085352', 'd97ee823-f7d5-418b-b281-711cb1d8e947', '789f1163-f157-4df3-9764-8100277cacba', 'likert-scale', 'Do you think the team can make the deadline?', '1', 'something', 'd9bf74dd-6f25-44e3-b83c-9b7fb19af548', 2, 'deadline'),
 ('5c49dbcd-df16-4f04-bb98-a2dbb339e4d6', null, '2022-12-05 16:33:28.085352', '2022-12-05 16:33:28.085352', 'd97ee823-f7d5-418b-b281-711cb1d8e947', '789f1163-f157-4df3-9764-8100277cacba', 'likert-scale', 'How much that you learn about your work and your team this week?', '1', '', '627b2be7-2dcb-4574-b8db-24d67f06e7b0', 3, 'learning');
+
+INSERT INTO public.feedback_events (id, deleted_at, created_at, updated_at, title, type, subtype, status, created_by, start_date, end_date, anonymity, anonymity_threshold) VALUES
+('6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f01', NULL, '2023-07-12 08:03:33.233262', '2023-07-12 08:03:33.233262', 'Q3 2023', 'survey', 'engagement', 'in-progress', 'ecea9d15-05ba-4a4e-9787-54210e3b98ce', NULL, NULL, 'anonymous', 3);
+
+INSERT INTO public.employee_event_topics (id, deleted_at, created_at, updated_at, title, event_id, employee_id, project_id) VALUES
+('6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f11', NULL, '2023-07-12 08:03:33.233262', '2023-07-12 08:03:33.233262', 'Engagement Survey for Thanh - Q3, 2023', '6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f01', '2655832e-f009-4b73-a535-64c3a22e558f', NULL);
+
+INSERT INTO public.employee_event_reviewers (id, deleted_at, created_at, updated_at, event_id, employee_event_topic_id, reviewer_id, relationship, is_shared, is_read, author_status, reviewer_status) VALUES
+('6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f21', NULL, '2023-07-12 08:03:33.233262', '2023-07-12 08:03:33.233262', '6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f01', '6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f11', '2655832e-f009-4b73-a535-64c3a22e558f', 'self', false, false, 'done', 'done');
@@ -0,0 +1,12 @@
+INSERT INTO public.feedback_events (id, deleted_at, created_at, updated_at, title, type, subtype, status, created_by, start_date, end_date, anonymity, anonymity_threshold) VALUES
+('6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f01', NULL, '2023-07-12 08:03:33.233262', '2023-07-12 08:03:33.233262', 'Q3 2023', 'survey', 'engagement', 'in-progress', 'ecea9d15-05ba-4a4e-9787-54210e3b98ce', NULL, NULL, 'anonymous', 3),
+('6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f02', NULL, '2023-07-12 08:03:33.233262', '2023-07-12 08:03:33.233262', 'Q2 2023', 'survey', 'engagement', 'done', 'ecea9d15-05ba-4a4e-9787-54210e3b98ce', NULL, NULL, 'pseudonymous', 2),
+('6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f03', NULL, '2023-07-12 08:03:33.233262', '2023-07-12 08:03:33.233262', 'Q1 2023', 'survey', 'engagement', 'done', 'ecea9d15-05ba-4a4e-9787-54210e3b98ce', NULL, NULL, 'identified', 0);
+
+INSERT INTO public.anonymous_event_answers (id, deleted_at, created_at, updated_at, event_id, employee_event_topic_id, response_key, question_id, template_question_id, content, type, answer, note, "order", domain, seniority, chapters, projects) VALUES
+('6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f41', NULL, '2023-07-12 00:00:00', '2023-07-12 00:00:00', '6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f01', NULL, '0b7e5b0e-7f7d-4a0c-a1b6-4a4d2a1f9c01', '4e71821e-e8d7-4fc9-9f02-7b4de4efa7f8', NULL, 'I know what is expected of me at work.', 'likert-scale', '4', 'ok', 1, 'engagement', 'Senior', '["Backend"]', '["Fortress"]'),
+('6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f42', NULL, '2023-07-12 00:00:00', '2023-07-12 00:00:00', '6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f01', NULL, '0b7e5b0e-7f7d-4a0c-a1b6-4a4d2a1f9c02', '4e71821e-e8d7-4fc9-9f02-7b4de4efa7f8', NULL, 'I know what is expected of me at work.', 'likert-scale', '3', '', 1, 'engagement', 'Junior', '["Frontend"]', '["Fortress"]'),
+('6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f43', NULL, '2023-06-12 00:00:00', '2023-06-12 00:00:00', '6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f02', NULL, '1c4f7e2a9b3d', '4e71821e-e8d7-4fc9-9f02-7b4de4efa7f8', NULL, 'I know what is expected of me at work.', 'likert-scale', '5', 'great', 1, 'engagement', 'Senior', '["Backend"]', '["Fortress"]'),
+('6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f44', NULL, '2023-06-12 00:00:00', '2023-06-12 00:00:00', '6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f02', NULL, '1c4f7e2a9b3d', 'ba00c397-f8ad-4cbd-ba63-02ac903d0886', NULL, 'I have the materials and equipment I need to do my work right.', 'likert-scale', '4', '', 2, 'engagement', 'Senior', '["Backend"]', '["Fortress"]'),
+('6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f45', NULL, '2023-06-12 00:00:00', '2023-06-12 00:00:00', '6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f02', NULL, '8d2a6c5e1f0b', '4e71821e-e8d7-4fc9-9f02-7b4de4efa7f8', NULL, 'I know what is expected of me at work.', 'likert-scale', '2', 'unclear goals', 1, 'engagement', 'Junior', '["Frontend"]', '["Fortress"]'),
+('6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f46', NULL, '2023-06-12 00:00:00', '2023-06-12 00:00:00', '6f0c2d3e-8a41-4b7e-9d52-3c1a7e4b2f02', NULL, '8d2a6c5e1f0b', 'ba00c397-f8ad-4cbd-ba63-02ac903d0886', NULL, 'I have the materials and equipment I need to do my work right.', 'likert-scale', '3', '', 2, 'engagement', 'Junior', '["Frontend"]', '["Fortress"]');
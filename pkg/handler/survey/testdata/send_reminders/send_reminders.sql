@@ -0,0 +1,26 @@
+INSERT INTO public.discord_accounts (id, deleted_at, created_at, updated_at, discord_id, username) VALUES
+('7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e51', NULL, '2023-07-13 09:22:14.000000', '2023-07-13 09:22:14.000000', '790170208228212766', 'thanh');
+
+UPDATE public.employees SET discord_account_id = '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e51' WHERE id = '2655832e-f009-4b73-a535-64c3a22e558f';
+
+-- the first survey is due for its 5 days reminder, the second one is not
+INSERT INTO public.feedback_events (id, deleted_at, created_at, updated_at, title, type, subtype, status, created_by, start_date, end_date, deadline, reminder_days, auto_close) VALUES
+('7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e01', NULL, '2023-07-13 09:22:14.000000', '2023-07-13 09:22:14.000000', 'Q3 2023', 'survey', 'engagement', 'in-progress', 'ecea9d15-05ba-4a4e-9787-54210e3b98ce', NULL, NULL, now() + interval '2 days', '[5, 1]', false),
+('7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e02', NULL, '2023-07-13 09:22:14.000000', '2023-07-13 09:22:14.000000', 'Q4 2023', 'survey', 'engagement', 'in-progress', 'ecea9d15-05ba-4a4e-9787-54210e3b98ce', NULL, NULL, now() + interval '10 days', '[5]', false);
+
+INSERT INTO public.employee_event_topics (id, deleted_at, created_at, updated_at, title, event_id, employee_id, project_id) VALUES
+('7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e11', NULL, '2023-07-13 09:22:14.000000', '2023-07-13 09:22:14.000000', 'Engagement Survey for Thanh - Q3, 2023', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e01', '2655832e-f009-4b73-a535-64c3a22e558f', NULL),
+('7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e12', NULL, '2023-07-13 09:22:14.000000', '2023-07-13 09:22:14.000000', 'Engagement Survey for Huy - Q3, 2023', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e01', 'ecea9d15-05ba-4a4e-9787-54210e3b98ce', NULL),
+('7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e13', NULL, '2023-07-13 09:22:14.000000', '2023-07-13 09:22:14.000000', 'Engagement Survey for Huy - Q4, 2023', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e02', 'ecea9d15-05ba-4a4e-9787-54210e3b98ce', NULL);
+
+INSERT INTO public.employee_event_reviewers (id, deleted_at, created_at, updated_at, event_id, employee_event_topic_id, reviewer_id, relationship, is_shared, is_read, author_status, reviewer_status) VALUES
+('7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e21', NULL, '2023-07-13 09:22:14.000000', '2023-07-13 09:22:14.000000', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e01', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e11', '2655832e-f009-4b73-a535-64c3a22e558f', 'self', false, false, 'sent', 'draft'),
+('7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e22', NULL, '2023-07-13 09:22:14.000000', '2023-07-13 09:22:14.000000', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e01', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e12', '2655832e-f009-4b73-a535-64c3a22e558f', 'peer', false, false, 'sent', 'new'),
+('7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e23', NULL, '2023-07-13 09:22:14.000000', '2023-07-13 09:22:14.000000', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e01', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e12', 'ecea9d15-05ba-4a4e-9787-54210e3b98ce', 'self', false, false, 'sent', 'draft'),
+('7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e24', NULL, '2023-07-13 09:22:14.000000', '2023-07-13 09:22:14.000000', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e01', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e12', '8d7c99c0-3253-4286-93a9-e7554cb327ef', 'peer', false, false, 'sent', 'draft'),
+('7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e25', NULL, '2023-07-13 09:22:14.000000', '2023-07-13 09:22:14.000000', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e01', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e12', 'eeae589a-94e3-49ac-a94c-fcfb084152b2', 'peer', false, false, 'done', 'done'),
+('7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e26', NULL, '2023-07-13 09:22:14.000000', '2023-07-13 09:22:14.000000', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e02', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e13', 'ecea9d15-05ba-4a4e-9787-54210e3b98ce', 'self', false, false, 'sent', 'draft');
+
+-- Nam already got the 5 days reminder
+INSERT INTO public.survey_reminders (id, deleted_at, created_at, updated_at, event_id, employee_event_reviewer_id, reviewer_id, days_before, channel, sent_at) VALUES
+('7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e31', NULL, '2023-07-13 09:22:14.000000', '2023-07-13 09:22:14.000000', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e01', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e24', '8d7c99c0-3253-4286-93a9-e7554cb327ef', 5, 'email', '2023-07-13 09:22:14.000000');
@@ -0,0 +1,12 @@
+INSERT INTO public.feedback_events (id, deleted_at, created_at, updated_at, title, type, subtype, status, created_by, start_date, end_date, deadline, reminder_days, auto_close) VALUES
+('7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e01', NULL, '2023-07-13 09:22:14.000000', '2023-07-13 09:22:14.000000', 'Q3 2023', 'survey', 'engagement', 'in-progress', 'ecea9d15-05ba-4a4e-9787-54210e3b98ce', NULL, NULL, '2099-01-01 23:59:59', '[3, 1]', false),
+('7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e02', NULL, '2023-07-13 09:22:14.000000', '2023-07-13 09:22:14.000000', 'Q2 2023', 'survey', 'engagement', 'done', 'ecea9d15-05ba-4a4e-9787-54210e3b98ce', NULL, NULL, NULL, NULL, false);
+
+INSERT INTO public.employee_event_topics (id, deleted_at, created_at, updated_at, title, event_id, employee_id, project_id) VALUES
+('7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e11', NULL, '2023-07-13 09:22:14.000000', '2023-07-13 09:22:14.000000', 'Engagement Survey for Thanh - Q3, 2023', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e01', '2655832e-f009-4b73-a535-64c3a22e558f', NULL);
+
+INSERT INTO public.employee_event_reviewers (id, deleted_at, created_at, updated_at, event_id, employee_event_topic_id, reviewer_id, relationship, is_shared, is_read, author_status, reviewer_status) VALUES
+('7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e21', NULL, '2023-07-13 09:22:14.000000', '2023-07-13 09:22:14.000000', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e01', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e11', '2655832e-f009-4b73-a535-64c3a22e558f', 'self', false, false, 'sent', 'draft');
+
+INSERT INTO public.survey_reminders (id, deleted_at, created_at, updated_at, event_id, employee_event_reviewer_id, reviewer_id, days_before, channel, sent_at) VALUES
+('7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e31', NULL, '2023-07-13 09:22:14.000000', '2023-07-13 09:22:14.000000', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e01', '7c1d4e2f-3a5b-4c6d-8e9f-0a1b2c3d4e21', '2655832e-f009-4b73-a535-64c3a22e558f', 3, 'discord', '2023-07-13 09:22:14.000000');
@@ -0,0 +1,53 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/surveycampaign"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidSurveyCampaignID = errors.New("invalid survey campaign ID")
+	ErrInvalidSubtype          = errors.New("invalid survey subtype")
+	ErrInvalidRecurrence       = errors.New("invalid recurrence")
+	ErrInvalidStartDate        = errors.New("invalid start date")
+	ErrInvalidTemplateID       = errors.New("invalid survey template id")
+	ErrInvalidIsActive         = errors.New("invalid isActive, must be true or false")
+	ErrEmptyName               = errors.New("name is required")
+)
+
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, surveycampaign.ErrSurveyCampaignNotFound),
+		errors.Is(err, surveycampaign.ErrSurveyTemplateNotFound),
+		errors.Is(err, surveycampaign.ErrCreatorNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, surveycampaign.ErrInvalidSubtype),
+		errors.Is(err, surveycampaign.ErrInvalidRecurrence),
+		errors.Is(err, surveycampaign.ErrInvalidLeadDays),
+		errors.Is(err, surveycampaign.ErrInvalidDeadlineDays),
+		errors.Is(err, surveycampaign.ErrInvalidReminderDays),
+		errors.Is(err, surveycampaign.ErrDeadlineRequired),
+		errors.Is(err, surveycampaign.ErrInvalidAnonymity),
+		errors.Is(err, surveycampaign.ErrAnonymityNotSupported),
+		errors.Is(err, surveycampaign.ErrInvalidThreshold),
+		errors.Is(err, surveycampaign.ErrPseudonymSecretNotConfigured),
+		errors.Is(err, surveycampaign.ErrTemplateSubtypeMismatch),
+		errors.Is(err, surveycampaign.ErrInvalidProjectID):
+		status = http.StatusBadRequest
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
@@ -0,0 +1,11 @@
+package surveycampaign
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	List(c *gin.Context)
+	Detail(c *gin.Context)
+	Create(c *gin.Context)
+	Update(c *gin.Context)
+	ListRuns(c *gin.Context)
+}
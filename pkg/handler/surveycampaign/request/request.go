@@ -0,0 +1,110 @@
+package request
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/handler/surveycampaign/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type GetListSurveyCampaignInput struct {
+	model.Pagination
+
+	Subtype  string `json:"subtype" form:"subtype"`
+	IsActive string `json:"isActive" form:"isActive"`
+	Keyword  string `json:"keyword" form:"keyword"`
+}
+
+func (i *GetListSurveyCampaignInput) Validate() error {
+	if i.Subtype != "" && !model.EventSubtype(i.Subtype).IsValidSurvey() {
+		return errs.ErrInvalidSubtype
+	}
+
+	if i.IsActive != "" {
+		if _, err := strconv.ParseBool(i.IsActive); err != nil {
+			return errs.ErrInvalidIsActive
+		}
+	}
+
+	return nil
+}
+
+// GetIsActive returns the isActive filter, nil when it is not set
+func (i *GetListSurveyCampaignInput) GetIsActive() *bool {
+	if i.IsActive == "" {
+		return nil
+	}
+
+	isActive, _ := strconv.ParseBool(i.IsActive)
+	return &isActive
+}
+
+// SurveyCampaignSettings are the settings of the surveys created by a campaign
+type SurveyCampaignSettings struct {
+	Name string `json:"name" binding:"required"`
+	// LeadDays is the number of days before the end of a period the survey is created and sent
+	LeadDays int `json:"leadDays"`
+	// DeadlineDays is the number of days reviewers have to answer, zero means no deadline
+	DeadlineDays       int                         `json:"deadlineDays"`
+	ReminderDays       []int                       `json:"reminderDays"`
+	AutoClose          bool                        `json:"autoClose"`
+	TemplateID         string                      `json:"templateID"`
+	Anonymity          string                      `json:"anonymity"`
+	AnonymityThreshold int                         `json:"anonymityThreshold"`
+	Participants       model.SurveyParticipantRule `json:"participants"`
+}
+
+func (i *SurveyCampaignSettings) Validate() error {
+	if strings.TrimSpace(i.Name) == "" {
+		return errs.ErrEmptyName
+	}
+
+	if i.TemplateID != "" && !model.IsUUIDFromString(i.TemplateID) {
+		return errs.ErrInvalidTemplateID
+	}
+
+	return nil
+}
+
+type CreateSurveyCampaignInput struct {
+	SurveyCampaignSettings
+
+	Subtype    string `json:"subtype" binding:"required"`
+	Recurrence string `json:"recurrence" binding:"required"`
+	// StartDate is a date in YYYY-MM-DD format, defaults to today
+	StartDate string `json:"startDate"`
+}
+
+func (i *CreateSurveyCampaignInput) Validate() error {
+	if !model.EventSubtype(i.Subtype).IsValidSurvey() {
+		return errs.ErrInvalidSubtype
+	}
+
+	if !model.SurveyRecurrence(i.Recurrence).IsValid() {
+		return errs.ErrInvalidRecurrence
+	}
+
+	if _, err := i.GetStartDate(); err != nil {
+		return errs.ErrInvalidStartDate
+	}
+
+	return i.SurveyCampaignSettings.Validate()
+}
+
+// GetStartDate returns the start date of the campaign
+func (i *CreateSurveyCampaignInput) GetStartDate() (time.Time, error) {
+	if i.StartDate == "" {
+		now := time.Now()
+		return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC), nil
+	}
+
+	return time.Parse("2006-01-02", i.StartDate)
+}
+
+type UpdateSurveyCampaignInput struct {
+	SurveyCampaignSettings
+
+	IsActive bool `json:"isActive"`
+}
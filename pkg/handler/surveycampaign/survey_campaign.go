@@ -0,0 +1,299 @@
+package surveycampaign
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	"github.com/dwarvesf/fortress-api/pkg/controller/surveycampaign"
+	"github.com/dwarvesf/fortress-api/pkg/handler/surveycampaign/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/surveycampaign/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	controller *controller.Controller
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		controller: controller,
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+	}
+}
+
+// List godoc
+// @Summary Get list of survey campaigns
+// @Description Get list of survey campaigns
+// @Tags SurveyCampaign
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param subtype query string false "Survey subtype"
+// @Param isActive query bool false "Is active"
+// @Param keyword query string false "Keyword"
+// @Param page query string false "Page"
+// @Param size query string false "Size"
+// @Success 200 {object} view.ListSurveyCampaignResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /survey-campaigns [get]
+func (h *handler) List(c *gin.Context) {
+	input := request.GetListSurveyCampaignInput{}
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	input.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "surveycampaign",
+		"method":  "List",
+		"input":   input,
+	})
+
+	campaigns, total, err := h.controller.SurveyCampaign.List(surveycampaign.ListInput{
+		Pagination: input.Pagination,
+		Subtype:    input.Subtype,
+		IsActive:   input.GetIsActive(),
+		Keyword:    input.Keyword,
+	})
+	if err != nil {
+		l.Error(err, "failed to get list survey campaigns")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToSurveyCampaigns(campaigns),
+		&view.PaginationResponse{Pagination: input.Pagination, Total: total}, nil, nil, ""))
+}
+
+// Detail godoc
+// @Summary Get detail of a survey campaign
+// @Description Get detail of a survey campaign
+// @Tags SurveyCampaign
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Survey campaign ID"
+// @Success 200 {object} view.SurveyCampaignResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /survey-campaigns/{id} [get]
+func (h *handler) Detail(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidSurveyCampaignID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "surveycampaign",
+		"method":  "Detail",
+		"id":      id,
+	})
+
+	campaign, err := h.controller.SurveyCampaign.Detail(id)
+	if err != nil {
+		l.Error(err, "failed to get survey campaign")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToSurveyCampaign(campaign), nil, nil, nil, ""))
+}
+
+// Create godoc
+// @Summary Create a survey campaign
+// @Description Create a survey campaign, the scheduler creates and sends a survey every period
+// @Tags SurveyCampaign
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param Body body request.CreateSurveyCampaignInput true "Body"
+// @Success 200 {object} view.SurveyCampaignResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /survey-campaigns [post]
+func (h *handler) Create(c *gin.Context) {
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	input := request.CreateSurveyCampaignInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "surveycampaign",
+		"method":  "Create",
+		"input":   input,
+	})
+
+	startDate, _ := input.GetStartDate()
+
+	campaign, err := h.controller.SurveyCampaign.Create(surveycampaign.CreateInput{
+		Name:               strings.TrimSpace(input.Name),
+		Subtype:            model.EventSubtype(input.Subtype),
+		Recurrence:         model.SurveyRecurrence(input.Recurrence),
+		StartDate:          startDate,
+		LeadDays:           input.LeadDays,
+		DeadlineDays:       input.DeadlineDays,
+		ReminderDays:       input.ReminderDays,
+		AutoClose:          input.AutoClose,
+		TemplateID:         input.TemplateID,
+		Anonymity:          model.SurveyAnonymity(input.Anonymity),
+		AnonymityThreshold: input.AnonymityThreshold,
+		ParticipantRule:    input.Participants,
+		CreatedBy:          userID,
+	})
+	if err != nil {
+		l.Error(err, "failed to create survey campaign")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToSurveyCampaign(campaign), nil, nil, nil, ""))
+}
+
+// Update godoc
+// @Summary Update a survey campaign
+// @Description Update the settings of a survey campaign, surveys already created are not changed
+// @Tags SurveyCampaign
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Survey campaign ID"
+// @Param Body body request.UpdateSurveyCampaignInput true "Body"
+// @Success 200 {object} view.SurveyCampaignResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /survey-campaigns/{id} [put]
+func (h *handler) Update(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidSurveyCampaignID, nil, ""))
+		return
+	}
+
+	input := request.UpdateSurveyCampaignInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "surveycampaign",
+		"method":  "Update",
+		"id":      id,
+		"input":   input,
+	})
+
+	campaign, err := h.controller.SurveyCampaign.Update(surveycampaign.UpdateInput{
+		ID:                 id,
+		Name:               strings.TrimSpace(input.Name),
+		LeadDays:           input.LeadDays,
+		DeadlineDays:       input.DeadlineDays,
+		ReminderDays:       input.ReminderDays,
+		AutoClose:          input.AutoClose,
+		TemplateID:         input.TemplateID,
+		Anonymity:          model.SurveyAnonymity(input.Anonymity),
+		AnonymityThreshold: input.AnonymityThreshold,
+		ParticipantRule:    input.Participants,
+		IsActive:           input.IsActive,
+	})
+	if err != nil {
+		l.Error(err, "failed to update survey campaign")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToSurveyCampaign(campaign), nil, nil, nil, ""))
+}
+
+// ListRuns godoc
+// @Summary Get history of a survey campaign
+// @Description Get the surveys created by a survey campaign, latest period first
+// @Tags SurveyCampaign
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Survey campaign ID"
+// @Param page query string false "Page"
+// @Param size query string false "Size"
+// @Success 200 {object} view.ListSurveyCampaignRunResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /survey-campaigns/{id}/runs [get]
+func (h *handler) ListRuns(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidSurveyCampaignID, nil, ""))
+		return
+	}
+
+	pagination := model.Pagination{}
+	if err := c.ShouldBindQuery(&pagination); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+	pagination.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "surveycampaign",
+		"method":  "ListRuns",
+		"id":      id,
+	})
+
+	runs, total, err := h.controller.SurveyCampaign.ListRuns(id, pagination)
+	if err != nil {
+		l.Error(err, "failed to get survey campaign runs")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToSurveyCampaignRuns(runs),
+		&view.PaginationResponse{Pagination: pagination, Total: total}, nil, nil, ""))
+}
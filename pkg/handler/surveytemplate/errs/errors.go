@@ -0,0 +1,41 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/surveytemplate"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidSurveyTemplateID = errors.New("invalid survey template ID")
+	ErrInvalidVersion          = errors.New("invalid survey template version")
+	ErrInvalidSubtype          = errors.New("invalid survey subtype")
+	ErrEmptyName               = errors.New("name is required")
+)
+
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, surveytemplate.ErrSurveyTemplateNotFound),
+		errors.Is(err, surveytemplate.ErrSurveyTemplateVersionNotFound),
+		errors.Is(err, surveytemplate.ErrCreatorNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, surveytemplate.ErrInvalidSubtype),
+		errors.Is(err, model.ErrInvalidSurveyQuestion):
+		status = http.StatusBadRequest
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
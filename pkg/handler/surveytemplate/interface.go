@@ -0,0 +1,11 @@
+package surveytemplate
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	List(c *gin.Context)
+	Detail(c *gin.Context)
+	Create(c *gin.Context)
+	CreateVersion(c *gin.Context)
+	GetVersion(c *gin.Context)
+}
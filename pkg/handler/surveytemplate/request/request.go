@@ -0,0 +1,82 @@
+package request
+
+import (
+	"strings"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/surveytemplate"
+	"github.com/dwarvesf/fortress-api/pkg/handler/surveytemplate/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type GetListSurveyTemplateInput struct {
+	model.Pagination
+
+	Subtype string `json:"subtype" form:"subtype"`
+	Keyword string `json:"keyword" form:"keyword"`
+}
+
+func (i *GetListSurveyTemplateInput) Validate() error {
+	if i.Subtype != "" && !model.EventSubtype(i.Subtype).IsValidSurvey() {
+		return errs.ErrInvalidSubtype
+	}
+
+	return nil
+}
+
+// SurveyTemplateQuestionInput is a question of a template version, the order of the questions is kept
+type SurveyTemplateQuestionInput struct {
+	Key       string   `json:"key" binding:"required"`
+	Type      string   `json:"type" binding:"required"`
+	Content   string   `json:"content" binding:"required"`
+	Domain    string   `json:"domain"`
+	Required  bool     `json:"required"`
+	Options   []string `json:"options"`
+	MinValue  *int     `json:"minValue"`
+	MaxValue  *int     `json:"maxValue"`
+	ParentKey string   `json:"parentKey"`
+	ShowIf    []string `json:"showIf"`
+}
+
+type CreateSurveyTemplateInput struct {
+	Name        string                        `json:"name" binding:"required"`
+	Description string                        `json:"description"`
+	Subtype     string                        `json:"subtype"`
+	Questions   []SurveyTemplateQuestionInput `json:"questions" binding:"required"`
+}
+
+func (i *CreateSurveyTemplateInput) Validate() error {
+	if strings.TrimSpace(i.Name) == "" {
+		return errs.ErrEmptyName
+	}
+
+	if i.Subtype != "" && !model.EventSubtype(i.Subtype).IsValidSurvey() {
+		return errs.ErrInvalidSubtype
+	}
+
+	return nil
+}
+
+type CreateSurveyTemplateVersionInput struct {
+	Questions []SurveyTemplateQuestionInput `json:"questions" binding:"required"`
+}
+
+// ToQuestionInputs converts the request questions to the controller input
+func ToQuestionInputs(questions []SurveyTemplateQuestionInput) []surveytemplate.QuestionInput {
+	rs := make([]surveytemplate.QuestionInput, 0, len(questions))
+	for _, q := range questions {
+		rs = append(rs, surveytemplate.QuestionInput{
+			Key:       strings.TrimSpace(q.Key),
+			Type:      model.QuestionType(q.Type),
+			Content:   q.Content,
+			Domain:    model.QuestionDomain(q.Domain),
+			Required:  q.Required,
+			Options:   q.Options,
+			MinValue:  q.MinValue,
+			MaxValue:  q.MaxValue,
+			ParentKey: strings.TrimSpace(q.ParentKey),
+			ShowIf:    q.ShowIf,
+		})
+	}
+
+	return rs
+}
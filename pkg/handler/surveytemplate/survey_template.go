@@ -0,0 +1,282 @@
+package surveytemplate
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	"github.com/dwarvesf/fortress-api/pkg/controller/surveytemplate"
+	"github.com/dwarvesf/fortress-api/pkg/handler/surveytemplate/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/surveytemplate/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	controller *controller.Controller
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		controller: controller,
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+	}
+}
+
+// List godoc
+// @Summary Get list of survey templates
+// @Description Get list of survey templates
+// @Tags SurveyTemplate
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param subtype query string false "Survey subtype, templates without subtype are always included"
+// @Param keyword query string false "Keyword"
+// @Param page query string false "Page"
+// @Param size query string false "Size"
+// @Success 200 {object} view.ListSurveyTemplateResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /survey-templates [get]
+func (h *handler) List(c *gin.Context) {
+	input := request.GetListSurveyTemplateInput{}
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	input.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "surveytemplate",
+		"method":  "List",
+		"input":   input,
+	})
+
+	templates, total, err := h.controller.SurveyTemplate.List(surveytemplate.ListInput{
+		Pagination: input.Pagination,
+		Subtype:    input.Subtype,
+		Keyword:    input.Keyword,
+	})
+	if err != nil {
+		l.Error(err, "failed to get list survey templates")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToSurveyTemplates(templates),
+		&view.PaginationResponse{Pagination: input.Pagination, Total: total}, nil, nil, ""))
+}
+
+// Detail godoc
+// @Summary Get detail of a survey template
+// @Description Get detail of a survey template with the list of its versions
+// @Tags SurveyTemplate
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Survey template ID"
+// @Success 200 {object} view.SurveyTemplateResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /survey-templates/{id} [get]
+func (h *handler) Detail(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidSurveyTemplateID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "surveytemplate",
+		"method":  "Detail",
+		"id":      id,
+	})
+
+	template, err := h.controller.SurveyTemplate.Detail(id)
+	if err != nil {
+		l.Error(err, "failed to get survey template")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToSurveyTemplate(template), nil, nil, nil, ""))
+}
+
+// Create godoc
+// @Summary Create a survey template
+// @Description Create a survey template with its first version
+// @Tags SurveyTemplate
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param Body body request.CreateSurveyTemplateInput true "Body"
+// @Success 200 {object} view.SurveyTemplateResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /survey-templates [post]
+func (h *handler) Create(c *gin.Context) {
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	input := request.CreateSurveyTemplateInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "surveytemplate",
+		"method":  "Create",
+		"input":   input,
+	})
+
+	template, err := h.controller.SurveyTemplate.Create(surveytemplate.CreateInput{
+		Name:        input.Name,
+		Description: input.Description,
+		Subtype:     model.EventSubtype(input.Subtype),
+		Questions:   request.ToQuestionInputs(input.Questions),
+		CreatedBy:   userID,
+	})
+	if err != nil {
+		l.Error(err, "failed to create survey template")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToSurveyTemplate(template), nil, nil, nil, ""))
+}
+
+// CreateVersion godoc
+// @Summary Publish a new version of a survey template
+// @Description Publish a new version of a survey template, surveys created before keep their own version
+// @Tags SurveyTemplate
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Survey template ID"
+// @Param Body body request.CreateSurveyTemplateVersionInput true "Body"
+// @Success 200 {object} view.SurveyTemplateVersionResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /survey-templates/{id}/versions [post]
+func (h *handler) CreateVersion(c *gin.Context) {
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidSurveyTemplateID, nil, ""))
+		return
+	}
+
+	input := request.CreateSurveyTemplateVersionInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "surveytemplate",
+		"method":  "CreateVersion",
+		"id":      id,
+		"input":   input,
+	})
+
+	templateVersion, err := h.controller.SurveyTemplate.CreateVersion(surveytemplate.CreateVersionInput{
+		TemplateID: id,
+		Questions:  request.ToQuestionInputs(input.Questions),
+		CreatedBy:  userID,
+	})
+	if err != nil {
+		l.Error(err, "failed to create survey template version")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToSurveyTemplateVersion(templateVersion), nil, nil, nil, ""))
+}
+
+// GetVersion godoc
+// @Summary Get a version of a survey template
+// @Description Get a version of a survey template with its questions, use `latest` to get the latest version
+// @Tags SurveyTemplate
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Survey template ID"
+// @Param version path string true "Version number or latest"
+// @Success 200 {object} view.SurveyTemplateVersionResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /survey-templates/{id}/versions/{version} [get]
+func (h *handler) GetVersion(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidSurveyTemplateID, nil, ""))
+		return
+	}
+
+	version := 0
+	if v := c.Param("version"); v != "latest" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidVersion, nil, ""))
+			return
+		}
+		version = n
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "surveytemplate",
+		"method":  "GetVersion",
+		"id":      id,
+		"version": version,
+	})
+
+	templateVersion, err := h.controller.SurveyTemplate.GetVersion(id, version)
+	if err != nil {
+		l.Error(err, "failed to get survey template version")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToSurveyTemplateVersion(templateVersion), nil, nil, nil, ""))
+}
@@ -0,0 +1,61 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/timesheet"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidTimesheetID = errors.New("invalid timesheet ID")
+	ErrInvalidEntryID     = errors.New("invalid timesheet entry ID")
+	ErrInvalidEmployeeID  = errors.New("invalid employee ID")
+	ErrInvalidProjectID   = errors.New("invalid project ID")
+	ErrInvalidWorkUnitID  = errors.New("invalid work unit ID")
+	ErrInvalidRole        = errors.New("role must be reviewer")
+	ErrInvalidStatus      = errors.New("invalid timesheet status")
+	ErrInvalidDate        = errors.New("invalid date")
+	ErrInvalidFrom        = errors.New("invalid from date")
+	ErrInvalidTo          = errors.New("invalid to date")
+	ErrInvalidFileFormat  = errors.New("import file must be a csv file")
+	ErrInvalidFileHeader  = errors.New("import file must have date, project and hours columns")
+)
+
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, timesheet.ErrTimesheetNotFound),
+		errors.Is(err, timesheet.ErrEntryNotFound),
+		errors.Is(err, timesheet.ErrEmployeeNotFound),
+		errors.Is(err, timesheet.ErrProjectNotFound),
+		errors.Is(err, timesheet.ErrWorkUnitNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, timesheet.ErrNotAllowedToLog),
+		errors.Is(err, timesheet.ErrNotAllowedToRead),
+		errors.Is(err, timesheet.ErrNotAllowedToReview),
+		errors.Is(err, timesheet.ErrNotAllowedToReviewSelf):
+		status = http.StatusForbidden
+	case errors.Is(err, timesheet.ErrNotProjectMember),
+		errors.Is(err, timesheet.ErrInvalidHours),
+		errors.Is(err, timesheet.ErrDailyHoursExceeded),
+		errors.Is(err, timesheet.ErrInvalidReviewStatus),
+		errors.Is(err, timesheet.ErrTimesheetLocked),
+		errors.Is(err, timesheet.ErrTimesheetNotSubmitted),
+		errors.Is(err, timesheet.ErrEmptyTimesheet),
+		errors.Is(err, timesheet.ErrEmptyImport):
+		status = http.StatusBadRequest
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
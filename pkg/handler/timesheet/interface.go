@@ -0,0 +1,14 @@
+package timesheet
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	List(c *gin.Context)
+	Detail(c *gin.Context)
+	Submit(c *gin.Context)
+	Review(c *gin.Context)
+	Log(c *gin.Context)
+	UpdateEntry(c *gin.Context)
+	DeleteEntry(c *gin.Context)
+	Import(c *gin.Context)
+}
@@ -0,0 +1,197 @@
+package request
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/timesheet"
+	"github.com/dwarvesf/fortress-api/pkg/handler/timesheet/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type GetListTimesheetInput struct {
+	model.Pagination
+
+	// Role is reviewer to get the timesheets of the projects the user leads, own timesheets are returned when it is empty
+	Role       string `json:"role" form:"role"`
+	EmployeeID string `json:"employeeID" form:"employeeID"`
+	ProjectID  string `json:"projectID" form:"projectID"`
+	Status     string `json:"status" form:"status"`
+	// From and To are dates in YYYY-MM-DD format, timesheets of the weeks starting between them are returned
+	From string `json:"from" form:"from"`
+	To   string `json:"to" form:"to"`
+}
+
+func (i *GetListTimesheetInput) Validate() error {
+	if i.Role != "" && i.Role != "reviewer" {
+		return errs.ErrInvalidRole
+	}
+	if i.EmployeeID != "" && !model.IsUUIDFromString(i.EmployeeID) {
+		return errs.ErrInvalidEmployeeID
+	}
+	if i.ProjectID != "" && !model.IsUUIDFromString(i.ProjectID) {
+		return errs.ErrInvalidProjectID
+	}
+	if i.Status != "" && !model.TimesheetStatus(i.Status).IsValid() {
+		return errs.ErrInvalidStatus
+	}
+	if _, err := parseOptionalDate(i.From); err != nil {
+		return errs.ErrInvalidFrom
+	}
+	if _, err := parseOptionalDate(i.To); err != nil {
+		return errs.ErrInvalidTo
+	}
+
+	return nil
+}
+
+// GetPeriod returns the from and to dates, nil when they are not set
+func (i *GetListTimesheetInput) GetPeriod() (*time.Time, *time.Time) {
+	from, _ := parseOptionalDate(i.From)
+	to, _ := parseOptionalDate(i.To)
+	return from, to
+}
+
+type LogEntryInput struct {
+	// EmployeeID defaults to the logged-in user
+	EmployeeID string `json:"employeeID"`
+	// ProjectID is the id or the code of the project
+	ProjectID  string  `json:"projectID" binding:"required"`
+	WorkUnitID string  `json:"workUnitID"`
+	Date       string  `json:"date" binding:"required"`
+	Hours      float64 `json:"hours" binding:"required"`
+	Note       string  `json:"note"`
+}
+
+func (i *LogEntryInput) Validate() error {
+	if i.EmployeeID != "" && !model.IsUUIDFromString(i.EmployeeID) {
+		return errs.ErrInvalidEmployeeID
+	}
+	if i.WorkUnitID != "" && !model.IsUUIDFromString(i.WorkUnitID) {
+		return errs.ErrInvalidWorkUnitID
+	}
+	if _, err := time.Parse("2006-01-02", i.Date); err != nil {
+		return errs.ErrInvalidDate
+	}
+
+	return nil
+}
+
+// GetDate returns the date of the entry
+func (i *LogEntryInput) GetDate() time.Time {
+	date, _ := time.Parse("2006-01-02", i.Date)
+	return date
+}
+
+type UpdateEntryInput struct {
+	WorkUnitID string  `json:"workUnitID"`
+	Hours      float64 `json:"hours" binding:"required"`
+	Note       string  `json:"note"`
+}
+
+func (i *UpdateEntryInput) Validate() error {
+	if i.WorkUnitID != "" && !model.IsUUIDFromString(i.WorkUnitID) {
+		return errs.ErrInvalidWorkUnitID
+	}
+
+	return nil
+}
+
+type ReviewTimesheetInput struct {
+	// Status is approved or rejected
+	Status model.TimesheetStatus `json:"status" binding:"required"`
+	Note   string                `json:"note"`
+}
+
+func (i *ReviewTimesheetInput) Validate() error {
+	if i.Status != model.TimesheetStatusApproved && i.Status != model.TimesheetStatusRejected {
+		return errs.ErrInvalidStatus
+	}
+
+	return nil
+}
+
+// ParseImportFile reads the rows of a csv file with a header line,
+// the date, project and hours columns are required, the email, work_unit and note columns are optional
+func ParseImportFile(r io.Reader) ([]timesheet.ImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, timesheet.ErrEmptyImport
+		}
+		return nil, errs.ErrInvalidFileFormat
+	}
+
+	columns := map[string]int{}
+	for idx, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = idx
+	}
+	for _, name := range []string{"date", "project", "hours"} {
+		if _, ok := columns[name]; !ok {
+			return nil, errs.ErrInvalidFileHeader
+		}
+	}
+
+	get := func(record []string, name string) string {
+		idx, ok := columns[name]
+		if !ok || idx >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[idx])
+	}
+
+	var rows []timesheet.ImportRow
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, errs.ErrInvalidFileFormat
+		}
+
+		date, err := time.Parse("2006-01-02", get(record, "date"))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, errs.ErrInvalidDate)
+		}
+
+		hours, err := strconv.ParseFloat(get(record, "hours"), 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, timesheet.ErrInvalidHours)
+		}
+
+		rows = append(rows, timesheet.ImportRow{
+			Line:     line,
+			Email:    get(record, "email"),
+			Project:  get(record, "project"),
+			WorkUnit: get(record, "work_unit"),
+			Date:     date,
+			Hours:    hours,
+			Note:     get(record, "note"),
+		})
+	}
+
+	return rows, nil
+}
+
+func parseOptionalDate(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
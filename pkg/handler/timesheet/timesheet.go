@@ -0,0 +1,529 @@
+package timesheet
+
+import (
+	"errors"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	"github.com/dwarvesf/fortress-api/pkg/controller/timesheet"
+	"github.com/dwarvesf/fortress-api/pkg/handler/timesheet/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/timesheet/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	controller *controller.Controller
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		controller: controller,
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+	}
+}
+
+// List godoc
+// @Summary Get list of timesheets
+// @Description Get the timesheets of the logged-in user, or of the projects the user leads when role is reviewer. Users with the timesheets.read permission can get the timesheets of any employee
+// @Tags Timesheet
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param role query string false "reviewer"
+// @Param employeeID query string false "Employee ID"
+// @Param projectID query string false "Project ID"
+// @Param status query string false "Status"
+// @Param from query string false "From date"
+// @Param to query string false "To date"
+// @Param page query string false "Page"
+// @Param size query string false "Size"
+// @Success 200 {object} view.ListTimesheetResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /timesheets [get]
+func (h *handler) List(c *gin.Context) {
+	input := request.GetListTimesheetInput{}
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	input.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "timesheet",
+		"method":  "List",
+		"input":   input,
+	})
+
+	userInfo, err := authutils.GetLoggedInUserInfo(c, h.store, h.repo.DB(), h.config)
+	if err != nil {
+		l.Error(err, "failed to get logged in user")
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	from, to := input.GetPeriod()
+
+	timesheets, total, err := h.controller.Timesheet.List(timesheet.ListInput{
+		Pagination: input.Pagination,
+		Role:       input.Role,
+		EmployeeID: input.EmployeeID,
+		ProjectID:  input.ProjectID,
+		Status:     input.Status,
+		From:       from,
+		To:         to,
+		UserID:     userInfo.UserID,
+		CanRead:    authutils.HasPermission(userInfo.Permissions, model.PermissionTimesheetsRead),
+	})
+	if err != nil {
+		l.Error(err, "failed to get list timesheets")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToTimesheets(timesheets),
+		&view.PaginationResponse{Pagination: input.Pagination, Total: total}, nil, nil, ""))
+}
+
+// Detail godoc
+// @Summary Get detail of a timesheet
+// @Description Get a timesheet with its entries
+// @Tags Timesheet
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Timesheet ID"
+// @Success 200 {object} view.TimesheetResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 403 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /timesheets/{id} [get]
+func (h *handler) Detail(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidTimesheetID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "timesheet",
+		"method":  "Detail",
+		"id":      id,
+	})
+
+	userInfo, err := authutils.GetLoggedInUserInfo(c, h.store, h.repo.DB(), h.config)
+	if err != nil {
+		l.Error(err, "failed to get logged in user")
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	t, err := h.controller.Timesheet.Detail(timesheet.DetailInput{
+		ID:      id,
+		UserID:  userInfo.UserID,
+		CanRead: authutils.HasPermission(userInfo.Permissions, model.PermissionTimesheetsRead),
+	})
+	if err != nil {
+		l.Error(err, "failed to get timesheet")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToTimesheet(t), nil, nil, nil, ""))
+}
+
+// Submit godoc
+// @Summary Submit a timesheet
+// @Description Submit a timesheet of the logged-in user for approval, submitted timesheets are locked
+// @Tags Timesheet
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Timesheet ID"
+// @Success 200 {object} view.TimesheetResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 403 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /timesheets/{id}/submit [post]
+func (h *handler) Submit(c *gin.Context) {
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidTimesheetID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "timesheet",
+		"method":  "Submit",
+		"id":      id,
+	})
+
+	t, err := h.controller.Timesheet.Submit(timesheet.DetailInput{
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		l.Error(err, "failed to submit timesheet")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToTimesheet(t), nil, nil, nil, "ok"))
+}
+
+// Review godoc
+// @Summary Review a timesheet
+// @Description Approve or reject a submitted timesheet, only the technical leads of the project or users with the timesheets.approve permission can review it
+// @Tags Timesheet
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Timesheet ID"
+// @Param Body body request.ReviewTimesheetInput true "Body"
+// @Success 200 {object} view.TimesheetResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 403 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /timesheets/{id}/review [put]
+func (h *handler) Review(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidTimesheetID, nil, ""))
+		return
+	}
+
+	input := request.ReviewTimesheetInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "timesheet",
+		"method":  "Review",
+		"id":      id,
+		"input":   input,
+	})
+
+	userInfo, err := authutils.GetLoggedInUserInfo(c, h.store, h.repo.DB(), h.config)
+	if err != nil {
+		l.Error(err, "failed to get logged in user")
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	t, err := h.controller.Timesheet.Review(timesheet.ReviewInput{
+		ID:         id,
+		Status:     input.Status,
+		Note:       input.Note,
+		UserID:     userInfo.UserID,
+		CanApprove: authutils.HasPermission(userInfo.Permissions, model.PermissionTimesheetsApprove),
+	})
+	if err != nil {
+		l.Error(err, "failed to review timesheet")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToTimesheet(t), nil, nil, nil, "ok"))
+}
+
+// Log godoc
+// @Summary Log hours on a project
+// @Description Log hours of the logged-in user, or of an employee for users with the timesheets.edit permission, on a project and work unit. The entry is added to the timesheet of the week
+// @Tags Timesheet
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param Body body request.LogEntryInput true "Body"
+// @Success 200 {object} view.TimesheetEntryResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 403 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /timesheets/entries [post]
+func (h *handler) Log(c *gin.Context) {
+	input := request.LogEntryInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "timesheet",
+		"method":  "Log",
+		"input":   input,
+	})
+
+	userInfo, err := authutils.GetLoggedInUserInfo(c, h.store, h.repo.DB(), h.config)
+	if err != nil {
+		l.Error(err, "failed to get logged in user")
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	entry, err := h.controller.Timesheet.Log(timesheet.LogInput{
+		EmployeeID: input.EmployeeID,
+		ProjectID:  input.ProjectID,
+		WorkUnitID: input.WorkUnitID,
+		Date:       input.GetDate(),
+		Hours:      input.Hours,
+		Note:       input.Note,
+		UserID:     userInfo.UserID,
+		CanEdit:    authutils.HasPermission(userInfo.Permissions, model.PermissionTimesheetsEdit),
+	})
+	if err != nil {
+		l.Error(err, "failed to log hours")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToTimesheetEntry(entry), nil, nil, nil, ""))
+}
+
+// UpdateEntry godoc
+// @Summary Update an entry of a timesheet
+// @Description Update the hours, work unit or note of an entry, submitted and approved timesheets can not be changed
+// @Tags Timesheet
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Timesheet ID"
+// @Param entryID path string true "Entry ID"
+// @Param Body body request.UpdateEntryInput true "Body"
+// @Success 200 {object} view.TimesheetEntryResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 403 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /timesheets/{id}/entries/{entryID} [put]
+func (h *handler) UpdateEntry(c *gin.Context) {
+	timesheetID := c.Param("id")
+	if timesheetID == "" || !model.IsUUIDFromString(timesheetID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidTimesheetID, nil, ""))
+		return
+	}
+
+	entryID := c.Param("entryID")
+	if entryID == "" || !model.IsUUIDFromString(entryID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidEntryID, nil, ""))
+		return
+	}
+
+	input := request.UpdateEntryInput{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":     "timesheet",
+		"method":      "UpdateEntry",
+		"timesheetID": timesheetID,
+		"entryID":     entryID,
+		"input":       input,
+	})
+
+	userInfo, err := authutils.GetLoggedInUserInfo(c, h.store, h.repo.DB(), h.config)
+	if err != nil {
+		l.Error(err, "failed to get logged in user")
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	entry, err := h.controller.Timesheet.UpdateEntry(timesheet.EntryInput{
+		TimesheetID: timesheetID,
+		ID:          entryID,
+		WorkUnitID:  input.WorkUnitID,
+		Hours:       input.Hours,
+		Note:        input.Note,
+		UserID:      userInfo.UserID,
+		CanEdit:     authutils.HasPermission(userInfo.Permissions, model.PermissionTimesheetsEdit),
+	})
+	if err != nil {
+		l.Error(err, "failed to update timesheet entry")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToTimesheetEntry(entry), nil, nil, nil, ""))
+}
+
+// DeleteEntry godoc
+// @Summary Delete an entry of a timesheet
+// @Description Delete an entry, submitted and approved timesheets can not be changed
+// @Tags Timesheet
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param id path string true "Timesheet ID"
+// @Param entryID path string true "Entry ID"
+// @Success 200 {object} view.MessageResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 403 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /timesheets/{id}/entries/{entryID} [delete]
+func (h *handler) DeleteEntry(c *gin.Context) {
+	timesheetID := c.Param("id")
+	if timesheetID == "" || !model.IsUUIDFromString(timesheetID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidTimesheetID, nil, ""))
+		return
+	}
+
+	entryID := c.Param("entryID")
+	if entryID == "" || !model.IsUUIDFromString(entryID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidEntryID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":     "timesheet",
+		"method":      "DeleteEntry",
+		"timesheetID": timesheetID,
+		"entryID":     entryID,
+	})
+
+	userInfo, err := authutils.GetLoggedInUserInfo(c, h.store, h.repo.DB(), h.config)
+	if err != nil {
+		l.Error(err, "failed to get logged in user")
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	err = h.controller.Timesheet.DeleteEntry(timesheet.EntryInput{
+		TimesheetID: timesheetID,
+		ID:          entryID,
+		UserID:      userInfo.UserID,
+		CanEdit:     authutils.HasPermission(userInfo.Permissions, model.PermissionTimesheetsEdit),
+	})
+	if err != nil {
+		l.Error(err, "failed to delete timesheet entry")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
+}
+
+// Import godoc
+// @Summary Import timesheet entries from a csv file
+// @Description Log the rows of a csv file with date, project and hours columns, and optional email, work_unit and note columns. Nothing is imported when any row is invalid
+// @Tags Timesheet
+// @Accept  multipart/form-data
+// @Produce  json
+// @Param Authorization header string true "jwt token"
+// @Param file formData file true "CSV file"
+// @Success 200 {object} view.ImportTimesheetResponse
+// @Failure 400 {object} view.ErrorResponse
+// @Failure 403 {object} view.ErrorResponse
+// @Failure 404 {object} view.ErrorResponse
+// @Failure 500 {object} view.ErrorResponse
+// @Router /timesheets/import [post]
+func (h *handler) Import(c *gin.Context) {
+	file, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	if !strings.EqualFold(filepath.Ext(file.Filename), ".csv") {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidFileFormat, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":  "timesheet",
+		"method":   "Import",
+		"fileName": file.Filename,
+	})
+
+	f, err := file.Open()
+	if err != nil {
+		l.Error(err, "failed to open import file")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+	defer f.Close()
+
+	rows, err := request.ParseImportFile(f)
+	if err != nil {
+		l.Error(err, "failed to parse import file")
+		if errors.Is(err, timesheet.ErrInvalidHours) || errors.Is(err, timesheet.ErrEmptyImport) {
+			errs.ConvertControllerErr(c, err)
+			return
+		}
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	userInfo, err := authutils.GetLoggedInUserInfo(c, h.store, h.repo.DB(), h.config)
+	if err != nil {
+		l.Error(err, "failed to get logged in user")
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	imported, err := h.controller.Timesheet.Import(timesheet.ImportInput{
+		Rows:    rows,
+		UserID:  userInfo.UserID,
+		CanEdit: authutils.HasPermission(userInfo.Permissions, model.PermissionTimesheetsEdit),
+	})
+	if err != nil {
+		l.Error(err, "failed to import timesheet entries")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ImportTimesheetResult{Imported: imported}, nil, nil, nil, "ok"))
+}
@@ -50,7 +50,7 @@ func (h *handler) basecampExpenseValidate(msg model.BasecampWebhookMessage) erro
 		errMsg := fmt.Sprintf(
 			`Hi %v, I'm not smart enough to understand your expense submission. Please ensure the following format 😊
 
-			Title: < Reason > | < Amount > | < VND/USD >
+			Title: < Reason > | < Amount > | < VND/USD > | < Project code (optional) >
 			Assign To: Han Ngo, < payee >
 
 			Example:
@@ -168,6 +168,11 @@ func (h *handler) extractExpenseData(msg model.BasecampWebhookMessage) (*bc.Base
 
 	res.CurrencyType = strings.ToUpper(t)
 
+	// extract the optional project code
+	if len(parts) > 3 {
+		res.ProjectCode = strings.TrimSpace(parts[3])
+	}
+
 	list, err := h.service.Basecamp.Todo.GetList(msg.Recording.Parent.URL)
 	if err != nil {
 		return nil, err
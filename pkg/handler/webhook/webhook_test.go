@@ -0,0 +1,184 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/mw"
+)
+
+const (
+	testN8nSecret      = "n8n-secret"
+	testBasecampSecret = "basecamp-secret"
+)
+
+func sign(secret string, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestHandler_N8n(t *testing.T) {
+	loggerMock := logger.NewLogrusLogger()
+	// an event kind the handler ignores, so verified requests stop at the handler
+	const body = `{"kind":"ping","calendarData":{}}`
+
+	tests := []struct {
+		name             string
+		webhook          config.Webhook
+		headers          map[string]string
+		wantCode         int
+		wantResponsePath string
+	}{
+		{
+			name:     "ok_signed_body",
+			webhook:  config.Webhook{N8nSecret: testN8nSecret},
+			headers:  map[string]string{mw.WebhookSignatureHeader: sign(testN8nSecret, body)},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "ok_shared_secret",
+			webhook:  config.Webhook{N8nSecret: testN8nSecret},
+			headers:  map[string]string{mw.WebhookSecretHeader: testN8nSecret},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:             "failed_signed_with_another_secret",
+			webhook:          config.Webhook{N8nSecret: testN8nSecret},
+			headers:          map[string]string{mw.WebhookSignatureHeader: sign("another-secret", body)},
+			wantCode:         http.StatusUnauthorized,
+			wantResponsePath: "testdata/verify/invalid_signature.json",
+		},
+		{
+			name:             "failed_unsigned",
+			webhook:          config.Webhook{N8nSecret: testN8nSecret},
+			wantCode:         http.StatusUnauthorized,
+			wantResponsePath: "testdata/verify/invalid_signature.json",
+		},
+		{
+			name:             "failed_secret_not_configured",
+			webhook:          config.Webhook{},
+			headers:          map[string]string{mw.WebhookSecretHeader: testN8nSecret},
+			wantCode:         http.StatusUnauthorized,
+			wantResponsePath: "testdata/verify/secret_not_configured.json",
+		},
+		{
+			name:             "failed_unsigned_in_report_only_mode",
+			webhook:          config.Webhook{N8nSecret: testN8nSecret, N8nReportOnly: true},
+			wantCode:         http.StatusUnauthorized,
+			wantResponsePath: "testdata/verify/invalid_signature.json",
+		},
+		{
+			name:     "ok_secret_not_configured_in_report_only_mode",
+			webhook:  config.Webhook{N8nReportOnly: true},
+			wantCode: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.LoadTestConfig()
+			cfg.Webhook = tt.webhook
+			h := New(nil, nil, nil, nil, loggerMock, &cfg, nil)
+			wmw := mw.NewWebhookMiddleware(&cfg)
+
+			r := gin.New()
+			r.POST("/webhooks/n8n", wmw.VerifyN8n, h.N8n)
+
+			req := httptest.NewRequest(http.MethodPost, "/webhooks/n8n", strings.NewReader(body))
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			require.Equal(t, tt.wantCode, w.Code)
+			if tt.wantResponsePath != "" {
+				expRespRaw, err := os.ReadFile(tt.wantResponsePath)
+				require.NoError(t, err)
+				require.JSONEq(t, string(expRespRaw), w.Body.String(), "[Handler.N8n] response mismatched")
+			}
+		})
+	}
+}
+
+func TestHandler_ValidateBasecampExpense(t *testing.T) {
+	loggerMock := logger.NewLogrusLogger()
+
+	tests := []struct {
+		name             string
+		webhook          config.Webhook
+		url              string
+		wantCode         int
+		wantResponsePath string
+	}{
+		{
+			name:             "ok_token_in_url",
+			webhook:          config.Webhook{BasecampSecret: testBasecampSecret},
+			url:              "/webhooks/basecamp/expense/validate?token=" + testBasecampSecret,
+			wantCode:         http.StatusOK,
+			wantResponsePath: "testdata/verify/200.json",
+		},
+		{
+			name:             "failed_wrong_token",
+			webhook:          config.Webhook{BasecampSecret: testBasecampSecret},
+			url:              "/webhooks/basecamp/expense/validate?token=wrong",
+			wantCode:         http.StatusUnauthorized,
+			wantResponsePath: "testdata/verify/invalid_signature.json",
+		},
+		{
+			name:             "failed_no_token",
+			webhook:          config.Webhook{BasecampSecret: testBasecampSecret},
+			url:              "/webhooks/basecamp/expense/validate",
+			wantCode:         http.StatusUnauthorized,
+			wantResponsePath: "testdata/verify/invalid_signature.json",
+		},
+		{
+			name:             "failed_no_token_in_report_only_mode",
+			webhook:          config.Webhook{BasecampSecret: testBasecampSecret, BasecampReportOnly: true},
+			url:              "/webhooks/basecamp/expense/validate",
+			wantCode:         http.StatusUnauthorized,
+			wantResponsePath: "testdata/verify/invalid_signature.json",
+		},
+		{
+			name:             "ok_secret_not_configured_in_report_only_mode",
+			webhook:          config.Webhook{BasecampReportOnly: true},
+			url:              "/webhooks/basecamp/expense/validate",
+			wantCode:         http.StatusOK,
+			wantResponsePath: "testdata/verify/200.json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.LoadTestConfig()
+			cfg.Webhook = tt.webhook
+			h := New(nil, nil, nil, nil, loggerMock, &cfg, nil)
+			wmw := mw.NewWebhookMiddleware(&cfg)
+
+			r := gin.New()
+			r.POST("/webhooks/basecamp/expense/validate", wmw.VerifyBasecamp, h.ValidateBasecampExpense)
+
+			// a message the handler can not parse is acknowledged without doing anything
+			req := httptest.NewRequest(http.MethodPost, tt.url, strings.NewReader(`{}`))
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			require.Equal(t, tt.wantCode, w.Code)
+			expRespRaw, err := os.ReadFile(tt.wantResponsePath)
+			require.NoError(t, err)
+			require.JSONEq(t, string(expRespRaw), w.Body.String(), "[Handler.ValidateBasecampExpense] response mismatched")
+		})
+	}
+}
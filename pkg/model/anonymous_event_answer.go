@@ -0,0 +1,26 @@
+package model
+
+// AnonymousEventAnswer model for anonymous_event_answers table,
+// answers of anonymous surveys are moved here once submitted and keep no link to the reviewer
+type AnonymousEventAnswer struct {
+	BaseModel
+
+	EventID UUID
+	// EmployeeEventTopicID is only kept for topics shared by all reviewers
+	EmployeeEventTopicID *UUID
+	// ResponseKey groups the answers of one response, it is a pseudonym or a random key
+	ResponseKey        string
+	QuestionID         UUID
+	TemplateQuestionID UUID
+	Content            string
+	Type               string
+	Answer             string
+	Note               string
+	Order              int64
+	Domain             QuestionDomain
+
+	// cohort of the reviewer when answering, used to group results on dashboards
+	Seniority string
+	Chapters  []string `gorm:"serializer:json"`
+	Projects  []string `gorm:"serializer:json"`
+}
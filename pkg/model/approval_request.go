@@ -0,0 +1,92 @@
+package model
+
+import (
+	"time"
+)
+
+// ApprovalRequest is a pending sensitive action which needs a second person
+// holding the same permission to approve before it is executed.
+// It is requested by an employee, or by an API key when RequesterAPIKeyID is set
+type ApprovalRequest struct {
+	BaseModel
+
+	Action            ApprovalAction
+	Status            ApprovalRequestStatus
+	Permission        string
+	Summary           string
+	Payload           JSON
+	RequesterID       UUID
+	RequesterAPIKeyID *UUID
+	ApproverID        *UUID
+	ExpiredAt         time.Time
+	ReviewedAt        *time.Time
+	ExecutedAt        *time.Time
+	RejectReason      string
+	ErrorMessage      string
+
+	Requester       *Employee `gorm:"foreignKey:RequesterID"`
+	RequesterAPIKey *APIKey   `gorm:"foreignKey:RequesterAPIKeyID"`
+	Approver        *Employee `gorm:"foreignKey:ApproverID"`
+}
+
+// IsRequestedByAPIKey checks if the request came from a scheduled job or an integration instead of an employee
+func (r ApprovalRequest) IsRequestedByAPIKey() bool {
+	return r.RequesterAPIKeyID != nil && !r.RequesterAPIKeyID.IsZero()
+}
+
+// IsExpired checks if the request passed its deadline while still pending
+func (r ApprovalRequest) IsExpired(now time.Time) bool {
+	return r.Status == ApprovalRequestStatusPending && !now.Before(r.ExpiredAt)
+}
+
+type ApprovalAction string
+
+const (
+	ApprovalActionPayrollMarkPaid    ApprovalAction = "payroll.mark-paid"
+	ApprovalActionPayrollCommit      ApprovalAction = "payroll.commit"
+	ApprovalActionEmployeeBaseSalary ApprovalAction = "employee.update-base-salary"
+	ApprovalActionInvoiceMarkPaid    ApprovalAction = "invoice.mark-paid"
+)
+
+func (e ApprovalAction) IsValid() bool {
+	switch e {
+	case
+		ApprovalActionPayrollMarkPaid,
+		ApprovalActionPayrollCommit,
+		ApprovalActionEmployeeBaseSalary,
+		ApprovalActionInvoiceMarkPaid:
+		return true
+	}
+	return false
+}
+
+func (e ApprovalAction) String() string {
+	return string(e)
+}
+
+type ApprovalRequestStatus string
+
+const (
+	ApprovalRequestStatusPending  ApprovalRequestStatus = "pending"
+	ApprovalRequestStatusApproved ApprovalRequestStatus = "approved"
+	ApprovalRequestStatusRejected ApprovalRequestStatus = "rejected"
+	ApprovalRequestStatusExpired  ApprovalRequestStatus = "expired"
+	ApprovalRequestStatusFailed   ApprovalRequestStatus = "failed"
+)
+
+func (e ApprovalRequestStatus) IsValid() bool {
+	switch e {
+	case
+		ApprovalRequestStatusPending,
+		ApprovalRequestStatusApproved,
+		ApprovalRequestStatusRejected,
+		ApprovalRequestStatusExpired,
+		ApprovalRequestStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e ApprovalRequestStatus) String() string {
+	return string(e)
+}
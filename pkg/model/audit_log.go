@@ -0,0 +1,86 @@
+package model
+
+import (
+	"net/http"
+	"time"
+)
+
+// AuditLog is an append-only record of a mutating request,
+// it has no UpdatedAt/DeletedAt because rows are never modified
+type AuditLog struct {
+	ID           UUID      `sql:",type:uuid" json:"id" gorm:"default:uuid()"`
+	CreatedAt    time.Time `sql:"default:now()" json:"createdAt"`
+	ActorType    AuditLogActorType
+	ActorID      string
+	Action       AuditLogAction
+	ResourceType string
+	ResourceID   string
+	Method       string
+	Path         string
+	StatusCode   int
+	Before       JSON
+	After        JSON
+	Diff         JSON
+	RequestID    string
+	IPAddress    string
+}
+
+type AuditLogAction string
+
+const (
+	AuditLogActionCreate AuditLogAction = "create"
+	AuditLogActionUpdate AuditLogAction = "update"
+	AuditLogActionDelete AuditLogAction = "delete"
+)
+
+func (e AuditLogAction) IsValid() bool {
+	switch e {
+	case
+		AuditLogActionCreate,
+		AuditLogActionUpdate,
+		AuditLogActionDelete:
+		return true
+	}
+	return false
+}
+
+func (e AuditLogAction) String() string {
+	return string(e)
+}
+
+// AuditLogActionFromMethod maps a http method to an audit log action,
+// the second value is false for non-mutating methods
+func AuditLogActionFromMethod(method string) (AuditLogAction, bool) {
+	switch method {
+	case http.MethodPost:
+		return AuditLogActionCreate, true
+	case http.MethodPut, http.MethodPatch:
+		return AuditLogActionUpdate, true
+	case http.MethodDelete:
+		return AuditLogActionDelete, true
+	}
+	return "", false
+}
+
+type AuditLogActorType string
+
+const (
+	AuditLogActorTypeEmployee  AuditLogActorType = "employee"
+	AuditLogActorTypeAPIKey    AuditLogActorType = "api-key"
+	AuditLogActorTypeAnonymous AuditLogActorType = "anonymous"
+)
+
+func (e AuditLogActorType) IsValid() bool {
+	switch e {
+	case
+		AuditLogActorTypeEmployee,
+		AuditLogActorTypeAPIKey,
+		AuditLogActorTypeAnonymous:
+		return true
+	}
+	return false
+}
+
+func (e AuditLogActorType) String() string {
+	return string(e)
+}
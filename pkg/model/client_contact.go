@@ -1,6 +1,8 @@
 package model
 
 import (
+	"encoding/json"
+
 	"gorm.io/datatypes"
 )
 
@@ -13,6 +15,18 @@ type ClientContact struct {
 	Role          string
 	Emails        datatypes.JSON
 	IsMainContact bool
+
+	PortalAccess *ClientPortalAccess
+}
+
+// GetEmails returns the emails of the contact
+func (c ClientContact) GetEmails() []string {
+	var rs ClientEmail
+	if len(c.Emails) == 0 || json.Unmarshal(c.Emails, &rs) != nil {
+		return nil
+	}
+
+	return rs.Emails
 }
 
 type ClientEmail struct {
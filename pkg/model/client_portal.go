@@ -0,0 +1,86 @@
+package model
+
+import (
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// ClientPortalAudience is the audience of the client portal tokens
+const ClientPortalAudience = "client-portal"
+
+type ClientPortalAccessStatus string
+
+const (
+	ClientPortalAccessStatusActive  ClientPortalAccessStatus = "active"
+	ClientPortalAccessStatusRevoked ClientPortalAccessStatus = "revoked"
+)
+
+// IsValid validation for ClientPortalAccessStatus
+func (e ClientPortalAccessStatus) IsValid() bool {
+	switch e {
+	case
+		ClientPortalAccessStatusActive,
+		ClientPortalAccessStatusRevoked:
+		return true
+	}
+	return false
+}
+
+// String returns the string type from the ClientPortalAccessStatus type
+func (e ClientPortalAccessStatus) String() string {
+	return string(e)
+}
+
+// ClientPortalAccess is the access of a client contact to the client portal,
+// the token is the one-time login link sent to the contact
+type ClientPortalAccess struct {
+	BaseModel
+
+	ClientID        UUID
+	ClientContactID UUID
+	Email           string
+	Status          ClientPortalAccessStatus
+	IssuedBy        *UUID
+	TokenHash       string     `json:"-"`
+	TokenExpiredAt  *time.Time `json:"-"`
+	LastLoginAt     *time.Time
+	RevokedAt       *time.Time
+	// SessionVersion is bumped when the access is revoked or issued again to end the sessions started before
+	SessionVersion int
+}
+
+// IsActive checks if the contact can use the client portal
+func (a ClientPortalAccess) IsActive() bool {
+	return a.Status == ClientPortalAccessStatusActive
+}
+
+// IsSessionValid checks if a session of the contact started with the given version can still be used
+func (a ClientPortalAccess) IsSessionValid(version int) bool {
+	return a.IsActive() && a.SessionVersion == version
+}
+
+// IsTokenUsable checks if the login link of the access can still be used to log in
+func (a ClientPortalAccess) IsTokenUsable(now time.Time) bool {
+	return a.IsActive() && a.TokenHash != "" && a.TokenExpiredAt != nil && now.Before(*a.TokenExpiredAt)
+}
+
+// ClientPortalClaims are the claims of the token of a client contact, the token is only valid in the client portal
+type ClientPortalClaims struct {
+	jwt.RegisteredClaims
+
+	AccessID       string `json:"accessID"`
+	ContactID      string `json:"contactID"`
+	ClientID       string `json:"clientID"`
+	SessionVersion int    `json:"sessionVersion"`
+}
+
+// ClientPortalEmail is the login link sent to a client contact,
+// IsInvitation is true for the first link sent when the access is issued
+type ClientPortalEmail struct {
+	Email        string `json:"email"`
+	Name         string `json:"name"`
+	ClientName   string `json:"clientName"`
+	Link         string `json:"link"`
+	IsInvitation bool   `json:"isInvitation"`
+}
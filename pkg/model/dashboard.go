@@ -84,6 +84,9 @@ type ResourceUtilization struct {
 	Staffed   int       `json:"staffed"`
 	Internal  int       `json:"internal"`
 	Available int       `json:"available"`
+	// ApprovedHours are the hours of the approved timesheets in the month, BillableHours leave out internal projects
+	ApprovedHours float64 `json:"approvedHours"`
+	BillableHours float64 `json:"billableHours"`
 }
 
 type TotalWorkUnitDistribution struct {
@@ -15,15 +15,26 @@ type EmployeeEventQuestion struct {
 	Type                    string
 	Order                   int64
 	Domain                  QuestionDomain
+	TemplateQuestionID      UUID
+
+	TemplateQuestion *SurveyTemplateQuestion `gorm:"foreignKey:TemplateQuestionID"`
 }
 
 type StatisticEngagementDashboard struct {
-	Name       string
-	Content    string
-	Title      string
-	Point      float64
-	QuestionID UUID
-	StartDate  time.Time
+	Name        string
+	Content     string
+	Title       string
+	Point       float64
+	QuestionID  UUID
+	StartDate   time.Time
+	Respondents int
+	// Threshold is the anonymity threshold of the event, zero for identified surveys
+	Threshold int
+}
+
+// IsHidden returns true if the group is too small to show its result without exposing the respondents
+func (s *StatisticEngagementDashboard) IsHidden() bool {
+	return s.Threshold > 0 && s.Respondents < s.Threshold
 }
 
 type EngagementDashboardFilter string
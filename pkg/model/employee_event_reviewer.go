@@ -12,7 +12,9 @@ type EmployeeEventReviewer struct {
 	IsShared             bool
 	IsRead               bool
 	IsForcedDone         bool
-	EventID              UUID
+	// IsNonResponse is set when the reviewer is forced to done because the survey closed at its deadline
+	IsNonResponse bool
+	EventID       UUID
 
 	Event                  FeedbackEvent
 	EmployeeEventTopic     EmployeeEventTopic
@@ -0,0 +1,37 @@
+package model
+
+import "time"
+
+// EmployeeIdentity links an external login identity to an employee,
+// an employee can log in with any of the linked identities
+type EmployeeIdentity struct {
+	BaseModel
+
+	EmployeeID  UUID
+	Provider    string
+	Subject     string
+	Email       string
+	LastLoginAt *time.Time
+
+	Employee *Employee
+}
+
+type MagicLinkToken struct {
+	BaseModel
+
+	EmployeeID UUID
+	Email      string
+	TokenHash  string
+	ExpiredAt  time.Time
+	UsedAt     *time.Time
+}
+
+// IsUsable checks if the token can still be used to log in
+func (t MagicLinkToken) IsUsable(now time.Time) bool {
+	return t.UsedAt == nil && now.Before(t.ExpiredAt)
+}
+
+type MagicLinkEmail struct {
+	Email string `json:"email"`
+	Link  string `json:"link"`
+}
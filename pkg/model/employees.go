@@ -3,6 +3,9 @@ package model
 import (
 	"strings"
 	"time"
+
+	// register the serializer for encrypted columns
+	_ "github.com/dwarvesf/fortress-api/pkg/utils/cryptoutils"
 )
 
 // WorkingStatus working_status type for employee table
@@ -61,6 +64,21 @@ func (e AccountRole) String() string {
 	return string(e)
 }
 
+// EmployeeEncryptedColumns are the PII columns encrypted at application level,
+// see cryptoutils.EncryptedSerializer
+var EmployeeEncryptedColumns = []string{
+	"personal_email",
+	"phone_number",
+	"address",
+	"passport_photo_front",
+	"passport_photo_back",
+	"identity_card_photo_front",
+	"identity_card_photo_back",
+	"date_of_birth",
+	"wise_account_number",
+	"local_bank_number",
+}
+
 // Employee define the model for table employees
 type Employee struct {
 	BaseModel
@@ -70,19 +88,19 @@ type Employee struct {
 	DisplayName            string     `gorm:"default:null"`
 	Username               string     `gorm:"default:null"`
 	TeamEmail              string     `gorm:"default:null"`
-	PersonalEmail          string     `gorm:"default:null"`
+	PersonalEmail          string     `gorm:"serializer:encrypted;blindindex;default:null"`
 	Avatar                 string     `gorm:"default:null"`
-	PhoneNumber            string     `gorm:"default:null"`
-	Address                string     `gorm:"default:null"`
+	PhoneNumber            string     `gorm:"serializer:encrypted;blindindex;default:null"`
+	Address                string     `gorm:"serializer:encrypted;default:null"`
 	PlaceOfResidence       string     `gorm:"default:null"`
 	MBTI                   string     `gorm:"default:null"`
 	Gender                 string     `gorm:"default:null"`
 	Horoscope              string     `gorm:"default:null"`
-	PassportPhotoFront     string     `gorm:"default:null"`
-	PassportPhotoBack      string     `gorm:"default:null"`
-	IdentityCardPhotoFront string     `gorm:"default:null"`
-	IdentityCardPhotoBack  string     `gorm:"default:null"`
-	DateOfBirth            *time.Time `gorm:"default:null"`
+	PassportPhotoFront     string     `gorm:"serializer:encrypted;default:null"`
+	PassportPhotoBack      string     `gorm:"serializer:encrypted;default:null"`
+	IdentityCardPhotoFront string     `gorm:"serializer:encrypted;default:null"`
+	IdentityCardPhotoBack  string     `gorm:"serializer:encrypted;default:null"`
+	DateOfBirth            *time.Time `gorm:"serializer:encrypted;default:null"`
 	Country                string     `gorm:"default:null"`
 	City                   string     `gorm:"default:null"`
 	Lat                    string     `gorm:"default:null"`
@@ -105,11 +123,11 @@ type Employee struct {
 	WiseRecipientEmail string `gorm:"default:null"`
 	WiseRecipientID    string `gorm:"default:null"`
 	WiseRecipientName  string `gorm:"default:null"`
-	WiseAccountNumber  string `gorm:"default:null"`
+	WiseAccountNumber  string `gorm:"serializer:encrypted;default:null"`
 	WiseCurrency       string `gorm:"default:null"`
 
 	LocalBankBranch        string `gorm:"default:null"`
-	LocalBankNumber        string `gorm:"default:null"`
+	LocalBankNumber        string `gorm:"serializer:encrypted;default:null"`
 	LocalBankCurrency      string `gorm:"default:null"`
 	LocalBranchName        string `gorm:"default:null"`
 	LocalBankRecipientName string `gorm:"default:null"`
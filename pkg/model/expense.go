@@ -19,4 +19,5 @@ type Expense struct {
 	Metadata                datatypes.JSON `json:"metadata"`
 	BasecampID              int            `json:"basecamp_id"`
 	AccountingTransactionID *UUID          `json:"accounting_transaction_id"`
+	ProjectID               *UUID          `json:"project_id"`
 }
@@ -0,0 +1,41 @@
+package model
+
+// ExportFormat is the file format of an exported report
+type ExportFormat string
+
+// values for ExportFormat
+const (
+	ExportFormatCSV  ExportFormat = "csv"
+	ExportFormatXLSX ExportFormat = "xlsx"
+	ExportFormatPDF  ExportFormat = "pdf"
+)
+
+// IsValid validation for ExportFormat
+func (e ExportFormat) IsValid() bool {
+	switch e {
+	case
+		ExportFormatCSV,
+		ExportFormatXLSX,
+		ExportFormatPDF:
+		return true
+	}
+	return false
+}
+
+// String returns the string type from the ExportFormat type
+func (e ExportFormat) String() string {
+	return string(e)
+}
+
+// ContentType returns the MIME type of the format
+func (e ExportFormat) ContentType() string {
+	switch e {
+	case ExportFormatCSV:
+		return "text/csv"
+	case ExportFormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case ExportFormatPDF:
+		return "application/pdf"
+	}
+	return "application/octet-stream"
+}
@@ -91,6 +91,17 @@ func (e EventSubtype) IsSurveyValid() bool {
 	return false
 }
 
+// IsFeedbackValid validation for EventSubtype of continuous feedback sent outside of surveys
+func (e EventSubtype) IsFeedbackValid() bool {
+	switch e {
+	case
+		EventSubtypeAppreciation,
+		EventSubtypeComment:
+		return true
+	}
+	return false
+}
+
 // String returns the string type from the EventSubtype type
 func (e EventSubtype) String() string {
 	return string(e)
@@ -108,6 +119,112 @@ func (e EventSubtype) IsValidSurvey() bool {
 	return false
 }
 
+// SurveyAnonymity is how answers of a survey are linked to the reviewers
+type SurveyAnonymity string
+
+// values for SurveyAnonymity
+const (
+	// SurveyAnonymityIdentified answers are linked to the reviewer
+	SurveyAnonymityIdentified SurveyAnonymity = "identified"
+	// SurveyAnonymityPseudonymous answers are detached from the reviewer but share a pseudonym across surveys
+	SurveyAnonymityPseudonymous SurveyAnonymity = "pseudonymous"
+	// SurveyAnonymityAnonymous answers are detached from the reviewer, every response gets a random key
+	SurveyAnonymityAnonymous SurveyAnonymity = "anonymous"
+)
+
+// IsValid validation for SurveyAnonymity
+func (e SurveyAnonymity) IsValid() bool {
+	switch e {
+	case
+		SurveyAnonymityIdentified,
+		SurveyAnonymityPseudonymous,
+		SurveyAnonymityAnonymous:
+		return true
+	}
+	return false
+}
+
+// String returns the string type from the SurveyAnonymity type
+func (e SurveyAnonymity) String() string {
+	return string(e)
+}
+
+// IsDetached returns true if answers must not be linked to the reviewer
+func (e SurveyAnonymity) IsDetached() bool {
+	return e == SurveyAnonymityPseudonymous || e == SurveyAnonymityAnonymous
+}
+
+// FeedbackVisibility is who can read a continuous feedback
+type FeedbackVisibility string
+
+// values for FeedbackVisibility
+const (
+	// FeedbackVisibilityPrivate only the sender and the recipient can read the feedback
+	FeedbackVisibilityPrivate FeedbackVisibility = "private"
+	// FeedbackVisibilityManager the line manager of the recipient can read the feedback too
+	FeedbackVisibilityManager FeedbackVisibility = "manager"
+	// FeedbackVisibilityPublic everyone can read the feedback, public kudos are announced on Discord
+	FeedbackVisibilityPublic FeedbackVisibility = "public"
+)
+
+// IsValid validation for FeedbackVisibility
+func (e FeedbackVisibility) IsValid() bool {
+	switch e {
+	case
+		FeedbackVisibilityPrivate,
+		FeedbackVisibilityManager,
+		FeedbackVisibilityPublic:
+		return true
+	}
+	return false
+}
+
+// String returns the string type from the FeedbackVisibility type
+func (e FeedbackVisibility) String() string {
+	return string(e)
+}
+
+// VisibleFeedbacks returns the visibilities of the feedback an employee received that a viewer can read
+func VisibleFeedbacks(isRecipient bool, isManager bool) []FeedbackVisibility {
+	switch {
+	case isRecipient:
+		return []FeedbackVisibility{FeedbackVisibilityPrivate, FeedbackVisibilityManager, FeedbackVisibilityPublic}
+	case isManager:
+		return []FeedbackVisibility{FeedbackVisibilityManager, FeedbackVisibilityPublic}
+	}
+	return []FeedbackVisibility{FeedbackVisibilityPublic}
+}
+
+// IsAnonymous returns true if answers of the event are detached from the reviewers
+func (e *FeedbackEvent) IsAnonymous() bool {
+	return e.Anonymity.IsDetached()
+}
+
+// IsOverdue returns true if the event has a deadline and it has passed
+func (e *FeedbackEvent) IsOverdue(now time.Time) bool {
+	return e.Deadline != nil && now.After(*e.Deadline)
+}
+
+// DueReminderDays returns the reminder which is due at the given time, i.e. the smallest number of days
+// before the deadline which has been reached. Reminders missed by earlier runs collapse into this one
+func (e *FeedbackEvent) DueReminderDays(now time.Time) (int, bool) {
+	if e.Deadline == nil || e.IsOverdue(now) {
+		return 0, false
+	}
+
+	due, ok := 0, false
+	for _, d := range e.ReminderDays {
+		if now.Before(e.Deadline.AddDate(0, 0, -d)) {
+			continue
+		}
+		if !ok || d < due {
+			due, ok = d, true
+		}
+	}
+
+	return due, ok
+}
+
 // FeedbackEvent model for feedback_events table
 type FeedbackEvent struct {
 	BaseModel
@@ -119,6 +236,20 @@ type FeedbackEvent struct {
 	CreatedBy UUID
 	StartDate *time.Time
 	EndDate   *time.Time
+	// TemplateVersionID is set when the survey questions come from a survey template
+	TemplateVersionID UUID
+	Anonymity         SurveyAnonymity `gorm:"default:identified"`
+	// AnonymityThreshold is the minimum number of respondents of a group before its results are shown
+	AnonymityThreshold int
+	// Deadline is when reviewers are expected to finish, reminders are counted backward from it
+	Deadline *time.Time
+	// ReminderDays are the number of days before the deadline a reminder is sent to pending reviewers
+	ReminderDays []int `gorm:"serializer:json"`
+	// AutoClose forces pending reviewers to done and records them as non-response once the deadline passes
+	AutoClose bool
+	ClosedAt  *time.Time
+	// Visibility is who can read a continuous feedback besides the recipient
+	Visibility FeedbackVisibility `gorm:"default:private"`
 
 	Employee             Employee              `gorm:"foreignKey:CreatedBy"`
 	Topics               []*EmployeeEventTopic `gorm:"foreignKey:EventID"`
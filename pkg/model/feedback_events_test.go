@@ -0,0 +1,136 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSurveyAnonymity(t *testing.T) {
+	testcases := []struct {
+		anonymity  SurveyAnonymity
+		isValid    bool
+		isDetached bool
+	}{
+		{SurveyAnonymityIdentified, true, false},
+		{SurveyAnonymityPseudonymous, true, true},
+		{SurveyAnonymityAnonymous, true, true},
+		{SurveyAnonymity("public"), false, false},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.anonymity.String(), func(t *testing.T) {
+			if got := tc.anonymity.IsValid(); got != tc.isValid {
+				t.Errorf("IsValid() = %v, want %v", got, tc.isValid)
+			}
+			if got := tc.anonymity.IsDetached(); got != tc.isDetached {
+				t.Errorf("IsDetached() = %v, want %v", got, tc.isDetached)
+			}
+		})
+	}
+}
+
+func TestStatisticEngagementDashboard_IsHidden(t *testing.T) {
+	testcases := []struct {
+		name        string
+		respondents int
+		threshold   int
+		want        bool
+	}{
+		{"identified survey", 1, 0, false},
+		{"below threshold", 4, 5, true},
+		{"reach threshold", 5, 5, false},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := StatisticEngagementDashboard{Respondents: tc.respondents, Threshold: tc.threshold}
+			if got := s.IsHidden(); got != tc.want {
+				t.Errorf("IsHidden() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFeedbackEvent_DueReminderDays(t *testing.T) {
+	deadline := time.Date(2023, 7, 20, 23, 59, 59, 0, time.UTC)
+
+	testcases := []struct {
+		name     string
+		event    FeedbackEvent
+		now      time.Time
+		wantDays int
+		wantOK   bool
+	}{
+		{
+			name:  "no deadline",
+			event: FeedbackEvent{ReminderDays: []int{3, 1}},
+			now:   deadline.AddDate(0, 0, -1),
+		},
+		{
+			name:  "no reminder reached",
+			event: FeedbackEvent{Deadline: &deadline, ReminderDays: []int{3, 1}},
+			now:   deadline.AddDate(0, 0, -5),
+		},
+		{
+			name:     "first reminder",
+			event:    FeedbackEvent{Deadline: &deadline, ReminderDays: []int{3, 1}},
+			now:      deadline.AddDate(0, 0, -2),
+			wantDays: 3,
+			wantOK:   true,
+		},
+		{
+			name:     "missed reminders collapse into the latest",
+			event:    FeedbackEvent{Deadline: &deadline, ReminderDays: []int{1, 7, 3}},
+			now:      deadline.Add(-time.Hour),
+			wantDays: 1,
+			wantOK:   true,
+		},
+		{
+			name:  "overdue",
+			event: FeedbackEvent{Deadline: &deadline, ReminderDays: []int{3, 1}},
+			now:   deadline.Add(time.Hour),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			days, ok := tc.event.DueReminderDays(tc.now)
+			if days != tc.wantDays || ok != tc.wantOK {
+				t.Errorf("DueReminderDays() = (%v, %v), want (%v, %v)", days, ok, tc.wantDays, tc.wantOK)
+			}
+		})
+	}
+}
+
+func TestVisibleFeedbacks(t *testing.T) {
+	testcases := []struct {
+		name        string
+		isRecipient bool
+		isManager   bool
+		want        []FeedbackVisibility
+	}{
+		{
+			name:        "recipient reads everything",
+			isRecipient: true,
+			want:        []FeedbackVisibility{FeedbackVisibilityPrivate, FeedbackVisibilityManager, FeedbackVisibilityPublic},
+		},
+		{
+			name:      "line manager reads what is shared with managers",
+			isManager: true,
+			want:      []FeedbackVisibility{FeedbackVisibilityManager, FeedbackVisibilityPublic},
+		},
+		{
+			name: "others only read public kudos",
+			want: []FeedbackVisibility{FeedbackVisibilityPublic},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := VisibleFeedbacks(tc.isRecipient, tc.isManager); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("VisibleFeedbacks() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
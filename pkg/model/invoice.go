@@ -71,6 +71,9 @@ type Invoice struct {
 	ProjectID UUID
 	Project   *Project
 
+	// BudgetPhaseID links a milestone invoice of a fixed-cost project to its budget phase
+	BudgetPhaseID *UUID
+
 	InvoiceFileContent []byte `gorm:"-"` // we not store this in db
 	MessageID          string `gorm:"-"`
 	References         string `gorm:"-"`
@@ -0,0 +1,221 @@
+package model
+
+import (
+	"time"
+)
+
+// Objective model for objectives table, the O of an OKR.
+// Objectives can be aligned to a parent objective of a higher level, its progress rolls up to the parent
+type Objective struct {
+	BaseModel
+
+	Title       string
+	Description string
+	Level       ObjectiveLevel
+	Status      ObjectiveStatus
+	ParentID    UUID
+	OwnerID     UUID
+	ProjectID   UUID
+	ChapterID   UUID
+	EmployeeID  UUID
+	StartDate   time.Time
+	EndDate     time.Time
+	// CheckInIntervalDays is how often owners of key results are expected to check in
+	CheckInIntervalDays int
+	CreatedBy           UUID
+
+	// Progress is the rolled-up progress of the objective, between 0 and 1
+	Progress float64 `gorm:"-"`
+
+	Owner      *Employee    `gorm:"foreignKey:OwnerID"`
+	Project    *Project     `gorm:"foreignKey:ProjectID"`
+	Chapter    *Chapter     `gorm:"foreignKey:ChapterID"`
+	Employee   *Employee    `gorm:"foreignKey:EmployeeID"`
+	KeyResults []*KeyResult `gorm:"foreignKey:ObjectiveID"`
+}
+
+// KeyResult model for key_results table, a measurable target of an objective
+type KeyResult struct {
+	BaseModel
+
+	ObjectiveID    UUID
+	Title          string
+	OwnerID        UUID
+	Unit           string
+	StartValue     float64
+	TargetValue    float64
+	CurrentValue   float64
+	LastCheckInAt  *time.Time
+	LastRemindedAt *time.Time
+
+	Objective *Objective `gorm:"foreignKey:ObjectiveID"`
+	Owner     *Employee  `gorm:"foreignKey:OwnerID"`
+}
+
+// KeyResultCheckIn model for key_result_check_ins table, the progress history of a key result
+type KeyResultCheckIn struct {
+	BaseModel
+
+	KeyResultID UUID
+	EmployeeID  UUID
+	Value       float64
+	Progress    float64
+	// Confidence is how confident the owner is to reach the target, from 1 to 10
+	Confidence int
+	Note       string
+
+	Employee *Employee `gorm:"foreignKey:EmployeeID"`
+}
+
+// ObjectiveLevel is who an objective belongs to
+type ObjectiveLevel string
+
+// values for ObjectiveLevel
+const (
+	ObjectiveLevelCompany    ObjectiveLevel = "company"
+	ObjectiveLevelChapter    ObjectiveLevel = "chapter"
+	ObjectiveLevelProject    ObjectiveLevel = "project"
+	ObjectiveLevelIndividual ObjectiveLevel = "individual"
+)
+
+// IsValid validation for ObjectiveLevel
+func (e ObjectiveLevel) IsValid() bool {
+	switch e {
+	case
+		ObjectiveLevelCompany,
+		ObjectiveLevelChapter,
+		ObjectiveLevelProject,
+		ObjectiveLevelIndividual:
+		return true
+	}
+	return false
+}
+
+// String returns the string type from the ObjectiveLevel type
+func (e ObjectiveLevel) String() string {
+	return string(e)
+}
+
+// ObjectiveStatus is the status of an objective
+type ObjectiveStatus string
+
+// values for ObjectiveStatus
+const (
+	ObjectiveStatusActive    ObjectiveStatus = "active"
+	ObjectiveStatusCompleted ObjectiveStatus = "completed"
+	ObjectiveStatusCancelled ObjectiveStatus = "cancelled"
+)
+
+// IsValid validation for ObjectiveStatus
+func (e ObjectiveStatus) IsValid() bool {
+	switch e {
+	case
+		ObjectiveStatusActive,
+		ObjectiveStatusCompleted,
+		ObjectiveStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// String returns the string type from the ObjectiveStatus type
+func (e ObjectiveStatus) String() string {
+	return string(e)
+}
+
+// ProgressAt returns how far a value is from the start value to the target, between 0 and 1.
+// Targets can be below the start value, i.e. reducing the number of incidents
+func (k *KeyResult) ProgressAt(value float64) float64 {
+	if k.TargetValue == k.StartValue {
+		if value == k.TargetValue {
+			return 1
+		}
+		return 0
+	}
+
+	progress := (value - k.StartValue) / (k.TargetValue - k.StartValue)
+	switch {
+	case progress < 0:
+		return 0
+	case progress > 1:
+		return 1
+	}
+	return progress
+}
+
+// Progress returns the progress of the current value of the key result
+func (k *KeyResult) Progress() float64 {
+	return k.ProgressAt(k.CurrentValue)
+}
+
+// CheckInDueAt returns when the owner is expected to check in next
+func (k *KeyResult) CheckInDueAt(intervalDays int) time.Time {
+	last := k.CreatedAt
+	if k.LastCheckInAt != nil {
+		last = *k.LastCheckInAt
+	}
+	return last.AddDate(0, 0, intervalDays)
+}
+
+// IsCheckInReminderDue is true when the check-in is overdue and the owner was not reminded since it became due
+func (k *KeyResult) IsCheckInReminderDue(intervalDays int, now time.Time) bool {
+	due := k.CheckInDueAt(intervalDays)
+	if now.Before(due) {
+		return false
+	}
+	return k.LastRemindedAt == nil || k.LastRemindedAt.Before(due)
+}
+
+// RollUpObjectiveProgress returns the progress of the given objectives by id.
+// The progress of an objective is the average progress of its key results and of the active objectives aligned to it,
+// cancelled objectives are left out of the progress of their parent
+func RollUpObjectiveProgress(objectives []*Objective) map[UUID]float64 {
+	children := map[UUID][]*Objective{}
+	for _, o := range objectives {
+		if !o.ParentID.IsZero() {
+			children[o.ParentID] = append(children[o.ParentID], o)
+		}
+	}
+
+	progress := map[UUID]float64{}
+	visiting := map[UUID]bool{}
+
+	var rollUp func(o *Objective) float64
+	rollUp = func(o *Objective) float64 {
+		if p, ok := progress[o.ID]; ok {
+			return p
+		}
+		// a parent loop must not recurse forever
+		if visiting[o.ID] {
+			return 0
+		}
+		visiting[o.ID] = true
+
+		sum, count := 0.0, 0
+		for _, kr := range o.KeyResults {
+			sum += kr.Progress()
+			count++
+		}
+		for _, child := range children[o.ID] {
+			if child.Status == ObjectiveStatusCancelled {
+				continue
+			}
+			sum += rollUp(child)
+			count++
+		}
+
+		p := 0.0
+		if count > 0 {
+			p = sum / float64(count)
+		}
+		progress[o.ID] = p
+
+		return p
+	}
+
+	for _, o := range objectives {
+		rollUp(o)
+	}
+
+	return progress
+}
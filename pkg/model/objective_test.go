@@ -0,0 +1,97 @@
+package model
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestKeyResult_ProgressAt(t *testing.T) {
+	testcases := []struct {
+		name   string
+		kr     KeyResult
+		value  float64
+		wanted float64
+	}{
+		{name: "half way", kr: KeyResult{StartValue: 0, TargetValue: 10}, value: 5, wanted: 0.5},
+		{name: "over target", kr: KeyResult{StartValue: 0, TargetValue: 10}, value: 12, wanted: 1},
+		{name: "below start", kr: KeyResult{StartValue: 4, TargetValue: 10}, value: 2, wanted: 0},
+		{name: "decreasing target", kr: KeyResult{StartValue: 20, TargetValue: 10}, value: 15, wanted: 0.5},
+		{name: "target equals start reached", kr: KeyResult{StartValue: 3, TargetValue: 3}, value: 3, wanted: 1},
+		{name: "target equals start missed", kr: KeyResult{StartValue: 3, TargetValue: 3}, value: 2, wanted: 0},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.kr.ProgressAt(tc.value); got != tc.wanted {
+				t.Errorf("ProgressAt() = %v, want %v", got, tc.wanted)
+			}
+		})
+	}
+}
+
+func TestKeyResult_IsCheckInReminderDue(t *testing.T) {
+	now := time.Date(2023, 7, 18, 9, 0, 0, 0, time.UTC)
+	daysAgo := func(days int) *time.Time {
+		t := now.AddDate(0, 0, -days)
+		return &t
+	}
+
+	testcases := []struct {
+		name   string
+		kr     KeyResult
+		wanted bool
+	}{
+		{name: "never checked in and overdue", kr: KeyResult{BaseModel: BaseModel{CreatedAt: *daysAgo(8)}}, wanted: true},
+		{name: "checked in recently", kr: KeyResult{BaseModel: BaseModel{CreatedAt: *daysAgo(30)}, LastCheckInAt: daysAgo(2)}},
+		{name: "reminded since due", kr: KeyResult{BaseModel: BaseModel{CreatedAt: *daysAgo(30)}, LastCheckInAt: daysAgo(10), LastRemindedAt: daysAgo(1)}},
+		{name: "reminded before due", kr: KeyResult{BaseModel: BaseModel{CreatedAt: *daysAgo(30)}, LastCheckInAt: daysAgo(10), LastRemindedAt: daysAgo(5)}, wanted: true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.kr.IsCheckInReminderDue(7, now); got != tc.wanted {
+				t.Errorf("IsCheckInReminderDue() = %v, want %v", got, tc.wanted)
+			}
+		})
+	}
+}
+
+func TestRollUpObjectiveProgress(t *testing.T) {
+	company := &Objective{BaseModel: BaseModel{ID: NewUUID()}, Status: ObjectiveStatusActive}
+	project := &Objective{
+		BaseModel: BaseModel{ID: NewUUID()},
+		ParentID:  company.ID,
+		Status:    ObjectiveStatusActive,
+		KeyResults: []*KeyResult{
+			{StartValue: 0, TargetValue: 10, CurrentValue: 10},
+			{StartValue: 0, TargetValue: 10, CurrentValue: 0},
+		},
+	}
+	individual := &Objective{
+		BaseModel:  BaseModel{ID: NewUUID()},
+		ParentID:   project.ID,
+		Status:     ObjectiveStatusActive,
+		KeyResults: []*KeyResult{{StartValue: 0, TargetValue: 4, CurrentValue: 1}},
+	}
+	cancelled := &Objective{
+		BaseModel:  BaseModel{ID: NewUUID()},
+		ParentID:   company.ID,
+		Status:     ObjectiveStatusCancelled,
+		KeyResults: []*KeyResult{{StartValue: 0, TargetValue: 1, CurrentValue: 1}},
+	}
+
+	progress := RollUpObjectiveProgress([]*Objective{company, project, individual, cancelled})
+
+	wanted := map[UUID]float64{
+		individual.ID: 0.25,
+		project.ID:    1.25 / 3,
+		company.ID:    1.25 / 3,
+		cancelled.ID:  1,
+	}
+	for id, want := range wanted {
+		if math.Abs(progress[id]-want) > 1e-9 {
+			t.Errorf("RollUpObjectiveProgress()[%v] = %v, want %v", id, progress[id], want)
+		}
+	}
+}
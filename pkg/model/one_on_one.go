@@ -0,0 +1,112 @@
+package model
+
+import (
+	"time"
+)
+
+// OneOnOneMeeting model for one_on_one_meetings table, a recurring conversation between a line manager and a report.
+// The time of the meeting lives in its schedule, so it can be synced with Google Calendar
+type OneOnOneMeeting struct {
+	BaseModel
+
+	ManagerID  UUID
+	ReportID   UUID
+	ScheduleID UUID
+	Status     OneOnOneStatus
+	// ManagerNote is private to the manager, it is never shown to the report
+	ManagerNote string
+
+	// CarriedOverAgendaItems and CarriedOverActionItems are the open items of the earlier meetings of the same pair
+	CarriedOverAgendaItems []*OneOnOneAgendaItem `gorm:"-"`
+	CarriedOverActionItems []*OneOnOneActionItem `gorm:"-"`
+
+	Manager     *Employee             `gorm:"foreignKey:ManagerID"`
+	Report      *Employee             `gorm:"foreignKey:ReportID"`
+	Schedule    *Schedule             `gorm:"foreignKey:ScheduleID"`
+	AgendaItems []*OneOnOneAgendaItem `gorm:"foreignKey:MeetingID"`
+	ActionItems []*OneOnOneActionItem `gorm:"foreignKey:MeetingID"`
+}
+
+// OneOnOneAgendaItem model for one_on_one_agenda_items table, a topic either participant wants to talk about
+type OneOnOneAgendaItem struct {
+	BaseModel
+
+	MeetingID   UUID
+	AuthorID    UUID
+	Content     string
+	IsDiscussed bool
+
+	Author *Employee `gorm:"foreignKey:AuthorID"`
+}
+
+// OneOnOneActionItem model for one_on_one_action_items table, a follow-up agreed in a meeting.
+// Open action items are carried over to the next meetings of the same manager and report until they are done
+type OneOnOneActionItem struct {
+	BaseModel
+
+	MeetingID   UUID
+	OwnerID     UUID
+	Content     string
+	DueDate     *time.Time
+	Status      OneOnOneActionItemStatus
+	CompletedAt *time.Time
+
+	Owner *Employee `gorm:"foreignKey:OwnerID"`
+}
+
+// IsParticipant is true when the employee is the manager or the report of the meeting
+func (m *OneOnOneMeeting) IsParticipant(employeeID string) bool {
+	return m.ManagerID.String() == employeeID || m.ReportID.String() == employeeID
+}
+
+// OneOnOneStatus is the status of a 1:1 meeting
+type OneOnOneStatus string
+
+// values for OneOnOneStatus
+const (
+	OneOnOneStatusScheduled OneOnOneStatus = "scheduled"
+	OneOnOneStatusCompleted OneOnOneStatus = "completed"
+	OneOnOneStatusCancelled OneOnOneStatus = "cancelled"
+)
+
+// IsValid validation for OneOnOneStatus
+func (e OneOnOneStatus) IsValid() bool {
+	switch e {
+	case
+		OneOnOneStatusScheduled,
+		OneOnOneStatusCompleted,
+		OneOnOneStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// String returns the string type from the OneOnOneStatus type
+func (e OneOnOneStatus) String() string {
+	return string(e)
+}
+
+// OneOnOneActionItemStatus is the status of an action item of a 1:1 meeting
+type OneOnOneActionItemStatus string
+
+// values for OneOnOneActionItemStatus
+const (
+	OneOnOneActionItemStatusOpen OneOnOneActionItemStatus = "open"
+	OneOnOneActionItemStatusDone OneOnOneActionItemStatus = "done"
+)
+
+// IsValid validation for OneOnOneActionItemStatus
+func (e OneOnOneActionItemStatus) IsValid() bool {
+	switch e {
+	case
+		OneOnOneActionItemStatusOpen,
+		OneOnOneActionItemStatusDone:
+		return true
+	}
+	return false
+}
+
+// String returns the string type from the OneOnOneActionItemStatus type
+func (e OneOnOneActionItemStatus) String() string {
+	return string(e)
+}
@@ -0,0 +1,232 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// PerformanceReviewCycle model for performance_review_cycles table, a cycle reviews every employee over a period
+type PerformanceReviewCycle struct {
+	BaseModel
+
+	Name      string
+	StartDate time.Time
+	EndDate   time.Time
+	// PeerReviewEventID is the peer-review survey whose answers go into the review packets
+	PeerReviewEventID UUID
+	Status            PerformanceReviewCycleStatus
+	CreatedBy         UUID
+
+	Creator         *Employee            `gorm:"foreignKey:CreatedBy"`
+	PeerReviewEvent *FeedbackEvent       `gorm:"foreignKey:PeerReviewEventID"`
+	Reviews         []*PerformanceReview `gorm:"foreignKey:CycleID"`
+}
+
+// PerformanceReview model for performance_reviews table, the review of an employee in a cycle.
+// Finalized reviews are the rating history of the employee
+type PerformanceReview struct {
+	BaseModel
+
+	CycleID    UUID
+	EmployeeID UUID
+	// ReviewerID is the line manager of the employee when the cycle started, they fill in the assessment
+	ReviewerID       UUID
+	Status           PerformanceReviewStatus
+	Assessment       []PerformanceAssessmentItem `gorm:"serializer:json"`
+	ProposedRating   PerformanceRating
+	CalibratedRating PerformanceRating
+	CalibrationNote  string
+	FinalRating      PerformanceRating
+	FinalizedAt      *time.Time
+
+	Cycle    *PerformanceReviewCycle `gorm:"foreignKey:CycleID"`
+	Employee *Employee               `gorm:"foreignKey:EmployeeID"`
+	Reviewer *Employee               `gorm:"foreignKey:ReviewerID"`
+}
+
+// PerformanceAssessmentItem is the rating of a criterion in the line manager assessment, from 1 to 5
+type PerformanceAssessmentItem struct {
+	Criterion PerformanceCriterion `json:"criterion"`
+	Rating    int                  `json:"rating"`
+	Comment   string               `json:"comment"`
+}
+
+// PerformanceReviewPacket is everything known about an employee for their review
+type PerformanceReviewPacket struct {
+	Review *PerformanceReview
+	// Reviews are the answered peer-review survey reviews about the employee, the self review included
+	Reviews         []*EmployeeEventReviewer
+	ProjectMembers  []*ProjectMember
+	WorkUnitMembers []*WorkUnitMember
+	BraineryLogs    []*BraineryLog
+	Engagement      PerformanceEngagementStats
+}
+
+// PerformanceEngagementStats is how an employee took part in the engagement surveys of the cycle period
+type PerformanceEngagementStats struct {
+	Surveys  int
+	Answered int
+}
+
+// PerformanceReviewCycleStatus is the stage of a performance review cycle
+type PerformanceReviewCycleStatus string
+
+// values for PerformanceReviewCycleStatus
+const (
+	// PerformanceReviewCycleStatusAssessment line managers fill in the assessments
+	PerformanceReviewCycleStatusAssessment PerformanceReviewCycleStatus = "assessment"
+	// PerformanceReviewCycleStatusCalibration proposed ratings are compared and adjusted across teams
+	PerformanceReviewCycleStatusCalibration PerformanceReviewCycleStatus = "calibration"
+	// PerformanceReviewCycleStatusFinalized ratings are final and part of the employee history
+	PerformanceReviewCycleStatusFinalized PerformanceReviewCycleStatus = "finalized"
+)
+
+// IsValid validation for PerformanceReviewCycleStatus
+func (e PerformanceReviewCycleStatus) IsValid() bool {
+	switch e {
+	case
+		PerformanceReviewCycleStatusAssessment,
+		PerformanceReviewCycleStatusCalibration,
+		PerformanceReviewCycleStatusFinalized:
+		return true
+	}
+	return false
+}
+
+// String returns the string type from the PerformanceReviewCycleStatus type
+func (e PerformanceReviewCycleStatus) String() string {
+	return string(e)
+}
+
+// Next returns the stage following this one, empty for the last stage
+func (e PerformanceReviewCycleStatus) Next() PerformanceReviewCycleStatus {
+	switch e {
+	case PerformanceReviewCycleStatusAssessment:
+		return PerformanceReviewCycleStatusCalibration
+	case PerformanceReviewCycleStatusCalibration:
+		return PerformanceReviewCycleStatusFinalized
+	}
+	return ""
+}
+
+// PerformanceReviewStatus is the progress of the review of an employee
+type PerformanceReviewStatus string
+
+// values for PerformanceReviewStatus
+const (
+	PerformanceReviewStatusPending    PerformanceReviewStatus = "pending"
+	PerformanceReviewStatusAssessed   PerformanceReviewStatus = "assessed"
+	PerformanceReviewStatusCalibrated PerformanceReviewStatus = "calibrated"
+	PerformanceReviewStatusFinalized  PerformanceReviewStatus = "finalized"
+)
+
+// IsValid validation for PerformanceReviewStatus
+func (e PerformanceReviewStatus) IsValid() bool {
+	switch e {
+	case
+		PerformanceReviewStatusPending,
+		PerformanceReviewStatusAssessed,
+		PerformanceReviewStatusCalibrated,
+		PerformanceReviewStatusFinalized:
+		return true
+	}
+	return false
+}
+
+// String returns the string type from the PerformanceReviewStatus type
+func (e PerformanceReviewStatus) String() string {
+	return string(e)
+}
+
+// PerformanceRating is the overall rating of a review
+type PerformanceRating string
+
+// values for PerformanceRating
+const (
+	PerformanceRatingBelowExpectations   PerformanceRating = "below-expectations"
+	PerformanceRatingNeedsImprovement    PerformanceRating = "needs-improvement"
+	PerformanceRatingMeetsExpectations   PerformanceRating = "meets-expectations"
+	PerformanceRatingExceedsExpectations PerformanceRating = "exceeds-expectations"
+	PerformanceRatingOutstanding         PerformanceRating = "outstanding"
+)
+
+// IsValid validation for PerformanceRating
+func (e PerformanceRating) IsValid() bool {
+	switch e {
+	case
+		PerformanceRatingBelowExpectations,
+		PerformanceRatingNeedsImprovement,
+		PerformanceRatingMeetsExpectations,
+		PerformanceRatingExceedsExpectations,
+		PerformanceRatingOutstanding:
+		return true
+	}
+	return false
+}
+
+// String returns the string type from the PerformanceRating type
+func (e PerformanceRating) String() string {
+	return string(e)
+}
+
+// PerformanceCriterion is a criterion of the line manager assessment
+type PerformanceCriterion string
+
+// values for PerformanceCriterion
+const (
+	PerformanceCriterionDelivery      PerformanceCriterion = "delivery"
+	PerformanceCriterionQuality       PerformanceCriterion = "quality"
+	PerformanceCriterionCollaboration PerformanceCriterion = "collaboration"
+	PerformanceCriterionOwnership     PerformanceCriterion = "ownership"
+	PerformanceCriterionGrowth        PerformanceCriterion = "growth"
+)
+
+// PerformanceCriteria are the criteria every assessment rates, in order
+var PerformanceCriteria = []PerformanceCriterion{
+	PerformanceCriterionDelivery,
+	PerformanceCriterionQuality,
+	PerformanceCriterionCollaboration,
+	PerformanceCriterionOwnership,
+	PerformanceCriterionGrowth,
+}
+
+// errors of the assessment form
+var (
+	ErrInvalidAssessmentCriterion = errors.New("assessment must rate every criterion once: delivery, quality, collaboration, ownership and growth")
+	ErrInvalidAssessmentRating    = errors.New("assessment ratings must be between 1 and 5")
+)
+
+// ValidateAssessment checks every criterion is rated once from 1 to 5
+func ValidateAssessment(items []PerformanceAssessmentItem) error {
+	if len(items) != len(PerformanceCriteria) {
+		return ErrInvalidAssessmentCriterion
+	}
+
+	rated := map[PerformanceCriterion]bool{}
+	for _, item := range items {
+		if rated[item.Criterion] {
+			return ErrInvalidAssessmentCriterion
+		}
+		rated[item.Criterion] = true
+
+		if item.Rating < 1 || item.Rating > 5 {
+			return ErrInvalidAssessmentRating
+		}
+	}
+
+	for _, c := range PerformanceCriteria {
+		if !rated[c] {
+			return ErrInvalidAssessmentCriterion
+		}
+	}
+
+	return nil
+}
+
+// ResolveFinalRating returns the rating a review is finalized with, calibration overrides the proposed rating
+func (r *PerformanceReview) ResolveFinalRating() PerformanceRating {
+	if r.CalibratedRating != "" {
+		return r.CalibratedRating
+	}
+	return r.ProposedRating
+}
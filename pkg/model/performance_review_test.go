@@ -0,0 +1,65 @@
+package model
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateAssessment(t *testing.T) {
+	rated := func(rating int) []PerformanceAssessmentItem {
+		items := make([]PerformanceAssessmentItem, 0, len(PerformanceCriteria))
+		for _, c := range PerformanceCriteria {
+			items = append(items, PerformanceAssessmentItem{Criterion: c, Rating: rating})
+		}
+		return items
+	}
+
+	duplicated := rated(3)
+	duplicated[4].Criterion = PerformanceCriterionDelivery
+
+	testcases := []struct {
+		name  string
+		items []PerformanceAssessmentItem
+		want  error
+	}{
+		{name: "every criterion rated", items: rated(4)},
+		{name: "missing criterion", items: rated(4)[1:], want: ErrInvalidAssessmentCriterion},
+		{name: "duplicated criterion", items: duplicated, want: ErrInvalidAssessmentCriterion},
+		{name: "rating out of range", items: rated(6), want: ErrInvalidAssessmentRating},
+		{name: "unrated", items: rated(0), want: ErrInvalidAssessmentRating},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := ValidateAssessment(tc.items); !errors.Is(got, tc.want) {
+				t.Errorf("ValidateAssessment() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPerformanceReviewCycleStatusNext(t *testing.T) {
+	testcases := map[PerformanceReviewCycleStatus]PerformanceReviewCycleStatus{
+		PerformanceReviewCycleStatusAssessment:  PerformanceReviewCycleStatusCalibration,
+		PerformanceReviewCycleStatusCalibration: PerformanceReviewCycleStatusFinalized,
+		PerformanceReviewCycleStatusFinalized:   "",
+	}
+
+	for status, want := range testcases {
+		if got := status.Next(); got != want {
+			t.Errorf("%v.Next() = %v, want %v", status, got, want)
+		}
+	}
+}
+
+func TestResolveFinalRating(t *testing.T) {
+	review := &PerformanceReview{ProposedRating: PerformanceRatingMeetsExpectations}
+	if got := review.ResolveFinalRating(); got != PerformanceRatingMeetsExpectations {
+		t.Errorf("ResolveFinalRating() = %v, want proposed rating", got)
+	}
+
+	review.CalibratedRating = PerformanceRatingExceedsExpectations
+	if got := review.ResolveFinalRating(); got != PerformanceRatingExceedsExpectations {
+		t.Errorf("ResolveFinalRating() = %v, want calibrated rating", got)
+	}
+}
@@ -29,9 +29,11 @@ const (
 	PermissionClientRead                          PermissionCode = "clients.read"
 	PermissionCronjobExecute                      PermissionCode = "cronjobs.execute"
 	PermissionDashBoardEngagementRead             PermissionCode = "dashboards.engagement.read"
+	PermissionDashBoardProfitLossRead             PermissionCode = "dashboards.profitLoss.read"
 	PermissionDashBoardProjectsRead               PermissionCode = "dashboards.projects.read"
 	PermissionDashBoardRead                       PermissionCode = "dashboards.read"
 	PermissionDashBoardResourcesRead              PermissionCode = "dashboards.resources.read"
+	PermissionDashBoardSurveysRead                PermissionCode = "dashboards.surveys.read"
 	PermissionEarnRead                            PermissionCode = "earns.read"
 	PermissionEmployeeEventQuestionsCreate        PermissionCode = "employeeEventQuestions.create"
 	PermissionEmployeeEventQuestionsDelete        PermissionCode = "employeeEventQuestions.delete"
@@ -75,9 +77,16 @@ const (
 	PermissionNotionCreate                        PermissionCode = "notion.create"
 	PermissionNotionRead                          PermissionCode = "notion.read"
 	PermissionNotionSend                          PermissionCode = "notion.send"
+	PermissionObjectivesCreate                    PermissionCode = "objectives.create"
+	PermissionObjectivesEdit                      PermissionCode = "objectives.edit"
 	PermissionPayrollsCreate                      PermissionCode = "payrolls.create"
 	PermissionPayrollsEdit                        PermissionCode = "payrolls.edit"
 	PermissionPayrollsRead                        PermissionCode = "payrolls.read"
+	PermissionPerformanceReviewsCreate            PermissionCode = "performanceReviews.create"
+	PermissionPerformanceReviewsEdit              PermissionCode = "performanceReviews.edit"
+	PermissionPerformanceReviewsRead              PermissionCode = "performanceReviews.read"
+	PermissionProjectHealthEdit                   PermissionCode = "projectHealth.edit"
+	PermissionProjectHealthRead                   PermissionCode = "projectHealth.read"
 	PermissionProjectMembersCreate                PermissionCode = "projectMembers.create"
 	PermissionProjectMembersDelete                PermissionCode = "projectMembers.delete"
 	PermissionProjectMembersEdit                  PermissionCode = "projectMembers.edit"
@@ -92,24 +101,36 @@ const (
 	PermissionProjectWorkUnitsEditFullAccess      PermissionCode = "projectWorkUnits.edit.fullAccess"
 	PermissionProjectWorkUnitsRead                PermissionCode = "projectWorkUnits.read"
 	PermissionProjectWorkUnitsReadFullAccess      PermissionCode = "projectWorkUnits.read.fullAccess"
+	PermissionProjectsBudgetsEdit                 PermissionCode = "projects.budgets.edit"
+	PermissionProjectsBudgetsRead                 PermissionCode = "projects.budgets.read"
 	PermissionProjectsCommissionRateEdit          PermissionCode = "projects.commissionRate.edit"
 	PermissionProjectsCommissionRateRead          PermissionCode = "projects.commissionRate.read"
 	PermissionProjectsCreate                      PermissionCode = "projects.create"
 	PermissionProjectsEdit                        PermissionCode = "projects.edit"
+	PermissionProjectsProfitLossRead              PermissionCode = "projects.profitLoss.read"
 	PermissionProjectsRead                        PermissionCode = "projects.read"
 	PermissionProjectsReadFullAccess              PermissionCode = "projects.read.fullAccess"
 	PermissionProjectsReadMonthlyRevenue          PermissionCode = "projects.read.monthlyRevenue"
 	PermissionProjectsReadReadActive              PermissionCode = "projects.read.readActive"
+	PermissionRateCardsEdit                       PermissionCode = "rateCards.edit"
+	PermissionRateCardsRead                       PermissionCode = "rateCards.read"
+	PermissionStaffingRequestsEdit                PermissionCode = "staffingRequests.edit"
+	PermissionStaffingRequestsRead                PermissionCode = "staffingRequests.read"
 	PermissionSurveysCreate                       PermissionCode = "surveys.create"
 	PermissionSurveysDelete                       PermissionCode = "surveys.delete"
 	PermissionSurveysEdit                         PermissionCode = "surveys.edit"
 	PermissionSurveysRead                         PermissionCode = "surveys.read"
+	PermissionTimesheetsApprove                   PermissionCode = "timesheets.approve"
+	PermissionTimesheetsEdit                      PermissionCode = "timesheets.edit"
+	PermissionTimesheetsRead                      PermissionCode = "timesheets.read"
 	PermissionValuationRead                       PermissionCode = "valuations.read"
 	PermissionEngagementMetricsWrite              PermissionCode = "engagementMetrics.write"
 	PermissionEngagementMetricsRead               PermissionCode = "engagementMetrics.read"
 	PermissionIcyDistributionRead                 PermissionCode = "icyDistribution.read"
 	PermissionBraineryLogsWrite                   PermissionCode = "braineryLogs.write"
 	PermissionBraineryLogsRead                    PermissionCode = "braineryLogs.read"
+	PermissionAuditLogsRead                       PermissionCode = "auditLogs.read"
+	PermissionApprovalRequestsRead                PermissionCode = "approvalRequests.read"
 )
 
 func (p PermissionCode) String() string {
@@ -0,0 +1,92 @@
+package model
+
+import (
+	"math"
+	"time"
+)
+
+// ProfitLoss is the revenue and the costs of a month, amounts are in VND
+type ProfitLoss struct {
+	InvoicedRevenue  float64
+	CollectedRevenue float64
+	PayrollCost      float64
+	CommissionCost   float64
+	ExpenseCost      float64
+}
+
+// Add adds the amounts of another profit and loss
+func (p *ProfitLoss) Add(o ProfitLoss) {
+	p.InvoicedRevenue += o.InvoicedRevenue
+	p.CollectedRevenue += o.CollectedRevenue
+	p.PayrollCost += o.PayrollCost
+	p.CommissionCost += o.CommissionCost
+	p.ExpenseCost += o.ExpenseCost
+}
+
+// TotalCost is the payroll, commission and expense costs
+func (p ProfitLoss) TotalCost() float64 {
+	return p.PayrollCost + p.CommissionCost + p.ExpenseCost
+}
+
+// GrossMargin is the invoiced revenue minus the costs
+func (p ProfitLoss) GrossMargin() float64 {
+	return p.InvoicedRevenue - p.TotalCost()
+}
+
+// MarginPercentage is the gross margin over the invoiced revenue, rounded to 2 decimals
+func (p ProfitLoss) MarginPercentage() float64 {
+	if p.InvoicedRevenue == 0 {
+		return 0
+	}
+
+	return math.Round(p.GrossMargin()/p.InvoicedRevenue*10000) / 100
+}
+
+// ProjectProfitLoss is the profit and loss of a project in the month starting on Month
+type ProjectProfitLoss struct {
+	ProfitLoss
+
+	Month time.Time
+}
+
+// ProfitLossGroup is the profit and loss of the projects of an organization and a project type
+type ProfitLossGroup struct {
+	ProfitLoss
+
+	Organization *Organization
+	ProjectType  ProjectType
+	Projects     int
+}
+
+// ProfitLossMonth is the company profit and loss in the month starting on Month
+type ProfitLossMonth struct {
+	Month  time.Time
+	Total  ProfitLoss
+	Groups []*ProfitLossGroup
+}
+
+// ProjectMonthAmount is an amount of a project in a month
+type ProjectMonthAmount struct {
+	ProjectID UUID
+	Year      int
+	Month     int
+	Amount    float64
+}
+
+// EmployeeMonthAmount is an amount of an employee in a month
+type EmployeeMonthAmount struct {
+	EmployeeID UUID
+	Year       int
+	Month      int
+	Amount     float64
+}
+
+// AllocatePayrollCost returns the share of a payroll cost for an allocation. The cost is split by the
+// allocations of the employee, the unallocated part stays on the bench when the employee is under-allocated
+func AllocatePayrollCost(cost, allocation, totalAllocation float64) float64 {
+	if allocation <= 0 || cost <= 0 {
+		return 0
+	}
+
+	return cost * allocation / math.Max(totalAllocation, MaxAllocationPercentage)
+}
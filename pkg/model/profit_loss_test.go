@@ -0,0 +1,107 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestProfitLoss_MarginPercentage(t *testing.T) {
+	testcases := []struct {
+		name   string
+		pl     ProfitLoss
+		margin float64
+		wanted float64
+	}{
+		{
+			name:   "profitable month",
+			pl:     ProfitLoss{InvoicedRevenue: 300, PayrollCost: 150, CommissionCost: 20, ExpenseCost: 30},
+			margin: 100,
+			wanted: 33.33,
+		},
+		{
+			name:   "losing month",
+			pl:     ProfitLoss{InvoicedRevenue: 100, PayrollCost: 150},
+			margin: -50,
+			wanted: -50,
+		},
+		{
+			name:   "month without revenue",
+			pl:     ProfitLoss{PayrollCost: 150},
+			margin: -150,
+			wanted: 0,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.pl.GrossMargin(); got != tc.margin {
+				t.Errorf("GrossMargin() = %v, want %v", got, tc.margin)
+			}
+			if got := tc.pl.MarginPercentage(); got != tc.wanted {
+				t.Errorf("MarginPercentage() = %v, want %v", got, tc.wanted)
+			}
+		})
+	}
+}
+
+func TestAllocatePayrollCost(t *testing.T) {
+	testcases := []struct {
+		name            string
+		allocation      float64
+		totalAllocation float64
+		wanted          float64
+	}{
+		{
+			name:            "fully allocated to one project",
+			allocation:      100,
+			totalAllocation: 100,
+			wanted:          1000,
+		},
+		{
+			name:            "under-allocated keeps the rest on the bench",
+			allocation:      50,
+			totalAllocation: 50,
+			wanted:          500,
+		},
+		{
+			name:            "over-allocated is split by allocation",
+			allocation:      100,
+			totalAllocation: 200,
+			wanted:          500,
+		},
+		{
+			name:            "shadow member",
+			allocation:      0,
+			totalAllocation: 100,
+			wanted:          0,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := AllocatePayrollCost(1000, tc.allocation, tc.totalAllocation); got != tc.wanted {
+				t.Errorf("AllocatePayrollCost() = %v, want %v", got, tc.wanted)
+			}
+		})
+	}
+}
+
+func TestProjectMember_AverageAllocationInPeriod(t *testing.T) {
+	date := func(day int) *time.Time {
+		d := time.Date(2023, time.June, day, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+
+	member := ProjectMember{
+		DeploymentType: MemberDeploymentTypeOfficial,
+		StartDate:      date(1),
+		Allocations: []*ProjectMemberAllocation{
+			{Percentage: 100, StartDate: *date(1), EndDate: date(15)},
+			{Percentage: 40, StartDate: *date(16)},
+		},
+	}
+
+	if got := member.AverageAllocationInPeriod(*date(1), *date(30)); got != 70 {
+		t.Errorf("AverageAllocationInPeriod() = %v, want %v", got, 70)
+	}
+}
@@ -0,0 +1,216 @@
+package model
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+	"time"
+)
+
+// BudgetAtRiskBurnPercentage is the burn percentage from which a budget is at risk whatever its schedule
+const BudgetAtRiskBurnPercentage = 90
+
+// DefaultBudgetAlertThresholds are the burn percentages at which the project heads are alerted
+var DefaultBudgetAlertThresholds = []float64{50, 75, 90, 100}
+
+// ProjectBudget is the contract value of a fixed-cost project, amounts are in VND
+type ProjectBudget struct {
+	BaseModel
+
+	ProjectID   UUID
+	TotalAmount float64
+	StartDate   time.Time
+	EndDate     *time.Time
+	// AlertThresholds are the burn percentages at which the project heads are alerted
+	AlertThresholds JSON
+	// AlertedThreshold is the last threshold the project heads were alerted at
+	AlertedThreshold float64
+	Note             string
+
+	Project *Project
+	Phases  []*ProjectBudgetPhase
+}
+
+// ProjectBudgetPhase is a milestone of a project budget, invoices of the milestone are linked to it
+type ProjectBudgetPhase struct {
+	BaseModel
+
+	ProjectBudgetID UUID
+	Name            string
+	Amount          float64
+	StartDate       time.Time
+	EndDate         *time.Time
+	Note            string
+
+	Invoices []*Invoice `gorm:"foreignKey:BudgetPhaseID"`
+}
+
+// GetAlertThresholds returns the alert thresholds in ascending order, the default ones when none is set
+func (b *ProjectBudget) GetAlertThresholds() []float64 {
+	var thresholds []float64
+	if len(b.AlertThresholds) == 0 || json.Unmarshal(b.AlertThresholds, &thresholds) != nil || len(thresholds) == 0 {
+		thresholds = append(thresholds[:0], DefaultBudgetAlertThresholds...)
+	}
+
+	sort.Float64s(thresholds)
+	return thresholds
+}
+
+// NextAlertThreshold returns the highest threshold crossed by a burn percentage which has not been alerted yet
+func (b *ProjectBudget) NextAlertThreshold(burnPercentage float64) (float64, bool) {
+	var rs float64
+	found := false
+	for _, t := range b.GetAlertThresholds() {
+		if t > b.AlertedThreshold && t <= burnPercentage {
+			rs = t
+			found = true
+		}
+	}
+
+	return rs, found
+}
+
+// PhasesAmount is the sum of the amounts of the phases, it can not exceed the total amount
+func (b *ProjectBudget) PhasesAmount(excludedPhaseID UUID) float64 {
+	var rs float64
+	for _, p := range b.Phases {
+		if p.ID != excludedPhaseID {
+			rs += p.Amount
+		}
+	}
+
+	return rs
+}
+
+// InvoicedAmount is the converted amount of the sent invoices of the phase
+func (p *ProjectBudgetPhase) InvoicedAmount() float64 {
+	var rs float64
+	for _, iv := range p.Invoices {
+		if iv.Status != InvoiceStatusDraft && iv.Status != InvoiceStatusError {
+			rs += iv.ConversionAmount
+		}
+	}
+
+	return rs
+}
+
+// IsPaid is true when the phase is invoiced and all its invoices are paid
+func (p *ProjectBudgetPhase) IsPaid() bool {
+	paid := false
+	for _, iv := range p.Invoices {
+		switch iv.Status {
+		case InvoiceStatusPaid:
+			paid = true
+		case InvoiceStatusDraft, InvoiceStatusError:
+		default:
+			return false
+		}
+	}
+
+	return paid
+}
+
+// MilestoneStatus is the progress of the phase as a milestone shown to the client
+func (p *ProjectBudgetPhase) MilestoneStatus(now time.Time) ProjectMilestoneStatus {
+	if p.IsPaid() {
+		return ProjectMilestoneStatusPaid
+	}
+
+	for _, iv := range p.Invoices {
+		if iv.Status != InvoiceStatusDraft && iv.Status != InvoiceStatusError && iv.Status != InvoiceStatusScheduled {
+			return ProjectMilestoneStatusInvoiced
+		}
+	}
+
+	switch {
+	case now.Before(p.StartDate):
+		return ProjectMilestoneStatusUpcoming
+	case p.EndDate != nil && !now.Before(*p.EndDate):
+		return ProjectMilestoneStatusCompleted
+	default:
+		return ProjectMilestoneStatusInProgress
+	}
+}
+
+// ProjectBudgetHealth is the burn of a project budget
+type ProjectBudgetHealth struct {
+	Budget *ProjectBudget
+	// BurnedAmount is the cost of the project since the budget start: allocated member payroll, commissions and expenses
+	BurnedAmount  float64
+	ApprovedHours float64
+}
+
+// BurnPercentage is the burned amount over the total amount, rounded to 2 decimals
+func (h *ProjectBudgetHealth) BurnPercentage() float64 {
+	if h.Budget.TotalAmount <= 0 {
+		return 0
+	}
+
+	return math.Round(h.BurnedAmount/h.Budget.TotalAmount*10000) / 100
+}
+
+// ElapsedPercentage is the part of the budget schedule elapsed at a time, 0 when the budget has no end date
+func (h *ProjectBudgetHealth) ElapsedPercentage(now time.Time) float64 {
+	if h.Budget.EndDate == nil || !h.Budget.EndDate.After(h.Budget.StartDate) {
+		return 0
+	}
+
+	elapsed := now.Sub(h.Budget.StartDate).Hours() / h.Budget.EndDate.Sub(h.Budget.StartDate).Hours()
+	return math.Round(math.Min(math.Max(elapsed, 0), 1)*10000) / 100
+}
+
+// InvoicedAmount is the amount invoiced against the phases of the budget
+func (h *ProjectBudgetHealth) InvoicedAmount() float64 {
+	var rs float64
+	for _, p := range h.Budget.Phases {
+		rs += p.InvoicedAmount()
+	}
+
+	return rs
+}
+
+// Status is over-budget once the budget is burned, at-risk when the budget burns faster than its schedule
+// or is almost burned, on-track otherwise
+func (h *ProjectBudgetHealth) Status(now time.Time) ProjectBudgetStatus {
+	burn := h.BurnPercentage()
+	switch {
+	case burn > 100:
+		return ProjectBudgetStatusOverBudget
+	case burn >= BudgetAtRiskBurnPercentage:
+		return ProjectBudgetStatusAtRisk
+	case h.Budget.EndDate != nil && burn > h.ElapsedPercentage(now):
+		return ProjectBudgetStatusAtRisk
+	}
+
+	return ProjectBudgetStatusOnTrack
+}
+
+// ProjectBudgetStatus is the health of a project budget
+type ProjectBudgetStatus string
+
+const (
+	ProjectBudgetStatusOnTrack    ProjectBudgetStatus = "on-track"
+	ProjectBudgetStatusAtRisk     ProjectBudgetStatus = "at-risk"
+	ProjectBudgetStatusOverBudget ProjectBudgetStatus = "over-budget"
+)
+
+// String returns the string type from the ProjectBudgetStatus type
+func (e ProjectBudgetStatus) String() string {
+	return string(e)
+}
+
+// ProjectMilestoneStatus is the progress of a budget phase as a milestone
+type ProjectMilestoneStatus string
+
+const (
+	ProjectMilestoneStatusUpcoming   ProjectMilestoneStatus = "upcoming"
+	ProjectMilestoneStatusInProgress ProjectMilestoneStatus = "in-progress"
+	ProjectMilestoneStatusCompleted  ProjectMilestoneStatus = "completed"
+	ProjectMilestoneStatusInvoiced   ProjectMilestoneStatus = "invoiced"
+	ProjectMilestoneStatusPaid       ProjectMilestoneStatus = "paid"
+)
+
+// String returns the string type from the ProjectMilestoneStatus type
+func (e ProjectMilestoneStatus) String() string {
+	return string(e)
+}
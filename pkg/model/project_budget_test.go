@@ -0,0 +1,179 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestProjectBudget_NextAlertThreshold(t *testing.T) {
+	testcases := []struct {
+		name     string
+		budget   ProjectBudget
+		burn     float64
+		wanted   float64
+		expected bool
+	}{
+		{
+			name:     "below the first default threshold",
+			budget:   ProjectBudget{},
+			burn:     40,
+			expected: false,
+		},
+		{
+			name:     "highest crossed default threshold",
+			budget:   ProjectBudget{},
+			burn:     80,
+			wanted:   75,
+			expected: true,
+		},
+		{
+			name:     "already alerted threshold",
+			budget:   ProjectBudget{AlertedThreshold: 75},
+			burn:     80,
+			expected: false,
+		},
+		{
+			name:     "custom thresholds",
+			budget:   ProjectBudget{AlertThresholds: JSON(`[80, 60]`), AlertedThreshold: 50},
+			burn:     70,
+			wanted:   60,
+			expected: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := tc.budget.NextAlertThreshold(tc.burn)
+			if ok != tc.expected || got != tc.wanted {
+				t.Errorf("NextAlertThreshold() = %v, %v, want %v, %v", got, ok, tc.wanted, tc.expected)
+			}
+		})
+	}
+}
+
+func TestProjectBudgetHealth_Status(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2023, 7, 2, 0, 0, 0, 0, time.UTC)
+
+	testcases := []struct {
+		name   string
+		health ProjectBudgetHealth
+		burn   float64
+		wanted ProjectBudgetStatus
+	}{
+		{
+			name:   "burning slower than the schedule",
+			health: ProjectBudgetHealth{Budget: &ProjectBudget{TotalAmount: 1000, StartDate: start, EndDate: &end}, BurnedAmount: 400},
+			burn:   40,
+			wanted: ProjectBudgetStatusOnTrack,
+		},
+		{
+			name:   "burning faster than the schedule",
+			health: ProjectBudgetHealth{Budget: &ProjectBudget{TotalAmount: 1000, StartDate: start, EndDate: &end}, BurnedAmount: 600},
+			burn:   60,
+			wanted: ProjectBudgetStatusAtRisk,
+		},
+		{
+			name:   "almost burned without end date",
+			health: ProjectBudgetHealth{Budget: &ProjectBudget{TotalAmount: 1000, StartDate: start}, BurnedAmount: 950},
+			burn:   95,
+			wanted: ProjectBudgetStatusAtRisk,
+		},
+		{
+			name:   "burned",
+			health: ProjectBudgetHealth{Budget: &ProjectBudget{TotalAmount: 1000, StartDate: start}, BurnedAmount: 1200},
+			burn:   120,
+			wanted: ProjectBudgetStatusOverBudget,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.health.BurnPercentage(); got != tc.burn {
+				t.Errorf("BurnPercentage() = %v, want %v", got, tc.burn)
+			}
+			if got := tc.health.Status(now); got != tc.wanted {
+				t.Errorf("Status() = %v, want %v", got, tc.wanted)
+			}
+		})
+	}
+}
+
+func TestProjectBudgetPhase_IsPaid(t *testing.T) {
+	testcases := []struct {
+		name     string
+		invoices []*Invoice
+		wanted   bool
+	}{
+		{
+			name:   "not invoiced",
+			wanted: false,
+		},
+		{
+			name:     "paid and draft invoices",
+			invoices: []*Invoice{{Status: InvoiceStatusPaid}, {Status: InvoiceStatusDraft}},
+			wanted:   true,
+		},
+		{
+			name:     "sent invoice",
+			invoices: []*Invoice{{Status: InvoiceStatusPaid}, {Status: InvoiceStatusSent}},
+			wanted:   false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := ProjectBudgetPhase{Invoices: tc.invoices}
+			if got := p.IsPaid(); got != tc.wanted {
+				t.Errorf("IsPaid() = %v, want %v", got, tc.wanted)
+			}
+		})
+	}
+}
+
+func TestProjectBudgetPhase_MilestoneStatus(t *testing.T) {
+	now := time.Date(2023, 7, 28, 0, 0, 0, 0, time.UTC)
+	start := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2023, 7, 15, 0, 0, 0, 0, time.UTC)
+
+	testcases := []struct {
+		name   string
+		phase  ProjectBudgetPhase
+		wanted ProjectMilestoneStatus
+	}{
+		{
+			name:   "not started",
+			phase:  ProjectBudgetPhase{StartDate: now.AddDate(0, 0, 1)},
+			wanted: ProjectMilestoneStatusUpcoming,
+		},
+		{
+			name:   "started without end date",
+			phase:  ProjectBudgetPhase{StartDate: start},
+			wanted: ProjectMilestoneStatusInProgress,
+		},
+		{
+			name:   "ended with a draft invoice",
+			phase:  ProjectBudgetPhase{StartDate: start, EndDate: &end, Invoices: []*Invoice{{Status: InvoiceStatusDraft}}},
+			wanted: ProjectMilestoneStatusCompleted,
+		},
+		{
+			name:   "sent invoice",
+			phase:  ProjectBudgetPhase{StartDate: start, EndDate: &end, Invoices: []*Invoice{{Status: InvoiceStatusSent}}},
+			wanted: ProjectMilestoneStatusInvoiced,
+		},
+		{
+			name:   "paid invoice",
+			phase:  ProjectBudgetPhase{StartDate: start, EndDate: &end, Invoices: []*Invoice{{Status: InvoiceStatusPaid}}},
+			wanted: ProjectMilestoneStatusPaid,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.phase.MilestoneStatus(now); got != tc.wanted {
+				t.Errorf("MilestoneStatus() = %v, want %v", got, tc.wanted)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// ProjectChangelog is a changelog email sent to the client of a project
+type ProjectChangelog struct {
+	BaseModel
+
+	ProjectID    UUID
+	NotionPageID string
+	Title        string
+	Content      string
+	SentAt       time.Time
+}
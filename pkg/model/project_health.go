@@ -0,0 +1,260 @@
+package model
+
+import (
+	"encoding/json"
+	"math"
+	"time"
+)
+
+const (
+	// ProjectHealthGreenScore is the score from which a project is healthy
+	ProjectHealthGreenScore = 75
+	// ProjectHealthYellowScore is the score from which a project needs attention, below it the project is at risk
+	ProjectHealthYellowScore = 50
+
+	// ActionItemHighPenalty, ActionItemMediumPenalty and ActionItemLowPenalty are the points lost for each open action item
+	ActionItemHighPenalty   = 15
+	ActionItemMediumPenalty = 5
+	ActionItemLowPenalty    = 1
+
+	// OverdueInvoiceMaxDays is the number of days past due after which the overdue invoices signal scores 0
+	OverdueInvoiceMaxDays = 60
+)
+
+type ProjectHealthSignal string
+
+// values for project health signal
+const (
+	ProjectHealthSignalAccountRating     ProjectHealthSignal = "account-rating"
+	ProjectHealthSignalDeliveryRating    ProjectHealthSignal = "delivery-rating"
+	ProjectHealthSignalLeadRating        ProjectHealthSignal = "lead-rating"
+	ProjectHealthSignalAudit             ProjectHealthSignal = "audit"
+	ProjectHealthSignalEngineeringHealth ProjectHealthSignal = "engineering-health"
+	ProjectHealthSignalActionItems       ProjectHealthSignal = "action-items"
+	ProjectHealthSignalWorkload          ProjectHealthSignal = "workload"
+	ProjectHealthSignalOverdueInvoices   ProjectHealthSignal = "overdue-invoices"
+)
+
+// ProjectHealthSignals are the signals of the scorecard in display order
+var ProjectHealthSignals = []ProjectHealthSignal{
+	ProjectHealthSignalAccountRating,
+	ProjectHealthSignalDeliveryRating,
+	ProjectHealthSignalLeadRating,
+	ProjectHealthSignalAudit,
+	ProjectHealthSignalEngineeringHealth,
+	ProjectHealthSignalActionItems,
+	ProjectHealthSignalWorkload,
+	ProjectHealthSignalOverdueInvoices,
+}
+
+// DefaultProjectHealthWeights are the weights used for the signals without a configured weight
+var DefaultProjectHealthWeights = map[ProjectHealthSignal]float64{
+	ProjectHealthSignalAccountRating:     15,
+	ProjectHealthSignalDeliveryRating:    15,
+	ProjectHealthSignalLeadRating:        10,
+	ProjectHealthSignalAudit:             15,
+	ProjectHealthSignalEngineeringHealth: 15,
+	ProjectHealthSignalActionItems:       10,
+	ProjectHealthSignalWorkload:          10,
+	ProjectHealthSignalOverdueInvoices:   10,
+}
+
+// IsValid validation for ProjectHealthSignal
+func (e ProjectHealthSignal) IsValid() bool {
+	_, ok := DefaultProjectHealthWeights[e]
+	return ok
+}
+
+// String returns the string representation
+func (e ProjectHealthSignal) String() string {
+	return string(e)
+}
+
+type ProjectHealthStatus string
+
+// values for project health status
+const (
+	ProjectHealthStatusGreen  ProjectHealthStatus = "green"
+	ProjectHealthStatusYellow ProjectHealthStatus = "yellow"
+	ProjectHealthStatusRed    ProjectHealthStatus = "red"
+)
+
+// IsValid validation for ProjectHealthStatus
+func (e ProjectHealthStatus) IsValid() bool {
+	switch e {
+	case
+		ProjectHealthStatusGreen,
+		ProjectHealthStatusYellow,
+		ProjectHealthStatusRed:
+		return true
+	}
+	return false
+}
+
+// String returns the string representation
+func (e ProjectHealthStatus) String() string {
+	return string(e)
+}
+
+// ToProjectHealthStatus returns the status of a score
+func ToProjectHealthStatus(score float64) ProjectHealthStatus {
+	switch {
+	case score >= ProjectHealthGreenScore:
+		return ProjectHealthStatusGreen
+	case score >= ProjectHealthYellowScore:
+		return ProjectHealthStatusYellow
+	default:
+		return ProjectHealthStatusRed
+	}
+}
+
+// ProjectHealthWeight is the configured weight of a signal in the project health score
+type ProjectHealthWeight struct {
+	BaseModel
+
+	Signal ProjectHealthSignal
+	Weight float64
+}
+
+// ToProjectHealthWeightMap returns the weight of every signal, the default one when it is not configured
+func ToProjectHealthWeightMap(weights []*ProjectHealthWeight) map[ProjectHealthSignal]float64 {
+	rs := make(map[ProjectHealthSignal]float64, len(DefaultProjectHealthWeights))
+	for s, w := range DefaultProjectHealthWeights {
+		rs[s] = w
+	}
+	for _, w := range weights {
+		if w.Signal.IsValid() {
+			rs[w.Signal] = w.Weight
+		}
+	}
+
+	return rs
+}
+
+// ProjectHealthScore is the weekly health score of a project, from 0 to 100
+type ProjectHealthScore struct {
+	BaseModel
+
+	ProjectID UUID
+	// Week is the monday of the scored week
+	Week   time.Time
+	Score  float64
+	Status ProjectHealthStatus
+	// Signals is the breakdown of the score, a list of ProjectHealthSignalScore
+	Signals JSON
+
+	Project *Project
+}
+
+// ProjectHealthSignalScore explains how a signal contributes to the health score of a project
+type ProjectHealthSignalScore struct {
+	Signal ProjectHealthSignal `json:"signal"`
+	// Available is false when the project has no data for the signal, its weight is then spread over the other signals
+	Available bool `json:"available"`
+	// Value is the raw value of the signal, e.g. the rating or the number of overdue invoices
+	Value float64 `json:"value"`
+	// Score is the value normalized from 0 to 100
+	Score  float64 `json:"score"`
+	Weight float64 `json:"weight"`
+	// Contribution is the number of points the signal adds to the health score
+	Contribution float64 `json:"contribution"`
+	Detail       string  `json:"detail"`
+}
+
+// GetSignals returns the breakdown of the score
+func (s *ProjectHealthScore) GetSignals() []ProjectHealthSignalScore {
+	var rs []ProjectHealthSignalScore
+	if len(s.Signals) == 0 || json.Unmarshal(s.Signals, &rs) != nil {
+		return nil
+	}
+
+	return rs
+}
+
+// TurnedRed reports whether the project went red since the previous score
+func (s *ProjectHealthScore) TurnedRed(previous *ProjectHealthScore) bool {
+	return s.Status == ProjectHealthStatusRed && (previous == nil || previous.Status != ProjectHealthStatusRed)
+}
+
+// NewProjectHealthScore weighs the available signals into a score, the weights of the unavailable signals are spread
+// over the available ones. It returns false when no signal with a weight is available
+func NewProjectHealthScore(projectID UUID, week time.Time, signals []ProjectHealthSignalScore, weights map[ProjectHealthSignal]float64) (*ProjectHealthScore, bool) {
+	totalWeight := 0.0
+	for i := range signals {
+		signals[i].Weight = weights[signals[i].Signal]
+		if signals[i].Available {
+			totalWeight += signals[i].Weight
+		}
+	}
+	if totalWeight <= 0 {
+		return nil, false
+	}
+
+	score := 0.0
+	for i := range signals {
+		signals[i].Contribution = 0
+		if signals[i].Available {
+			signals[i].Contribution = roundHealthScore(signals[i].Score * signals[i].Weight / totalWeight)
+			score += signals[i].Score * signals[i].Weight / totalWeight
+		}
+	}
+	score = roundHealthScore(score)
+
+	breakdown, err := json.Marshal(signals)
+	if err != nil {
+		return nil, false
+	}
+
+	return &ProjectHealthScore{
+		ProjectID: projectID,
+		Week:      week,
+		Score:     score,
+		Status:    ToProjectHealthStatus(score),
+		Signals:   breakdown,
+	}, true
+}
+
+// LikertHealthScore normalizes a rating from 1 to 5 to a score from 0 to 100, 0 means not rated
+func LikertHealthScore(rating float64) (float64, bool) {
+	if rating < 1 {
+		return 0, false
+	}
+
+	return roundHealthScore(math.Min(rating-1, 4) / 4 * 100), true
+}
+
+// AuditHealthScore normalizes an audit score from 0 to 5 to a score from 0 to 100, 0 means not audited
+func AuditHealthScore(score float64) (float64, bool) {
+	if score <= 0 {
+		return 0, false
+	}
+
+	return roundHealthScore(math.Min(score, 5) / 5 * 100), true
+}
+
+// ActionItemsHealthScore takes points off 100 for every open action item by priority
+func ActionItemsHealthScore(high, medium, low int64) float64 {
+	penalty := float64(high*ActionItemHighPenalty + medium*ActionItemMediumPenalty + low*ActionItemLowPenalty)
+	return math.Max(0, 100-penalty)
+}
+
+// OverdueInvoicesHealthScore decreases from 100 to 0 as the oldest overdue invoice gets OverdueInvoiceMaxDays past due
+func OverdueInvoicesHealthScore(oldestDaysPastDue int) float64 {
+	if oldestDaysPastDue <= 0 {
+		return 100
+	}
+
+	return roundHealthScore(math.Max(0, 100-float64(oldestDaysPastDue)*100/OverdueInvoiceMaxDays))
+}
+
+func roundHealthScore(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+// ProjectHealthPortfolio is the health of all scored projects for a week
+type ProjectHealthPortfolio struct {
+	Week   time.Time
+	Scores []*ProjectHealthScore
+	// PreviousScores are the scores of the week before by project ID
+	PreviousScores map[UUID]*ProjectHealthScore
+}
@@ -0,0 +1,117 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewProjectHealthScore(t *testing.T) {
+	week := time.Date(2023, 7, 24, 0, 0, 0, 0, time.UTC)
+	weights := map[ProjectHealthSignal]float64{
+		ProjectHealthSignalAccountRating:   30,
+		ProjectHealthSignalAudit:           50,
+		ProjectHealthSignalOverdueInvoices: 20,
+	}
+
+	testcases := []struct {
+		name       string
+		signals    []ProjectHealthSignalScore
+		wantOK     bool
+		wantScore  float64
+		wantStatus ProjectHealthStatus
+	}{
+		{
+			name: "all signals available",
+			signals: []ProjectHealthSignalScore{
+				{Signal: ProjectHealthSignalAccountRating, Available: true, Score: 100},
+				{Signal: ProjectHealthSignalAudit, Available: true, Score: 60},
+				{Signal: ProjectHealthSignalOverdueInvoices, Available: true, Score: 50},
+			},
+			wantOK:     true,
+			wantScore:  70,
+			wantStatus: ProjectHealthStatusYellow,
+		},
+		{
+			name: "weight of an unavailable signal is spread",
+			signals: []ProjectHealthSignalScore{
+				{Signal: ProjectHealthSignalAccountRating, Available: true, Score: 100},
+				{Signal: ProjectHealthSignalAudit},
+				{Signal: ProjectHealthSignalOverdueInvoices, Available: true, Score: 50},
+			},
+			wantOK:     true,
+			wantScore:  80,
+			wantStatus: ProjectHealthStatusGreen,
+		},
+		{
+			name: "no weighted signal available",
+			signals: []ProjectHealthSignalScore{
+				{Signal: ProjectHealthSignalAudit},
+				{Signal: ProjectHealthSignalWorkload, Available: true, Score: 20},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := NewProjectHealthScore(UUID{}, week, tc.signals, weights)
+			if ok != tc.wantOK {
+				t.Fatalf("NewProjectHealthScore() ok = %v, want %v", ok, tc.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if got.Score != tc.wantScore || got.Status != tc.wantStatus {
+				t.Errorf("NewProjectHealthScore() = (%v, %v), want (%v, %v)", got.Score, got.Status, tc.wantScore, tc.wantStatus)
+			}
+
+			total := 0.0
+			for _, s := range got.GetSignals() {
+				total += s.Contribution
+			}
+			if total != got.Score {
+				t.Errorf("contributions add up to %v, want %v", total, got.Score)
+			}
+		})
+	}
+}
+
+func TestProjectHealthScore_TurnedRed(t *testing.T) {
+	testcases := []struct {
+		name     string
+		current  ProjectHealthStatus
+		previous *ProjectHealthScore
+		wanted   bool
+	}{
+		{name: "yellow to red", current: ProjectHealthStatusRed, previous: &ProjectHealthScore{Status: ProjectHealthStatusYellow}, wanted: true},
+		{name: "first score is red", current: ProjectHealthStatusRed, wanted: true},
+		{name: "still red", current: ProjectHealthStatusRed, previous: &ProjectHealthScore{Status: ProjectHealthStatusRed}},
+		{name: "red to yellow", current: ProjectHealthStatusYellow, previous: &ProjectHealthScore{Status: ProjectHealthStatusRed}},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &ProjectHealthScore{Status: tc.current}
+			if got := s.TurnedRed(tc.previous); got != tc.wanted {
+				t.Errorf("TurnedRed() = %v, want %v", got, tc.wanted)
+			}
+		})
+	}
+}
+
+func TestHealthSignalScores(t *testing.T) {
+	if got, ok := LikertHealthScore(4); !ok || got != 75 {
+		t.Errorf("LikertHealthScore(4) = (%v, %v), want (75, true)", got, ok)
+	}
+	if _, ok := LikertHealthScore(0); ok {
+		t.Errorf("LikertHealthScore(0) should not be available")
+	}
+	if got := ActionItemsHealthScore(2, 3, 4); got != 51 {
+		t.Errorf("ActionItemsHealthScore(2, 3, 4) = %v, want 51", got)
+	}
+	if got := OverdueInvoicesHealthScore(30); got != 50 {
+		t.Errorf("OverdueInvoicesHealthScore(30) = %v, want 50", got)
+	}
+	if got := OverdueInvoicesHealthScore(90); got != 0 {
+		t.Errorf("OverdueInvoicesHealthScore(90) = %v, want 0", got)
+	}
+}
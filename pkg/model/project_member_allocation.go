@@ -0,0 +1,133 @@
+package model
+
+import (
+	"time"
+)
+
+// MaxAllocationPercentage is the share of an employee's time above which they are over-allocated
+const MaxAllocationPercentage = 100
+
+// ProjectMemberAllocation model for project_member_allocations table, the share of an employee's time
+// a project membership takes in a date range. Allocations of a membership do not overlap
+type ProjectMemberAllocation struct {
+	BaseModel
+
+	ProjectMemberID UUID
+	Percentage      float64
+	StartDate       time.Time
+	EndDate         *time.Time
+	Note            string
+}
+
+// DefaultAllocationPercentage is the allocation of a membership without allocations,
+// official members work full-time on the project and shadow members on top of their other work
+func DefaultAllocationPercentage(deploymentType DeploymentType) float64 {
+	if deploymentType == MemberDeploymentTypeOfficial {
+		return MaxAllocationPercentage
+	}
+	return 0
+}
+
+// Overlaps is true when the allocation covers a day between from and to
+func (a *ProjectMemberAllocation) Overlaps(from, to time.Time) bool {
+	if a.StartDate.After(to) {
+		return false
+	}
+	return a.EndDate == nil || !a.EndDate.Before(from)
+}
+
+// OverlapsAllocation is true when both allocations cover a same day
+func (a *ProjectMemberAllocation) OverlapsAllocation(b *ProjectMemberAllocation) bool {
+	if a.EndDate != nil && a.EndDate.Before(b.StartDate) {
+		return false
+	}
+	return b.EndDate == nil || !b.EndDate.Before(a.StartDate)
+}
+
+// IsActiveInPeriod is true when the membership covers a day between from and to
+func (m *ProjectMember) IsActiveInPeriod(from, to time.Time) bool {
+	if m.StartDate != nil && m.StartDate.After(to) {
+		return false
+	}
+	return m.EndDate == nil || !m.EndDate.Before(from)
+}
+
+// AllocationInPeriod returns the highest allocation of the membership between from and to,
+// allocations only count while the membership is active
+func (m *ProjectMember) AllocationInPeriod(from, to time.Time) float64 {
+	if !m.IsActiveInPeriod(from, to) {
+		return 0
+	}
+
+	if m.StartDate != nil && m.StartDate.After(from) {
+		from = *m.StartDate
+	}
+	if m.EndDate != nil && m.EndDate.Before(to) {
+		to = *m.EndDate
+	}
+
+	if len(m.Allocations) == 0 {
+		return DefaultAllocationPercentage(m.DeploymentType)
+	}
+
+	var rs float64
+	for _, a := range m.Allocations {
+		if a.Overlaps(from, to) && a.Percentage > rs {
+			rs = a.Percentage
+		}
+	}
+
+	return rs
+}
+
+// AverageAllocationInPeriod returns the allocation of the membership averaged over the days between from and to
+func (m *ProjectMember) AverageAllocationInPeriod(from, to time.Time) float64 {
+	var total float64
+	days := 0
+	for d := from; !d.After(to); d = d.AddDate(0, 0, 1) {
+		total += m.AllocationInPeriod(d, d)
+		days++
+	}
+	if days == 0 {
+		return 0
+	}
+
+	return total / float64(days)
+}
+
+// EmployeeCapacity is the weekly allocation of an employee across their project memberships
+type EmployeeCapacity struct {
+	Employee *Employee
+	Members  []*ProjectMember
+	Weeks    []CapacityWeek
+}
+
+// CapacityWeek is the allocation of an employee in the week starting on WeekStart
+type CapacityWeek struct {
+	WeekStart  time.Time
+	Allocation float64
+}
+
+// IsOverAllocated is true when the employee is allocated above their capacity in the week
+func (w CapacityWeek) IsOverAllocated() bool {
+	return w.Allocation > MaxAllocationPercentage
+}
+
+// IsOverAllocated is true when the employee is over-allocated in any week
+func (c *EmployeeCapacity) IsOverAllocated() bool {
+	for _, w := range c.Weeks {
+		if w.IsOverAllocated() {
+			return true
+		}
+	}
+	return false
+}
+
+// FreeingUpMember is a membership ending in the planned weeks, Allocation is its allocation in its last week
+// and Remaining is the allocation of the employee in the week after it ends
+type FreeingUpMember struct {
+	Member     *ProjectMember
+	Employee   *Employee
+	Allocation float64
+	Remaining  float64
+}
@@ -0,0 +1,134 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestProjectMember_AllocationInPeriod(t *testing.T) {
+	date := func(month time.Month, day int) *time.Time {
+		d := time.Date(2023, month, day, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+	weekStart, weekEnd := *date(7, 17), *date(7, 23)
+
+	testcases := []struct {
+		name   string
+		member ProjectMember
+		wanted float64
+	}{
+		{
+			name:   "official without allocations",
+			member: ProjectMember{DeploymentType: MemberDeploymentTypeOfficial, StartDate: date(1, 1)},
+			wanted: 100,
+		},
+		{
+			name:   "shadow without allocations",
+			member: ProjectMember{DeploymentType: MemberDeploymentTypeShadow, StartDate: date(1, 1)},
+			wanted: 0,
+		},
+		{
+			name:   "ended before the week",
+			member: ProjectMember{DeploymentType: MemberDeploymentTypeOfficial, StartDate: date(1, 1), EndDate: date(7, 14)},
+			wanted: 0,
+		},
+		{
+			name: "part-time allocation",
+			member: ProjectMember{
+				DeploymentType: MemberDeploymentTypeOfficial,
+				StartDate:      date(1, 1),
+				Allocations:    []*ProjectMemberAllocation{{Percentage: 50, StartDate: *date(1, 1)}},
+			},
+			wanted: 50,
+		},
+		{
+			name: "allocation changing in the week",
+			member: ProjectMember{
+				DeploymentType: MemberDeploymentTypeOfficial,
+				StartDate:      date(1, 1),
+				Allocations: []*ProjectMemberAllocation{
+					{Percentage: 50, StartDate: *date(1, 1), EndDate: date(7, 18)},
+					{Percentage: 80, StartDate: *date(7, 19)},
+				},
+			},
+			wanted: 80,
+		},
+		{
+			name: "allocation after the membership ends",
+			member: ProjectMember{
+				DeploymentType: MemberDeploymentTypeOfficial,
+				StartDate:      date(1, 1),
+				EndDate:        date(7, 18),
+				Allocations: []*ProjectMemberAllocation{
+					{Percentage: 50, StartDate: *date(1, 1), EndDate: date(7, 18)},
+					{Percentage: 80, StartDate: *date(7, 19)},
+				},
+			},
+			wanted: 50,
+		},
+		{
+			name: "allocations outside the week",
+			member: ProjectMember{
+				DeploymentType: MemberDeploymentTypeOfficial,
+				StartDate:      date(1, 1),
+				Allocations:    []*ProjectMemberAllocation{{Percentage: 50, StartDate: *date(8, 1)}},
+			},
+			wanted: 0,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.member.AllocationInPeriod(weekStart, weekEnd); got != tc.wanted {
+				t.Errorf("AllocationInPeriod() = %v, want %v", got, tc.wanted)
+			}
+		})
+	}
+}
+
+func TestProjectMemberAllocation_OverlapsAllocation(t *testing.T) {
+	date := func(month time.Month, day int) *time.Time {
+		d := time.Date(2023, month, day, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+
+	testcases := []struct {
+		name   string
+		a      ProjectMemberAllocation
+		b      ProjectMemberAllocation
+		wanted bool
+	}{
+		{
+			name:   "before",
+			a:      ProjectMemberAllocation{StartDate: *date(1, 1), EndDate: date(1, 31)},
+			b:      ProjectMemberAllocation{StartDate: *date(2, 1)},
+			wanted: false,
+		},
+		{
+			name:   "same end and start day",
+			a:      ProjectMemberAllocation{StartDate: *date(1, 1), EndDate: date(2, 1)},
+			b:      ProjectMemberAllocation{StartDate: *date(2, 1), EndDate: date(3, 1)},
+			wanted: true,
+		},
+		{
+			name:   "open-ended",
+			a:      ProjectMemberAllocation{StartDate: *date(1, 1)},
+			b:      ProjectMemberAllocation{StartDate: *date(6, 1), EndDate: date(6, 30)},
+			wanted: true,
+		},
+		{
+			name:   "after",
+			a:      ProjectMemberAllocation{StartDate: *date(7, 1)},
+			b:      ProjectMemberAllocation{StartDate: *date(6, 1), EndDate: date(6, 30)},
+			wanted: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.a.OverlapsAllocation(&tc.b); got != tc.wanted {
+				t.Errorf("OverlapsAllocation() = %v, want %v", got, tc.wanted)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package model
+
+import (
+	"time"
+)
+
+// projectStatusTransitions are the statuses a project can move to from each status, closed projects stay closed
+var projectStatusTransitions = map[ProjectStatus][]ProjectStatus{
+	ProjectStatusOnBoarding: {ProjectStatusActive, ProjectStatusPaused, ProjectStatusClosed},
+	ProjectStatusActive:     {ProjectStatusPaused, ProjectStatusClosed},
+	ProjectStatusPaused:     {ProjectStatusActive, ProjectStatusClosed},
+	ProjectStatusClosed:     {},
+}
+
+// ProjectStatusTransition model for project_status_transitions table, the history of the status changes of a project
+type ProjectStatusTransition struct {
+	BaseModel
+
+	ProjectID  UUID
+	FromStatus ProjectStatus `gorm:"default:null"`
+	ToStatus   ProjectStatus
+	ActorID    *UUID
+	Reason     string
+
+	Actor *Employee `gorm:"foreignKey:ActorID"`
+}
+
+// CanTransitionTo is true when a project can move from the status to another one
+func (e ProjectStatus) CanTransitionTo(to ProjectStatus) bool {
+	for _, s := range projectStatusTransitions[e] {
+		if s == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NextStatuses returns the statuses a project can move to from the status
+func (e ProjectStatus) NextStatuses() []ProjectStatus {
+	return projectStatusTransitions[e]
+}
+
+// RequiresReason is true when moving to the status must be explained
+func (e ProjectStatus) RequiresReason() bool {
+	return e == ProjectStatusPaused || e == ProjectStatusClosed
+}
+
+// RequiresFinalInvoice is true when a project must be invoiced up to its end date before it is closed,
+// internal projects and projects closed before they started are not invoiced
+func (p *Project) RequiresFinalInvoice() bool {
+	return p.Type != ProjectTypeDwarves && p.Status != ProjectStatusOnBoarding
+}
+
+// IsFinalInvoice is true when an invoice covers the month of the end date of a project or a later month
+func (i *Invoice) IsFinalInvoice(endDate time.Time) bool {
+	if i.Status == InvoiceStatusDraft || i.Status == InvoiceStatusError {
+		return false
+	}
+
+	return i.Year*12+i.Month >= endDate.Year()*12+int(endDate.Month())
+}
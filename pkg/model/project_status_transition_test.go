@@ -0,0 +1,69 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestProjectStatus_CanTransitionTo(t *testing.T) {
+	testcases := []struct {
+		from   ProjectStatus
+		to     ProjectStatus
+		wanted bool
+	}{
+		{from: ProjectStatusOnBoarding, to: ProjectStatusActive, wanted: true},
+		{from: ProjectStatusActive, to: ProjectStatusPaused, wanted: true},
+		{from: ProjectStatusPaused, to: ProjectStatusActive, wanted: true},
+		{from: ProjectStatusPaused, to: ProjectStatusClosed, wanted: true},
+		{from: ProjectStatusActive, to: ProjectStatusOnBoarding, wanted: false},
+		{from: ProjectStatusClosed, to: ProjectStatusActive, wanted: false},
+		{from: ProjectStatusActive, to: ProjectStatusActive, wanted: false},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.from.String()+"_to_"+tc.to.String(), func(t *testing.T) {
+			if got := tc.from.CanTransitionTo(tc.to); got != tc.wanted {
+				t.Errorf("CanTransitionTo() = %v, want %v", got, tc.wanted)
+			}
+		})
+	}
+}
+
+func TestInvoice_IsFinalInvoice(t *testing.T) {
+	endDate := time.Date(2023, 7, 20, 0, 0, 0, 0, time.UTC)
+
+	testcases := []struct {
+		name    string
+		invoice Invoice
+		wanted  bool
+	}{
+		{
+			name:    "invoice of the end month",
+			invoice: Invoice{Status: InvoiceStatusSent, Month: 7, Year: 2023},
+			wanted:  true,
+		},
+		{
+			name:    "invoice of a later year",
+			invoice: Invoice{Status: InvoiceStatusPaid, Month: 1, Year: 2024},
+			wanted:  true,
+		},
+		{
+			name:    "invoice of the previous month",
+			invoice: Invoice{Status: InvoiceStatusPaid, Month: 6, Year: 2023},
+			wanted:  false,
+		},
+		{
+			name:    "draft invoice of the end month",
+			invoice: Invoice{Status: InvoiceStatusDraft, Month: 7, Year: 2023},
+			wanted:  false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.invoice.IsFinalInvoice(endDate); got != tc.wanted {
+				t.Errorf("IsFinalInvoice() = %v, want %v", got, tc.wanted)
+			}
+		})
+	}
+}
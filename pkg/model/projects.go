@@ -214,6 +214,7 @@ type ProjectMember struct {
 	Project                Project
 	Seniority              *Seniority
 	ProjectMemberPositions []ProjectMemberPosition
+	Allocations            []*ProjectMemberAllocation
 	Positions              []Position   `gorm:"-"`
 	Head                   *ProjectHead `gorm:"-"`
 }
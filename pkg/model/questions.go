@@ -5,8 +5,13 @@ type QuestionType string
 
 // valid values for QuestionType
 const (
-	QuestionTypeScale   QuestionType = "likert-scale"
-	QuestionTypeGeneral QuestionType = "general"
+	QuestionTypeScale        QuestionType = "likert-scale"
+	QuestionTypeGeneral      QuestionType = "general"
+	QuestionTypeSingleChoice QuestionType = "single-choice"
+	QuestionTypeMultiChoice  QuestionType = "multi-choice"
+	QuestionTypeNPS          QuestionType = "nps"
+	QuestionTypeRating       QuestionType = "rating"
+	QuestionTypeText         QuestionType = "text"
 )
 
 // IsValid validation for QuestionType
@@ -14,12 +19,22 @@ func (e QuestionType) IsValid() bool {
 	switch e {
 	case
 		QuestionTypeScale,
-		QuestionTypeGeneral:
+		QuestionTypeGeneral,
+		QuestionTypeSingleChoice,
+		QuestionTypeMultiChoice,
+		QuestionTypeNPS,
+		QuestionTypeRating,
+		QuestionTypeText:
 		return true
 	}
 	return false
 }
 
+// IsChoice returns true if answers of the question type are picked from options
+func (e QuestionType) IsChoice() bool {
+	return e == QuestionTypeSingleChoice || e == QuestionTypeMultiChoice
+}
+
 // String returns a string representation of QuestionType
 func (e QuestionType) String() string {
 	return string(e)
@@ -0,0 +1,177 @@
+package model
+
+import (
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// RateCard is the contracted rates of a client or a project by seniority and position, rates are in the currency
+// of the bank account of the billed project. The card is valid from its start date until its end date, when the
+// contract rates are due for renewal
+type RateCard struct {
+	BaseModel
+
+	ClientID  *UUID
+	ProjectID *UUID
+	Name      string
+	StartDate time.Time
+	EndDate   *time.Time
+	Note      string
+
+	Client  *Client
+	Project *Project
+	Rates   []*RateCardRate
+}
+
+// RateCardRate is the rate of a seniority in a rate card, a rate without position applies to all positions
+type RateCardRate struct {
+	BaseModel
+
+	RateCardID  UUID
+	SeniorityID UUID
+	PositionID  *UUID
+	Rate        decimal.Decimal
+	Discount    decimal.Decimal
+
+	Seniority *Seniority
+	Position  *Position
+}
+
+// ProjectMemberRate is a rate change of a project member, the rate applies from its effective date
+// until the next change of the member. Rate changes are kept as the pricing history of the member
+type ProjectMemberRate struct {
+	BaseModel
+
+	ProjectMemberID UUID
+	RateCardID      *UUID
+	Rate            decimal.Decimal
+	Discount        decimal.Decimal
+	EffectiveFrom   time.Time
+	CreatedBy       *UUID
+	Note            string
+
+	RateCard *RateCard
+	Creator  *Employee `gorm:"foreignKey:CreatedBy"`
+}
+
+// IsValidAt is true when the rate card covers a date
+func (c *RateCard) IsValidAt(date time.Time) bool {
+	if c.StartDate.After(date) {
+		return false
+	}
+	return c.EndDate == nil || !c.EndDate.Before(date)
+}
+
+// FindRate returns the rate of a seniority, a rate of one of the positions is preferred over the rate for all positions
+func (c *RateCard) FindRate(seniorityID UUID, positionIDs []UUID) *RateCardRate {
+	var rs *RateCardRate
+	for _, r := range c.Rates {
+		if r.SeniorityID != seniorityID {
+			continue
+		}
+
+		if r.PositionID == nil {
+			if rs == nil {
+				rs = r
+			}
+			continue
+		}
+
+		for _, id := range positionIDs {
+			if *r.PositionID == id {
+				return r
+			}
+		}
+	}
+
+	return rs
+}
+
+// DaysUntilRenewal is the number of days from a date until the end of the rate card, -1 when it has no end date
+func (c *RateCard) DaysUntilRenewal(now time.Time) int {
+	if c.EndDate == nil {
+		return -1
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	end := time.Date(c.EndDate.Year(), c.EndDate.Month(), c.EndDate.Day(), 0, 0, 0, 0, time.UTC)
+	return int(end.Sub(today).Hours() / 24)
+}
+
+// FindProjectRateCard returns the rate card valid at a date for a project, a card of the project is preferred
+// over a card of its client
+func FindProjectRateCard(cards []*RateCard, project *Project, date time.Time) *RateCard {
+	var rs *RateCard
+	for _, c := range cards {
+		if !c.IsValidAt(date) {
+			continue
+		}
+
+		if c.ProjectID != nil && *c.ProjectID == project.ID {
+			return c
+		}
+		if rs == nil && c.ProjectID == nil && c.ClientID != nil && *c.ClientID == project.ClientID {
+			rs = c
+		}
+	}
+
+	return rs
+}
+
+// RateAt returns the rate change of the member in effect at a date, nil when the member had no rate yet
+func RateAt(rates []*ProjectMemberRate, date time.Time) *ProjectMemberRate {
+	var rs *ProjectMemberRate
+	for _, r := range rates {
+		if r.EffectiveFrom.After(date) {
+			continue
+		}
+		if rs == nil || !r.EffectiveFrom.Before(rs.EffectiveFrom) {
+			rs = r
+		}
+	}
+
+	return rs
+}
+
+// RatePeriod is a date range billed at one rate
+type RatePeriod struct {
+	From     time.Time
+	To       time.Time
+	Rate     decimal.Decimal
+	Discount decimal.Decimal
+}
+
+// RatePeriods splits the days between from and to by the rate changes of a member. The rate before the first
+// change is the current rate of the member
+func (m *ProjectMember) RatePeriods(rates []*ProjectMemberRate, from, to time.Time) []RatePeriod {
+	changes := make([]*ProjectMemberRate, 0, len(rates))
+	for _, r := range rates {
+		if r.EffectiveFrom.After(from) && !r.EffectiveFrom.After(to) {
+			changes = append(changes, r)
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].EffectiveFrom.Before(changes[j].EffectiveFrom)
+	})
+
+	current := RatePeriod{From: from, Rate: m.Rate, Discount: m.Discount}
+	if r := RateAt(rates, from); r != nil {
+		current.Rate, current.Discount = r.Rate, r.Discount
+	}
+
+	rs := make([]RatePeriod, 0, len(changes)+1)
+	for _, c := range changes {
+		if c.Rate.Equal(current.Rate) && c.Discount.Equal(current.Discount) {
+			continue
+		}
+
+		current.To = c.EffectiveFrom.AddDate(0, 0, -1)
+		rs = append(rs, current)
+		current = RatePeriod{From: c.EffectiveFrom, Rate: c.Rate, Discount: c.Discount}
+	}
+	current.To = to
+
+	return append(rs, current)
+}
@@ -0,0 +1,130 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestRateCard_FindRate(t *testing.T) {
+	senior := MustGetUUIDFromString("2b2bc4b4-7a7c-4a7e-9a9f-2f7b8a4a7e01")
+	junior := MustGetUUIDFromString("2b2bc4b4-7a7c-4a7e-9a9f-2f7b8a4a7e02")
+	frontend := MustGetUUIDFromString("2b2bc4b4-7a7c-4a7e-9a9f-2f7b8a4a7e03")
+	backend := MustGetUUIDFromString("2b2bc4b4-7a7c-4a7e-9a9f-2f7b8a4a7e04")
+
+	card := RateCard{
+		Rates: []*RateCardRate{
+			{SeniorityID: senior, Rate: decimal.NewFromInt(5000)},
+			{SeniorityID: senior, PositionID: &frontend, Rate: decimal.NewFromInt(5500)},
+			{SeniorityID: junior, PositionID: &frontend, Rate: decimal.NewFromInt(3000)},
+		},
+	}
+
+	testcases := []struct {
+		name        string
+		seniorityID UUID
+		positionIDs []UUID
+		wanted      int64
+		found       bool
+	}{
+		{
+			name:        "rate of the position",
+			seniorityID: senior,
+			positionIDs: []UUID{backend, frontend},
+			wanted:      5500,
+			found:       true,
+		},
+		{
+			name:        "rate for all positions",
+			seniorityID: senior,
+			positionIDs: []UUID{backend},
+			wanted:      5000,
+			found:       true,
+		},
+		{
+			name:        "no rate for the position",
+			seniorityID: junior,
+			positionIDs: []UUID{backend},
+			found:       false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := card.FindRate(tc.seniorityID, tc.positionIDs)
+			if (got != nil) != tc.found {
+				t.Fatalf("FindRate() = %v, want found %v", got, tc.found)
+			}
+			if got != nil && !got.Rate.Equal(decimal.NewFromInt(tc.wanted)) {
+				t.Errorf("FindRate() rate = %v, want %v", got.Rate, tc.wanted)
+			}
+		})
+	}
+}
+
+func TestProjectMember_RatePeriods(t *testing.T) {
+	date := func(day int) time.Time {
+		return time.Date(2023, 7, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	member := ProjectMember{Rate: decimal.NewFromInt(4000)}
+
+	testcases := []struct {
+		name   string
+		rates  []*ProjectMemberRate
+		wanted []RatePeriod
+	}{
+		{
+			name: "no rate history",
+			wanted: []RatePeriod{
+				{From: date(1), To: date(31), Rate: decimal.NewFromInt(4000)},
+			},
+		},
+		{
+			name: "rate changed before the period",
+			rates: []*ProjectMemberRate{
+				{Rate: decimal.NewFromInt(3000), EffectiveFrom: date(1).AddDate(0, -6, 0)},
+				{Rate: decimal.NewFromInt(3500), EffectiveFrom: date(1).AddDate(0, -1, 0)},
+			},
+			wanted: []RatePeriod{
+				{From: date(1), To: date(31), Rate: decimal.NewFromInt(3500)},
+			},
+		},
+		{
+			name: "rate changed in the period",
+			rates: []*ProjectMemberRate{
+				{Rate: decimal.NewFromInt(4500), EffectiveFrom: date(16)},
+				{Rate: decimal.NewFromInt(3500), EffectiveFrom: date(1).AddDate(0, -1, 0)},
+			},
+			wanted: []RatePeriod{
+				{From: date(1), To: date(15), Rate: decimal.NewFromInt(3500)},
+				{From: date(16), To: date(31), Rate: decimal.NewFromInt(4500)},
+			},
+		},
+		{
+			name: "rate changed after the period",
+			rates: []*ProjectMemberRate{
+				{Rate: decimal.NewFromInt(3500), EffectiveFrom: date(1)},
+				{Rate: decimal.NewFromInt(4500), EffectiveFrom: date(1).AddDate(0, 1, 0)},
+			},
+			wanted: []RatePeriod{
+				{From: date(1), To: date(31), Rate: decimal.NewFromInt(3500)},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := member.RatePeriods(tc.rates, date(1), date(31))
+			if len(got) != len(tc.wanted) {
+				t.Fatalf("RatePeriods() = %v, want %v", got, tc.wanted)
+			}
+			for i := range got {
+				if !got[i].From.Equal(tc.wanted[i].From) || !got[i].To.Equal(tc.wanted[i].To) || !got[i].Rate.Equal(tc.wanted[i].Rate) {
+					t.Errorf("RatePeriods()[%d] = %v, want %v", i, got[i], tc.wanted[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+// RateLimitBucket is a token bucket shared between api instances
+type RateLimitBucket struct {
+	Key       string `gorm:"primaryKey"`
+	Tokens    float64
+	Allowed   bool
+	UpdatedAt time.Time
+}
@@ -2,6 +2,9 @@ package model
 
 import "time"
 
+// ScheduleTypeOneOnOne is the type of the schedules of 1:1 meetings
+const ScheduleTypeOneOnOne = "one-on-one"
+
 type Schedule struct {
 	BaseModel
 
@@ -0,0 +1,178 @@
+package model
+
+import (
+	"math"
+	"time"
+)
+
+// StaffingSoonAvailableWeeks is how many weeks after the start date of a staffing request
+// an employee can free up and still be proposed
+const StaffingSoonAvailableWeeks = 4
+
+// StaffingRequest model for staffing_requests table, the demand to fill a pending project slot
+type StaffingRequest struct {
+	BaseModel
+
+	ProjectID     UUID
+	ProjectSlotID UUID
+	SeniorityID   UUID
+	StartDate     time.Time
+	// AllocationPercentage is the share of the time of the filling employee the slot takes
+	AllocationPercentage float64
+	Status               StaffingRequestStatus
+	Note                 string
+	RequestedBy          UUID
+	ProjectMemberID      UUID
+	FilledAt             *time.Time
+
+	Project     *Project                `gorm:"foreignKey:ProjectID"`
+	ProjectSlot *ProjectSlot            `gorm:"foreignKey:ProjectSlotID"`
+	Seniority   *Seniority              `gorm:"foreignKey:SeniorityID"`
+	Requester   *Employee               `gorm:"foreignKey:RequestedBy"`
+	Stacks      []*StaffingRequestStack `gorm:"foreignKey:StaffingRequestID"`
+	Candidates  []*StaffingCandidate    `gorm:"foreignKey:StaffingRequestID"`
+}
+
+// StaffingRequestStack model for staffing_request_stacks table, a stack required by a staffing request
+type StaffingRequestStack struct {
+	BaseModel
+
+	StaffingRequestID UUID
+	StackID           UUID
+
+	Stack *Stack `gorm:"foreignKey:StackID"`
+}
+
+// StaffingCandidate model for staffing_candidates table, an employee proposed for a staffing request
+type StaffingCandidate struct {
+	BaseModel
+
+	StaffingRequestID UUID
+	EmployeeID        UUID
+	Score             float64
+	// Allocation is the allocation of the employee in the week they are available from
+	Allocation    float64
+	AvailableFrom time.Time
+	Status        StaffingCandidateStatus
+	Note          string
+
+	Employee *Employee `gorm:"foreignKey:EmployeeID"`
+}
+
+// IsClosed is true when the request is filled or cancelled
+func (r *StaffingRequest) IsClosed() bool {
+	return r.Status == StaffingRequestStatusFilled || r.Status == StaffingRequestStatusCancelled
+}
+
+// StaffingRequirement is what a staffing request asks of a candidate
+type StaffingRequirement struct {
+	SeniorityLevel int
+	PositionIDs    []UUID
+	StackIDs       []UUID
+}
+
+// ScoreStaffingCandidate ranks an employee for a requirement between 0 and 1. Stacks weigh the most,
+// then availability, positions and seniority. delayWeeks is how many weeks after the start date the employee
+// has room for the requested allocation
+func ScoreStaffingCandidate(req StaffingRequirement, e *Employee, allocation float64, delayWeeks int) float64 {
+	stackScore := 1.0
+	if len(req.StackIDs) > 0 {
+		stacks := map[UUID]bool{}
+		for _, s := range e.EmployeeStacks {
+			stacks[s.StackID] = true
+		}
+
+		matched := 0
+		for _, id := range req.StackIDs {
+			if stacks[id] {
+				matched++
+			}
+		}
+		stackScore = float64(matched) / float64(len(req.StackIDs))
+	}
+
+	positionScore := 1.0
+	if len(req.PositionIDs) > 0 {
+		positionScore = 0
+		for _, p := range e.EmployeePositions {
+			for _, id := range req.PositionIDs {
+				if p.PositionID == id {
+					positionScore = 1
+				}
+			}
+		}
+	}
+
+	seniorityScore := 0.0
+	if e.Seniority != nil {
+		seniorityScore = math.Max(0, 1-0.25*math.Abs(float64(e.Seniority.Level-req.SeniorityLevel)))
+	}
+
+	availabilityScore := math.Max(0, MaxAllocationPercentage-allocation) / MaxAllocationPercentage
+	availabilityScore *= 1 - float64(delayWeeks)/float64(StaffingSoonAvailableWeeks+1)
+
+	score := 0.35*stackScore + 0.25*availabilityScore + 0.2*positionScore + 0.2*seniorityScore
+	return math.Round(score*1000) / 1000
+}
+
+// StaffingRequestStatus is the stage of a staffing request
+type StaffingRequestStatus string
+
+// values for StaffingRequestStatus
+const (
+	StaffingRequestStatusOpen         StaffingRequestStatus = "open"
+	StaffingRequestStatusProposed     StaffingRequestStatus = "proposed"
+	StaffingRequestStatusInterviewing StaffingRequestStatus = "interviewing"
+	StaffingRequestStatusConfirmed    StaffingRequestStatus = "confirmed"
+	StaffingRequestStatusFilled       StaffingRequestStatus = "filled"
+	StaffingRequestStatusCancelled    StaffingRequestStatus = "cancelled"
+)
+
+// IsValid validation for StaffingRequestStatus
+func (e StaffingRequestStatus) IsValid() bool {
+	switch e {
+	case
+		StaffingRequestStatusOpen,
+		StaffingRequestStatusProposed,
+		StaffingRequestStatusInterviewing,
+		StaffingRequestStatusConfirmed,
+		StaffingRequestStatusFilled,
+		StaffingRequestStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// String returns the string type from the StaffingRequestStatus type
+func (e StaffingRequestStatus) String() string {
+	return string(e)
+}
+
+// StaffingCandidateStatus is the stage of a candidate of a staffing request
+type StaffingCandidateStatus string
+
+// values for StaffingCandidateStatus
+const (
+	StaffingCandidateStatusProposed     StaffingCandidateStatus = "proposed"
+	StaffingCandidateStatusInterviewing StaffingCandidateStatus = "interviewing"
+	StaffingCandidateStatusConfirmed    StaffingCandidateStatus = "confirmed"
+	StaffingCandidateStatusRejected     StaffingCandidateStatus = "rejected"
+)
+
+// IsValid validation for StaffingCandidateStatus
+func (e StaffingCandidateStatus) IsValid() bool {
+	switch e {
+	case
+		StaffingCandidateStatusProposed,
+		StaffingCandidateStatusInterviewing,
+		StaffingCandidateStatusConfirmed,
+		StaffingCandidateStatusRejected:
+		return true
+	}
+	return false
+}
+
+// String returns the string type from the StaffingCandidateStatus type
+func (e StaffingCandidateStatus) String() string {
+	return string(e)
+}
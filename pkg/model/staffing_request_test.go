@@ -0,0 +1,69 @@
+package model
+
+import "testing"
+
+func TestScoreStaffingCandidate(t *testing.T) {
+	golang, react := NewUUID(), NewUUID()
+	backend := NewUUID()
+
+	req := StaffingRequirement{
+		SeniorityLevel: 3,
+		PositionIDs:    []UUID{backend},
+		StackIDs:       []UUID{golang, react},
+	}
+
+	matched := &Employee{
+		Seniority:         &Seniority{Level: 3},
+		EmployeeStacks:    []EmployeeStack{{StackID: golang}, {StackID: react}},
+		EmployeePositions: []EmployeePosition{{PositionID: backend}},
+	}
+
+	testcases := []struct {
+		name       string
+		req        StaffingRequirement
+		employee   *Employee
+		allocation float64
+		delayWeeks int
+		wanted     float64
+	}{
+		{
+			name:     "free employee matching everything",
+			req:      req,
+			employee: matched,
+			wanted:   1,
+		},
+		{
+			name:       "free employee matching everything in two weeks",
+			req:        req,
+			employee:   matched,
+			delayWeeks: 2,
+			wanted:     0.9,
+		},
+		{
+			name: "half allocated employee with half the stacks and a different position",
+			req:  req,
+			employee: &Employee{
+				Seniority:         &Seniority{Level: 4},
+				EmployeeStacks:    []EmployeeStack{{StackID: golang}},
+				EmployeePositions: []EmployeePosition{{PositionID: NewUUID()}},
+			},
+			allocation: 50,
+			wanted:     0.45,
+		},
+		{
+			name:       "fully allocated employee without seniority for a request without stacks and positions",
+			req:        StaffingRequirement{SeniorityLevel: 3},
+			employee:   &Employee{},
+			allocation: 100,
+			wanted:     0.55,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := ScoreStaffingCandidate(tc.req, tc.employee, tc.allocation, tc.delayWeeks); got != tc.wanted {
+				t.Errorf("ScoreStaffingCandidate() = %v, want %v", got, tc.wanted)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package model
+
+import (
+	"math"
+	"strconv"
+	"time"
+)
+
+// SurveyAnalyticsGroupBy is the dimension survey results are compared by across periods
+type SurveyAnalyticsGroupBy string
+
+// SurveyAnalyticsGroupBy values
+const (
+	SurveyAnalyticsGroupByQuestion  SurveyAnalyticsGroupBy = "question"
+	SurveyAnalyticsGroupByDomain    SurveyAnalyticsGroupBy = "domain"
+	SurveyAnalyticsGroupByProject   SurveyAnalyticsGroupBy = "project"
+	SurveyAnalyticsGroupByChapter   SurveyAnalyticsGroupBy = "chapter"
+	SurveyAnalyticsGroupBySeniority SurveyAnalyticsGroupBy = "seniority"
+)
+
+// IsValid validation for SurveyAnalyticsGroupBy
+func (e SurveyAnalyticsGroupBy) IsValid() bool {
+	switch e {
+	case
+		SurveyAnalyticsGroupByQuestion,
+		SurveyAnalyticsGroupByDomain,
+		SurveyAnalyticsGroupByProject,
+		SurveyAnalyticsGroupByChapter,
+		SurveyAnalyticsGroupBySeniority:
+		return true
+	}
+	return false
+}
+
+// String returns the string type from the SurveyAnalyticsGroupBy type
+func (e SurveyAnalyticsGroupBy) String() string {
+	return string(e)
+}
+
+// IsCohort returns true if results are grouped by who answered rather than by what was asked
+func (e SurveyAnalyticsGroupBy) IsCohort() bool {
+	return e == SurveyAnalyticsGroupByProject || e == SurveyAnalyticsGroupByChapter || e == SurveyAnalyticsGroupBySeniority
+}
+
+// SurveyAnalyticsFilter filters the answers compared by survey analytics
+type SurveyAnalyticsFilter struct {
+	Subtype EventSubtype
+	GroupBy SurveyAnalyticsGroupBy
+	From    *time.Time
+	To      *time.Time
+	Domain  QuestionDomain
+	// ProjectID limits the answers to reviewers of the project,
+	// ProjectName is needed as anonymous answers only keep the project names
+	ProjectID   string
+	ProjectName string
+}
+
+// SurveyAnalyticsAnswer is an answer of a finished review, GroupName is its value of the group-by dimension
+type SurveyAnalyticsAnswer struct {
+	EventID   UUID
+	Title     string
+	StartDate time.Time
+	// Threshold is the anonymity threshold of the event, zero for identified surveys
+	Threshold int
+	// RespondentKey identifies the response the answer belongs to, answers of a respondent share the key
+	RespondentKey string
+	GroupName     string
+	Type          string
+	Domain        QuestionDomain
+	Answer        string
+}
+
+// SurveyAnalyticsResponseCount is the number of reviewers a survey was sent to and the number who answered,
+// GroupName is empty when the results are not grouped by a cohort
+type SurveyAnalyticsResponseCount struct {
+	EventID   UUID
+	GroupName string
+	Total     int
+	Responded int
+}
+
+// LikertScore converts a likert-scale answer to its score, 1 for strongly disagree to 5 for strongly agree
+func LikertScore(answer string) (float64, bool) {
+	// answers are stored as scores, older answers as agreement levels
+	if _, ok := AgreementLevelValueMap[answer]; !ok {
+		value, ok := AgreementLevelMap[AgreementLevel(answer)]
+		if !ok {
+			return 0, false
+		}
+		answer = value
+	}
+
+	score, err := strconv.Atoi(answer)
+	if err != nil {
+		return 0, false
+	}
+
+	return float64(score), true
+}
+
+// SurveyStats summarizes likert-scale scores of a period
+type SurveyStats struct {
+	Count  int
+	Mean   float64
+	StdDev float64
+	// Favorable and Unfavorable are the shares of agree or better and disagree or worse answers
+	Favorable   float64
+	Unfavorable float64
+}
+
+// NewSurveyStats returns the summary of the scores, the standard deviation is the sample one
+func NewSurveyStats(scores []float64) SurveyStats {
+	stats := SurveyStats{Count: len(scores)}
+	if stats.Count == 0 {
+		return stats
+	}
+
+	var sum float64
+	var favorable, unfavorable int
+	for _, s := range scores {
+		sum += s
+		if s >= 4 {
+			favorable++
+		}
+		if s <= 2 {
+			unfavorable++
+		}
+	}
+	stats.Mean = sum / float64(stats.Count)
+	stats.Favorable = float64(favorable) / float64(stats.Count)
+	stats.Unfavorable = float64(unfavorable) / float64(stats.Count)
+
+	if stats.Count > 1 {
+		var squares float64
+		for _, s := range scores {
+			squares += (s - stats.Mean) * (s - stats.Mean)
+		}
+		stats.StdDev = math.Sqrt(squares / float64(stats.Count-1))
+	}
+
+	return stats
+}
+
+// SurveyDelta is the change of the mean score between two periods
+type SurveyDelta struct {
+	Value  float64
+	TScore float64
+	// IsSignificant is true when the change is unlikely to be noise, a two-sided Welch's t-test at 95% confidence
+	IsSignificant bool
+}
+
+// CompareSurveyStats returns the change from the previous period to the current one, nil when a period has no answers
+func CompareSurveyStats(previous, current SurveyStats) *SurveyDelta {
+	if previous.Count == 0 || current.Count == 0 {
+		return nil
+	}
+
+	delta := &SurveyDelta{Value: current.Mean - previous.Mean}
+	// a sample of one has no variance to test against
+	if previous.Count < 2 || current.Count < 2 {
+		return delta
+	}
+
+	v1 := previous.StdDev * previous.StdDev / float64(previous.Count)
+	v2 := current.StdDev * current.StdDev / float64(current.Count)
+	if v1+v2 == 0 {
+		// every answer of both periods is the same
+		delta.IsSignificant = delta.Value != 0
+		return delta
+	}
+
+	delta.TScore = delta.Value / math.Sqrt(v1+v2)
+
+	// Welch–Satterthwaite degrees of freedom
+	df := (v1 + v2) * (v1 + v2) / (v1*v1/float64(previous.Count-1) + v2*v2/float64(current.Count-1))
+	delta.IsSignificant = math.Abs(delta.TScore) >= tCriticalValue(df)
+
+	return delta
+}
+
+// tCriticalValues are the two-sided 95% critical values of the t-distribution by degrees of freedom
+var tCriticalValues = []struct {
+	df    float64
+	value float64
+}{
+	{1, 12.706}, {2, 4.303}, {3, 3.182}, {4, 2.776}, {5, 2.571},
+	{6, 2.447}, {7, 2.365}, {8, 2.306}, {9, 2.262}, {10, 2.228},
+	{15, 2.131}, {20, 2.086}, {30, 2.042}, {60, 2.000}, {120, 1.980},
+}
+
+// tCriticalValue returns the critical value of the largest tabulated degrees of freedom not above df,
+// rounding down keeps the test conservative
+func tCriticalValue(df float64) float64 {
+	if df >= 1000 {
+		return 1.96
+	}
+
+	for i := len(tCriticalValues) - 1; i > 0; i-- {
+		if df >= tCriticalValues[i].df {
+			return tCriticalValues[i].value
+		}
+	}
+
+	return tCriticalValues[0].value
+}
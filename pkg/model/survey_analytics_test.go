@@ -0,0 +1,89 @@
+package model
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLikertScore(t *testing.T) {
+	testcases := []struct {
+		answer string
+		want   float64
+		wantOK bool
+	}{
+		{answer: "4", want: 4, wantOK: true},
+		{answer: "strongly-disagree", want: 1, wantOK: true},
+		{answer: "6"},
+		{answer: "yes"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.answer, func(t *testing.T) {
+			got, ok := LikertScore(tc.answer)
+			if got != tc.want || ok != tc.wantOK {
+				t.Errorf("LikertScore() = (%v, %v), want (%v, %v)", got, ok, tc.want, tc.wantOK)
+			}
+		})
+	}
+}
+
+func TestNewSurveyStats(t *testing.T) {
+	stats := NewSurveyStats([]float64{1, 2, 4, 5})
+
+	if stats.Count != 4 || stats.Mean != 3 || stats.Favorable != 0.5 || stats.Unfavorable != 0.5 {
+		t.Errorf("NewSurveyStats() = %+v", stats)
+	}
+	if math.Abs(stats.StdDev-1.826) > 0.001 {
+		t.Errorf("NewSurveyStats() StdDev = %v, want 1.826", stats.StdDev)
+	}
+}
+
+func TestCompareSurveyStats(t *testing.T) {
+	testcases := []struct {
+		name            string
+		previous        []float64
+		current         []float64
+		wantNil         bool
+		wantSignificant bool
+	}{
+		{
+			name:    "no previous answers",
+			current: []float64{3, 4},
+			wantNil: true,
+		},
+		{
+			name:     "small change is noise",
+			previous: []float64{3, 4, 3, 4, 5, 2},
+			current:  []float64{3, 3, 4, 4, 2, 3},
+		},
+		{
+			name:            "workload dropped",
+			previous:        []float64{4, 4, 5, 4, 5, 4, 4, 5},
+			current:         []float64{2, 2, 1, 3, 2, 2, 1, 2},
+			wantSignificant: true,
+		},
+		{
+			name:     "a sample of one is never significant",
+			previous: []float64{5},
+			current:  []float64{1},
+		},
+		{
+			name:            "unanimous answers changed",
+			previous:        []float64{4, 4, 4},
+			current:         []float64{2, 2, 2},
+			wantSignificant: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := CompareSurveyStats(NewSurveyStats(tc.previous), NewSurveyStats(tc.current))
+			if (got == nil) != tc.wantNil {
+				t.Fatalf("CompareSurveyStats() = %v, want nil %v", got, tc.wantNil)
+			}
+			if got != nil && got.IsSignificant != tc.wantSignificant {
+				t.Errorf("CompareSurveyStats() = %+v, want significant %v", got, tc.wantSignificant)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package model
+
+import "time"
+
+// SurveyCampaign model for survey_campaigns table, a campaign creates and sends a survey every period
+type SurveyCampaign struct {
+	BaseModel
+
+	Name       string
+	Subtype    EventSubtype
+	Recurrence SurveyRecurrence
+	// StartDate anchors weekly and biweekly periods, periods ending before it are never run
+	StartDate time.Time
+	// LeadDays is the number of days before the end of a period the survey is created and sent
+	LeadDays int
+	// DeadlineDays is the number of days reviewers have to answer, zero means no deadline
+	DeadlineDays int
+	ReminderDays []int `gorm:"serializer:json"`
+	AutoClose    bool
+
+	TemplateID         UUID
+	Anonymity          SurveyAnonymity `gorm:"default:identified"`
+	AnonymityThreshold int
+	ParticipantRule    SurveyParticipantRule `gorm:"serializer:json"`
+
+	IsActive  bool
+	CreatedBy UUID
+
+	Creator  *Employee            `gorm:"foreignKey:CreatedBy"`
+	Template *SurveyTemplate      `gorm:"foreignKey:TemplateID"`
+	Runs     []*SurveyCampaignRun `gorm:"foreignKey:CampaignID"`
+}
+
+// SurveyCampaignRun model for survey_campaign_runs table, the history of the surveys created by a campaign
+type SurveyCampaignRun struct {
+	BaseModel
+
+	CampaignID  UUID
+	EventID     UUID
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	Status      SurveyCampaignRunStatus
+	Error       string
+
+	Event *FeedbackEvent `gorm:"foreignKey:EventID"`
+}
+
+// SurveyParticipantRule narrows down who is asked in a survey, the zero value keeps the default participants:
+// full-time Dwarves for peer review and engagement, active members of projects allowing surveys for work surveys,
+// and line managers of the reviewees for peer review
+type SurveyParticipantRule struct {
+	// ProjectIDs limits participants to active members of these projects
+	ProjectIDs []string `json:"projectIDs,omitempty"`
+	// SurveyProjectsOnly limits participants to active members of projects allowing surveys
+	SurveyProjectsOnly bool `json:"surveyProjectsOnly,omitempty"`
+	// ExcludeLineManagers skips the line manager reviews of peer review surveys
+	ExcludeLineManagers bool `json:"excludeLineManagers,omitempty"`
+}
+
+// IsRestricted returns true if participants are limited to members of some projects
+func (r SurveyParticipantRule) IsRestricted() bool {
+	return len(r.ProjectIDs) > 0 || r.SurveyProjectsOnly
+}
+
+// SurveyRecurrence is how often a campaign runs
+type SurveyRecurrence string
+
+// SurveyRecurrence values
+const (
+	SurveyRecurrenceWeekly     SurveyRecurrence = "weekly"
+	SurveyRecurrenceBiweekly   SurveyRecurrence = "biweekly"
+	SurveyRecurrenceQuarterly  SurveyRecurrence = "quarterly"
+	SurveyRecurrenceHalfYearly SurveyRecurrence = "half-yearly"
+)
+
+// IsValid validation for SurveyRecurrence
+func (e SurveyRecurrence) IsValid() bool {
+	switch e {
+	case
+		SurveyRecurrenceWeekly,
+		SurveyRecurrenceBiweekly,
+		SurveyRecurrenceQuarterly,
+		SurveyRecurrenceHalfYearly:
+		return true
+	}
+	return false
+}
+
+// String returns the string type from the SurveyRecurrence type
+func (e SurveyRecurrence) String() string {
+	return string(e)
+}
+
+// IsValidFor returns true if surveys of the subtype can be created every period of the recurrence,
+// peer reviews cover half a year, engagement surveys a quarter and work surveys at most 2 weeks
+func (e SurveyRecurrence) IsValidFor(subtype EventSubtype) bool {
+	switch subtype {
+	case EventSubtypePeerReview:
+		return e == SurveyRecurrenceHalfYearly
+	case EventSubtypeEngagement:
+		return e == SurveyRecurrenceQuarterly
+	case EventSubtypeWork:
+		return e == SurveyRecurrenceWeekly || e == SurveyRecurrenceBiweekly
+	}
+	return false
+}
+
+// MinDays returns the number of days of the shortest period of the recurrence
+func (e SurveyRecurrence) MinDays() int {
+	switch e {
+	case SurveyRecurrenceWeekly:
+		return 7
+	case SurveyRecurrenceBiweekly:
+		return 14
+	case SurveyRecurrenceQuarterly:
+		return 90
+	case SurveyRecurrenceHalfYearly:
+		return 181
+	}
+	return 0
+}
+
+// SurveyCampaignRunStatus is the result of a campaign run
+type SurveyCampaignRunStatus string
+
+// SurveyCampaignRunStatus values
+const (
+	// SurveyCampaignRunStatusPending claims the period while its survey is being created
+	SurveyCampaignRunStatusPending SurveyCampaignRunStatus = "pending"
+	SurveyCampaignRunStatusSent    SurveyCampaignRunStatus = "sent"
+	SurveyCampaignRunStatusSkipped SurveyCampaignRunStatus = "skipped"
+	SurveyCampaignRunStatusFailed  SurveyCampaignRunStatus = "failed"
+)
+
+// IsValid validation for SurveyCampaignRunStatus
+func (e SurveyCampaignRunStatus) IsValid() bool {
+	switch e {
+	case
+		SurveyCampaignRunStatusPending,
+		SurveyCampaignRunStatusSent,
+		SurveyCampaignRunStatusSkipped,
+		SurveyCampaignRunStatusFailed:
+		return true
+	}
+	return false
+}
+
+// String returns the string type from the SurveyCampaignRunStatus type
+func (e SurveyCampaignRunStatus) String() string {
+	return string(e)
+}
+
+// CanRetry checks if the run can be claimed again, failed runs are retried right away while pending runs
+// are retried once they are older than staleBefore, e.g. when the process creating their survey stopped
+func (r SurveyCampaignRun) CanRetry(staleBefore time.Time) bool {
+	switch r.Status {
+	case SurveyCampaignRunStatusFailed:
+		return true
+	case SurveyCampaignRunStatusPending:
+		claimedAt := r.CreatedAt
+		if r.UpdatedAt != nil {
+			claimedAt = *r.UpdatedAt
+		}
+		return claimedAt.Before(staleBefore)
+	}
+	return false
+}
+
+// SurveyPeriod is the time range covered by a survey, both dates are included
+type SurveyPeriod struct {
+	Start time.Time
+	End   time.Time
+}
+
+// PeriodAt returns the period of the campaign which contains the given date
+func (c *SurveyCampaign) PeriodAt(t time.Time) SurveyPeriod {
+	date := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch c.Recurrence {
+	case SurveyRecurrenceQuarterly, SurveyRecurrenceHalfYearly:
+		months := 3
+		if c.Recurrence == SurveyRecurrenceHalfYearly {
+			months = 6
+		}
+		month := (int(date.Month())-1)/months*months + 1
+		start := time.Date(date.Year(), time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+		return SurveyPeriod{Start: start, End: start.AddDate(0, months, -1)}
+	default:
+		days := c.Recurrence.MinDays()
+		anchor := time.Date(c.StartDate.Year(), c.StartDate.Month(), c.StartDate.Day(), 0, 0, 0, 0, time.UTC)
+		elapsed := int(date.Sub(anchor).Hours() / 24)
+
+		n := elapsed / days
+		if elapsed < 0 && elapsed%days != 0 {
+			n--
+		}
+		start := anchor.AddDate(0, 0, n*days)
+		return SurveyPeriod{Start: start, End: start.AddDate(0, 0, days-1)}
+	}
+}
+
+// RunDate returns the date the survey of the period is created and sent
+func (c *SurveyCampaign) RunDate(period SurveyPeriod) time.Time {
+	return period.End.AddDate(0, 0, -c.LeadDays)
+}
+
+// DuePeriods returns the periods whose survey should have been sent by the given time,
+// the previous period is included so a missed run is caught up
+func (c *SurveyCampaign) DuePeriods(now time.Time) []SurveyPeriod {
+	current := c.PeriodAt(now)
+	previous := c.PeriodAt(current.Start.AddDate(0, 0, -1))
+
+	var periods []SurveyPeriod
+	for _, p := range []SurveyPeriod{previous, current} {
+		if p.End.Before(c.StartDate) || now.Before(c.RunDate(p)) {
+			continue
+		}
+		periods = append(periods, p)
+	}
+
+	return periods
+}
@@ -0,0 +1,150 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestSurveyCampaign_PeriodAt(t *testing.T) {
+	testcases := []struct {
+		name     string
+		campaign SurveyCampaign
+		at       time.Time
+		want     SurveyPeriod
+	}{
+		{
+			name:     "quarterly",
+			campaign: SurveyCampaign{Recurrence: SurveyRecurrenceQuarterly},
+			at:       date(2023, 8, 15),
+			want:     SurveyPeriod{Start: date(2023, 7, 1), End: date(2023, 9, 30)},
+		},
+		{
+			name:     "half-yearly",
+			campaign: SurveyCampaign{Recurrence: SurveyRecurrenceHalfYearly},
+			at:       date(2023, 6, 30),
+			want:     SurveyPeriod{Start: date(2023, 1, 1), End: date(2023, 6, 30)},
+		},
+		{
+			name:     "biweekly anchored on the start date",
+			campaign: SurveyCampaign{Recurrence: SurveyRecurrenceBiweekly, StartDate: date(2023, 7, 3)},
+			at:       date(2023, 7, 20),
+			want:     SurveyPeriod{Start: date(2023, 7, 17), End: date(2023, 7, 30)},
+		},
+		{
+			name:     "weekly before the start date",
+			campaign: SurveyCampaign{Recurrence: SurveyRecurrenceWeekly, StartDate: date(2023, 7, 3)},
+			at:       date(2023, 7, 1),
+			want:     SurveyPeriod{Start: date(2023, 6, 26), End: date(2023, 7, 2)},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.campaign.PeriodAt(tc.at); got != tc.want {
+				t.Errorf("PeriodAt() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSurveyCampaign_DuePeriods(t *testing.T) {
+	testcases := []struct {
+		name     string
+		campaign SurveyCampaign
+		now      time.Time
+		want     []SurveyPeriod
+	}{
+		{
+			name:     "run date not reached",
+			campaign: SurveyCampaign{Recurrence: SurveyRecurrenceQuarterly, StartDate: date(2023, 7, 1), LeadDays: 7},
+			now:      date(2023, 9, 20),
+		},
+		{
+			name:     "run date reached",
+			campaign: SurveyCampaign{Recurrence: SurveyRecurrenceQuarterly, StartDate: date(2023, 7, 1), LeadDays: 7},
+			now:      date(2023, 9, 23),
+			want:     []SurveyPeriod{{Start: date(2023, 7, 1), End: date(2023, 9, 30)}},
+		},
+		{
+			name:     "previous period is caught up",
+			campaign: SurveyCampaign{Recurrence: SurveyRecurrenceWeekly, StartDate: date(2023, 7, 3), LeadDays: 2},
+			now:      date(2023, 7, 14),
+			want: []SurveyPeriod{
+				{Start: date(2023, 7, 3), End: date(2023, 7, 9)},
+				{Start: date(2023, 7, 10), End: date(2023, 7, 16)},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.campaign.DuePeriods(tc.now); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("DuePeriods() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSurveyRecurrence_IsValidFor(t *testing.T) {
+	if !SurveyRecurrenceHalfYearly.IsValidFor(EventSubtypePeerReview) {
+		t.Error("half-yearly should be valid for peer review")
+	}
+	if SurveyRecurrenceWeekly.IsValidFor(EventSubtypeEngagement) {
+		t.Error("weekly should not be valid for engagement")
+	}
+	if !SurveyRecurrenceBiweekly.IsValidFor(EventSubtypeWork) {
+		t.Error("biweekly should be valid for work")
+	}
+}
+
+func TestSurveyCampaignRun_CanRetry(t *testing.T) {
+	staleBefore := date(2023, 7, 1)
+	claimedAt := staleBefore.Add(-time.Minute)
+	retriedAt := staleBefore.Add(time.Minute)
+
+	testcases := []struct {
+		name string
+		run  SurveyCampaignRun
+		want bool
+	}{
+		{
+			name: "failed",
+			run:  SurveyCampaignRun{BaseModel: BaseModel{CreatedAt: retriedAt}, Status: SurveyCampaignRunStatusFailed},
+			want: true,
+		},
+		{
+			name: "stale pending",
+			run:  SurveyCampaignRun{BaseModel: BaseModel{CreatedAt: claimedAt}, Status: SurveyCampaignRunStatusPending},
+			want: true,
+		},
+		{
+			name: "pending",
+			run:  SurveyCampaignRun{BaseModel: BaseModel{CreatedAt: retriedAt}, Status: SurveyCampaignRunStatusPending},
+		},
+		{
+			name: "pending again after a retry",
+			run:  SurveyCampaignRun{BaseModel: BaseModel{CreatedAt: claimedAt, UpdatedAt: &retriedAt}, Status: SurveyCampaignRunStatusPending},
+		},
+		{
+			name: "sent",
+			run:  SurveyCampaignRun{BaseModel: BaseModel{CreatedAt: claimedAt}, Status: SurveyCampaignRunStatusSent},
+		},
+		{
+			name: "skipped",
+			run:  SurveyCampaignRun{BaseModel: BaseModel{CreatedAt: claimedAt}, Status: SurveyCampaignRunStatusSkipped},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.run.CanRetry(staleBefore); got != tc.want {
+				t.Errorf("CanRetry() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package model
+
+import "time"
+
+// SurveyReminder model for survey_reminders table, one record per reminder sent to a pending reviewer
+type SurveyReminder struct {
+	BaseModel
+
+	EventID                 UUID
+	EmployeeEventReviewerID UUID
+	ReviewerID              UUID
+	DaysBefore              int
+	Channel                 SurveyReminderChannel
+	SentAt                  *time.Time
+}
+
+// SurveyReminderChannel is how a reminder reaches the reviewer
+type SurveyReminderChannel string
+
+// SurveyReminderChannel values
+const (
+	SurveyReminderChannelDiscord SurveyReminderChannel = "discord"
+	SurveyReminderChannelEmail   SurveyReminderChannel = "email"
+)
+
+// IsValid validation for SurveyReminderChannel
+func (e SurveyReminderChannel) IsValid() bool {
+	switch e {
+	case
+		SurveyReminderChannelDiscord,
+		SurveyReminderChannelEmail:
+		return true
+	}
+	return false
+}
+
+// String returns the string type from the SurveyReminderChannel type
+func (e SurveyReminderChannel) String() string {
+	return string(e)
+}
+
+// SurveyReminderEmail is the content of the reminder email sent to a pending reviewer
+type SurveyReminderEmail struct {
+	Email    string
+	Name     string
+	Survey   string
+	Deadline string
+	Link     string
+}
+
+// SurveyProjectCompletion is the number of reviewers of a survey who are members of a project
+type SurveyProjectCompletion struct {
+	ProjectID   UUID
+	ProjectName string
+	Total       int64
+	Done        int64
+}
+
+// CompletionRate returns the percentage of reviewers who answered
+func (c *SurveyProjectCompletion) CompletionRate() float64 {
+	if c.Total == 0 {
+		return 0
+	}
+	return float64(c.Done) * 100 / float64(c.Total)
+}
@@ -0,0 +1,342 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// npsMax is the highest score of a net promoter score question
+const npsMax = 10
+
+// default range of rating questions
+const (
+	defaultRatingMin = 1
+	defaultRatingMax = 5
+)
+
+var (
+	ErrSurveyAnswerRequired    = errors.New("answer is required")
+	ErrInvalidSurveyAnswer     = errors.New("invalid answer")
+	ErrSurveyAnswerNotExpected = errors.New("answer is not expected, the question is hidden by its condition")
+	ErrInvalidSurveyQuestion   = errors.New("invalid survey question")
+)
+
+// SurveyTemplate model for survey_templates table
+type SurveyTemplate struct {
+	BaseModel
+
+	Name          string
+	Description   string
+	Subtype       EventSubtype
+	LatestVersion int
+	CreatedBy     UUID
+
+	Creator  *Employee                `gorm:"foreignKey:CreatedBy"`
+	Versions []*SurveyTemplateVersion `gorm:"foreignKey:TemplateID"`
+}
+
+// SurveyTemplateVersion model for survey_template_versions table,
+// versions are immutable so surveys keep the questions they were sent with
+type SurveyTemplateVersion struct {
+	BaseModel
+
+	TemplateID UUID
+	Version    int
+	CreatedBy  UUID
+
+	Template  *SurveyTemplate           `gorm:"foreignKey:TemplateID"`
+	Questions []*SurveyTemplateQuestion `gorm:"foreignKey:TemplateVersionID"`
+}
+
+// SurveyTemplateQuestion model for survey_template_questions table
+type SurveyTemplateQuestion struct {
+	BaseModel
+
+	TemplateVersionID UUID
+	// Key identifies the question inside a version, follow-up questions refer to their parent by key
+	Key      string
+	Type     QuestionType
+	Content  string
+	Order    int64
+	Domain   QuestionDomain
+	Required bool
+	// Options are the choices of single-choice and multi-choice questions
+	Options []string `gorm:"serializer:json"`
+	// MinValue and MaxValue are the score range of rating questions,
+	// the number of picked options of multi-choice questions and the length of text questions
+	MinValue *int
+	MaxValue *int
+	// ParentKey and ShowIf make the question a follow-up,
+	// it is only shown when the answer of the parent is one of ShowIf
+	ParentKey string
+	ShowIf    []string `gorm:"serializer:json"`
+}
+
+// IsFollowUp returns true if the question depends on the answer of another question
+func (q *SurveyTemplateQuestion) IsFollowUp() bool {
+	return q.ParentKey != ""
+}
+
+// ValidateSurveyTemplateQuestions validates the definition of the questions of a template version
+func ValidateSurveyTemplateQuestions(questions []*SurveyTemplateQuestion) error {
+	if len(questions) == 0 {
+		return fmt.Errorf("%w: a template needs at least one question", ErrInvalidSurveyQuestion)
+	}
+
+	byKey := make(map[string]*SurveyTemplateQuestion, len(questions))
+	for _, q := range questions {
+		if q.Key == "" {
+			return fmt.Errorf("%w: key is required", ErrInvalidSurveyQuestion)
+		}
+		if _, ok := byKey[q.Key]; ok {
+			return fmt.Errorf("%w: duplicated key %s", ErrInvalidSurveyQuestion, q.Key)
+		}
+		byKey[q.Key] = q
+
+		if err := q.validateDefinition(); err != nil {
+			return fmt.Errorf("%w: question %s: %v", ErrInvalidSurveyQuestion, q.Key, err)
+		}
+	}
+
+	for _, q := range questions {
+		if !q.IsFollowUp() {
+			continue
+		}
+
+		parent, ok := byKey[q.ParentKey]
+		if !ok {
+			return fmt.Errorf("%w: question %s: parent %s not found", ErrInvalidSurveyQuestion, q.Key, q.ParentKey)
+		}
+		// parents must be asked first, this also rules out cycles
+		if parent.Order >= q.Order {
+			return fmt.Errorf("%w: question %s: parent %s must come before its follow-ups", ErrInvalidSurveyQuestion, q.Key, q.ParentKey)
+		}
+		if len(q.ShowIf) == 0 {
+			return fmt.Errorf("%w: question %s: showIf is required for follow-up questions", ErrInvalidSurveyQuestion, q.Key)
+		}
+		for _, v := range q.ShowIf {
+			if err := parent.validateValue(v); err != nil {
+				return fmt.Errorf("%w: question %s: showIf value %s is not a valid answer of %s", ErrInvalidSurveyQuestion, q.Key, v, q.ParentKey)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (q *SurveyTemplateQuestion) validateDefinition() error {
+	if !q.Type.IsValid() {
+		return errors.New("invalid type")
+	}
+	if strings.TrimSpace(q.Content) == "" {
+		return errors.New("content is required")
+	}
+	if q.Domain != "" && !q.Domain.IsValid() && q.Domain != QuestionDomainEngagement {
+		return errors.New("invalid domain")
+	}
+	if q.MinValue != nil && q.MaxValue != nil && *q.MinValue > *q.MaxValue {
+		return errors.New("minValue must not be greater than maxValue")
+	}
+	if q.MinValue != nil && *q.MinValue < 0 {
+		return errors.New("minValue must not be negative")
+	}
+
+	if q.Type.IsChoice() {
+		if len(q.Options) < 2 {
+			return errors.New("choice questions need at least 2 options")
+		}
+		seen := map[string]bool{}
+		for _, o := range q.Options {
+			if strings.TrimSpace(o) == "" || seen[o] {
+				return errors.New("options must be unique and not empty")
+			}
+			seen[o] = true
+		}
+	} else if len(q.Options) > 0 {
+		return errors.New("options are only allowed for choice questions")
+	}
+
+	if q.Type == QuestionTypeMultiChoice && q.MaxValue != nil && *q.MaxValue > len(q.Options) {
+		return errors.New("maxValue must not be greater than the number of options")
+	}
+
+	return nil
+}
+
+// ValidateAnswer validates a non-empty answer against the question definition
+func (q *SurveyTemplateQuestion) ValidateAnswer(answer string) error {
+	if q.Type == QuestionTypeMultiChoice {
+		picked, err := ParseMultiChoiceAnswer(answer)
+		if err != nil {
+			return err
+		}
+		if len(picked) == 0 {
+			return ErrInvalidSurveyAnswer
+		}
+		if q.MinValue != nil && len(picked) < *q.MinValue || q.MaxValue != nil && len(picked) > *q.MaxValue {
+			return ErrInvalidSurveyAnswer
+		}
+
+		seen := map[string]bool{}
+		for _, p := range picked {
+			if seen[p] {
+				return ErrInvalidSurveyAnswer
+			}
+			seen[p] = true
+
+			if err := q.validateValue(p); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return q.validateValue(answer)
+}
+
+// validateValue validates a single value, i.e. one picked option of a multi-choice question
+func (q *SurveyTemplateQuestion) validateValue(value string) error {
+	switch q.Type {
+	case QuestionTypeScale:
+		if !AgreementLevel(normalizeLikertAnswer(value)).IsValid() {
+			return ErrInvalidSurveyAnswer
+		}
+	case QuestionTypeSingleChoice, QuestionTypeMultiChoice:
+		for _, o := range q.Options {
+			if o == value {
+				return nil
+			}
+		}
+		return ErrInvalidSurveyAnswer
+	case QuestionTypeNPS:
+		return validateScore(value, 0, npsMax)
+	case QuestionTypeRating:
+		min, max := defaultRatingMin, defaultRatingMax
+		if q.MinValue != nil {
+			min = *q.MinValue
+		}
+		if q.MaxValue != nil {
+			max = *q.MaxValue
+		}
+		return validateScore(value, min, max)
+	case QuestionTypeText, QuestionTypeGeneral:
+		length := utf8.RuneCountInString(strings.TrimSpace(value))
+		if q.MinValue != nil && length < *q.MinValue || q.MaxValue != nil && length > *q.MaxValue {
+			return ErrInvalidSurveyAnswer
+		}
+	}
+
+	return nil
+}
+
+// isShownBy returns true if the parent answer shows the follow-up question
+func (q *SurveyTemplateQuestion) isShownBy(parent *SurveyTemplateQuestion, parentAnswer string) bool {
+	if parentAnswer == "" {
+		return false
+	}
+
+	values := []string{parentAnswer}
+	if parent.Type == QuestionTypeMultiChoice {
+		picked, err := ParseMultiChoiceAnswer(parentAnswer)
+		if err != nil {
+			return false
+		}
+		values = picked
+	}
+
+	for _, v := range values {
+		if parent.Type == QuestionTypeScale {
+			v = normalizeLikertAnswer(v)
+		}
+		for _, s := range q.ShowIf {
+			if parent.Type == QuestionTypeScale {
+				s = normalizeLikertAnswer(s)
+			}
+			if v == s {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ValidateSurveyAnswers validates the answers, keyed by question key, against the questions of a template version.
+// Hidden follow-ups must not be answered, required questions must be answered when requireAll is set
+func ValidateSurveyAnswers(questions []*SurveyTemplateQuestion, answers map[string]string, requireAll bool) error {
+	byKey := make(map[string]*SurveyTemplateQuestion, len(questions))
+	for _, q := range questions {
+		byKey[q.Key] = q
+	}
+
+	visible := make(map[string]bool, len(questions))
+	var isVisible func(q *SurveyTemplateQuestion) bool
+	isVisible = func(q *SurveyTemplateQuestion) bool {
+		if v, ok := visible[q.Key]; ok {
+			return v
+		}
+
+		v := true
+		if q.IsFollowUp() {
+			parent, ok := byKey[q.ParentKey]
+			v = ok && isVisible(parent) && q.isShownBy(parent, answers[parent.Key])
+		}
+		visible[q.Key] = v
+
+		return v
+	}
+
+	for _, q := range questions {
+		answer := answers[q.Key]
+
+		if !isVisible(q) {
+			if answer != "" && requireAll {
+				return fmt.Errorf("question %s: %w", q.Key, ErrSurveyAnswerNotExpected)
+			}
+			continue
+		}
+
+		if answer == "" {
+			if requireAll && q.Required {
+				return fmt.Errorf("question %s: %w", q.Key, ErrSurveyAnswerRequired)
+			}
+			continue
+		}
+
+		if err := q.ValidateAnswer(answer); err != nil {
+			return fmt.Errorf("question %s: %w", q.Key, err)
+		}
+	}
+
+	return nil
+}
+
+// ParseMultiChoiceAnswer parses answers of multi-choice questions, they are stored as a json array
+func ParseMultiChoiceAnswer(answer string) ([]string, error) {
+	var picked []string
+	if err := json.Unmarshal([]byte(answer), &picked); err != nil {
+		return nil, ErrInvalidSurveyAnswer
+	}
+
+	return picked, nil
+}
+
+// normalizeLikertAnswer converts stored likert-scale answers, e.g. `4`, back to the agreement level
+func normalizeLikertAnswer(answer string) string {
+	if level, ok := AgreementLevelValueMap[answer]; ok {
+		return level.String()
+	}
+	return answer
+}
+
+func validateScore(value string, min, max int) error {
+	score, err := strconv.Atoi(value)
+	if err != nil || score < min || score > max {
+		return ErrInvalidSurveyAnswer
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package model
+
+import (
+	"errors"
+	"testing"
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func testSurveyTemplateQuestions() []*SurveyTemplateQuestion {
+	return []*SurveyTemplateQuestion{
+		{Key: "nps", Type: QuestionTypeNPS, Content: "How likely are you to recommend us?", Order: 1, Required: true},
+		{Key: "channel", Type: QuestionTypeSingleChoice, Content: "Main channel", Order: 2, Required: true, Options: []string{"discord", "basecamp", "email"}},
+		{Key: "why-email", Type: QuestionTypeText, Content: "Why email?", Order: 3, Required: true, MaxValue: intPtr(10), ParentKey: "channel", ShowIf: []string{"email"}},
+		{Key: "tools", Type: QuestionTypeMultiChoice, Content: "Tools", Order: 4, Options: []string{"go", "rust", "js"}, MaxValue: intPtr(2)},
+		{Key: "rating", Type: QuestionTypeRating, Content: "Rating", Order: 5, MinValue: intPtr(1), MaxValue: intPtr(3)},
+		{Key: "agree", Type: QuestionTypeScale, Content: "I am happy", Order: 6},
+		{Key: "why-unhappy", Type: QuestionTypeText, Content: "Why?", Order: 7, Required: true, ParentKey: "agree", ShowIf: []string{"disagree", "strongly-disagree"}},
+	}
+}
+
+func TestValidateSurveyTemplateQuestions(t *testing.T) {
+	if err := ValidateSurveyTemplateQuestions(testSurveyTemplateQuestions()); err != nil {
+		t.Fatalf("expected valid questions, got %v", err)
+	}
+
+	testcases := []struct {
+		name   string
+		modify func(qs []*SurveyTemplateQuestion) []*SurveyTemplateQuestion
+	}{
+		{
+			name: "duplicated key",
+			modify: func(qs []*SurveyTemplateQuestion) []*SurveyTemplateQuestion {
+				qs[1].Key = "nps"
+				return qs
+			},
+		},
+		{
+			name: "choice question without options",
+			modify: func(qs []*SurveyTemplateQuestion) []*SurveyTemplateQuestion {
+				qs[1].Options = []string{"discord"}
+				return qs
+			},
+		},
+		{
+			name: "options on nps question",
+			modify: func(qs []*SurveyTemplateQuestion) []*SurveyTemplateQuestion {
+				qs[0].Options = []string{"a", "b"}
+				return qs
+			},
+		},
+		{
+			name: "unknown parent",
+			modify: func(qs []*SurveyTemplateQuestion) []*SurveyTemplateQuestion {
+				qs[2].ParentKey = "unknown"
+				return qs
+			},
+		},
+		{
+			name: "parent after follow-up",
+			modify: func(qs []*SurveyTemplateQuestion) []*SurveyTemplateQuestion {
+				qs[2].Order = 1
+				return qs
+			},
+		},
+		{
+			name: "showIf is not an option of the parent",
+			modify: func(qs []*SurveyTemplateQuestion) []*SurveyTemplateQuestion {
+				qs[2].ShowIf = []string{"slack"}
+				return qs
+			},
+		},
+		{
+			name: "invalid type",
+			modify: func(qs []*SurveyTemplateQuestion) []*SurveyTemplateQuestion {
+				qs[0].Type = "matrix"
+				return qs
+			},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSurveyTemplateQuestions(tt.modify(testSurveyTemplateQuestions()))
+			if !errors.Is(err, ErrInvalidSurveyQuestion) {
+				t.Errorf("ValidateSurveyTemplateQuestions() error = %v, want %v", err, ErrInvalidSurveyQuestion)
+			}
+		})
+	}
+}
+
+func TestValidateSurveyAnswers(t *testing.T) {
+	testcases := []struct {
+		name       string
+		answers    map[string]string
+		requireAll bool
+		wantErr    error
+	}{
+		{
+			name:       "valid answers",
+			answers:    map[string]string{"nps": "9", "channel": "discord", "tools": `["go","js"]`, "rating": "3", "agree": "agree"},
+			requireAll: true,
+		},
+		{
+			name:       "shown follow-up is answered",
+			answers:    map[string]string{"nps": "0", "channel": "email", "why-email": "faster"},
+			requireAll: true,
+		},
+		{
+			name:       "shown follow-up is missing",
+			answers:    map[string]string{"nps": "0", "channel": "email"},
+			requireAll: true,
+			wantErr:    ErrSurveyAnswerRequired,
+		},
+		{
+			name:       "hidden follow-up is answered",
+			answers:    map[string]string{"nps": "0", "channel": "discord", "why-email": "faster"},
+			requireAll: true,
+			wantErr:    ErrSurveyAnswerNotExpected,
+		},
+		{
+			name:       "follow-up of stored likert-scale answer",
+			answers:    map[string]string{"nps": "5", "channel": "discord", "agree": "2"},
+			requireAll: true,
+			wantErr:    ErrSurveyAnswerRequired,
+		},
+		{
+			name:    "draft allows missing required answers",
+			answers: map[string]string{"channel": "email"},
+		},
+		{
+			name:    "nps out of range",
+			answers: map[string]string{"nps": "11"},
+			wantErr: ErrInvalidSurveyAnswer,
+		},
+		{
+			name:    "unknown option",
+			answers: map[string]string{"channel": "slack"},
+			wantErr: ErrInvalidSurveyAnswer,
+		},
+		{
+			name:    "too many picked options",
+			answers: map[string]string{"tools": `["go","rust","js"]`},
+			wantErr: ErrInvalidSurveyAnswer,
+		},
+		{
+			name:    "multi-choice answer is not a json array",
+			answers: map[string]string{"tools": "go"},
+			wantErr: ErrInvalidSurveyAnswer,
+		},
+		{
+			name:    "rating out of range",
+			answers: map[string]string{"rating": "4"},
+			wantErr: ErrInvalidSurveyAnswer,
+		},
+		{
+			name:    "text too long",
+			answers: map[string]string{"channel": "email", "why-email": "because it is faster"},
+			wantErr: ErrInvalidSurveyAnswer,
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSurveyAnswers(testSurveyTemplateQuestions(), tt.answers, tt.requireAll)
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("ValidateSurveyAnswers() unexpected error = %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("ValidateSurveyAnswers() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package model
+
+import (
+	"time"
+)
+
+// TimesheetHoursPerMonth is the standard number of working hours in a month,
+// members of time-and-material projects are charged by the hour at their monthly rate over it
+const TimesheetHoursPerMonth = 160
+
+// MaxTimesheetHoursPerDay is the most hours an employee can log on a day across all projects
+const MaxTimesheetHoursPerDay = 24
+
+// Timesheet model for timesheets table, the hours an employee logs on a project in a week.
+// Leads review timesheets weekly, submitted and approved timesheets are locked
+type Timesheet struct {
+	BaseModel
+
+	EmployeeID UUID
+	ProjectID  UUID
+	// WeekStart is the monday of the week
+	WeekStart   time.Time
+	Status      TimesheetStatus
+	SubmittedAt *time.Time
+	ReviewedBy  UUID
+	ReviewedAt  *time.Time
+	ReviewNote  string
+
+	Employee *Employee         `gorm:"foreignKey:EmployeeID"`
+	Project  *Project          `gorm:"foreignKey:ProjectID"`
+	Reviewer *Employee         `gorm:"foreignKey:ReviewedBy"`
+	Entries  []*TimesheetEntry `gorm:"foreignKey:TimesheetID"`
+}
+
+// TimesheetEntry model for timesheet_entries table, the hours logged on a day, optionally on a work unit of the project
+type TimesheetEntry struct {
+	BaseModel
+
+	TimesheetID UUID
+	WorkUnitID  UUID
+	Date        time.Time
+	Hours       float64
+	Note        string
+
+	WorkUnit *WorkUnit `gorm:"foreignKey:WorkUnitID"`
+}
+
+// TimesheetHours is the number of approved hours of an employee
+type TimesheetHours struct {
+	EmployeeID UUID
+	Hours      float64
+}
+
+// IsLocked is true when the timesheet is waiting for review or approved, its entries can not be changed
+func (t *Timesheet) IsLocked() bool {
+	return t.Status == TimesheetStatusSubmitted || t.Status == TimesheetStatusApproved
+}
+
+// TotalHours returns the hours logged in the timesheet
+func (t *Timesheet) TotalHours() float64 {
+	total := 0.0
+	for _, e := range t.Entries {
+		total += e.Hours
+	}
+	return total
+}
+
+// TimesheetWeekStart returns the monday of the week of a date
+func TimesheetWeekStart(date time.Time) time.Time {
+	date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	// time.Sunday is 0, weeks start on monday
+	offset := (int(date.Weekday()) + 6) % 7
+	return date.AddDate(0, 0, -offset)
+}
+
+// TimesheetStatus is the review status of a timesheet
+type TimesheetStatus string
+
+// values for TimesheetStatus
+const (
+	TimesheetStatusDraft     TimesheetStatus = "draft"
+	TimesheetStatusSubmitted TimesheetStatus = "submitted"
+	TimesheetStatusApproved  TimesheetStatus = "approved"
+	TimesheetStatusRejected  TimesheetStatus = "rejected"
+)
+
+// IsValid validation for TimesheetStatus
+func (e TimesheetStatus) IsValid() bool {
+	switch e {
+	case
+		TimesheetStatusDraft,
+		TimesheetStatusSubmitted,
+		TimesheetStatusApproved,
+		TimesheetStatusRejected:
+		return true
+	}
+	return false
+}
+
+// String returns the string type from the TimesheetStatus type
+func (e TimesheetStatus) String() string {
+	return string(e)
+}
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimesheetWeekStart(t *testing.T) {
+	monday := time.Date(2023, 7, 17, 0, 0, 0, 0, time.UTC)
+
+	testcases := []struct {
+		name   string
+		date   time.Time
+		wanted time.Time
+	}{
+		{name: "monday", date: monday, wanted: monday},
+		{name: "wednesday afternoon", date: time.Date(2023, 7, 19, 15, 30, 0, 0, time.UTC), wanted: monday},
+		{name: "sunday", date: time.Date(2023, 7, 23, 23, 0, 0, 0, time.UTC), wanted: monday},
+		{name: "across months", date: time.Date(2023, 8, 2, 0, 0, 0, 0, time.UTC), wanted: time.Date(2023, 7, 31, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := TimesheetWeekStart(tc.date); !got.Equal(tc.wanted) {
+				t.Errorf("TimesheetWeekStart() = %v, want %v", got, tc.wanted)
+			}
+		})
+	}
+}
+
+func TestTimesheet_IsLocked(t *testing.T) {
+	testcases := []struct {
+		status TimesheetStatus
+		wanted bool
+	}{
+		{status: TimesheetStatusDraft, wanted: false},
+		{status: TimesheetStatusSubmitted, wanted: true},
+		{status: TimesheetStatusApproved, wanted: true},
+		{status: TimesheetStatusRejected, wanted: false},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.status.String(), func(t *testing.T) {
+			ts := Timesheet{Status: tc.status}
+			if got := ts.IsLocked(); got != tc.wanted {
+				t.Errorf("IsLocked() = %v, want %v", got, tc.wanted)
+			}
+		})
+	}
+}
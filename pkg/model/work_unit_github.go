@@ -0,0 +1,146 @@
+package model
+
+import (
+	"encoding/json"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// WorkUnitSourceGithub is the provider of the work units linked to a GitHub repository
+	WorkUnitSourceGithub = "github"
+
+	// GithubActivityDays is the number of days of commit and pull request activity synced into a work unit
+	GithubActivityDays = 30
+	// GithubMinLanguageShare is the share of the code from which a language of a repository is added to the work unit stacks
+	GithubMinLanguageShare = 0.1
+)
+
+// githubLanguageStackCodes maps the GitHub languages whose stack code is not their lowercased name
+var githubLanguageStackCodes = map[string]string{
+	"go":  "golang",
+	"c#":  "csharp",
+	"c++": "cpp",
+}
+
+// GithubRepository is the state of a GitHub repository synced into a work unit
+type GithubRepository struct {
+	Owner         string     `json:"owner"`
+	Name          string     `json:"name"`
+	DefaultBranch string     `json:"defaultBranch"`
+	PushedAt      *time.Time `json:"pushedAt"`
+	// Languages are the bytes of code by language
+	Languages    map[string]int      `json:"languages"`
+	Contributors []GithubContributor `json:"contributors"`
+	Activity     GithubActivity      `json:"activity"`
+}
+
+// GithubContributor is a contributor of a repository, EmployeeID is set when the login is the GitHub account of an employee
+type GithubContributor struct {
+	Login string `json:"login"`
+	// Contributions is the number of commits of all time on the default branch
+	Contributions int `json:"contributions"`
+	// RecentCommits is the number of commits in the activity window
+	RecentCommits int   `json:"recentCommits"`
+	EmployeeID    *UUID `json:"employeeID,omitempty"`
+}
+
+// GithubActivity is the commit and pull request activity of a repository since a time
+type GithubActivity struct {
+	Since              time.Time `json:"since"`
+	Commits            int       `json:"commits"`
+	PullRequestsOpened int       `json:"pullRequestsOpened"`
+	PullRequestsMerged int       `json:"pullRequestsMerged"`
+}
+
+// GithubSourceMetadata is the source metadata of a work unit linked to a GitHub repository
+type GithubSourceMetadata struct {
+	Provider string     `json:"provider"`
+	SyncedAt *time.Time `json:"syncedAt"`
+	// SyncError is the error of the last sync, the synced data is kept from the last successful sync
+	SyncError string `json:"syncError,omitempty"`
+
+	GithubRepository
+}
+
+// ParseGithubRepo gets the owner and the name of a repository from "owner/name" or its GitHub URL
+func ParseGithubRepo(s string) (string, string, bool) {
+	s = strings.TrimSpace(s)
+	if u, err := url.Parse(s); err == nil && u.Host != "" {
+		if !strings.EqualFold(u.Host, "github.com") && !strings.EqualFold(u.Host, "www.github.com") {
+			return "", "", false
+		}
+		s = u.Path
+	}
+
+	parts := strings.Split(strings.TrimSuffix(strings.Trim(s, "/"), ".git"), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+
+	return parts[0], parts[1], true
+}
+
+// GithubRepoURL returns the URL of a repository
+func GithubRepoURL(owner, name string) string {
+	return "https://github.com/" + owner + "/" + name
+}
+
+// GetGithubMetadata returns the GitHub metadata of a work unit, false when the work unit is not linked to a repository
+func (w *WorkUnit) GetGithubMetadata() (*GithubSourceMetadata, bool) {
+	if len(w.SourceMetadata) == 0 {
+		return nil, false
+	}
+
+	var rs GithubSourceMetadata
+	if err := json.Unmarshal(w.SourceMetadata, &rs); err != nil || rs.Provider != WorkUnitSourceGithub {
+		return nil, false
+	}
+
+	return &rs, true
+}
+
+// MainLanguages returns the languages making up at least a share of the code, the most used first
+func (r *GithubRepository) MainLanguages(minShare float64) []string {
+	total := 0
+	for _, bytes := range r.Languages {
+		total += bytes
+	}
+	if total == 0 {
+		return nil
+	}
+
+	var rs []string
+	for lang, bytes := range r.Languages {
+		if float64(bytes)/float64(total) >= minShare {
+			rs = append(rs, lang)
+		}
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		if r.Languages[rs[i]] != r.Languages[rs[j]] {
+			return r.Languages[rs[i]] > r.Languages[rs[j]]
+		}
+		return rs[i] < rs[j]
+	})
+
+	return rs
+}
+
+// FindLanguageStack returns the stack of a GitHub language by code or by name
+func FindLanguageStack(language string, stacks []*Stack) *Stack {
+	code := strings.ToLower(language)
+	if c, ok := githubLanguageStackCodes[code]; ok {
+		code = c
+	}
+	code = strings.ReplaceAll(code, " ", "-")
+
+	for _, s := range stacks {
+		if strings.EqualFold(s.Code, code) || strings.EqualFold(s.Name, language) {
+			return s
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseGithubRepo(t *testing.T) {
+	testcases := []struct {
+		name      string
+		repo      string
+		wantOwner string
+		wantName  string
+		wantOK    bool
+	}{
+		{name: "owner and name", repo: "dwarvesf/fortress-api", wantOwner: "dwarvesf", wantName: "fortress-api", wantOK: true},
+		{name: "url", repo: "https://github.com/dwarvesf/fortress-api", wantOwner: "dwarvesf", wantName: "fortress-api", wantOK: true},
+		{name: "clone url", repo: " https://github.com/dwarvesf/fortress-api.git/ ", wantOwner: "dwarvesf", wantName: "fortress-api", wantOK: true},
+		{name: "another host", repo: "https://gitlab.com/dwarvesf/fortress-api"},
+		{name: "no owner", repo: "fortress-api"},
+		{name: "tree url", repo: "https://github.com/dwarvesf/fortress-api/tree/develop"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			owner, name, ok := ParseGithubRepo(tc.repo)
+			if owner != tc.wantOwner || name != tc.wantName || ok != tc.wantOK {
+				t.Errorf("ParseGithubRepo() = (%v, %v, %v), want (%v, %v, %v)", owner, name, ok, tc.wantOwner, tc.wantName, tc.wantOK)
+			}
+		})
+	}
+}
+
+func TestGithubRepository_MainLanguages(t *testing.T) {
+	repo := GithubRepository{
+		Languages: map[string]int{
+			"Go":         7000,
+			"TypeScript": 2000,
+			"Shell":      500,
+			"Dockerfile": 500,
+		},
+	}
+
+	want := []string{"Go", "TypeScript"}
+	if got := repo.MainLanguages(GithubMinLanguageShare); !reflect.DeepEqual(got, want) {
+		t.Errorf("MainLanguages() = %v, want %v", got, want)
+	}
+
+	if got := (&GithubRepository{}).MainLanguages(GithubMinLanguageShare); got != nil {
+		t.Errorf("MainLanguages() of a repository without code = %v, want nil", got)
+	}
+}
+
+func TestFindLanguageStack(t *testing.T) {
+	stacks := []*Stack{
+		{Code: "golang", Name: "Golang"},
+		{Code: "typescript", Name: "TypeScript"},
+		{Code: "objective-c", Name: "Objective C"},
+	}
+
+	testcases := []struct {
+		language string
+		wanted   string
+	}{
+		{language: "Go", wanted: "golang"},
+		{language: "TypeScript", wanted: "typescript"},
+		{language: "Objective C", wanted: "objective-c"},
+		{language: "Rust"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.language, func(t *testing.T) {
+			got := ""
+			if s := FindLanguageStack(tc.language, stacks); s != nil {
+				got = s.Code
+			}
+			if got != tc.wanted {
+				t.Errorf("FindLanguageStack() = %v, want %v", got, tc.wanted)
+			}
+		})
+	}
+}
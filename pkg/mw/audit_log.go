@@ -0,0 +1,412 @@
+package mw
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/store/invoice"
+	"github.com/dwarvesf/fortress-api/pkg/utils/auditutils"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+)
+
+const (
+	RequestIDHeader = "X-Request-ID"
+	RequestIDKey    = "requestID"
+)
+
+// routePrefixes are stripped from the route before resolving the resource type
+var routePrefixes = []string{"/api/v1", "/cronjobs", "/webhooks"}
+
+type snapshotLoader func(s *store.Store, db *gorm.DB, id string) (interface{}, error)
+
+// resourceLoaders load the current state of a resource, keyed by resource type
+var resourceLoaders = map[string]snapshotLoader{
+	"employees": func(s *store.Store, db *gorm.DB, id string) (interface{}, error) {
+		return s.Employee.One(db, id, false)
+	},
+	"projects": func(s *store.Store, db *gorm.DB, id string) (interface{}, error) {
+		return s.Project.One(db, id, false)
+	},
+	"clients": func(s *store.Store, db *gorm.DB, id string) (interface{}, error) {
+		return s.Client.One(db, id)
+	},
+	"invoices": func(s *store.Store, db *gorm.DB, id string) (interface{}, error) {
+		if !model.IsUUIDFromString(id) {
+			return nil, nil
+		}
+		return s.Invoice.One(db, &invoice.Query{ID: id})
+	},
+	"surveys": func(s *store.Store, db *gorm.DB, id string) (interface{}, error) {
+		return s.FeedbackEvent.One(db, id, false)
+	},
+}
+
+// routeLoaders take precedence over resourceLoaders for sub-resources
+// which are not part of the parent snapshot
+var routeLoaders = map[string]snapshotLoader{
+	"/api/v1/employees/:id/base-salary": func(s *store.Store, db *gorm.DB, id string) (interface{}, error) {
+		return s.BaseSalary.OneByEmployeeID(db, id)
+	},
+	"/api/v1/employees/:id/skills":           loadEmployeeSkills,
+	"/api/v1/projects/:id/members":           loadProjectMembers,
+	"/api/v1/projects/:id/members/:memberID": loadProjectMembers,
+}
+
+// employeeSkillsSnapshot holds the relations replaced when the skills of an employee are updated,
+// they are compared by id since the rows are recreated on every update
+type employeeSkillsSnapshot struct {
+	SeniorityID     model.UUID   `json:"seniorityID"`
+	Positions       []model.UUID `json:"positions"`
+	Stacks          []model.UUID `json:"stacks"`
+	Chapters        []model.UUID `json:"chapters"`
+	LeadingChapters []model.UUID `json:"leadingChapters"`
+}
+
+func loadEmployeeSkills(s *store.Store, db *gorm.DB, id string) (interface{}, error) {
+	if !model.IsUUIDFromString(id) {
+		return nil, nil
+	}
+
+	employee, err := s.Employee.One(db, id, true)
+	if err != nil {
+		return nil, err
+	}
+
+	leadingChapters, err := s.Chapter.GetAllByLeadID(db, id)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := employeeSkillsSnapshot{SeniorityID: employee.SeniorityID}
+	for _, p := range employee.EmployeePositions {
+		rs.Positions = append(rs.Positions, p.PositionID)
+	}
+	for _, st := range employee.EmployeeStacks {
+		rs.Stacks = append(rs.Stacks, st.StackID)
+	}
+	for _, c := range employee.EmployeeChapters {
+		rs.Chapters = append(rs.Chapters, c.ChapterID)
+	}
+	for _, c := range leadingChapters {
+		rs.LeadingChapters = append(rs.LeadingChapters, c.ID)
+	}
+
+	return rs, nil
+}
+
+// projectMembersSnapshot holds the members and heads of a project,
+// which are changed by assigning, updating and unassigning members
+type projectMembersSnapshot struct {
+	Members []projectMemberSnapshot `json:"members"`
+	Heads   []projectHeadSnapshot   `json:"heads"`
+}
+
+type projectMemberSnapshot struct {
+	ID                   model.UUID                `json:"id"`
+	EmployeeID           model.UUID                `json:"employeeID"`
+	ProjectSlotID        model.UUID                `json:"projectSlotID"`
+	SeniorityID          model.UUID                `json:"seniorityID"`
+	Positions            []model.UUID              `json:"positions"`
+	Status               model.ProjectMemberStatus `json:"status"`
+	DeploymentType       model.DeploymentType      `json:"deploymentType"`
+	StartDate            *time.Time                `json:"startDate"`
+	EndDate              *time.Time                `json:"endDate"`
+	Rate                 decimal.Decimal           `json:"rate"`
+	Discount             decimal.Decimal           `json:"discount"`
+	UpsellPersonID       model.UUID                `json:"upsellPersonID"`
+	UpsellCommissionRate decimal.Decimal           `json:"upsellCommissionRate"`
+	Note                 string                    `json:"note"`
+}
+
+type projectHeadSnapshot struct {
+	EmployeeID     model.UUID         `json:"employeeID"`
+	Position       model.HeadPosition `json:"position"`
+	StartDate      time.Time          `json:"startDate"`
+	EndDate        *time.Time         `json:"endDate"`
+	CommissionRate decimal.Decimal    `json:"commissionRate"`
+}
+
+func loadProjectMembers(s *store.Store, db *gorm.DB, id string) (interface{}, error) {
+	if !model.IsUUIDFromString(id) {
+		return nil, nil
+	}
+
+	members, err := s.ProjectMember.GetAssignedMembers(db, id, "", true)
+	if err != nil {
+		return nil, err
+	}
+
+	heads, err := s.ProjectHead.GetActiveLeadsByProjectID(db, id)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := projectMembersSnapshot{}
+	for _, m := range members {
+		member := projectMemberSnapshot{
+			ID:                   m.ID,
+			EmployeeID:           m.EmployeeID,
+			ProjectSlotID:        m.ProjectSlotID,
+			SeniorityID:          m.SeniorityID,
+			Status:               m.Status,
+			DeploymentType:       m.DeploymentType,
+			StartDate:            m.StartDate,
+			EndDate:              m.EndDate,
+			Rate:                 m.Rate,
+			Discount:             m.Discount,
+			UpsellPersonID:       m.UpsellPersonID,
+			UpsellCommissionRate: m.UpsellCommissionRate,
+			Note:                 m.Note,
+		}
+		for _, p := range m.ProjectMemberPositions {
+			member.Positions = append(member.Positions, p.PositionID)
+		}
+		rs.Members = append(rs.Members, member)
+	}
+	for _, h := range heads {
+		rs.Heads = append(rs.Heads, projectHeadSnapshot{
+			EmployeeID:     h.EmployeeID,
+			Position:       h.Position,
+			StartDate:      h.StartDate,
+			EndDate:        h.EndDate,
+			CommissionRate: h.CommissionRate,
+		})
+	}
+
+	return rs, nil
+}
+
+// privateBodyRoutes tell whether the body of a request holds private content which must not reach the audit logs,
+// the request is still recorded without its body
+var privateBodyRoutes = map[string]func(m *AuditLogMiddleware, c *gin.Context, body []byte) bool{
+	// answers of anonymous surveys must not be stored next to the reviewer
+	"/api/v1/feedbacks/:id/topics/:topicID/submit": func(m *AuditLogMiddleware, c *gin.Context, body []byte) bool {
+		event, err := m.store.FeedbackEvent.One(m.repo.DB(), c.Param("id"), false)
+		if err != nil {
+			// the event can not be checked, keep the answers out to be safe
+			return true
+		}
+		return event.IsAnonymous()
+	},
+	// only the sender and the recipient can read private feedback
+	"/api/v1/feedbacks": func(m *AuditLogMiddleware, c *gin.Context, body []byte) bool {
+		var input struct {
+			Visibility model.FeedbackVisibility `json:"visibility"`
+		}
+		if err := json.Unmarshal(body, &input); err != nil {
+			return true
+		}
+		return input.Visibility != model.FeedbackVisibilityPublic
+	},
+	// the manager note of a 1:1 is only readable by the manager
+	"/api/v1/one-on-ones/:id/manager-note": func(m *AuditLogMiddleware, c *gin.Context, body []byte) bool {
+		return true
+	},
+}
+
+type AuditLogMiddleware struct {
+	cfg   *config.Config
+	store *store.Store
+	repo  store.DBRepo
+}
+
+func NewAuditLogMiddleware(cfg *config.Config, s *store.Store, r store.DBRepo) *AuditLogMiddleware {
+	return &AuditLogMiddleware{
+		cfg:   cfg,
+		store: s,
+		repo:  r,
+	}
+}
+
+// WithAuditLog a middleware to record every successful mutating request
+func (m *AuditLogMiddleware) WithAuditLog(c *gin.Context) {
+	requestID := c.GetHeader(RequestIDHeader)
+	if requestID == "" {
+		requestID = model.NewUUID().String()
+	}
+	c.Set(RequestIDKey, requestID)
+	c.Header(RequestIDHeader, requestID)
+
+	action, ok := model.AuditLogActionFromMethod(c.Request.Method)
+	if !ok || m.store == nil || m.repo == nil {
+		c.Next()
+		return
+	}
+
+	resourceType, resourceID := parseResource(c)
+	loader := m.getLoader(c.FullPath(), resourceType)
+
+	var before interface{}
+	if loader != nil && resourceID != "" {
+		before = m.snapshot(loader, resourceID)
+	}
+
+	// creations and resources without a loader are recorded from the request body
+	captureBody := loader == nil || resourceID == ""
+
+	var body []byte
+	if captureBody && strings.HasPrefix(c.ContentType(), "application/json") && c.Request.Body != nil {
+		body, _ = io.ReadAll(c.Request.Body)
+		c.Request.Body = io.NopCloser(bytes.NewBuffer(body))
+	}
+
+	c.Next()
+
+	if c.Writer.Status() >= 400 {
+		return
+	}
+
+	if isPrivate, ok := privateBodyRoutes[c.FullPath()]; ok && len(body) > 0 && isPrivate(m, c, body) {
+		body = nil
+	}
+
+	var after interface{}
+	switch {
+	case loader != nil && resourceID != "":
+		after = m.snapshot(loader, resourceID)
+	case captureBody && len(body) > 0:
+		after = json.RawMessage(body)
+	}
+
+	actorType, actorID := m.getActor(c)
+
+	log := &model.AuditLog{
+		ActorType:    actorType,
+		ActorID:      actorID,
+		Action:       action,
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		Method:       c.Request.Method,
+		Path:         c.FullPath(),
+		StatusCode:   c.Writer.Status(),
+		RequestID:    requestID,
+		IPAddress:    c.ClientIP(),
+	}
+
+	beforeMap, _ := auditutils.ToMap(before)
+	afterMap, _ := auditutils.ToMap(after)
+	beforeMap = auditutils.RedactMap(beforeMap)
+	afterMap = auditutils.RedactMap(afterMap)
+
+	log.Before = toJSON(beforeMap)
+	log.After = toJSON(afterMap)
+	log.Diff = toJSON(auditutils.Diff(beforeMap, afterMap))
+
+	if _, err := m.store.AuditLog.Create(m.repo.DB(), log); err != nil {
+		logger.L.Fields(logger.Fields{
+			"middleware": "auditLog",
+			"path":       log.Path,
+			"requestID":  requestID,
+		}).Error(err, "failed to create audit log")
+	}
+}
+
+func (m *AuditLogMiddleware) getLoader(fullPath string, resourceType string) snapshotLoader {
+	if loader, ok := routeLoaders[fullPath]; ok {
+		return loader
+	}
+	return resourceLoaders[resourceType]
+}
+
+func (m *AuditLogMiddleware) snapshot(loader snapshotLoader, id string) interface{} {
+	rs, err := loader(m.store, m.repo.DB(), id)
+	if err != nil {
+		return nil
+	}
+	return rs
+}
+
+// getActor resolves who made the request, requests without valid credentials are anonymous
+func (m *AuditLogMiddleware) getActor(c *gin.Context) (model.AuditLogActorType, string) {
+	accessToken, err := authutils.GetTokenFromRequest(c)
+	if err != nil {
+		return model.AuditLogActorTypeAnonymous, ""
+	}
+
+	if authutils.IsAPIKey(c) {
+		clientID, _, err := authutils.ExtractAPIKey(accessToken)
+		if err != nil {
+			return model.AuditLogActorTypeAnonymous, ""
+		}
+		return model.AuditLogActorTypeAPIKey, clientID
+	}
+
+	if m.cfg == nil {
+		return model.AuditLogActorTypeAnonymous, ""
+	}
+
+	claims := &model.AuthenticationInfo{}
+	token, err := jwt.ParseWithClaims(accessToken, claims, func(token *jwt.Token) (interface{}, error) {
+		return []byte(m.cfg.JWTSecretKey), nil
+	})
+	if err != nil || token == nil || !token.Valid {
+		return model.AuditLogActorTypeAnonymous, ""
+	}
+
+	return model.AuditLogActorTypeEmployee, claims.UserID
+}
+
+// parseResource gets the resource type and id from the matched route,
+// e.g. /api/v1/employees/:id/base-salary => employees, <id>
+func parseResource(c *gin.Context) (string, string) {
+	route := c.FullPath()
+	for _, p := range routePrefixes {
+		if strings.HasPrefix(route, p) {
+			route = strings.TrimPrefix(route, p)
+			break
+		}
+	}
+
+	resourceType := ""
+	resourceID := ""
+	for _, segment := range strings.Split(strings.Trim(route, "/"), "/") {
+		if segment == "" {
+			continue
+		}
+		if strings.HasPrefix(segment, ":") {
+			if resourceID == "" {
+				resourceID = c.Param(strings.TrimPrefix(segment, ":"))
+			}
+			continue
+		}
+		if resourceType == "" {
+			resourceType = segment
+		}
+	}
+
+	return resourceType, resourceID
+}
+
+func toJSON(v interface{}) model.JSON {
+	if v == nil {
+		return nil
+	}
+	switch o := v.(type) {
+	case map[string]interface{}:
+		if o == nil {
+			return nil
+		}
+	case map[string]auditutils.DiffEntry:
+		if len(o) == 0 {
+			return nil
+		}
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	return b
+}
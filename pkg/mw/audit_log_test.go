@@ -0,0 +1,274 @@
+package mw
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/store/auditlog"
+	"github.com/dwarvesf/fortress-api/pkg/store/chapter"
+	"github.com/dwarvesf/fortress-api/pkg/store/employee"
+	"github.com/dwarvesf/fortress-api/pkg/store/feedbackevent"
+	"github.com/dwarvesf/fortress-api/pkg/store/projecthead"
+	"github.com/dwarvesf/fortress-api/pkg/store/projectmember"
+)
+
+type fakeRepo struct {
+	store.DBRepo
+}
+
+func (r *fakeRepo) DB() *gorm.DB {
+	return nil
+}
+
+type fakeAuditLogStore struct {
+	auditlog.IStore
+	logs []*model.AuditLog
+}
+
+func (s *fakeAuditLogStore) Create(db *gorm.DB, log *model.AuditLog) (*model.AuditLog, error) {
+	s.logs = append(s.logs, log)
+	return log, nil
+}
+
+type fakeFeedbackEventStore struct {
+	feedbackevent.IStore
+	event *model.FeedbackEvent
+}
+
+func (s *fakeFeedbackEventStore) One(db *gorm.DB, id string, preload bool) (*model.FeedbackEvent, error) {
+	if s.event == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return s.event, nil
+}
+
+type fakeEmployeeStore struct {
+	employee.IStore
+	employee *model.Employee
+}
+
+func (s *fakeEmployeeStore) One(db *gorm.DB, id string, preload bool) (*model.Employee, error) {
+	rs := *s.employee
+	return &rs, nil
+}
+
+type fakeChapterStore struct {
+	chapter.IStore
+}
+
+func (s *fakeChapterStore) GetAllByLeadID(db *gorm.DB, leadID string) ([]*model.Chapter, error) {
+	return nil, nil
+}
+
+type fakeProjectMemberStore struct {
+	projectmember.IStore
+	members []*model.ProjectMember
+}
+
+func (s *fakeProjectMemberStore) GetAssignedMembers(db *gorm.DB, projectID string, status string, preload bool) ([]*model.ProjectMember, error) {
+	return append([]*model.ProjectMember{}, s.members...), nil
+}
+
+type fakeProjectHeadStore struct {
+	projecthead.IStore
+}
+
+func (s *fakeProjectHeadStore) GetActiveLeadsByProjectID(db *gorm.DB, projectID string) ([]*model.ProjectHead, error) {
+	return nil, nil
+}
+
+func TestWithAuditLog_SubResource(t *testing.T) {
+	const (
+		employeeID = "2655832e-f009-4b73-a535-64c3a22e558f"
+		memberID   = "ecea9d15-05ba-4a4e-9787-54210e3b98ce"
+		projectID  = "8dc3be2e-19a4-4942-8a79-56db391a4b15"
+	)
+
+	staff := model.MustGetUUIDFromString("01fb6322-d727-47e3-a242-5039ea4732fd")
+	backend := model.MustGetUUIDFromString("dac16ce6-9e5a-4ff3-9ea2-fdea4853925e")
+	frontend := model.MustGetUUIDFromString("39735742-829b-47f3-8f9d-daf0983914e5")
+
+	tcs := map[string]struct {
+		method   string
+		route    string
+		path     string
+		mutate   func(s *store.Store)
+		wantDiff []string
+	}{
+		"positions of an employee are recorded": {
+			method: http.MethodPut,
+			route:  "/api/v1/employees/:id/skills",
+			path:   "/api/v1/employees/" + employeeID + "/skills",
+			mutate: func(s *store.Store) {
+				e := s.Employee.(*fakeEmployeeStore)
+				e.employee = &model.Employee{
+					SeniorityID:       e.employee.SeniorityID,
+					EmployeePositions: []model.EmployeePosition{{PositionID: frontend}},
+				}
+			},
+			wantDiff: []string{"positions"},
+		},
+		"assigned member of a project is recorded": {
+			method: http.MethodPost,
+			route:  "/api/v1/projects/:id/members",
+			path:   "/api/v1/projects/" + projectID + "/members",
+			mutate: func(s *store.Store) {
+				m := s.ProjectMember.(*fakeProjectMemberStore)
+				m.members = append(m.members, &model.ProjectMember{EmployeeID: model.MustGetUUIDFromString(employeeID)})
+			},
+			wantDiff: []string{"members"},
+		},
+		"unassigned member of a project is recorded": {
+			method: http.MethodDelete,
+			route:  "/api/v1/projects/:id/members/:memberID",
+			path:   "/api/v1/projects/" + projectID + "/members/" + memberID,
+			mutate: func(s *store.Store) {
+				s.ProjectMember.(*fakeProjectMemberStore).members = nil
+			},
+			wantDiff: []string{"members"},
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			cfg := config.LoadTestConfig()
+			auditLogStore := &fakeAuditLogStore{}
+			s := &store.Store{
+				AuditLog: auditLogStore,
+				Employee: &fakeEmployeeStore{employee: &model.Employee{
+					SeniorityID:       staff,
+					EmployeePositions: []model.EmployeePosition{{PositionID: backend}},
+				}},
+				Chapter:       &fakeChapterStore{},
+				ProjectMember: &fakeProjectMemberStore{members: []*model.ProjectMember{{EmployeeID: model.MustGetUUIDFromString(memberID)}}},
+				ProjectHead:   &fakeProjectHeadStore{},
+			}
+			alw := NewAuditLogMiddleware(&cfg, s, &fakeRepo{})
+
+			r := gin.New()
+			r.Handle(tc.method, tc.route, alw.WithAuditLog, func(c *gin.Context) {
+				tc.mutate(s)
+				c.JSON(http.StatusOK, nil)
+			})
+
+			req, _ := http.NewRequest(tc.method, tc.path, bytes.NewBufferString(`{}`))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+			require.Len(t, auditLogStore.logs, 1)
+
+			var diff map[string]interface{}
+			require.NoError(t, json.Unmarshal(auditLogStore.logs[0].Diff, &diff))
+			keys := make([]string, 0, len(diff))
+			for k := range diff {
+				keys = append(keys, k)
+			}
+			require.ElementsMatch(t, tc.wantDiff, keys)
+		})
+	}
+}
+
+func TestWithAuditLog_PrivateBody(t *testing.T) {
+	const (
+		eventID = "8a5bfedb-6e11-4f5c-82d9-2635cfcce3e7"
+		topicID = "e4725518-7f9b-4ed8-a1c7-3ca1d5a4ba6f"
+	)
+
+	tcs := map[string]struct {
+		method    string
+		route     string
+		path      string
+		body      string
+		event     *model.FeedbackEvent
+		wantAfter bool
+	}{
+		"answers of an identified survey are recorded": {
+			method:    http.MethodPost,
+			route:     "/api/v1/feedbacks/:id/topics/:topicID/submit",
+			path:      "/api/v1/feedbacks/" + eventID + "/topics/" + topicID + "/submit",
+			body:      `{"status":"done","answers":[{"eventQuestionID":"1","answer":"yes"}]}`,
+			event:     &model.FeedbackEvent{Anonymity: model.SurveyAnonymityIdentified},
+			wantAfter: true,
+		},
+		"answers of an anonymous survey are not recorded": {
+			method: http.MethodPost,
+			route:  "/api/v1/feedbacks/:id/topics/:topicID/submit",
+			path:   "/api/v1/feedbacks/" + eventID + "/topics/" + topicID + "/submit",
+			body:   `{"status":"done","answers":[{"eventQuestionID":"1","answer":"yes"}]}`,
+			event:  &model.FeedbackEvent{Anonymity: model.SurveyAnonymityAnonymous},
+		},
+		"answers of a pseudonymous survey are not recorded": {
+			method: http.MethodPost,
+			route:  "/api/v1/feedbacks/:id/topics/:topicID/submit",
+			path:   "/api/v1/feedbacks/" + eventID + "/topics/" + topicID + "/submit",
+			body:   `{"status":"done","answers":[{"eventQuestionID":"1","answer":"yes"}]}`,
+			event:  &model.FeedbackEvent{Anonymity: model.SurveyAnonymityPseudonymous},
+		},
+		"answers of a survey which can not be found are not recorded": {
+			method: http.MethodPost,
+			route:  "/api/v1/feedbacks/:id/topics/:topicID/submit",
+			path:   "/api/v1/feedbacks/" + eventID + "/topics/" + topicID + "/submit",
+			body:   `{"status":"done","answers":[{"eventQuestionID":"1","answer":"yes"}]}`,
+		},
+		"public kudos are recorded": {
+			method:    http.MethodPost,
+			route:     "/api/v1/feedbacks",
+			path:      "/api/v1/feedbacks",
+			body:      `{"subtype":"appreciation","message":"thanks","visibility":"public"}`,
+			wantAfter: true,
+		},
+		"private feedback is not recorded": {
+			method: http.MethodPost,
+			route:  "/api/v1/feedbacks",
+			path:   "/api/v1/feedbacks",
+			body:   `{"subtype":"comment","message":"please review your PRs","visibility":"private"}`,
+		},
+		"manager note of a 1:1 is not recorded": {
+			method: http.MethodPut,
+			route:  "/api/v1/one-on-ones/:id/manager-note",
+			path:   "/api/v1/one-on-ones/" + eventID + "/manager-note",
+			body:   `{"note":"struggling with the project"}`,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			cfg := config.LoadTestConfig()
+			auditLogStore := &fakeAuditLogStore{}
+			s := &store.Store{
+				AuditLog:      auditLogStore,
+				FeedbackEvent: &fakeFeedbackEventStore{event: tc.event},
+			}
+			alw := NewAuditLogMiddleware(&cfg, s, &fakeRepo{})
+
+			r := gin.New()
+			r.Handle(tc.method, tc.route, alw.WithAuditLog, func(c *gin.Context) {
+				c.JSON(http.StatusOK, nil)
+			})
+
+			req, _ := http.NewRequest(tc.method, tc.path, bytes.NewBufferString(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+			require.Len(t, auditLogStore.logs, 1)
+			if tc.wantAfter {
+				require.NotNil(t, auditLogStore.logs[0].After)
+				return
+			}
+			require.Nil(t, auditLogStore.logs[0].After)
+			require.Nil(t, auditLogStore.logs[0].Diff)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package mw
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+)
+
+type ClientPortalMiddleware struct {
+	cfg   *config.Config
+	store *store.Store
+	repo  store.DBRepo
+}
+
+func NewClientPortalMiddleware(cfg *config.Config, s *store.Store, r store.DBRepo) *ClientPortalMiddleware {
+	return &ClientPortalMiddleware{
+		cfg:   cfg,
+		store: s,
+		repo:  r,
+	}
+}
+
+// WithClientPortalAuth a middleware to check the token of a client contact, employee tokens, revoked accesses
+// and sessions started before the access was revoked or issued again are rejected
+func (m *ClientPortalMiddleware) WithClientPortalAuth(c *gin.Context) {
+	claims, err := authutils.GetClientPortalClaimsFromContext(c, m.cfg)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, map[string]string{"message": err.Error()})
+		return
+	}
+
+	access, err := m.store.ClientPortalAccess.One(m.repo.DB(), claims.AccessID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, map[string]string{"message": ErrUnauthorized.Error()})
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusInternalServerError, map[string]string{"message": err.Error()})
+		return
+	}
+
+	if !access.IsSessionValid(claims.SessionVersion) || access.ClientID.String() != claims.ClientID {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, map[string]string{"message": ErrClientPortalAccessRevoked.Error()})
+		return
+	}
+
+	c.Next()
+}
@@ -0,0 +1,118 @@
+package mw
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/store/clientportalaccess"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+)
+
+type fakeClientPortalAccessStore struct {
+	clientportalaccess.IStore
+	access *model.ClientPortalAccess
+}
+
+func (s *fakeClientPortalAccessStore) One(db *gorm.DB, id string) (*model.ClientPortalAccess, error) {
+	if s.access == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return s.access, nil
+}
+
+func TestClientPortalMiddleware_WithClientPortalAuth(t *testing.T) {
+	const (
+		accessID  = "9a3a4f5c-9b1e-4a38-a3a4-3f5b1c2d4e6f"
+		contactID = "596ae21d-570b-4852-92a6-117633629046"
+		clientID  = "67f9f420-cdd5-4793-88c7-d2068bd17f61"
+	)
+
+	cfg := &config.Config{
+		JWTSecretKey: "JWTSecretKey",
+		ClientPortal: config.ClientPortal{JWTSecretKey: "ClientPortalJWTSecretKey"},
+	}
+
+	tcs := map[string]struct {
+		access         *model.ClientPortalAccess
+		sessionVersion int
+		wantCode       int
+	}{
+		"active access": {
+			access: &model.ClientPortalAccess{
+				ClientID: model.MustGetUUIDFromString(clientID),
+				Status:   model.ClientPortalAccessStatusActive,
+			},
+			wantCode: http.StatusOK,
+		},
+		"revoked access": {
+			access: &model.ClientPortalAccess{
+				ClientID:       model.MustGetUUIDFromString(clientID),
+				Status:         model.ClientPortalAccessStatusRevoked,
+				SessionVersion: 1,
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+		"session started before the access was issued again": {
+			access: &model.ClientPortalAccess{
+				ClientID:       model.MustGetUUIDFromString(clientID),
+				Status:         model.ClientPortalAccessStatusActive,
+				SessionVersion: 2,
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+		"session started after the access was issued again": {
+			access: &model.ClientPortalAccess{
+				ClientID:       model.MustGetUUIDFromString(clientID),
+				Status:         model.ClientPortalAccessStatusActive,
+				SessionVersion: 2,
+			},
+			sessionVersion: 2,
+			wantCode:       http.StatusOK,
+		},
+		"access of another client": {
+			access: &model.ClientPortalAccess{
+				ClientID: model.MustGetUUIDFromString("2655832e-f009-4b73-a535-64c3a22e558f"),
+				Status:   model.ClientPortalAccessStatusActive,
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+		"access not found": {
+			wantCode: http.StatusUnauthorized,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			token, err := authutils.GenerateClientPortalToken(cfg, &model.ClientPortalClaims{
+				AccessID:       accessID,
+				ContactID:      contactID,
+				ClientID:       clientID,
+				SessionVersion: tc.sessionVersion,
+			}, time.Now().Add(time.Hour))
+			require.NoError(t, err)
+
+			s := &store.Store{ClientPortalAccess: &fakeClientPortalAccessStore{access: tc.access}}
+			cpmw := NewClientPortalMiddleware(cfg, s, &fakeRepo{})
+
+			r := gin.New()
+			r.GET("/api/v1/client-portal/me", cpmw.WithClientPortalAuth, func(c *gin.Context) {
+				c.JSON(http.StatusOK, nil)
+			})
+
+			req, _ := http.NewRequest(http.MethodGet, "/api/v1/client-portal/me", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			require.Equal(t, tc.wantCode, w.Code)
+		})
+	}
+}
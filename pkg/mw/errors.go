@@ -11,6 +11,10 @@ var (
 	ErrAuthenticationTypeHeaderInvalid = errors.New("authentication type header is invalid")
 	ErrUnexpectedAuthorizationHeader   = errors.New("unexpected authorization headers")
 	ErrInvalidAPIKey                   = errors.New("invalid API key")
+	ErrTooManyRequests                 = errors.New("too many requests")
+	ErrInvalidWebhookSignature         = errors.New("invalid webhook signature")
+	ErrWebhookSecretNotConfigured      = errors.New("webhook secret is not configured")
+	ErrClientPortalAccessRevoked       = errors.New("client portal access is revoked")
 )
 
 // errUnauthorized returns unauthorized custom error
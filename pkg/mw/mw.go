@@ -96,23 +96,33 @@ func NewPermissionMiddleware(s *store.Store, r store.DBRepo, cfg *config.Config)
 }
 
 func (amw *AuthMiddleware) validateAPIKey(apiKey string) error {
+	_, err := validateAPIKey(amw.store, amw.repo.DB(), apiKey)
+	return err
+}
+
+// validateAPIKey checks the api key against the store and returns its client id
+func validateAPIKey(s *store.Store, db *gorm.DB, apiKey string) (string, error) {
 	clientID, key, err := authutils.ExtractAPIKey(apiKey)
 	if err != nil {
-		return ErrInvalidAPIKey
+		return "", ErrInvalidAPIKey
 	}
 
-	rec, err := amw.store.APIKey.GetByClientID(amw.repo.DB(), clientID)
+	rec, err := s.APIKey.GetByClientID(db, clientID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return ErrInvalidAPIKey
+			return "", ErrInvalidAPIKey
 		}
-		return err
+		return "", err
 	}
 	if rec.Status != model.ApikeyStatusValid {
-		return ErrInvalidAPIKey
+		return "", ErrInvalidAPIKey
+	}
+
+	if err := authutils.ValidateHashedKey(rec.SecretKey, key); err != nil {
+		return "", err
 	}
 
-	return authutils.ValidateHashedKey(rec.SecretKey, key)
+	return clientID, nil
 }
 
 type PermMiddleware struct {
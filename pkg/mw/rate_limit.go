@@ -0,0 +1,173 @@
+package mw
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/utils/ratelimit"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+const (
+	RateLimitLimitHeader     = "X-RateLimit-Limit"
+	RateLimitRemainingHeader = "X-RateLimit-Remaining"
+	RateLimitResetHeader     = "X-RateLimit-Reset"
+	RetryAfterHeader         = "Retry-After"
+
+	rateLimitBackendPostgres = "postgres"
+)
+
+// RateLimitGroup is a group of routes sharing the same rule
+type RateLimitGroup string
+
+const (
+	RateLimitGroupPublic  RateLimitGroup = "public"
+	RateLimitGroupAuth    RateLimitGroup = "auth"
+	RateLimitGroupWebhook RateLimitGroup = "webhook"
+)
+
+// defaultRateLimitRules are used when a group is not configured
+var defaultRateLimitRules = map[RateLimitGroup]string{
+	RateLimitGroupPublic:  "60/1m",
+	RateLimitGroupAuth:    "20/1m",
+	RateLimitGroupWebhook: "300/1m",
+}
+
+// RateLimitKeyFunc returns the bucket key of a request
+type RateLimitKeyFunc func(c *gin.Context) string
+
+// KeyByIP limits each client ip separately
+func KeyByIP(c *gin.Context) string {
+	return "ip:" + c.ClientIP()
+}
+
+// KeyByRouteGroup shares one bucket between all requests to a route group,
+// it is meant for webhooks which come from a few known senders
+func KeyByRouteGroup(name string) RateLimitKeyFunc {
+	return func(c *gin.Context) string {
+		return "route:" + name
+	}
+}
+
+type RateLimitMiddleware struct {
+	cfg     *config.Config
+	store   *store.Store
+	repo    store.DBRepo
+	backend ratelimit.Backend
+}
+
+func NewRateLimitMiddleware(cfg *config.Config, s *store.Store, r store.DBRepo) *RateLimitMiddleware {
+	var backend ratelimit.Backend = ratelimit.NewMemoryBackend()
+	if cfg != nil && cfg.RateLimit.Backend == rateLimitBackendPostgres && s != nil && r != nil {
+		backend = ratelimit.NewPostgresBackend(s, r)
+	}
+
+	return &RateLimitMiddleware{
+		cfg:     cfg,
+		store:   s,
+		repo:    r,
+		backend: backend,
+	}
+}
+
+// KeyByAPIKeyOrIP limits each valid api key separately, and falls back to the client ip,
+// the key is checked against the store so made up keys can not get a fresh bucket on every request
+func (m *RateLimitMiddleware) KeyByAPIKeyOrIP(c *gin.Context) string {
+	if !authutils.IsAPIKey(c) || m.store == nil || m.repo == nil {
+		return KeyByIP(c)
+	}
+
+	token, err := authutils.GetTokenFromRequest(c)
+	if err != nil {
+		return KeyByIP(c)
+	}
+
+	clientID, err := validateAPIKey(m.store, m.repo.DB(), token)
+	if err != nil {
+		return KeyByIP(c)
+	}
+
+	return "apikey:" + clientID
+}
+
+// WithRateLimit a middleware to limit requests of a route group with token buckets
+func (m *RateLimitMiddleware) WithRateLimit(group RateLimitGroup, keyFunc RateLimitKeyFunc) gin.HandlerFunc {
+	rule := m.getRule(group)
+
+	return func(c *gin.Context) {
+		if rule == nil {
+			c.Next()
+			return
+		}
+
+		rs, err := m.backend.Take(string(group)+":"+keyFunc(c), *rule)
+		if err != nil {
+			// fail open, an unavailable backend must not take the api down
+			logger.L.Fields(logger.Fields{
+				"middleware": "rateLimit",
+				"group":      group,
+			}).Error(err, "failed to take rate limit token")
+			c.Next()
+			return
+		}
+
+		c.Header(RateLimitLimitHeader, strconv.Itoa(rs.Limit))
+		c.Header(RateLimitRemainingHeader, strconv.Itoa(rs.Remaining))
+		c.Header(RateLimitResetHeader, toSeconds(rs.ResetAfter))
+
+		if !rs.Allowed {
+			c.Header(RetryAfterHeader, toSeconds(rs.RetryAfter))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, view.CreateResponse[any](nil, nil, ErrTooManyRequests, nil, ""))
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func (m *RateLimitMiddleware) getRule(group RateLimitGroup) *ratelimit.Rule {
+	raw := defaultRateLimitRules[group]
+	if m.cfg != nil {
+		switch group {
+		case RateLimitGroupPublic:
+			raw = withDefault(m.cfg.RateLimit.Public, raw)
+		case RateLimitGroupAuth:
+			raw = withDefault(m.cfg.RateLimit.Auth, raw)
+		case RateLimitGroupWebhook:
+			raw = withDefault(m.cfg.RateLimit.Webhook, raw)
+		}
+	}
+
+	rule, err := ratelimit.ParseRule(raw)
+	if err != nil {
+		logger.L.Fields(logger.Fields{
+			"middleware": "rateLimit",
+			"group":      group,
+			"rule":       raw,
+		}).Error(err, "invalid rate limit rule, using the default rule")
+
+		rule, _ = ratelimit.ParseRule(defaultRateLimitRules[group])
+	}
+
+	return rule
+}
+
+func withDefault(value string, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
+
+// toSeconds rounds up so clients never retry too early
+func toSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
@@ -0,0 +1,38 @@
+package mw
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/testhelper"
+)
+
+func TestRateLimitMiddleware_KeyByAPIKeyOrIP(t *testing.T) {
+	testhelper.TestWithTxDB(t, func(txRepo store.DBRepo) {
+		cfg := config.LoadTestConfig()
+		cfg.RateLimit.Public = "1/1m"
+		rlw := NewRateLimitMiddleware(&cfg, store.New(), txRepo)
+
+		r := gin.New()
+		r.GET("/public/employees", rlw.WithRateLimit(RateLimitGroupPublic, rlw.KeyByAPIKeyOrIP), func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+
+		// made up keys from the same client share the bucket of its ip
+		for i, want := range []int{http.StatusOK, http.StatusTooManyRequests} {
+			req := httptest.NewRequest(http.MethodGet, "/public/employees", nil)
+			req.Header.Set("Authorization", []string{"ApiKey fake-1.secret", "ApiKey fake-2.secret"}[i])
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			require.Equal(t, want, w.Code)
+		}
+	})
+}
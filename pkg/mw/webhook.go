@@ -0,0 +1,141 @@
+package mw
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+const (
+	// WebhookSignatureHeader carries `sha256=<hex hmac of the raw body>`
+	WebhookSignatureHeader = "X-Webhook-Signature"
+	// WebhookSecretHeader carries the shared secret for senders which can't sign the body
+	WebhookSecretHeader = "X-Webhook-Secret"
+	// webhookTokenQuery carries the shared secret for senders which can only be given an url, e.g. basecamp
+	webhookTokenQuery = "token"
+
+	webhookSignaturePrefix = "sha256="
+)
+
+type WebhookMiddleware struct {
+	cfg *config.Config
+}
+
+func NewWebhookMiddleware(cfg *config.Config) *WebhookMiddleware {
+	return &WebhookMiddleware{
+		cfg: cfg,
+	}
+}
+
+// VerifyN8n a middleware to verify n8n webhooks by a body signature or the shared secret
+func (m *WebhookMiddleware) VerifyN8n(c *gin.Context) {
+	if m.cfg == nil {
+		c.Next()
+		return
+	}
+
+	secret := m.cfg.Webhook.N8nSecret
+	if secret == "" {
+		m.secretNotConfigured(c, m.cfg.Webhook.N8nReportOnly)
+		return
+	}
+
+	if signature := c.GetHeader(WebhookSignatureHeader); signature != "" {
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+			return
+		}
+		// restore the body for the handler
+		c.Request.Body = io.NopCloser(bytes.NewBuffer(body))
+
+		if !validSignature(secret, body, signature) {
+			reject(c, ErrInvalidWebhookSignature)
+			return
+		}
+
+		c.Next()
+		return
+	}
+
+	if !equalSecret(secret, c.GetHeader(WebhookSecretHeader)) {
+		reject(c, ErrInvalidWebhookSignature)
+		return
+	}
+
+	c.Next()
+}
+
+// VerifyBasecamp a middleware to verify basecamp webhooks by the shared secret,
+// basecamp can't sign requests so the secret is part of the registered webhook url
+func (m *WebhookMiddleware) VerifyBasecamp(c *gin.Context) {
+	if m.cfg == nil {
+		c.Next()
+		return
+	}
+
+	secret := m.cfg.Webhook.BasecampSecret
+	if secret == "" {
+		m.secretNotConfigured(c, m.cfg.Webhook.BasecampReportOnly)
+		return
+	}
+
+	token := c.GetHeader(WebhookSecretHeader)
+	if token == "" {
+		token = c.Query(webhookTokenQuery)
+	}
+
+	if !equalSecret(secret, token) {
+		reject(c, ErrInvalidWebhookSignature)
+		return
+	}
+
+	c.Next()
+}
+
+// secretNotConfigured rejects the webhooks of a sender without a secret. A sender in report only mode
+// is logged and goes through, so its secret can be rolled out without dropping its webhooks
+func (m *WebhookMiddleware) secretNotConfigured(c *gin.Context, reportOnly bool) {
+	if !reportOnly {
+		reject(c, ErrWebhookSecretNotConfigured)
+		return
+	}
+
+	logger.L.Fields(logger.Fields{
+		"middleware": "webhook",
+		"path":       c.FullPath(),
+	}).Warnf("unverified webhook is accepted: %v", ErrWebhookSecretNotConfigured)
+
+	c.Next()
+}
+
+func reject(c *gin.Context, err error) {
+	c.AbortWithStatusJSON(http.StatusUnauthorized, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
+
+func validSignature(secret string, body []byte, signature string) bool {
+	expected, err := hex.DecodeString(strings.TrimPrefix(signature, webhookSignaturePrefix))
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+func equalSecret(secret string, value string) bool {
+	return value != "" && subtle.ConstantTimeCompare([]byte(secret), []byte(value)) == 1
+}
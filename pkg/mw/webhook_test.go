@@ -0,0 +1,197 @@
+package mw
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+)
+
+func sign(secret string, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return webhookSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerifyN8n(t *testing.T) {
+	// unverified webhooks are logged with the global logger
+	logger.NewLogrusLogger()
+
+	const (
+		secret = "n8n-secret"
+		body   = `{"type":"leave"}`
+	)
+
+	tcs := map[string]struct {
+		cfg              config.Webhook
+		headers          map[string]string
+		expectedHTTPCode int
+		expectedError    error
+	}{
+		"valid signature": {
+			cfg:              config.Webhook{N8nSecret: secret},
+			headers:          map[string]string{WebhookSignatureHeader: sign(secret, body)},
+			expectedHTTPCode: http.StatusOK,
+		},
+		"signature of another body": {
+			cfg:              config.Webhook{N8nSecret: secret},
+			headers:          map[string]string{WebhookSignatureHeader: sign(secret, `{"type":"other"}`)},
+			expectedHTTPCode: http.StatusUnauthorized,
+			expectedError:    ErrInvalidWebhookSignature,
+		},
+		"signature with another secret": {
+			cfg:              config.Webhook{N8nSecret: secret},
+			headers:          map[string]string{WebhookSignatureHeader: sign("another-secret", body)},
+			expectedHTTPCode: http.StatusUnauthorized,
+			expectedError:    ErrInvalidWebhookSignature,
+		},
+		"valid shared secret": {
+			cfg:              config.Webhook{N8nSecret: secret},
+			headers:          map[string]string{WebhookSecretHeader: secret},
+			expectedHTTPCode: http.StatusOK,
+		},
+		"invalid shared secret": {
+			cfg:              config.Webhook{N8nSecret: secret},
+			headers:          map[string]string{WebhookSecretHeader: "wrong"},
+			expectedHTTPCode: http.StatusUnauthorized,
+			expectedError:    ErrInvalidWebhookSignature,
+		},
+		"no credentials": {
+			cfg:              config.Webhook{N8nSecret: secret},
+			expectedHTTPCode: http.StatusUnauthorized,
+			expectedError:    ErrInvalidWebhookSignature,
+		},
+		"secret not configured": {
+			cfg:              config.Webhook{},
+			expectedHTTPCode: http.StatusUnauthorized,
+			expectedError:    ErrWebhookSecretNotConfigured,
+		},
+		"invalid shared secret in report only mode": {
+			cfg:              config.Webhook{N8nSecret: secret, N8nReportOnly: true},
+			headers:          map[string]string{WebhookSecretHeader: "wrong"},
+			expectedHTTPCode: http.StatusUnauthorized,
+			expectedError:    ErrInvalidWebhookSignature,
+		},
+		"secret not configured in report only mode": {
+			cfg:              config.Webhook{N8nReportOnly: true},
+			expectedHTTPCode: http.StatusOK,
+		},
+		"secret not configured in report only mode of another sender": {
+			cfg:              config.Webhook{BasecampReportOnly: true},
+			expectedHTTPCode: http.StatusUnauthorized,
+			expectedError:    ErrWebhookSecretNotConfigured,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			wmw := NewWebhookMiddleware(&config.Config{Webhook: tc.cfg})
+
+			r := gin.New()
+			r.POST("/webhooks/n8n", wmw.VerifyN8n, func(c *gin.Context) {
+				// the handler still gets the whole body
+				b, _ := io.ReadAll(c.Request.Body)
+				require.Equal(t, body, string(b))
+				c.JSON(http.StatusOK, nil)
+			})
+
+			req, _ := http.NewRequest(http.MethodPost, "/webhooks/n8n", bytes.NewBufferString(body))
+			for k, v := range tc.headers {
+				req.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedHTTPCode, w.Code)
+			if tc.expectedError != nil {
+				require.Contains(t, w.Body.String(), tc.expectedError.Error())
+			}
+		})
+	}
+}
+
+func TestVerifyBasecamp(t *testing.T) {
+	logger.NewLogrusLogger()
+
+	const secret = "basecamp-secret"
+
+	tcs := map[string]struct {
+		cfg              config.Webhook
+		url              string
+		headers          map[string]string
+		expectedHTTPCode int
+		expectedError    error
+	}{
+		"valid token in the url": {
+			cfg:              config.Webhook{BasecampSecret: secret},
+			url:              "/webhooks/basecamp/expense?token=" + secret,
+			expectedHTTPCode: http.StatusOK,
+		},
+		"valid shared secret header": {
+			cfg:              config.Webhook{BasecampSecret: secret},
+			url:              "/webhooks/basecamp/expense",
+			headers:          map[string]string{WebhookSecretHeader: secret},
+			expectedHTTPCode: http.StatusOK,
+		},
+		"invalid token in the url": {
+			cfg:              config.Webhook{BasecampSecret: secret},
+			url:              "/webhooks/basecamp/expense?token=wrong",
+			expectedHTTPCode: http.StatusUnauthorized,
+			expectedError:    ErrInvalidWebhookSignature,
+		},
+		"no token": {
+			cfg:              config.Webhook{BasecampSecret: secret},
+			url:              "/webhooks/basecamp/expense",
+			expectedHTTPCode: http.StatusUnauthorized,
+			expectedError:    ErrInvalidWebhookSignature,
+		},
+		"secret not configured": {
+			cfg:              config.Webhook{},
+			url:              "/webhooks/basecamp/expense?token=" + secret,
+			expectedHTTPCode: http.StatusUnauthorized,
+			expectedError:    ErrWebhookSecretNotConfigured,
+		},
+		"no token in report only mode": {
+			cfg:              config.Webhook{BasecampSecret: secret, BasecampReportOnly: true},
+			url:              "/webhooks/basecamp/expense",
+			expectedHTTPCode: http.StatusUnauthorized,
+			expectedError:    ErrInvalidWebhookSignature,
+		},
+		"secret not configured in report only mode": {
+			cfg:              config.Webhook{BasecampReportOnly: true},
+			url:              "/webhooks/basecamp/expense",
+			expectedHTTPCode: http.StatusOK,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			wmw := NewWebhookMiddleware(&config.Config{Webhook: tc.cfg})
+
+			r := gin.New()
+			r.POST("/webhooks/basecamp/expense", wmw.VerifyBasecamp, func(c *gin.Context) {
+				c.JSON(http.StatusOK, nil)
+			})
+
+			req, _ := http.NewRequest(http.MethodPost, tc.url, bytes.NewBufferString(`{}`))
+			for k, v := range tc.headers {
+				req.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedHTTPCode, w.Code)
+			if tc.expectedError != nil {
+				require.Contains(t, w.Body.String(), tc.expectedError.Error())
+			}
+		})
+	}
+}
@@ -29,13 +29,28 @@ func setupCORS(r *gin.Engine, cfg *config.Config) {
 				AllowHeaders: []string{
 					"Origin", "Host", "Content-Type", "Content-Length", "Accept-Encoding", "Accept-Language", "Accept",
 					"X-CSRF-Token", "Authorization", "X-Requested-With", "X-Access-Token",
+					"X-Request-ID",
 				},
+				ExposeHeaders:    []string{"X-Request-ID", "X-RateLimit-Limit", "X-RateLimit-Remaining", "X-RateLimit-Reset", "Retry-After"},
 				AllowCredentials: true,
 			},
 		)(c)
 	})
 }
 
+// setupTrustedProxies makes the client ip come from X-Forwarded-For only when the request is sent by a trusted proxy,
+// otherwise anyone could pick their own ip and get around the rate limits
+func setupTrustedProxies(r *gin.Engine, cfg *config.Config) error {
+	var proxies []string
+	for _, p := range strings.Split(cfg.ApiServer.TrustedProxies, ";") {
+		if p = strings.TrimSpace(p); p != "" {
+			proxies = append(proxies, p)
+		}
+	}
+
+	return r.SetTrustedProxies(proxies)
+}
+
 func NewRoutes(cfg *config.Config, svc *service.Service, s *store.Store, repo store.DBRepo, worker *worker.Worker, logger logger.Logger) *gin.Engine {
 	// programmatically set swagger info
 	docs.SwaggerInfo.Title = "Swagger API"
@@ -43,6 +58,9 @@ func NewRoutes(cfg *config.Config, svc *service.Service, s *store.Store, repo st
 	docs.SwaggerInfo.Version = "1.0"
 	docs.SwaggerInfo.Schemes = []string{"https", "http"}
 	r := gin.New()
+	if err := setupTrustedProxies(r, cfg); err != nil {
+		logger.Fatal(err, "invalid trusted proxies")
+	}
 	pprof.Register(r)
 
 	ctrl := controller.New(s, repo, svc, worker, logger, cfg)
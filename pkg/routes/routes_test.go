@@ -0,0 +1,67 @@
+package routes
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/mw"
+)
+
+func TestSetupTrustedProxies(t *testing.T) {
+	tcs := map[string]struct {
+		trustedProxies string
+		remoteAddr     string
+		// forwardedFor is sent with each request in turn
+		forwardedFor []string
+		wantCodes    []int
+	}{
+		"spoofed X-Forwarded-For does not get around the limit": {
+			remoteAddr:   "203.0.113.7:5000",
+			forwardedFor: []string{"198.51.100.1", "198.51.100.2"},
+			wantCodes:    []int{http.StatusOK, http.StatusTooManyRequests},
+		},
+		"X-Forwarded-For from an untrusted proxy does not get around the limit": {
+			trustedProxies: "10.0.0.0/8",
+			remoteAddr:     "203.0.113.7:5000",
+			forwardedFor:   []string{"198.51.100.1", "198.51.100.2"},
+			wantCodes:      []int{http.StatusOK, http.StatusTooManyRequests},
+		},
+		"X-Forwarded-For from a trusted proxy limits each client": {
+			trustedProxies: "10.0.0.0/8; 192.168.1.1",
+			remoteAddr:     "10.1.2.3:5000",
+			forwardedFor:   []string{"198.51.100.1", "198.51.100.2", "198.51.100.1"},
+			wantCodes:      []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests},
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			cfg := &config.Config{
+				ApiServer: config.ApiServer{TrustedProxies: tc.trustedProxies},
+				RateLimit: config.RateLimit{Auth: "1/1m"},
+			}
+
+			r := gin.New()
+			require.NoError(t, setupTrustedProxies(r, cfg))
+
+			rlw := mw.NewRateLimitMiddleware(cfg, nil, nil)
+			r.POST("/api/v1/auth", rlw.WithRateLimit(mw.RateLimitGroupAuth, mw.KeyByIP), func(c *gin.Context) {
+				c.JSON(http.StatusOK, nil)
+			})
+
+			for i, ip := range tc.forwardedFor {
+				req, _ := http.NewRequest(http.MethodPost, "/api/v1/auth", nil)
+				req.RemoteAddr = tc.remoteAddr
+				req.Header.Set("X-Forwarded-For", ip)
+				w := httptest.NewRecorder()
+				r.ServeHTTP(w, req)
+
+				require.Equal(t, tc.wantCodes[i], w.Code, "request %d", i)
+			}
+		})
+	}
+}
@@ -13,11 +13,15 @@ import (
 func loadV1Routes(r *gin.Engine, h *handler.Handler, repo store.DBRepo, s *store.Store, cfg *config.Config) {
 	pmw := mw.NewPermissionMiddleware(s, repo, cfg)
 	amw := mw.NewAuthMiddleware(cfg, s, repo)
+	alw := mw.NewAuditLogMiddleware(cfg, s, repo)
+	rlw := mw.NewRateLimitMiddleware(cfg, s, repo)
+	wmw := mw.NewWebhookMiddleware(cfg)
+	cpmw := mw.NewClientPortalMiddleware(cfg, s, repo)
 
 	/////////////////
 	// Cronjob GROUP
 	/////////////////
-	cronjob := r.Group("/cronjobs")
+	cronjob := r.Group("/cronjobs", alw.WithAuditLog)
 	{
 		cronjob.POST("/audits", amw.WithAuth, pmw.WithPerm(model.PermissionCronjobExecute), h.Audit.Sync)
 		cronjob.POST("/birthday", amw.WithAuth, pmw.WithPerm(model.PermissionCronjobExecute), h.Discord.BirthdayDailyMessage)
@@ -28,16 +32,37 @@ func loadV1Routes(r *gin.Engine, h *handler.Handler, repo store.DBRepo, s *store
 		cronjob.POST("/store-vault-transaction", amw.WithAuth, pmw.WithPerm(model.PermissionCronjobExecute), h.Vault.StoreVaultTransaction)
 		cronjob.POST("/index-engagement-messages", amw.WithAuth, pmw.WithPerm(model.PermissionCronjobExecute), h.Engagement.IndexMessages)
 		cronjob.POST("/brainery-reports", amw.WithAuth, pmw.WithPerm(model.PermissionCronjobExecute), h.Discord.ReportBraineryMetrics)
+		cronjob.POST("/expire-approval-requests", amw.WithAuth, pmw.WithPerm(model.PermissionCronjobExecute), h.Approval.ExpireOverdue)
+		cronjob.POST("/reencrypt-employee-pii", amw.WithAuth, pmw.WithPerm(model.PermissionCronjobExecute), h.Employee.ReencryptPII)
+		cronjob.POST("/survey-reminders", amw.WithAuth, pmw.WithPerm(model.PermissionCronjobExecute), h.Survey.SendReminders)
+		cronjob.POST("/survey-digests", amw.WithAuth, pmw.WithPerm(model.PermissionCronjobExecute), h.Survey.SendDigests)
+		cronjob.POST("/close-overdue-surveys", amw.WithAuth, pmw.WithPerm(model.PermissionCronjobExecute), h.Survey.CloseOverdue)
+		cronjob.POST("/survey-campaigns", amw.WithAuth, pmw.WithPerm(model.PermissionCronjobExecute), h.Survey.RunCampaigns)
+		cronjob.POST("/objective-check-in-reminders", amw.WithAuth, pmw.WithPerm(model.PermissionCronjobExecute), h.Objective.SendCheckInReminders)
+		cronjob.POST("/budget-burn-alerts", amw.WithAuth, pmw.WithPerm(model.PermissionCronjobExecute), h.Budget.SendBurnAlerts)
+		cronjob.POST("/apply-member-rates", amw.WithAuth, pmw.WithPerm(model.PermissionCronjobExecute), h.RateCard.ApplyMemberRates)
+		cronjob.POST("/project-health-scores", amw.WithAuth, pmw.WithPerm(model.PermissionCronjobExecute), h.ProjectHealth.ComputeWeeklyScores)
+		cronjob.POST("/sync-github-work-units", amw.WithAuth, pmw.WithPerm(model.PermissionCronjobExecute), h.Project.SyncGithubWorkUnits)
 	}
 
 	/////////////////
 	// Webhook GROUP
 	/////////////////
+	// webhooks are verified before anything else reads their body, the audit log included
 	webhook := r.Group("/webhooks")
 	{
-		webhook.POST("/n8n", h.Webhook.N8n)
+		webhook.POST("/n8n",
+			rlw.WithRateLimit(mw.RateLimitGroupWebhook, mw.KeyByRouteGroup("n8n")),
+			wmw.VerifyN8n,
+			alw.WithAuditLog,
+			h.Webhook.N8n,
+		)
 
-		basecampGroup := webhook.Group("/basecamp")
+		basecampGroup := webhook.Group("/basecamp",
+			rlw.WithRateLimit(mw.RateLimitGroupWebhook, mw.KeyByRouteGroup("basecamp")),
+			wmw.VerifyBasecamp,
+			alw.WithAuditLog,
+		)
 		{
 			expenseGroup := basecampGroup.Group("/expense")
 			{
@@ -61,7 +86,7 @@ func loadV1Routes(r *gin.Engine, h *handler.Handler, repo store.DBRepo, s *store
 	/////////////////
 	// API GROUP
 	/////////////////
-	v1 := r.Group("/api/v1")
+	v1 := r.Group("/api/v1", alw.WithAuditLog)
 
 	// assets
 	assetGroup := v1.Group("/assets")
@@ -75,11 +100,18 @@ func loadV1Routes(r *gin.Engine, h *handler.Handler, repo store.DBRepo, s *store
 	}
 
 	// auth
+	// only logins and credential changes share the login limit, session reads are not limited
+	authLimit := rlw.WithRateLimit(mw.RateLimitGroupAuth, mw.KeyByIP)
 	authRoute := v1.Group("/auth")
 	{
-		authRoute.POST("", h.Auth.Auth)
+		authRoute.POST("", authLimit, h.Auth.Auth)
 		authRoute.GET("/me", amw.WithAuth, pmw.WithPerm(model.PermissionAuthRead), h.Auth.Me)
-		authRoute.POST("/api-key", amw.WithAuth, pmw.WithPerm(model.PermissionAuthCreate), h.Auth.CreateAPIKey)
+		authRoute.POST("/api-key", authLimit, amw.WithAuth, pmw.WithPerm(model.PermissionAuthCreate), h.Auth.CreateAPIKey)
+		authRoute.GET("/providers", authLimit, h.Auth.Providers)
+		authRoute.POST("/magic-link", authLimit, h.Auth.RequestMagicLink)
+		authRoute.GET("/identities", amw.WithAuth, pmw.WithPerm(model.PermissionAuthRead), h.Auth.ListIdentities)
+		authRoute.POST("/identities", authLimit, amw.WithAuth, pmw.WithPerm(model.PermissionAuthRead), h.Auth.LinkIdentity)
+		authRoute.DELETE("/identities/:id", authLimit, amw.WithAuth, pmw.WithPerm(model.PermissionAuthRead), h.Auth.UnlinkIdentity)
 	}
 
 	// user profile
@@ -104,6 +136,8 @@ func loadV1Routes(r *gin.Engine, h *handler.Handler, repo store.DBRepo, s *store
 		employeeRoute.POST("/:id/upload-avatar", amw.WithAuth, pmw.WithPerm(model.PermissionEmployeesEdit), h.Employee.UploadAvatar)
 		employeeRoute.PUT("/:id/roles", amw.WithAuth, pmw.WithPerm(model.PermissionEmployeeRolesEdit), h.Employee.UpdateRole)
 		employeeRoute.PUT("/:id/base-salary", amw.WithAuth, pmw.WithPerm(model.PermissionEmployeesBaseSalaryEdit), h.Employee.UpdateBaseSalary)
+		employeeRoute.GET("/:id/performance-reviews", amw.WithAuth, h.PerformanceReview.EmployeeHistory)
+		employeeRoute.GET("/:id/objectives", amw.WithAuth, h.Objective.EmployeeGoals)
 	}
 
 	// metadata
@@ -138,10 +172,24 @@ func loadV1Routes(r *gin.Engine, h *handler.Handler, repo store.DBRepo, s *store
 		projectGroup.PUT("/:id/sending-survey-state", amw.WithAuth, pmw.WithPerm(model.PermissionProjectsEdit), h.Project.UpdateSendingSurveyState)
 		projectGroup.POST("/:id/upload-avatar", amw.WithAuth, pmw.WithPerm(model.PermissionProjectsEdit), h.Project.UploadAvatar)
 		projectGroup.PUT("/:id/status", amw.WithAuth, pmw.WithPerm(model.PermissionProjectsEdit), h.Project.UpdateProjectStatus)
+		projectGroup.GET("/:id/status-transitions", amw.WithAuth, pmw.WithPerm(model.PermissionProjectsRead), h.Project.GetStatusTransitions)
 		projectGroup.POST("/:id/members", amw.WithAuth, pmw.WithPerm(model.PermissionProjectMembersCreate), h.Project.AssignMember)
 		projectGroup.GET("/:id/members", amw.WithAuth, pmw.WithPerm(model.PermissionProjectMembersRead), h.Project.GetMembers)
 		projectGroup.PUT("/:id/members", amw.WithAuth, pmw.WithPerm(model.PermissionProjectMembersEdit), h.Project.UpdateMember)
 		projectGroup.DELETE("/:id/members/:memberID", amw.WithAuth, pmw.WithPerm(model.PermissionProjectMembersDelete), h.Project.DeleteMember)
+		projectGroup.GET("/:id/budget", amw.WithAuth, pmw.WithPerm(model.PermissionProjectsBudgetsRead), h.Budget.Get)
+		projectGroup.PUT("/:id/budget", amw.WithAuth, pmw.WithPerm(model.PermissionProjectsBudgetsEdit), h.Budget.Upsert)
+		projectGroup.POST("/:id/budget/phases", amw.WithAuth, pmw.WithPerm(model.PermissionProjectsBudgetsEdit), h.Budget.CreatePhase)
+		projectGroup.PUT("/:id/budget/phases/:phaseID", amw.WithAuth, pmw.WithPerm(model.PermissionProjectsBudgetsEdit), h.Budget.UpdatePhase)
+		projectGroup.DELETE("/:id/budget/phases/:phaseID", amw.WithAuth, pmw.WithPerm(model.PermissionProjectsBudgetsEdit), h.Budget.DeletePhase)
+		projectGroup.GET("/:id/profit-loss", amw.WithAuth, pmw.WithPerm(model.PermissionProjectsProfitLossRead), h.ProfitLoss.GetProjectProfitLoss)
+		projectGroup.GET("/:id/health", amw.WithAuth, pmw.WithPerm(model.PermissionProjectHealthRead), h.ProjectHealth.GetProjectHealth)
+		projectGroup.GET("/:id/members/:memberID/allocations", amw.WithAuth, pmw.WithPerm(model.PermissionProjectMembersRead), h.Allocation.List)
+		projectGroup.POST("/:id/members/:memberID/allocations", amw.WithAuth, pmw.WithPerm(model.PermissionProjectMembersEdit), h.Allocation.Create)
+		projectGroup.DELETE("/:id/members/:memberID/allocations/:allocationID", amw.WithAuth, pmw.WithPerm(model.PermissionProjectMembersEdit), h.Allocation.Delete)
+		projectGroup.GET("/:id/members/:memberID/rates", amw.WithAuth, pmw.WithPerm(model.PermissionProjectMembersRateRead), h.RateCard.ListMemberRates)
+		projectGroup.POST("/:id/members/:memberID/rates", amw.WithAuth, pmw.WithPerm(model.PermissionProjectMembersRateEdit), h.RateCard.ChangeMemberRate)
+		projectGroup.DELETE("/:id/members/:memberID/rates/:rateID", amw.WithAuth, pmw.WithPerm(model.PermissionProjectMembersRateEdit), h.RateCard.DeleteMemberRate)
 		projectGroup.DELETE("/:id/slots/:slotID", amw.WithAuth, pmw.WithPerm(model.PermissionProjectMembersDelete), h.Project.DeleteSlot)
 		projectGroup.PUT("/:id/general-info", amw.WithAuth, pmw.WithPerm(model.PermissionProjectsEdit), h.Project.UpdateGeneralInfo)
 		projectGroup.PUT("/:id/contact-info", amw.WithAuth, pmw.WithPerm(model.PermissionProjectsEdit), h.Project.UpdateContactInfo)
@@ -150,6 +198,9 @@ func loadV1Routes(r *gin.Engine, h *handler.Handler, repo store.DBRepo, s *store
 		projectGroup.PUT("/:id/work-units/:workUnitID", amw.WithAuth, pmw.WithPerm(model.PermissionProjectWorkUnitsEdit), h.Project.UpdateWorkUnit)
 		projectGroup.PUT("/:id/work-units/:workUnitID/archive", amw.WithAuth, pmw.WithPerm(model.PermissionProjectWorkUnitsEdit), h.Project.ArchiveWorkUnit)
 		projectGroup.PUT("/:id/work-units/:workUnitID/unarchive", amw.WithAuth, pmw.WithPerm(model.PermissionProjectWorkUnitsEdit), h.Project.UnarchiveWorkUnit)
+		projectGroup.PUT("/:id/work-units/:workUnitID/github", amw.WithAuth, pmw.WithPerm(model.PermissionProjectWorkUnitsEdit), h.Project.LinkWorkUnitGithub)
+		projectGroup.DELETE("/:id/work-units/:workUnitID/github", amw.WithAuth, pmw.WithPerm(model.PermissionProjectWorkUnitsEdit), h.Project.UnlinkWorkUnitGithub)
+		projectGroup.POST("/:id/work-units/:workUnitID/github/sync", amw.WithAuth, pmw.WithPerm(model.PermissionProjectWorkUnitsEdit), h.Project.SyncWorkUnitGithub)
 		projectGroup.GET("/icy-distribution/weekly", amw.WithAuth, pmw.WithPerm(model.PermissionIcyDistributionRead), h.Project.IcyWeeklyDistribution)
 	}
 
@@ -160,6 +211,8 @@ func loadV1Routes(r *gin.Engine, h *handler.Handler, repo store.DBRepo, s *store
 		clientGroup.GET("/:id", amw.WithAuth, pmw.WithPerm(model.PermissionClientEdit), h.Client.Detail)
 		clientGroup.PUT("/:id", amw.WithAuth, pmw.WithPerm(model.PermissionClientRead), h.Client.Update)
 		clientGroup.DELETE("/:id", amw.WithAuth, pmw.WithPerm(model.PermissionClientDelete), h.Client.Delete)
+		clientGroup.POST("/:id/contacts/:contactID/portal-access", amw.WithAuth, pmw.WithPerm(model.PermissionClientEdit), h.Client.IssuePortalAccess)
+		clientGroup.DELETE("/:id/contacts/:contactID/portal-access", amw.WithAuth, pmw.WithPerm(model.PermissionClientEdit), h.Client.RevokePortalAccess)
 	}
 
 	feedbackGroup := v1.Group("/feedbacks")
@@ -168,6 +221,9 @@ func loadV1Routes(r *gin.Engine, h *handler.Handler, repo store.DBRepo, s *store
 		feedbackGroup.GET("/:id/topics/:topicID", amw.WithAuth, pmw.WithPerm(model.PermissionFeedbacksRead), h.Feedback.Detail)
 		feedbackGroup.POST("/:id/topics/:topicID/submit", amw.WithAuth, pmw.WithPerm(model.PermissionFeedbacksCreate), h.Feedback.Submit)
 		feedbackGroup.GET("/unreads", amw.WithAuth, pmw.WithPerm(model.PermissionFeedbacksRead), h.Feedback.CountUnreadFeedback)
+		feedbackGroup.POST("", amw.WithAuth, pmw.WithPerm(model.PermissionFeedbacksCreate), h.Feedback.Send)
+		feedbackGroup.GET("/kudos", amw.WithAuth, pmw.WithPerm(model.PermissionFeedbacksRead), h.Feedback.ListKudos)
+		feedbackGroup.GET("/employees/:employeeID", amw.WithAuth, pmw.WithPerm(model.PermissionFeedbacksRead), h.Feedback.ListEmployeeFeedback)
 	}
 
 	surveyGroup := v1.Group("/surveys")
@@ -175,16 +231,120 @@ func loadV1Routes(r *gin.Engine, h *handler.Handler, repo store.DBRepo, s *store
 		surveyGroup.POST("", amw.WithAuth, pmw.WithPerm(model.PermissionSurveysCreate), h.Survey.CreateSurvey)
 		surveyGroup.GET("", amw.WithAuth, pmw.WithPerm(model.PermissionSurveysRead), h.Survey.ListSurvey)
 		surveyGroup.GET("/:id", amw.WithAuth, pmw.WithPerm(model.PermissionSurveysRead), h.Survey.GetSurveyDetail)
+		surveyGroup.GET("/:id/responses", amw.WithAuth, pmw.WithPerm(model.PermissionEmployeeEventQuestionsRead), h.Survey.ListSurveyResponses)
+		surveyGroup.GET("/:id/export", amw.WithAuth, pmw.WithPerm(model.PermissionSurveysRead), h.Survey.ExportSurvey)
+		surveyGroup.GET("/:id/employees/:employeeID/review-packet", amw.WithAuth, h.Survey.ExportReviewPacket)
 		surveyGroup.DELETE("/:id", amw.WithAuth, pmw.WithPerm(model.PermissionSurveysDelete), h.Survey.DeleteSurvey)
 		surveyGroup.POST("/:id/send", amw.WithAuth, pmw.WithPerm(model.PermissionSurveysCreate), h.Survey.SendSurvey)
 		surveyGroup.GET("/:id/topics/:topicID/reviews/:reviewID", amw.WithAuth, pmw.WithPerm(model.PermissionEmployeeEventQuestionsRead), h.Survey.GetSurveyReviewDetail)
 		surveyGroup.DELETE("/:id/topics/:topicID", amw.WithAuth, pmw.WithPerm(model.PermissionSurveysDelete), h.Survey.DeleteSurveyTopic)
 		surveyGroup.GET("/:id/topics/:topicID", amw.WithAuth, pmw.WithPerm(model.PermissionSurveysRead), h.Survey.GetSurveyTopicDetail)
+		surveyGroup.GET("/:id/topics/:topicID/export", amw.WithAuth, pmw.WithPerm(model.PermissionSurveysRead), h.Survey.ExportSurveyTopic)
 		surveyGroup.PUT("/:id/topics/:topicID/employees", amw.WithAuth, pmw.WithPerm(model.PermissionSurveysEdit), h.Survey.UpdateTopicReviewers)
 		surveyGroup.PUT("/:id/done", amw.WithAuth, pmw.WithPerm(model.PermissionSurveysEdit), h.Survey.MarkDone)
+		surveyGroup.PUT("/:id/schedule", amw.WithAuth, pmw.WithPerm(model.PermissionSurveysEdit), h.Survey.UpdateSchedule)
 		surveyGroup.DELETE("/:id/topics/:topicID/employees", amw.WithAuth, pmw.WithPerm(model.PermissionSurveysEdit), h.Survey.DeleteTopicReviewers)
 	}
 
+	surveyTemplateGroup := v1.Group("/survey-templates")
+	{
+		surveyTemplateGroup.GET("", amw.WithAuth, pmw.WithPerm(model.PermissionSurveysRead), h.SurveyTemplate.List)
+		surveyTemplateGroup.POST("", amw.WithAuth, pmw.WithPerm(model.PermissionSurveysCreate), h.SurveyTemplate.Create)
+		surveyTemplateGroup.GET("/:id", amw.WithAuth, pmw.WithPerm(model.PermissionSurveysRead), h.SurveyTemplate.Detail)
+		surveyTemplateGroup.POST("/:id/versions", amw.WithAuth, pmw.WithPerm(model.PermissionSurveysCreate), h.SurveyTemplate.CreateVersion)
+		surveyTemplateGroup.GET("/:id/versions/:version", amw.WithAuth, pmw.WithPerm(model.PermissionSurveysRead), h.SurveyTemplate.GetVersion)
+	}
+
+	surveyCampaignGroup := v1.Group("/survey-campaigns")
+	{
+		surveyCampaignGroup.GET("", amw.WithAuth, pmw.WithPerm(model.PermissionSurveysRead), h.SurveyCampaign.List)
+		surveyCampaignGroup.POST("", amw.WithAuth, pmw.WithPerm(model.PermissionSurveysCreate), h.SurveyCampaign.Create)
+		surveyCampaignGroup.GET("/:id", amw.WithAuth, pmw.WithPerm(model.PermissionSurveysRead), h.SurveyCampaign.Detail)
+		surveyCampaignGroup.PUT("/:id", amw.WithAuth, pmw.WithPerm(model.PermissionSurveysEdit), h.SurveyCampaign.Update)
+		surveyCampaignGroup.GET("/:id/runs", amw.WithAuth, pmw.WithPerm(model.PermissionSurveysRead), h.SurveyCampaign.ListRuns)
+	}
+
+	performanceReviewCycleGroup := v1.Group("/performance-review-cycles")
+	{
+		performanceReviewCycleGroup.GET("", amw.WithAuth, pmw.WithPerm(model.PermissionPerformanceReviewsRead), h.PerformanceReview.List)
+		performanceReviewCycleGroup.POST("", amw.WithAuth, pmw.WithPerm(model.PermissionPerformanceReviewsCreate), h.PerformanceReview.Create)
+		performanceReviewCycleGroup.GET("/:id", amw.WithAuth, pmw.WithPerm(model.PermissionPerformanceReviewsRead), h.PerformanceReview.Detail)
+		performanceReviewCycleGroup.PUT("/:id/status", amw.WithAuth, pmw.WithPerm(model.PermissionPerformanceReviewsEdit), h.PerformanceReview.UpdateStatus)
+		performanceReviewCycleGroup.GET("/:id/reviews", amw.WithAuth, pmw.WithPerm(model.PermissionPerformanceReviewsRead), h.PerformanceReview.ListReviews)
+	}
+
+	performanceReviewGroup := v1.Group("/performance-reviews")
+	{
+		performanceReviewGroup.GET("/:id", amw.WithAuth, h.PerformanceReview.Packet)
+		performanceReviewGroup.PUT("/:id/assessment", amw.WithAuth, h.PerformanceReview.SubmitAssessment)
+		performanceReviewGroup.PUT("/:id/calibration", amw.WithAuth, pmw.WithPerm(model.PermissionPerformanceReviewsEdit), h.PerformanceReview.Calibrate)
+	}
+
+	objectiveGroup := v1.Group("/objectives")
+	{
+		objectiveGroup.GET("", amw.WithAuth, h.Objective.List)
+		objectiveGroup.POST("", amw.WithAuth, h.Objective.Create)
+		objectiveGroup.GET("/:id", amw.WithAuth, h.Objective.Detail)
+		objectiveGroup.PUT("/:id", amw.WithAuth, h.Objective.Update)
+		objectiveGroup.POST("/:id/key-results", amw.WithAuth, h.Objective.CreateKeyResult)
+		objectiveGroup.PUT("/:id/key-results/:keyResultID", amw.WithAuth, h.Objective.UpdateKeyResult)
+		objectiveGroup.GET("/:id/key-results/:keyResultID/check-ins", amw.WithAuth, h.Objective.ListCheckIns)
+		objectiveGroup.POST("/:id/key-results/:keyResultID/check-ins", amw.WithAuth, h.Objective.CheckIn)
+	}
+
+	oneOnOneGroup := v1.Group("/one-on-ones")
+	{
+		oneOnOneGroup.GET("", amw.WithAuth, h.OneOnOne.List)
+		oneOnOneGroup.POST("", amw.WithAuth, h.OneOnOne.Create)
+		oneOnOneGroup.GET("/:id", amw.WithAuth, h.OneOnOne.Detail)
+		oneOnOneGroup.PUT("/:id", amw.WithAuth, h.OneOnOne.Update)
+		oneOnOneGroup.PUT("/:id/manager-note", amw.WithAuth, h.OneOnOne.UpdateManagerNote)
+		oneOnOneGroup.POST("/:id/agenda-items", amw.WithAuth, h.OneOnOne.CreateAgendaItem)
+		oneOnOneGroup.PUT("/:id/agenda-items/:itemID", amw.WithAuth, h.OneOnOne.UpdateAgendaItem)
+		oneOnOneGroup.POST("/:id/action-items", amw.WithAuth, h.OneOnOne.CreateActionItem)
+		oneOnOneGroup.PUT("/:id/action-items/:itemID", amw.WithAuth, h.OneOnOne.UpdateActionItem)
+	}
+
+	timesheetGroup := v1.Group("/timesheets")
+	{
+		timesheetGroup.GET("", amw.WithAuth, h.Timesheet.List)
+		timesheetGroup.POST("/entries", amw.WithAuth, h.Timesheet.Log)
+		timesheetGroup.POST("/import", amw.WithAuth, h.Timesheet.Import)
+		timesheetGroup.GET("/:id", amw.WithAuth, h.Timesheet.Detail)
+		timesheetGroup.POST("/:id/submit", amw.WithAuth, h.Timesheet.Submit)
+		timesheetGroup.PUT("/:id/review", amw.WithAuth, h.Timesheet.Review)
+		timesheetGroup.PUT("/:id/entries/:entryID", amw.WithAuth, h.Timesheet.UpdateEntry)
+		timesheetGroup.DELETE("/:id/entries/:entryID", amw.WithAuth, h.Timesheet.DeleteEntry)
+	}
+
+	staffingRequestGroup := v1.Group("/staffing-requests")
+	{
+		staffingRequestGroup.GET("", amw.WithAuth, pmw.WithPerm(model.PermissionStaffingRequestsRead), h.Staffing.List)
+		staffingRequestGroup.POST("", amw.WithAuth, pmw.WithPerm(model.PermissionStaffingRequestsEdit), h.Staffing.Create)
+		staffingRequestGroup.GET("/:id", amw.WithAuth, pmw.WithPerm(model.PermissionStaffingRequestsRead), h.Staffing.Detail)
+		staffingRequestGroup.POST("/:id/propose", amw.WithAuth, pmw.WithPerm(model.PermissionStaffingRequestsEdit), h.Staffing.Propose)
+		staffingRequestGroup.PUT("/:id/candidates/:candidateID/status", amw.WithAuth, pmw.WithPerm(model.PermissionStaffingRequestsEdit), h.Staffing.UpdateCandidateStatus)
+		staffingRequestGroup.POST("/:id/fill", amw.WithAuth, pmw.WithPerm(model.PermissionStaffingRequestsEdit), h.Staffing.Fill)
+		staffingRequestGroup.POST("/:id/cancel", amw.WithAuth, pmw.WithPerm(model.PermissionStaffingRequestsEdit), h.Staffing.Cancel)
+	}
+
+	rateCardGroup := v1.Group("/rate-cards")
+	{
+		rateCardGroup.GET("", amw.WithAuth, pmw.WithPerm(model.PermissionRateCardsRead), h.RateCard.List)
+		rateCardGroup.POST("", amw.WithAuth, pmw.WithPerm(model.PermissionRateCardsEdit), h.RateCard.Create)
+		rateCardGroup.GET("/renewals", amw.WithAuth, pmw.WithPerm(model.PermissionRateCardsRead), h.RateCard.GetRenewals)
+		rateCardGroup.GET("/:id", amw.WithAuth, pmw.WithPerm(model.PermissionRateCardsRead), h.RateCard.Get)
+		rateCardGroup.PUT("/:id", amw.WithAuth, pmw.WithPerm(model.PermissionRateCardsEdit), h.RateCard.Update)
+		rateCardGroup.DELETE("/:id", amw.WithAuth, pmw.WithPerm(model.PermissionRateCardsEdit), h.RateCard.Delete)
+	}
+
+	projectHealthGroup := v1.Group("/project-health")
+	{
+		projectHealthGroup.GET("", amw.WithAuth, pmw.WithPerm(model.PermissionProjectHealthRead), h.ProjectHealth.GetPortfolio)
+		projectHealthGroup.GET("/weights", amw.WithAuth, pmw.WithPerm(model.PermissionProjectHealthRead), h.ProjectHealth.GetWeights)
+		projectHealthGroup.PUT("/weights", amw.WithAuth, pmw.WithPerm(model.PermissionProjectHealthEdit), h.ProjectHealth.UpdateWeights)
+	}
+
 	bankGroup := v1.Group("/bank-accounts")
 	{
 		bankGroup.GET("", pmw.WithPerm(model.PermissionBankAccountRead), h.BankAccount.List)
@@ -272,6 +432,11 @@ func loadV1Routes(r *gin.Engine, h *handler.Handler, repo store.DBRepo, s *store
 			engagementDashboardGroup.GET("/detail", h.Dashboard.GetEngagementInfoDetail)
 		}
 
+		surveyDashboardGroup := dashboard.Group("/surveys", amw.WithAuth, pmw.WithPerm(model.PermissionDashBoardSurveysRead))
+		{
+			surveyDashboardGroup.GET("/analytics", h.Dashboard.GetSurveyAnalytics)
+		}
+
 		projectDashboardGroup := dashboard.Group("/projects", amw.WithAuth, pmw.WithPerm(model.PermissionDashBoardProjectsRead))
 		{
 			projectDashboardGroup.GET("/sizes", h.Dashboard.GetProjectSizes)
@@ -287,10 +452,13 @@ func loadV1Routes(r *gin.Engine, h *handler.Handler, repo store.DBRepo, s *store
 		{
 			resourceDashboardGroup.GET("/availabilities", h.Dashboard.GetResourcesAvailability)
 			resourceDashboardGroup.GET("/utilization", h.Dashboard.GetResourceUtilization)
+			resourceDashboardGroup.GET("/capacity", h.Allocation.GetCapacityPlan)
 			resourceDashboardGroup.GET("/work-unit-distribution", h.Dashboard.GetWorkUnitDistribution)
 			resourceDashboardGroup.GET("/work-unit-distribution-summary", h.Dashboard.GetWorkUnitDistributionSummary)
 			resourceDashboardGroup.GET("/work-survey-summaries", h.Dashboard.GetResourceWorkSurveySummaries)
 		}
+
+		dashboard.GET("/profit-loss", amw.WithAuth, pmw.WithPerm(model.PermissionDashBoardProfitLossRead), h.ProfitLoss.GetCompanyProfitLoss)
 	}
 
 	payroll := v1.Group("payrolls")
@@ -330,12 +498,46 @@ func loadV1Routes(r *gin.Engine, h *handler.Handler, repo store.DBRepo, s *store
 		braineryGroup.POST("/sync", amw.WithAuth, pmw.WithPerm(model.PermissionCronjobExecute), h.BraineryLog.Sync)
 	}
 
+	auditLogGroup := v1.Group("/audit-logs", amw.WithAuth, pmw.WithPerm(model.PermissionAuditLogsRead))
+	{
+		auditLogGroup.GET("", h.AuditLog.List)
+		auditLogGroup.GET("/resources/:type/:id", h.AuditLog.ResourceHistory)
+	}
+
+	// approvers are checked against the permission of each request in the controller
+	approvalGroup := v1.Group("/approval-requests", amw.WithAuth, pmw.WithPerm(model.PermissionApprovalRequestsRead))
+	{
+		approvalGroup.GET("", h.Approval.List)
+		approvalGroup.GET("/:id", h.Approval.Detail)
+		approvalGroup.POST("/:id/approve", h.Approval.Approve)
+		approvalGroup.POST("/:id/reject", h.Approval.Reject)
+	}
+
+	/////////////////
+	// CLIENT PORTAL API GROUP
+	/////////////////
+
+	clientPortalGroup := v1.Group("/client-portal")
+	{
+		clientPortalAuthGroup := clientPortalGroup.Group("/auth", rlw.WithRateLimit(mw.RateLimitGroupAuth, mw.KeyByIP))
+		{
+			clientPortalAuthGroup.POST("", h.ClientPortal.Login)
+			clientPortalAuthGroup.POST("/login-link", h.ClientPortal.RequestLoginLink)
+		}
+
+		clientPortalGroup.GET("/me", cpmw.WithClientPortalAuth, h.ClientPortal.Me)
+		clientPortalGroup.GET("/projects", cpmw.WithClientPortalAuth, h.ClientPortal.ListProjects)
+		clientPortalGroup.GET("/projects/:id", cpmw.WithClientPortalAuth, h.ClientPortal.GetProject)
+		clientPortalGroup.GET("/projects/:id/invoices", cpmw.WithClientPortalAuth, h.ClientPortal.ListInvoices)
+		clientPortalGroup.GET("/projects/:id/changelogs", cpmw.WithClientPortalAuth, h.ClientPortal.ListChangelogs)
+	}
+
 	/////////////////
 	// PUBLIC API GROUP
 	/////////////////
 
 	// assets
-	publicGroup := v1.Group("/public")
+	publicGroup := v1.Group("/public", rlw.WithRateLimit(mw.RateLimitGroupPublic, rlw.KeyByAPIKeyOrIP))
 	{
 		publicGroup.GET("/employees", h.Employee.PublicList)
 		publicGroup.GET("/clients", h.Client.PublicList)
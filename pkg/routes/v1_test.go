@@ -344,6 +344,10 @@ func Test_loadV1Routes(t *testing.T) {
 			},
 		},
 		"/api/v1/feedbacks": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/feedback.IHandler.Send-fm",
+			},
 			"GET": {
 				Method:  "GET",
 				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/feedback.IHandler.List-fm",
@@ -823,6 +827,676 @@ func Test_loadV1Routes(t *testing.T) {
 				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/brainerylogs.IHandler.Sync-fm",
 			},
 		},
+		"/api/v1/audit-logs": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/auditlog.IHandler.List-fm",
+			},
+		},
+		"/api/v1/audit-logs/resources/:type/:id": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/auditlog.IHandler.ResourceHistory-fm",
+			},
+		},
+		"/api/v1/auth/providers": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/auth.IHandler.Providers-fm",
+			},
+		},
+		"/api/v1/auth/magic-link": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/auth.IHandler.RequestMagicLink-fm",
+			},
+		},
+		"/api/v1/auth/identities": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/auth.IHandler.LinkIdentity-fm",
+			},
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/auth.IHandler.ListIdentities-fm",
+			},
+		},
+		"/api/v1/auth/identities/:id": {
+			"DELETE": {
+				Method:  "DELETE",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/auth.IHandler.UnlinkIdentity-fm",
+			},
+		},
+		"/cronjobs/expire-approval-requests": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/approval.IHandler.ExpireOverdue-fm",
+			},
+		},
+		"/api/v1/approval-requests": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/approval.IHandler.List-fm",
+			},
+		},
+		"/api/v1/approval-requests/:id": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/approval.IHandler.Detail-fm",
+			},
+		},
+		"/api/v1/approval-requests/:id/approve": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/approval.IHandler.Approve-fm",
+			},
+		},
+		"/api/v1/approval-requests/:id/reject": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/approval.IHandler.Reject-fm",
+			},
+		},
+		"/cronjobs/reencrypt-employee-pii": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/employee.IHandler.ReencryptPII-fm",
+			},
+		},
+		"/api/v1/survey-templates": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/surveytemplate.IHandler.Create-fm",
+			},
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/surveytemplate.IHandler.List-fm",
+			},
+		},
+		"/api/v1/survey-templates/:id": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/surveytemplate.IHandler.Detail-fm",
+			},
+		},
+		"/api/v1/survey-templates/:id/versions": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/surveytemplate.IHandler.CreateVersion-fm",
+			},
+		},
+		"/api/v1/survey-templates/:id/versions/:version": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/surveytemplate.IHandler.GetVersion-fm",
+			},
+		},
+		"/api/v1/surveys/:id/responses": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/survey.IHandler.ListSurveyResponses-fm",
+			},
+		},
+		"/cronjobs/survey-reminders": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/survey.IHandler.SendReminders-fm",
+			},
+		},
+		"/cronjobs/survey-digests": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/survey.IHandler.SendDigests-fm",
+			},
+		},
+		"/cronjobs/close-overdue-surveys": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/survey.IHandler.CloseOverdue-fm",
+			},
+		},
+		"/api/v1/surveys/:id/schedule": {
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/survey.IHandler.UpdateSchedule-fm",
+			},
+		},
+		"/api/v1/survey-campaigns": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/surveycampaign.IHandler.Create-fm",
+			},
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/surveycampaign.IHandler.List-fm",
+			},
+		},
+		"/api/v1/survey-campaigns/:id": {
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/surveycampaign.IHandler.Update-fm",
+			},
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/surveycampaign.IHandler.Detail-fm",
+			},
+		},
+		"/api/v1/survey-campaigns/:id/runs": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/surveycampaign.IHandler.ListRuns-fm",
+			},
+		},
+		"/cronjobs/survey-campaigns": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/survey.IHandler.RunCampaigns-fm",
+			},
+		},
+		"/api/v1/dashboards/surveys/analytics": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/dashboard.IHandler.GetSurveyAnalytics-fm",
+			},
+		},
+		"/api/v1/surveys/:id/export": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/survey.IHandler.ExportSurvey-fm",
+			},
+		},
+		"/api/v1/surveys/:id/employees/:employeeID/review-packet": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/survey.IHandler.ExportReviewPacket-fm",
+			},
+		},
+		"/api/v1/surveys/:id/topics/:topicID/export": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/survey.IHandler.ExportSurveyTopic-fm",
+			},
+		},
+		"/api/v1/feedbacks/kudos": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/feedback.IHandler.ListKudos-fm",
+			},
+		},
+		"/api/v1/feedbacks/employees/:employeeID": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/feedback.IHandler.ListEmployeeFeedback-fm",
+			},
+		},
+		"/api/v1/employees/:id/performance-reviews": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/performancereview.IHandler.EmployeeHistory-fm",
+			},
+		},
+		"/api/v1/performance-review-cycles": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/performancereview.IHandler.Create-fm",
+			},
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/performancereview.IHandler.List-fm",
+			},
+		},
+		"/api/v1/performance-review-cycles/:id": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/performancereview.IHandler.Detail-fm",
+			},
+		},
+		"/api/v1/performance-review-cycles/:id/status": {
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/performancereview.IHandler.UpdateStatus-fm",
+			},
+		},
+		"/api/v1/performance-review-cycles/:id/reviews": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/performancereview.IHandler.ListReviews-fm",
+			},
+		},
+		"/api/v1/performance-reviews/:id": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/performancereview.IHandler.Packet-fm",
+			},
+		},
+		"/api/v1/performance-reviews/:id/assessment": {
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/performancereview.IHandler.SubmitAssessment-fm",
+			},
+		},
+		"/api/v1/performance-reviews/:id/calibration": {
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/performancereview.IHandler.Calibrate-fm",
+			},
+		},
+		"/cronjobs/objective-check-in-reminders": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/objective.IHandler.SendCheckInReminders-fm",
+			},
+		},
+		"/cronjobs/budget-burn-alerts": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/budget.IHandler.SendBurnAlerts-fm",
+			},
+		},
+		"/cronjobs/apply-member-rates": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/ratecard.IHandler.ApplyMemberRates-fm",
+			},
+		},
+		"/cronjobs/project-health-scores": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/projecthealth.IHandler.ComputeWeeklyScores-fm",
+			},
+		},
+		"/cronjobs/sync-github-work-units": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/project.IHandler.SyncGithubWorkUnits-fm",
+			},
+		},
+		"/api/v1/employees/:id/objectives": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/objective.IHandler.EmployeeGoals-fm",
+			},
+		},
+		"/api/v1/objectives": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/objective.IHandler.Create-fm",
+			},
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/objective.IHandler.List-fm",
+			},
+		},
+		"/api/v1/objectives/:id": {
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/objective.IHandler.Update-fm",
+			},
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/objective.IHandler.Detail-fm",
+			},
+		},
+		"/api/v1/objectives/:id/key-results": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/objective.IHandler.CreateKeyResult-fm",
+			},
+		},
+		"/api/v1/objectives/:id/key-results/:keyResultID": {
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/objective.IHandler.UpdateKeyResult-fm",
+			},
+		},
+		"/api/v1/objectives/:id/key-results/:keyResultID/check-ins": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/objective.IHandler.CheckIn-fm",
+			},
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/objective.IHandler.ListCheckIns-fm",
+			},
+		},
+		"/api/v1/one-on-ones": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/oneonone.IHandler.Create-fm",
+			},
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/oneonone.IHandler.List-fm",
+			},
+		},
+		"/api/v1/one-on-ones/:id": {
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/oneonone.IHandler.Update-fm",
+			},
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/oneonone.IHandler.Detail-fm",
+			},
+		},
+		"/api/v1/one-on-ones/:id/manager-note": {
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/oneonone.IHandler.UpdateManagerNote-fm",
+			},
+		},
+		"/api/v1/one-on-ones/:id/agenda-items": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/oneonone.IHandler.CreateAgendaItem-fm",
+			},
+		},
+		"/api/v1/one-on-ones/:id/agenda-items/:itemID": {
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/oneonone.IHandler.UpdateAgendaItem-fm",
+			},
+		},
+		"/api/v1/one-on-ones/:id/action-items": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/oneonone.IHandler.CreateActionItem-fm",
+			},
+		},
+		"/api/v1/one-on-ones/:id/action-items/:itemID": {
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/oneonone.IHandler.UpdateActionItem-fm",
+			},
+		},
+		"/api/v1/timesheets": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/timesheet.IHandler.List-fm",
+			},
+		},
+		"/api/v1/timesheets/entries": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/timesheet.IHandler.Log-fm",
+			},
+		},
+		"/api/v1/timesheets/import": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/timesheet.IHandler.Import-fm",
+			},
+		},
+		"/api/v1/timesheets/:id": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/timesheet.IHandler.Detail-fm",
+			},
+		},
+		"/api/v1/timesheets/:id/submit": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/timesheet.IHandler.Submit-fm",
+			},
+		},
+		"/api/v1/timesheets/:id/review": {
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/timesheet.IHandler.Review-fm",
+			},
+		},
+		"/api/v1/timesheets/:id/entries/:entryID": {
+			"DELETE": {
+				Method:  "DELETE",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/timesheet.IHandler.DeleteEntry-fm",
+			},
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/timesheet.IHandler.UpdateEntry-fm",
+			},
+		},
+		"/api/v1/projects/:id/members/:memberID/allocations": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/allocation.IHandler.Create-fm",
+			},
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/allocation.IHandler.List-fm",
+			},
+		},
+		"/api/v1/projects/:id/members/:memberID/allocations/:allocationID": {
+			"DELETE": {
+				Method:  "DELETE",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/allocation.IHandler.Delete-fm",
+			},
+		},
+		"/api/v1/dashboards/resources/capacity": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/allocation.IHandler.GetCapacityPlan-fm",
+			},
+		},
+		"/api/v1/staffing-requests": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/staffing.IHandler.Create-fm",
+			},
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/staffing.IHandler.List-fm",
+			},
+		},
+		"/api/v1/staffing-requests/:id": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/staffing.IHandler.Detail-fm",
+			},
+		},
+		"/api/v1/staffing-requests/:id/propose": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/staffing.IHandler.Propose-fm",
+			},
+		},
+		"/api/v1/staffing-requests/:id/candidates/:candidateID/status": {
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/staffing.IHandler.UpdateCandidateStatus-fm",
+			},
+		},
+		"/api/v1/staffing-requests/:id/fill": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/staffing.IHandler.Fill-fm",
+			},
+		},
+		"/api/v1/staffing-requests/:id/cancel": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/staffing.IHandler.Cancel-fm",
+			},
+		},
+		"/api/v1/projects/:id/profit-loss": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/profitloss.IHandler.GetProjectProfitLoss-fm",
+			},
+		},
+		"/api/v1/dashboards/profit-loss": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/profitloss.IHandler.GetCompanyProfitLoss-fm",
+			},
+		},
+		"/api/v1/projects/:id/budget": {
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/budget.IHandler.Upsert-fm",
+			},
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/budget.IHandler.Get-fm",
+			},
+		},
+		"/api/v1/projects/:id/budget/phases": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/budget.IHandler.CreatePhase-fm",
+			},
+		},
+		"/api/v1/projects/:id/budget/phases/:phaseID": {
+			"DELETE": {
+				Method:  "DELETE",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/budget.IHandler.DeletePhase-fm",
+			},
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/budget.IHandler.UpdatePhase-fm",
+			},
+		},
+		"/api/v1/rate-cards": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/ratecard.IHandler.Create-fm",
+			},
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/ratecard.IHandler.List-fm",
+			},
+		},
+		"/api/v1/rate-cards/renewals": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/ratecard.IHandler.GetRenewals-fm",
+			},
+		},
+		"/api/v1/rate-cards/:id": {
+			"DELETE": {
+				Method:  "DELETE",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/ratecard.IHandler.Delete-fm",
+			},
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/ratecard.IHandler.Update-fm",
+			},
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/ratecard.IHandler.Get-fm",
+			},
+		},
+		"/api/v1/projects/:id/members/:memberID/rates": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/ratecard.IHandler.ChangeMemberRate-fm",
+			},
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/ratecard.IHandler.ListMemberRates-fm",
+			},
+		},
+		"/api/v1/projects/:id/members/:memberID/rates/:rateID": {
+			"DELETE": {
+				Method:  "DELETE",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/ratecard.IHandler.DeleteMemberRate-fm",
+			},
+		},
+		"/api/v1/projects/:id/status-transitions": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/project.IHandler.GetStatusTransitions-fm",
+			},
+		},
+		"/api/v1/projects/:id/health": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/projecthealth.IHandler.GetProjectHealth-fm",
+			},
+		},
+		"/api/v1/project-health": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/projecthealth.IHandler.GetPortfolio-fm",
+			},
+		},
+		"/api/v1/project-health/weights": {
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/projecthealth.IHandler.UpdateWeights-fm",
+			},
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/projecthealth.IHandler.GetWeights-fm",
+			},
+		},
+		"/api/v1/projects/:id/work-units/:workUnitID/github": {
+			"DELETE": {
+				Method:  "DELETE",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/project.IHandler.UnlinkWorkUnitGithub-fm",
+			},
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/project.IHandler.LinkWorkUnitGithub-fm",
+			},
+		},
+		"/api/v1/projects/:id/work-units/:workUnitID/github/sync": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/project.IHandler.SyncWorkUnitGithub-fm",
+			},
+		},
+		"/api/v1/clients/:id/contacts/:contactID/portal-access": {
+			"DELETE": {
+				Method:  "DELETE",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/client.IHandler.RevokePortalAccess-fm",
+			},
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/client.IHandler.IssuePortalAccess-fm",
+			},
+		},
+		"/api/v1/client-portal/auth": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/clientportal.IHandler.Login-fm",
+			},
+		},
+		"/api/v1/client-portal/auth/login-link": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/clientportal.IHandler.RequestLoginLink-fm",
+			},
+		},
+		"/api/v1/client-portal/me": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/clientportal.IHandler.Me-fm",
+			},
+		},
+		"/api/v1/client-portal/projects": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/clientportal.IHandler.ListProjects-fm",
+			},
+		},
+		"/api/v1/client-portal/projects/:id": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/clientportal.IHandler.GetProject-fm",
+			},
+		},
+		"/api/v1/client-portal/projects/:id/invoices": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/clientportal.IHandler.ListInvoices-fm",
+			},
+		},
+		"/api/v1/client-portal/projects/:id/changelogs": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/clientportal.IHandler.ListChangelogs-fm",
+			},
+		},
 		"/api/v1/public/employees": {
 			"GET": {
 				Method:  "GET",
@@ -33,6 +33,8 @@ type BasecampExpenseData struct {
 	InvoiceImageURL string
 	MetaData        datatypes.JSON
 	BasecampID      int
+	// ProjectCode tags the expense to a project for its profit and loss
+	ProjectCode string
 }
 
 // ExtractBasecampExpenseAmount --
@@ -54,6 +56,15 @@ func (s *Service) CreateBasecampExpense(
 		return errors.New("failed to get currency by name: " + data.CurrencyType)
 	}
 
+	var projectID *model.UUID
+	if data.ProjectCode != "" {
+		p, err := s.store.Project.One(s.repo.DB(), data.ProjectCode, false)
+		if err != nil {
+			return errors.New("failed to get project by code: " + data.ProjectCode)
+		}
+		projectID = &p.ID
+	}
+
 	date := time.Now()
 
 	e, err := s.store.Expense.Create(s.repo.DB(), &model.Expense{
@@ -64,6 +75,7 @@ func (s *Service) CreateBasecampExpense(
 		InvoiceImageURL: data.InvoiceImageURL,
 		Metadata:        data.MetaData,
 		BasecampID:      data.BasecampID,
+		ProjectID:       projectID,
 	})
 	if err != nil {
 		return err
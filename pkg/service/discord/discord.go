@@ -149,6 +149,15 @@ func (d *discordClient) SendMessage(msg, webhookUrl string) (*model.DiscordMessa
 	return &discordMsg, nil
 }
 
+func (d *discordClient) SendDirectMessage(discordID string, msg string) (*discordgo.Message, error) {
+	channel, err := d.session.UserChannelCreate(discordID)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.session.ChannelMessageSend(channel.ID, msg)
+}
+
 func (d *discordClient) SearchMember(discordName string) ([]*discordgo.Member, error) {
 	members := make([]*discordgo.Member, 0)
 	guildMembers, err := d.session.GuildMembersSearch(d.cfg.Discord.IDs.DwarvesGuild, discordName, 1000)
@@ -24,6 +24,8 @@ type IService interface {
 
 	// SendMessage logs a message to a Discord channel
 	SendMessage(msg, webhookUrl string) (*model.DiscordMessage, error)
+	// SendDirectMessage sends a private message to a Discord user
+	SendDirectMessage(discordID string, msg string) (*discordgo.Message, error)
 
 	GetChannels() ([]*discordgo.Channel, error)
 	GetMessagesAfterCursor(channelID string, cursorMessageID string, lastMessageID string) ([]*discordgo.Message, error)
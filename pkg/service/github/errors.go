@@ -5,4 +5,6 @@ import "errors"
 var (
 	ErrFailedToGetGithubAccount  = errors.New("failed to get github account")
 	ErrFoundOneMoreGithubAccount = errors.New("failed to get github account due to more than 1 github account found")
+	ErrGithubTokenNotSet         = errors.New("github token is not set")
+	ErrRepositoryNotFound        = errors.New("github repository not found")
 )
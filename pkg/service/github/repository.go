@@ -0,0 +1,149 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v52/github"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// perPage is the page size of the GitHub list requests, the most GitHub allows
+const perPage = 100
+
+// GetRepository gets a repository with its languages, contributors and its commit and pull request activity since a time
+func (s githubService) GetRepository(ctx context.Context, owner, name string, since time.Time) (*model.GithubRepository, error) {
+	if s.Client == nil {
+		return nil, ErrGithubTokenNotSet
+	}
+
+	repo, _, err := s.Client.Repositories.Get(ctx, owner, name)
+	if err != nil {
+		var errResp *github.ErrorResponse
+		if errors.As(err, &errResp) && errResp.Response != nil && errResp.Response.StatusCode == http.StatusNotFound {
+			return nil, ErrRepositoryNotFound
+		}
+		s.log.Errorf(err, "[GetRepository] fail to get repository", "owner", owner, "name", name)
+		return nil, err
+	}
+
+	rs := &model.GithubRepository{
+		Owner:         repo.GetOwner().GetLogin(),
+		Name:          repo.GetName(),
+		DefaultBranch: repo.GetDefaultBranch(),
+		Activity:      model.GithubActivity{Since: since},
+	}
+	if repo.PushedAt != nil {
+		pushedAt := repo.PushedAt.Time
+		rs.PushedAt = &pushedAt
+	}
+
+	rs.Languages, _, err = s.Client.Repositories.ListLanguages(ctx, owner, name)
+	if err != nil {
+		s.log.Errorf(err, "[GetRepository] fail to list languages", "owner", owner, "name", name)
+		return nil, err
+	}
+
+	recentCommits, err := s.countRecentCommits(ctx, owner, name, since, &rs.Activity)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.countPullRequests(ctx, owner, name, since, &rs.Activity); err != nil {
+		return nil, err
+	}
+
+	opts := &github.ListContributorsOptions{ListOptions: github.ListOptions{PerPage: perPage}}
+	for {
+		contributors, resp, err := s.Client.Repositories.ListContributors(ctx, owner, name, opts)
+		if err != nil {
+			s.log.Errorf(err, "[GetRepository] fail to list contributors", "owner", owner, "name", name)
+			return nil, err
+		}
+
+		for _, c := range contributors {
+			if c.GetLogin() == "" {
+				continue
+			}
+			rs.Contributors = append(rs.Contributors, model.GithubContributor{
+				Login:         c.GetLogin(),
+				Contributions: c.GetContributions(),
+				RecentCommits: recentCommits[strings.ToLower(c.GetLogin())],
+			})
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return rs, nil
+}
+
+// countRecentCommits counts the commits on the default branch since a time, it returns the number of commits by lowercased author login
+func (s githubService) countRecentCommits(ctx context.Context, owner, name string, since time.Time, activity *model.GithubActivity) (map[string]int, error) {
+	rs := map[string]int{}
+
+	opts := &github.CommitsListOptions{Since: since, ListOptions: github.ListOptions{PerPage: perPage}}
+	for {
+		commits, resp, err := s.Client.Repositories.ListCommits(ctx, owner, name, opts)
+		if err != nil {
+			s.log.Errorf(err, "[GetRepository] fail to list commits", "owner", owner, "name", name)
+			return nil, err
+		}
+
+		for _, c := range commits {
+			activity.Commits++
+			if login := c.GetAuthor().GetLogin(); login != "" {
+				rs[strings.ToLower(login)]++
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return rs, nil
+}
+
+// countPullRequests counts the pull requests opened and merged since a time, pull requests are listed by last update
+// so the listing stops at the first one not updated since then
+func (s githubService) countPullRequests(ctx context.Context, owner, name string, since time.Time, activity *model.GithubActivity) error {
+	opts := &github.PullRequestListOptions{
+		State:       "all",
+		Sort:        "updated",
+		Direction:   "desc",
+		ListOptions: github.ListOptions{PerPage: perPage},
+	}
+	for {
+		pulls, resp, err := s.Client.PullRequests.List(ctx, owner, name, opts)
+		if err != nil {
+			s.log.Errorf(err, "[GetRepository] fail to list pull requests", "owner", owner, "name", name)
+			return err
+		}
+
+		for _, pr := range pulls {
+			if pr.UpdatedAt != nil && pr.UpdatedAt.Before(since) {
+				return nil
+			}
+			if pr.CreatedAt != nil && !pr.CreatedAt.Before(since) {
+				activity.PullRequestsOpened++
+			}
+			if pr.MergedAt != nil && !pr.MergedAt.Before(since) {
+				activity.PullRequestsMerged++
+			}
+		}
+
+		if resp.NextPage == 0 {
+			return nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
@@ -0,0 +1,102 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+)
+
+const testdataPath = "testdata/get_repository"
+
+// newFakeGithub serves responses recorded from the GitHub API for dwarvesf/fortress-api
+func newFakeGithub(t *testing.T) *httptest.Server {
+	serve := func(file string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			data, err := os.ReadFile(filepath.Join(testdataPath, file))
+			require.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			_, _ = w.Write(data)
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/dwarvesf/fortress-api", serve("repo.json"))
+	mux.HandleFunc("/repos/dwarvesf/fortress-api/languages", serve("languages.json"))
+	mux.HandleFunc("/repos/dwarvesf/fortress-api/contributors", serve("contributors.json"))
+	mux.HandleFunc("/repos/dwarvesf/fortress-api/pulls", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "all", r.URL.Query().Get("state"))
+		require.Equal(t, "updated", r.URL.Query().Get("sort"))
+		serve("pulls.json")(w, r)
+	})
+	mux.HandleFunc("/repos/dwarvesf/fortress-api/commits", func(w http.ResponseWriter, r *http.Request) {
+		require.NotEmpty(t, r.URL.Query().Get("since"))
+		if r.URL.Query().Get("page") == "2" {
+			serve("commits_page_2.json")(w, r)
+			return
+		}
+
+		w.Header().Set("Link", fmt.Sprintf(`<http://%s%s?page=2>; rel="next", <http://%s%s?page=2>; rel="last"`,
+			r.Host, r.URL.Path, r.Host, r.URL.Path))
+		serve("commits_page_1.json")(w, r)
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func TestGithubService_GetRepository(t *testing.T) {
+	server := newFakeGithub(t)
+	defer server.Close()
+
+	client := github.NewClient(nil)
+	baseURL, err := url.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.BaseURL = baseURL
+
+	s := githubService{Client: client, log: logger.NewLogrusLogger()}
+	since := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("happy case", func(t *testing.T) {
+		repo, err := s.GetRepository(context.Background(), "dwarvesf", "fortress-api", since)
+		require.NoError(t, err)
+
+		require.Equal(t, "dwarvesf", repo.Owner)
+		require.Equal(t, "fortress-api", repo.Name)
+		require.Equal(t, "develop", repo.DefaultBranch)
+		require.NotNil(t, repo.PushedAt)
+		require.Equal(t, 2814530, repo.Languages["Go"])
+
+		require.Equal(t, since, repo.Activity.Since)
+		require.Equal(t, 4, repo.Activity.Commits)
+		require.Equal(t, 2, repo.Activity.PullRequestsOpened)
+		require.Equal(t, 1, repo.Activity.PullRequestsMerged)
+
+		require.Len(t, repo.Contributors, 3)
+		require.Equal(t, "huynguyenh", repo.Contributors[0].Login)
+		require.Equal(t, 642, repo.Contributors[0].Contributions)
+		require.Equal(t, 2, repo.Contributors[0].RecentCommits)
+		require.Equal(t, 1, repo.Contributors[1].RecentCommits)
+		require.Equal(t, 0, repo.Contributors[2].RecentCommits)
+	})
+
+	t.Run("repository not found", func(t *testing.T) {
+		_, err := s.GetRepository(context.Background(), "dwarvesf", "unknown", since)
+		require.ErrorIs(t, err, ErrRepositoryNotFound)
+	})
+
+	t.Run("token is not set", func(t *testing.T) {
+		_, err := githubService{}.GetRepository(context.Background(), "dwarvesf", "fortress-api", since)
+		require.ErrorIs(t, err, ErrGithubTokenNotSet)
+	})
+}
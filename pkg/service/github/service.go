@@ -2,11 +2,13 @@ package github
 
 import (
 	"context"
+	"time"
 
 	"github.com/dwarvesf/fortress-api/pkg/model"
 )
 
 type IService interface {
+	GetRepository(ctx context.Context, owner, name string, since time.Time) (repo *model.GithubRepository, err error)
 	RemoveFromOrganizationByEmail(ctx context.Context, email string) error
 	RemoveFromOrganizationByUsername(ctx context.Context, username string) error
 	SendInvitationByEmail(ctx context.Context, e *model.Employee) error
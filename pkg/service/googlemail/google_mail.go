@@ -454,3 +454,87 @@ func (g *googleService) SendInvitationMail(invitation *model.InvitationEmail) (e
 	_, err = g.sendEmail(encodedEmail, id)
 	return err
 }
+
+// SendMagicLinkMail sends a one-time login link
+func (g *googleService) SendMagicLinkMail(m *model.MagicLinkEmail) (err error) {
+	if err := g.ensureToken(g.appConfig.Google.TeamGoogleRefreshToken); err != nil {
+		return err
+	}
+
+	if err := g.prepareService(); err != nil {
+		return err
+	}
+
+	m.Link = strings.Replace(m.Link, "=", "=3D", -1)
+
+	encodedEmail, err := composeMailContent(g.appConfig,
+		&MailParseInfo{
+			teamEmail,
+			"magicLink.tpl",
+			&m,
+			map[string]interface{}{},
+		})
+	if err != nil {
+		return err
+	}
+	id := g.appConfig.Google.TeamEmailID
+
+	_, err = g.sendEmail(encodedEmail, id)
+	return err
+}
+
+// SendClientPortalMail sends a one-time login link of the client portal to a client contact
+func (g *googleService) SendClientPortalMail(m *model.ClientPortalEmail) (err error) {
+	if err := g.ensureToken(g.appConfig.Google.TeamGoogleRefreshToken); err != nil {
+		return err
+	}
+
+	if err := g.prepareService(); err != nil {
+		return err
+	}
+
+	m.Link = strings.Replace(m.Link, "=", "=3D", -1)
+
+	encodedEmail, err := composeMailContent(g.appConfig,
+		&MailParseInfo{
+			teamEmail,
+			"clientPortal.tpl",
+			&m,
+			map[string]interface{}{},
+		})
+	if err != nil {
+		return err
+	}
+	id := g.appConfig.Google.TeamEmailID
+
+	_, err = g.sendEmail(encodedEmail, id)
+	return err
+}
+
+// SendSurveyReminderMail reminds a reviewer to answer a survey before its deadline
+func (g *googleService) SendSurveyReminderMail(r *model.SurveyReminderEmail) (err error) {
+	if err := g.ensureToken(g.appConfig.Google.TeamGoogleRefreshToken); err != nil {
+		return err
+	}
+
+	if err := g.prepareService(); err != nil {
+		return err
+	}
+
+	r.Link = strings.Replace(r.Link, "=", "=3D", -1)
+
+	encodedEmail, err := composeMailContent(g.appConfig,
+		&MailParseInfo{
+			teamEmail,
+			"surveyReminder.tpl",
+			&r,
+			map[string]interface{}{},
+		})
+	if err != nil {
+		return err
+	}
+	id := g.appConfig.Google.TeamEmailID
+
+	_, err = g.sendEmail(encodedEmail, id)
+	return err
+}
@@ -16,8 +16,11 @@ var (
 // IService interface contain related google calendar method
 type IService interface {
 	SendInvitationMail(invitation *model.InvitationEmail) (err error)
+	SendMagicLinkMail(m *model.MagicLinkEmail) (err error)
+	SendClientPortalMail(m *model.ClientPortalEmail) (err error)
 	SendInvoiceMail(invoice *model.Invoice) (msgID string, err error)
 	SendInvoiceOverdueMail(invoice *model.Invoice) (err error)
 	SendInvoiceThankYouMail(invoice *model.Invoice) (err error)
 	SendPayrollPaidMail(p *model.Payroll) (err error)
+	SendSurveyReminderMail(r *model.SurveyReminderEmail) (err error)
 }
@@ -0,0 +1,54 @@
+package identity
+
+import (
+	"strings"
+
+	googleauth "github.com/dwarvesf/fortress-api/pkg/service/google"
+)
+
+type googleProvider struct {
+	service        googleauth.IService
+	legacyUserInfo bool
+}
+
+// NewGoogleProvider returns a provider exchanging Google OAuth codes
+func NewGoogleProvider(service googleauth.IService, legacyUserInfo bool) IProvider {
+	return &googleProvider{
+		service:        service,
+		legacyUserInfo: legacyUserInfo,
+	}
+}
+
+func (p *googleProvider) Name() string {
+	return ProviderGoogle
+}
+
+func (p *googleProvider) Authenticate(in Credential) (*Identity, error) {
+	accessToken, err := p.service.GetAccessToken(in.Code, in.RedirectURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var email string
+	if p.legacyUserInfo {
+		email, err = p.service.GetGoogleEmailLegacy(accessToken)
+	} else {
+		email, err = p.service.GetGoogleEmail(accessToken)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if email == "" {
+		return nil, ErrEmptyEmail
+	}
+
+	// google only gives us the primary email, which is unique in the workspace
+	email = strings.ToLower(email)
+
+	return &Identity{
+		Provider: ProviderGoogle,
+		Subject:  email,
+		Email:    email,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package identity
+
+import (
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	googleauth "github.com/dwarvesf/fortress-api/pkg/service/google"
+)
+
+type identityService struct {
+	providers map[string]IProvider
+	names     []string
+}
+
+// New registers identity providers which are configured in cfg
+func New(cfg *config.Config, google googleauth.IService, l logger.Logger) IService {
+	s := &identityService{
+		providers: map[string]IProvider{},
+	}
+
+	if google != nil {
+		s.register(NewGoogleProvider(google, cfg.Auth.GoogleLegacyUserInfo))
+	}
+
+	if cfg.Auth.OIDC.Issuer != "" && cfg.Auth.OIDC.ClientID != "" {
+		s.register(NewOIDCProvider(cfg.Auth.OIDC))
+	}
+
+	// the stub logs in as anyone, so it never runs on a deployed environment
+	if cfg.Auth.StubEnabled {
+		if cfg.Env == "local" {
+			s.register(NewStubProvider())
+		} else {
+			l.Warnf("stub identity provider is ignored on %s", cfg.Env)
+		}
+	}
+
+	return s
+}
+
+func (s *identityService) register(p IProvider) {
+	s.providers[p.Name()] = p
+	s.names = append(s.names, p.Name())
+}
+
+// Provider gets an enabled provider by name
+func (s *identityService) Provider(name string) (IProvider, error) {
+	p, ok := s.providers[name]
+	if !ok {
+		return nil, ErrProviderNotSupported
+	}
+
+	return p, nil
+}
+
+// Providers lists names of enabled providers in registration order
+func (s *identityService) Providers() []string {
+	return s.names
+}
@@ -0,0 +1,197 @@
+package identity
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	googleauth "github.com/dwarvesf/fortress-api/pkg/service/google"
+)
+
+type fakeGoogleService struct {
+	googleauth.IService
+	email       string
+	legacyEmail string
+}
+
+func (s *fakeGoogleService) GetAccessToken(code string, redirectURL string) (string, error) {
+	return "access-token", nil
+}
+
+func (s *fakeGoogleService) GetGoogleEmail(accessToken string) (string, error) {
+	return s.email, nil
+}
+
+func (s *fakeGoogleService) GetGoogleEmailLegacy(accessToken string) (string, error) {
+	return s.legacyEmail, nil
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  config.Config
+		want []string
+	}{
+		{
+			name: "google only",
+			cfg:  config.Config{Env: "local"},
+			want: []string{ProviderGoogle},
+		},
+		{
+			name: "stub on local",
+			cfg:  config.Config{Env: "local", Auth: config.Auth{StubEnabled: true}},
+			want: []string{ProviderGoogle, ProviderStub},
+		},
+		{
+			name: "stub is ignored on dev",
+			cfg:  config.Config{Env: "dev", Auth: config.Auth{StubEnabled: true}},
+			want: []string{ProviderGoogle},
+		},
+		{
+			name: "stub is ignored on prod",
+			cfg:  config.Config{Env: "prod", Auth: config.Auth{StubEnabled: true}},
+			want: []string{ProviderGoogle},
+		},
+		{
+			name: "oidc when configured",
+			cfg:  config.Config{Env: "prod", Auth: config.Auth{OIDC: config.OIDC{Issuer: "https://sso.d.foundation", ClientID: "fortress"}}},
+			want: []string{ProviderGoogle, ProviderOIDC},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New(&tt.cfg, &fakeGoogleService{}, logger.NewLogrusLogger())
+			if got := s.Providers(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Providers() = %v, want %v", got, tt.want)
+			}
+			if _, err := s.Provider(ProviderStub); tt.cfg.Env != "local" && !errors.Is(err, ErrProviderNotSupported) {
+				t.Errorf("Provider(stub) error = %v, want %v", err, ErrProviderNotSupported)
+			}
+		})
+	}
+}
+
+func TestGoogleProvider_Authenticate(t *testing.T) {
+	tests := []struct {
+		name           string
+		legacyUserInfo bool
+		service        *fakeGoogleService
+		want           string
+		wantErr        error
+	}{
+		{
+			name:    "user info endpoint",
+			service: &fakeGoogleService{email: "Thanh@d.foundation", legacyEmail: "legacy@d.foundation"},
+			want:    "thanh@d.foundation",
+		},
+		{
+			name:           "legacy user info endpoint",
+			legacyUserInfo: true,
+			service:        &fakeGoogleService{email: "thanh@d.foundation", legacyEmail: "legacy@d.foundation"},
+			want:           "legacy@d.foundation",
+		},
+		{
+			name:    "empty email",
+			service: &fakeGoogleService{},
+			wantErr: ErrEmptyEmail,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewGoogleProvider(tt.service, tt.legacyUserInfo).Authenticate(Credential{Code: "code"})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Authenticate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr == nil && got.Email != tt.want {
+				t.Errorf("Authenticate() email = %v, want %v", got.Email, tt.want)
+			}
+		})
+	}
+}
+
+func TestOIDCProvider_Authenticate(t *testing.T) {
+	tests := []struct {
+		name     string
+		userInfo map[string]interface{}
+		want     string
+		wantErr  error
+	}{
+		{
+			name:     "verified email",
+			userInfo: map[string]interface{}{"sub": "1", "email": "Thanh@d.foundation", "email_verified": true},
+			want:     "thanh@d.foundation",
+		},
+		{
+			name:     "unverified email",
+			userInfo: map[string]interface{}{"sub": "1", "email": "thanh@d.foundation", "email_verified": false},
+			wantErr:  ErrEmailNotVerified,
+		},
+		{
+			name:     "missing email_verified claim",
+			userInfo: map[string]interface{}{"sub": "1", "email": "thanh@d.foundation"},
+			wantErr:  ErrEmailNotVerified,
+		},
+		{
+			name:     "empty email",
+			userInfo: map[string]interface{}{"sub": "1", "email_verified": true},
+			wantErr:  ErrEmptyEmail,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newOIDCServer(t, tt.userInfo)
+			defer srv.Close()
+
+			p := NewOIDCProvider(config.OIDC{Issuer: srv.URL, ClientID: "fortress", ClientSecret: "secret"})
+			got, err := p.Authenticate(Credential{Code: "code", RedirectURL: "http://localhost/callback"})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Authenticate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr == nil && got.Email != tt.want {
+				t.Errorf("Authenticate() email = %v, want %v", got.Email, tt.want)
+			}
+		})
+	}
+}
+
+func newOIDCServer(t *testing.T, userInfo map[string]interface{}) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+
+	writeJSON := func(w http.ResponseWriter, v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	mux.HandleFunc(oidcDiscoveryPath, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, oidcConfiguration{
+			Issuer:                srv.URL,
+			AuthorizationEndpoint: srv.URL + "/authorize",
+			TokenEndpoint:         srv.URL + "/token",
+			UserinfoEndpoint:      srv.URL + "/userinfo",
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]interface{}{"access_token": "access-token", "token_type": "Bearer", "expires_in": 3600})
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer access-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		writeJSON(w, userInfo)
+	})
+
+	return srv
+}
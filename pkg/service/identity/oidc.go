@@ -0,0 +1,163 @@
+package identity
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+)
+
+const oidcDiscoveryPath = "/.well-known/openid-configuration"
+
+var ErrInvalidOIDCConfiguration = errors.New("invalid openid configuration")
+
+type oidcConfiguration struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+}
+
+type oidcUserInfo struct {
+	Subject       string `json:"sub"`
+	Email         string `json:"email"`
+	EmailVerified *bool  `json:"email_verified"`
+	Name          string `json:"name"`
+	Picture       string `json:"picture"`
+}
+
+type oidcProvider struct {
+	cfg    config.OIDC
+	client *http.Client
+
+	mu        sync.Mutex
+	discovery *oidcConfiguration
+}
+
+// NewOIDCProvider returns a generic OpenID Connect provider,
+// endpoints are discovered lazily from the issuer
+func NewOIDCProvider(cfg config.OIDC) IProvider {
+	return &oidcProvider{
+		cfg:    cfg,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (p *oidcProvider) Name() string {
+	return ProviderOIDC
+}
+
+func (p *oidcProvider) Authenticate(in Credential) (*Identity, error) {
+	discovery, err := p.getConfiguration()
+	if err != nil {
+		return nil, err
+	}
+
+	oauthCfg := &oauth2.Config{
+		ClientID:     p.cfg.ClientID,
+		ClientSecret: p.cfg.ClientSecret,
+		RedirectURL:  in.RedirectURL,
+		Scopes:       []string{"openid", "email", "profile"},
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  discovery.AuthorizationEndpoint,
+			TokenURL: discovery.TokenEndpoint,
+		},
+	}
+
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, p.client)
+	token, err := oauthCfg.Exchange(ctx, in.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := p.getUserInfo(discovery.UserinfoEndpoint, token.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.Email == "" {
+		return nil, ErrEmptyEmail
+	}
+
+	// we match employees by email, so only trust emails which the provider says are verified
+	if info.EmailVerified == nil || !*info.EmailVerified {
+		return nil, ErrEmailNotVerified
+	}
+
+	return &Identity{
+		Provider: ProviderOIDC,
+		Subject:  info.Subject,
+		Email:    strings.ToLower(info.Email),
+		Name:     info.Name,
+		Avatar:   info.Picture,
+	}, nil
+}
+
+func (p *oidcProvider) getConfiguration() (*oidcConfiguration, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	res, err := p.client.Get(strings.TrimSuffix(p.cfg.Issuer, "/") + oidcDiscoveryPath)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get openid configuration: status %d", res.StatusCode)
+	}
+
+	var discovery oidcConfiguration
+	if err := json.NewDecoder(res.Body).Decode(&discovery); err != nil {
+		return nil, err
+	}
+
+	if discovery.TokenEndpoint == "" || discovery.UserinfoEndpoint == "" {
+		return nil, ErrInvalidOIDCConfiguration
+	}
+
+	p.discovery = &discovery
+
+	return p.discovery, nil
+}
+
+func (p *oidcProvider) getUserInfo(endpoint string, accessToken string) (*oidcUserInfo, error) {
+	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get openid user info: status %d", res.StatusCode)
+	}
+
+	var info oidcUserInfo
+	if err := json.NewDecoder(res.Body).Decode(&info); err != nil {
+		return nil, err
+	}
+
+	if info.Subject == "" {
+		return nil, ErrInvalidOIDCConfiguration
+	}
+
+	return &info, nil
+}
@@ -0,0 +1,46 @@
+package identity
+
+import (
+	"errors"
+)
+
+var (
+	ErrProviderNotSupported = errors.New("identity provider is not supported")
+	ErrEmptyEmail           = errors.New("identity provider returned empty email")
+	ErrEmailNotVerified     = errors.New("email is not verified by identity provider")
+)
+
+const (
+	ProviderGoogle    = "google"
+	ProviderOIDC      = "oidc"
+	ProviderMagicLink = "magic-link"
+	ProviderStub      = "stub"
+)
+
+// Credential is what the client sends back after the provider's login flow
+type Credential struct {
+	Code        string
+	RedirectURL string
+}
+
+// Identity is the user info resolved by a provider,
+// Subject is the stable user id on the provider side
+type Identity struct {
+	Provider string
+	Subject  string
+	Email    string
+	Name     string
+	Avatar   string
+}
+
+// IProvider is an external identity provider
+type IProvider interface {
+	Name() string
+	Authenticate(in Credential) (*Identity, error)
+}
+
+// IService holds all enabled identity providers
+type IService interface {
+	Provider(name string) (IProvider, error)
+	Providers() []string
+}
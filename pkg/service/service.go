@@ -20,6 +20,7 @@ import (
 	"github.com/dwarvesf/fortress-api/pkg/service/googleadmin"
 	"github.com/dwarvesf/fortress-api/pkg/service/googledrive"
 	"github.com/dwarvesf/fortress-api/pkg/service/googlemail"
+	"github.com/dwarvesf/fortress-api/pkg/service/identity"
 	"github.com/dwarvesf/fortress-api/pkg/service/improvmx"
 	"github.com/dwarvesf/fortress-api/pkg/service/mochi"
 	"github.com/dwarvesf/fortress-api/pkg/service/notion"
@@ -38,6 +39,7 @@ type Service struct {
 	GoogleDrive googledrive.IService
 	GoogleMail  googlemail.IService
 	GoogleAdmin googleadmin.IService
+	Identity    identity.IService
 	ImprovMX    improvmx.IService
 	Mochi       mochi.IService
 	Notion      notion.IService
@@ -112,6 +114,7 @@ func New(cfg *config.Config, store *store.Store, repo store.DBRepo) *Service {
 		GoogleAdmin: googleAdminSvc,
 		GoogleDrive: googleDriveSvc,
 		GoogleMail:  googleMailSvc,
+		Identity:    identity.New(cfg, googleSvc, logger.L),
 		ImprovMX:    improvmx.New(cfg.ImprovMX.Token),
 		Mochi:       mochi.New(cfg, logger.L),
 		Notion:      notion.New(cfg.Notion.Secret, cfg.Notion.Databases.Project, logger.L),
@@ -0,0 +1,40 @@
+package anonymouseventanswer
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// BatchCreate create anonymous answers
+func (s *store) BatchCreate(db *gorm.DB, answers []model.AnonymousEventAnswer) ([]model.AnonymousEventAnswer, error) {
+	return answers, db.Create(&answers).Error
+}
+
+// GetByEventID get anonymous answers of an event grouped by response
+func (s *store) GetByEventID(db *gorm.DB, eventID string) ([]*model.AnonymousEventAnswer, error) {
+	var answers []*model.AnonymousEventAnswer
+	return answers, db.Where("event_id = ?", eventID).
+		Order("response_key, \"order\"").
+		Find(&answers).Error
+}
+
+// CountRespondentsByEventID count the responses of an event
+func (s *store) CountRespondentsByEventID(db *gorm.DB, eventID string) (int64, error) {
+	var count int64
+	return count, db.Model(&model.AnonymousEventAnswer{}).
+		Where("event_id = ?", eventID).
+		Distinct("response_key").
+		Count(&count).Error
+}
+
+// DeleteByEventID delete anonymous answers of an event
+func (s *store) DeleteByEventID(db *gorm.DB, eventID string) error {
+	return db.Where("event_id = ?", eventID).Delete(&model.AnonymousEventAnswer{}).Error
+}
@@ -0,0 +1,14 @@
+package anonymouseventanswer
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	BatchCreate(db *gorm.DB, answers []model.AnonymousEventAnswer) ([]model.AnonymousEventAnswer, error)
+	GetByEventID(db *gorm.DB, eventID string) (answers []*model.AnonymousEventAnswer, err error)
+	CountRespondentsByEventID(db *gorm.DB, eventID string) (count int64, err error)
+	DeleteByEventID(db *gorm.DB, eventID string) error
+}
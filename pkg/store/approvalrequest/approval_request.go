@@ -0,0 +1,101 @@
+package approvalrequest
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+type Filter struct {
+	Statuses    []string
+	Actions     []string
+	RequesterID string
+}
+
+// One get approval request by id
+func (s *store) One(db *gorm.DB, id string) (*model.ApprovalRequest, error) {
+	var request *model.ApprovalRequest
+	return request, db.Where("id = ?", id).
+		Preload("Requester", "deleted_at IS NULL").
+		Preload("RequesterAPIKey", "deleted_at IS NULL").
+		Preload("Approver", "deleted_at IS NULL").
+		First(&request).Error
+}
+
+// All get approval requests by filter with pagination
+func (s *store) All(db *gorm.DB, filter Filter, pagination model.Pagination) ([]*model.ApprovalRequest, int64, error) {
+	var requests []*model.ApprovalRequest
+	var total int64
+
+	query := db.Table("approval_requests").Where("deleted_at IS NULL")
+
+	if len(filter.Statuses) > 0 {
+		query = query.Where("status IN ?", filter.Statuses)
+	}
+	if len(filter.Actions) > 0 {
+		query = query.Where("action IN ?", filter.Actions)
+	}
+	if filter.RequesterID != "" {
+		query = query.Where("requester_id = ?", filter.RequesterID)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if pagination.Sort != "" {
+		query = query.Order(pagination.Sort)
+	} else {
+		query = query.Order("created_at DESC")
+	}
+
+	limit, offset := pagination.ToLimitOffset()
+	if pagination.Page > 0 {
+		query = query.Limit(limit)
+	}
+
+	return requests, total, query.Offset(offset).
+		Preload("Requester", "deleted_at IS NULL").
+		Preload("RequesterAPIKey", "deleted_at IS NULL").
+		Preload("Approver", "deleted_at IS NULL").
+		Find(&requests).Error
+}
+
+// Create creates a new approval request
+func (s *store) Create(db *gorm.DB, request *model.ApprovalRequest) (*model.ApprovalRequest, error) {
+	return request, db.Create(request).Error
+}
+
+// UpdateSelectedFieldsByID just update selected fields by id
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.ApprovalRequest, updatedFields ...string) (*model.ApprovalRequest, error) {
+	request := model.ApprovalRequest{}
+	return &request, db.Model(&request).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+// UpdatePendingByID updates selected fields only if the request is still pending,
+// ok is false if another reviewer got there first
+func (s *store) UpdatePendingByID(db *gorm.DB, id string, updateModel model.ApprovalRequest, updatedFields ...string) (bool, error) {
+	res := db.Model(&model.ApprovalRequest{}).
+		Where("id = ? AND status = ?", id, model.ApprovalRequestStatusPending).
+		Select(updatedFields).
+		Updates(updateModel)
+	return res.RowsAffected == 1, res.Error
+}
+
+// ExpirePending marks all pending requests which passed their deadline as expired
+func (s *store) ExpirePending(db *gorm.DB, now time.Time) ([]*model.ApprovalRequest, error) {
+	var requests []*model.ApprovalRequest
+	return requests, db.Model(&requests).
+		Clauses(clause.Returning{}).
+		Where("status = ? AND expired_at <= ? AND deleted_at IS NULL", model.ApprovalRequestStatusPending, now).
+		Update("status", model.ApprovalRequestStatusExpired).Error
+}
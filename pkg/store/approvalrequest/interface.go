@@ -0,0 +1,18 @@
+package approvalrequest
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	One(db *gorm.DB, id string) (request *model.ApprovalRequest, err error)
+	All(db *gorm.DB, filter Filter, pagination model.Pagination) (requests []*model.ApprovalRequest, total int64, err error)
+	Create(db *gorm.DB, request *model.ApprovalRequest) (*model.ApprovalRequest, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.ApprovalRequest, updatedFields ...string) (*model.ApprovalRequest, error)
+	UpdatePendingByID(db *gorm.DB, id string, updateModel model.ApprovalRequest, updatedFields ...string) (ok bool, err error)
+	ExpirePending(db *gorm.DB, now time.Time) (requests []*model.ApprovalRequest, err error)
+}
@@ -0,0 +1,81 @@
+package auditlog
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+type AuditLogFilter struct {
+	ActorType    string
+	ActorID      string
+	Action       string
+	ResourceType string
+	ResourceID   string
+	RequestID    string
+	From         *time.Time
+	To           *time.Time
+}
+
+// Create appends an audit log record, audit logs are never updated nor deleted
+func (s *store) Create(db *gorm.DB, log *model.AuditLog) (*model.AuditLog, error) {
+	return log, db.Create(log).Error
+}
+
+// All gets audit logs by filter with pagination
+func (s *store) All(db *gorm.DB, filter AuditLogFilter, pagination model.Pagination) ([]*model.AuditLog, int64, error) {
+	var logs []*model.AuditLog
+	var total int64
+
+	query := db.Table("audit_logs")
+
+	if filter.ActorType != "" {
+		query = query.Where("actor_type = ?", filter.ActorType)
+	}
+	if filter.ActorID != "" {
+		query = query.Where("actor_id = ?", filter.ActorID)
+	}
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+	if filter.ResourceType != "" {
+		query = query.Where("resource_type = ?", filter.ResourceType)
+	}
+	if filter.ResourceID != "" {
+		query = query.Where("resource_id = ?", filter.ResourceID)
+	}
+	if filter.RequestID != "" {
+		query = query.Where("request_id = ?", filter.RequestID)
+	}
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at <= ?", filter.To)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if pagination.Sort != "" {
+		query = query.Order(pagination.Sort)
+	} else {
+		query = query.Order("created_at DESC")
+	}
+
+	limit, offset := pagination.ToLimitOffset()
+	if pagination.Page > 0 {
+		query = query.Limit(limit)
+	}
+
+	return logs, total, query.Offset(offset).Find(&logs).Error
+}
@@ -0,0 +1,12 @@
+package auditlog
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Create(db *gorm.DB, log *model.AuditLog) (*model.AuditLog, error)
+	All(db *gorm.DB, filter AuditLogFilter, pagination model.Pagination) (logs []*model.AuditLog, total int64, err error)
+}
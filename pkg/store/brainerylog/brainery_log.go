@@ -19,6 +19,12 @@ func (s *store) Create(db *gorm.DB, b []model.BraineryLog) (braineryLog []model.
 	return b, db.Create(b).Error
 }
 
+// GetByEmployeeIDInTimeRange gets brainery logs of an employee published in a specific time range
+func (s *store) GetByEmployeeIDInTimeRange(db *gorm.DB, employeeID string, start, end time.Time) ([]*model.BraineryLog, error) {
+	var logs []*model.BraineryLog
+	return logs, db.Where("employee_id = ? AND published_at BETWEEN ? AND ?", employeeID, start, end).Order("published_at DESC").Find(&logs).Error
+}
+
 // GetLimitByTimeRange gets brainery logs in a specific time range, with limit
 func (s *store) GetLimitByTimeRange(db *gorm.DB, start, end *time.Time, limit int) ([]*model.BraineryLog, error) {
 	var logs []*model.BraineryLog
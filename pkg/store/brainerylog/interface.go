@@ -10,6 +10,7 @@ import (
 
 type IStore interface {
 	Create(db *gorm.DB, b []model.BraineryLog) ([]model.BraineryLog, error)
+	GetByEmployeeIDInTimeRange(db *gorm.DB, employeeID string, start, end time.Time) ([]*model.BraineryLog, error)
 	GetLimitByTimeRange(db *gorm.DB, start, end *time.Time, limit int) ([]*model.BraineryLog, error)
 	GetNewContributorDiscordIDs(db *gorm.DB, start, end *time.Time) ([]string, error)
 }
@@ -15,7 +15,10 @@ func New() IStore {
 // One get client by id
 func (s *store) One(db *gorm.DB, id string) (*model.Client, error) {
 	var client *model.Client
-	return client, db.Where("id = ?", id).Preload("Contacts", "deleted_at IS NULL").First(&client).Error
+	return client, db.Where("id = ?", id).
+		Preload("Contacts", "deleted_at IS NULL").
+		Preload("Contacts.PortalAccess", "deleted_at IS NULL").
+		First(&client).Error
 }
 
 // IsExist check client existence
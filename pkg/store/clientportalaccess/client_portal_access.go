@@ -0,0 +1,64 @@
+package clientportalaccess
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// One get client portal access by id
+func (s *store) One(db *gorm.DB, id string) (*model.ClientPortalAccess, error) {
+	var access *model.ClientPortalAccess
+	return access, db.Where("id = ?", id).First(&access).Error
+}
+
+// OneByClientContactID get the client portal access of a client contact
+func (s *store) OneByClientContactID(db *gorm.DB, clientContactID string) (*model.ClientPortalAccess, error) {
+	var access *model.ClientPortalAccess
+	return access, db.Where("client_contact_id = ?", clientContactID).First(&access).Error
+}
+
+// OneByTokenHash get client portal access by the hash of its login token
+func (s *store) OneByTokenHash(db *gorm.DB, tokenHash string) (*model.ClientPortalAccess, error) {
+	var access *model.ClientPortalAccess
+	return access, db.Where("token_hash = ?", tokenHash).First(&access).Error
+}
+
+// OneActiveByEmail get the active client portal access of an email, the most recently issued one first
+func (s *store) OneActiveByEmail(db *gorm.DB, email string) (*model.ClientPortalAccess, error) {
+	var access *model.ClientPortalAccess
+	return access, db.Where("email = ? AND status = ?", email, model.ClientPortalAccessStatusActive).
+		Order("created_at DESC").
+		First(&access).Error
+}
+
+// Create creates a new client portal access
+func (s *store) Create(db *gorm.DB, access *model.ClientPortalAccess) (*model.ClientPortalAccess, error) {
+	return access, db.Create(access).Error
+}
+
+// UpdateSelectedFieldsByID just update selected fields by id
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.ClientPortalAccess, updatedFields ...string) (*model.ClientPortalAccess, error) {
+	access := model.ClientPortalAccess{}
+	return &access, db.Model(&access).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+// MarkTokenUsed clears the login token and records the login, ok is false if the token was already used by another request
+func (s *store) MarkTokenUsed(db *gorm.DB, id string, tokenHash string, usedAt time.Time) (bool, error) {
+	res := db.Model(&model.ClientPortalAccess{}).
+		Where("id = ? AND token_hash = ?", id, tokenHash).
+		Updates(map[string]interface{}{
+			"token_hash":       nil,
+			"token_expired_at": nil,
+			"last_login_at":    usedAt,
+		})
+	return res.RowsAffected == 1, res.Error
+}
@@ -0,0 +1,19 @@
+package clientportalaccess
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	One(db *gorm.DB, id string) (access *model.ClientPortalAccess, err error)
+	OneByClientContactID(db *gorm.DB, clientContactID string) (access *model.ClientPortalAccess, err error)
+	OneByTokenHash(db *gorm.DB, tokenHash string) (access *model.ClientPortalAccess, err error)
+	OneActiveByEmail(db *gorm.DB, email string) (access *model.ClientPortalAccess, err error)
+	Create(db *gorm.DB, access *model.ClientPortalAccess) (*model.ClientPortalAccess, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.ClientPortalAccess, updatedFields ...string) (access *model.ClientPortalAccess, err error)
+	MarkTokenUsed(db *gorm.DB, id string, tokenHash string, usedAt time.Time) (ok bool, err error)
+}
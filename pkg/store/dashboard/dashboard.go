@@ -73,6 +73,52 @@ func (s *store) GetWorkSurveysByProjectID(db *gorm.DB, projectID string) ([]*mod
 	return rs, db.Raw(query, projectID).Scan(&rs).Error
 }
 
+// GetLatestWorkSurveyByProjectID get the average answers of the latest work survey of a project, nil when the project has none
+func (s *store) GetLatestWorkSurveyByProjectID(db *gorm.DB, projectID string) (*model.WorkSurvey, error) {
+	var rs []*model.WorkSurvey
+
+	query := `
+		SELECT feedback_events.end_date,
+			AVG(
+				CASE
+					WHEN "order" = 1 THEN
+						CASE
+							When length(answer) = 0 THEN null
+							ELSE cast(answer AS integer)
+							END
+					END) as workload,
+			AVG(
+				CASE
+					WHEN "order" = 2 THEN
+						CASE
+							When length(answer) = 0 THEN null
+							ELSE cast(answer AS integer)
+							END
+					END) as deadline,
+			AVG(
+				CASE
+					WHEN "order" = 3 THEN
+						CASE
+							When length(answer) = 0 THEN null
+							ELSE cast(answer AS integer)
+							END
+					END) as learning
+		FROM feedback_events
+				JOIN employee_event_topics eet ON feedback_events.id = eet.event_id
+				JOIN employee_event_questions eeq ON feedback_events.id = eeq.event_id
+		WHERE eet.project_id = ? AND feedback_events.subtype='work' AND feedback_events.deleted_at IS NULL AND eet.deleted_at IS NULL AND eeq.deleted_at IS NULL
+		GROUP BY feedback_events.end_date
+		ORDER BY feedback_events.end_date DESC
+		LIMIT 1
+	`
+
+	if err := db.Raw(query, projectID).Scan(&rs).Error; err != nil || len(rs) == 0 {
+		return nil, err
+	}
+
+	return rs[0], nil
+}
+
 func (s *store) GetAllWorkSurveys(db *gorm.DB) ([]*model.WorkSurvey, error) {
 	var rs []*model.WorkSurvey
 
@@ -489,6 +535,7 @@ func (s *store) GetPendingSlots(db *gorm.DB) ([]*model.ProjectSlot, error) {
 		Find(&slots).Error
 }
 
+// GetAvailableEmployees get the employees allocated less than full-time on client projects for the next 2 months
 func (s *store) GetAvailableEmployees(db *gorm.DB) ([]*model.Employee, error) {
 	var employees []*model.Employee
 	return employees, db.
@@ -502,18 +549,33 @@ func (s *store) GetAvailableEmployees(db *gorm.DB) ([]*model.Employee, error) {
 			SELECT pm.employee_id
 			FROM project_members pm JOIN projects p ON pm.project_id = p.id
 			WHERE p.type <> ?
-				AND pm.deployment_type = ?
 				AND p.status IN ?
 				AND (pm.end_date IS NULL OR pm.end_date > now() + INTERVAL '2 months') 
 				AND p.deleted_at IS NULL
 				AND pm.deleted_at IS NULL
+			GROUP BY pm.employee_id
+			HAVING SUM(COALESCE(
+				(SELECT pma.percentage
+				FROM project_member_allocations pma
+				WHERE pma.project_member_id = pm.id
+					AND pma.deleted_at IS NULL
+					AND pma.start_date <= now()
+					AND (pma.end_date IS NULL OR pma.end_date >= now()::DATE)),
+				CASE
+					WHEN EXISTS (SELECT 1 FROM project_member_allocations pma2 WHERE pma2.project_member_id = pm.id AND pma2.deleted_at IS NULL) THEN 0
+					WHEN pm.deployment_type = ? THEN ?
+					ELSE 0
+				END
+			)) >= ?
 		)`,
 			model.ProjectTypeDwarves,
-			model.MemberDeploymentTypeOfficial,
 			[]string{
 				model.ProjectStatusOnBoarding.String(),
 				model.ProjectStatusActive.String(),
-			}).
+			},
+			model.MemberDeploymentTypeOfficial,
+			model.MaxAllocationPercentage,
+			model.MaxAllocationPercentage).
 		Where(`id IN (
 			SELECT e2.id 
 			FROM employees e2
@@ -627,7 +689,33 @@ func (s *store) GetResourceUtilization(db *gorm.DB, currentDate time.Time) ([]*m
 						AND (ru3.left_date IS NULL OR ru3.left_date > ru."date")
 				))
 				AND (left_date IS NULL OR left_date > "date")
-		) AS available
+		) AS available,
+
+		COALESCE((
+			SELECT SUM(te.hours)
+			FROM timesheet_entries te
+				JOIN timesheets t ON t.id = te.timesheet_id
+			WHERE te.deleted_at IS NULL
+				AND t.deleted_at IS NULL
+				AND t.status = 'approved'
+				AND te.date >= ru."date"
+				AND te.date < ru."date" + INTERVAL '1 month'
+				AND t.employee_id IN (SELECT ru4.employee_id FROM resource_utilization ru4)
+		), 0) AS approved_hours,
+
+		COALESCE((
+			SELECT SUM(te.hours)
+			FROM timesheet_entries te
+				JOIN timesheets t ON t.id = te.timesheet_id
+				JOIN projects p ON p.id = t.project_id
+			WHERE te.deleted_at IS NULL
+				AND t.deleted_at IS NULL
+				AND t.status = 'approved'
+				AND p."type" != 'dwarves'
+				AND te.date >= ru."date"
+				AND te.date < ru."date" + INTERVAL '1 month'
+				AND t.employee_id IN (SELECT ru5.employee_id FROM resource_utilization ru5)
+		), 0) AS billable_hours
 	FROM resource_utilization ru
 	GROUP BY "date"
 	`
@@ -11,6 +11,7 @@ import (
 type IStore interface {
 	GetProjectSizes(db *gorm.DB) (res []*model.ProjectSize, err error)
 	GetWorkSurveysByProjectID(db *gorm.DB, projectID string) ([]*model.WorkSurvey, error)
+	GetLatestWorkSurveyByProjectID(db *gorm.DB, projectID string) (*model.WorkSurvey, error)
 	GetAllWorkSurveys(db *gorm.DB) ([]*model.WorkSurvey, error)
 	GetActionItemReportsByProjectNotionID(db *gorm.DB, projectID string) ([]*model.ActionItemReport, error)
 	GetAllActionItemReports(db *gorm.DB) ([]*model.ActionItemReport, error)
@@ -33,4 +34,6 @@ type IStore interface {
 	GetAllWorkReviews(db *gorm.DB, keyword string, pagination model.Pagination) ([]*model.EmployeeEventReviewer, error)
 	GetProjectHeadByEmployeeID(db *gorm.DB, employeeID string) ([]*model.ManagementInfo, error)
 	GetWorkUnitDistributionEmployees(db *gorm.DB, keyword string, workUnitType string) ([]*model.Employee, error)
+	GetSurveyAnalyticsAnswers(db *gorm.DB, filter model.SurveyAnalyticsFilter) ([]*model.SurveyAnalyticsAnswer, error)
+	GetSurveyAnalyticsResponseCounts(db *gorm.DB, filter model.SurveyAnalyticsFilter) ([]*model.SurveyAnalyticsResponseCount, error)
 }
@@ -0,0 +1,132 @@
+package dashboard
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// GetSurveyAnalyticsAnswers returns the answers of finished reviews together with the detached answers of anonymous surveys,
+// forced done reviews are left out as they were never answered
+func (s *store) GetSurveyAnalyticsAnswers(db *gorm.DB, filter model.SurveyAnalyticsFilter) ([]*model.SurveyAnalyticsAnswer, error) {
+	var answers []*model.SurveyAnalyticsAnswer
+
+	identified := surveyAnalyticsEvents(db.Table("employee_event_questions eq"), "eq.event_id", filter).
+		Joins("JOIN employee_event_reviewers er ON eq.employee_event_reviewer_id = er.id").
+		Where("er.reviewer_status = ? AND er.is_forced_done = FALSE AND er.deleted_at IS NULL", model.EventReviewerStatusDone).
+		Where("eq.deleted_at IS NULL AND eq.answer <> ''")
+	anonymous := surveyAnalyticsEvents(db.Table("anonymous_event_answers aa"), "aa.event_id", filter).
+		Where("aa.deleted_at IS NULL AND aa.answer <> ''")
+
+	if filter.Domain != "" {
+		identified = identified.Where("eq.domain = ?", filter.Domain)
+		anonymous = anonymous.Where("aa.domain = ?", filter.Domain)
+	}
+
+	identified, identifiedName := surveyReviewerCohort(identified, filter)
+
+	anonymousName := "''"
+	switch filter.GroupBy {
+	case model.SurveyAnalyticsGroupByQuestion:
+		identifiedName, anonymousName = "eq.content", "aa.content"
+	case model.SurveyAnalyticsGroupByDomain:
+		identifiedName, anonymousName = "eq.domain", "aa.domain"
+	case model.SurveyAnalyticsGroupByChapter:
+		anonymous = anonymous.Joins("CROSS JOIN LATERAL jsonb_array_elements_text(CASE WHEN jsonb_typeof(aa.chapters) = 'array' THEN aa.chapters ELSE '[]'::jsonb END) AS f(name)")
+		anonymousName = "f.name"
+	case model.SurveyAnalyticsGroupBySeniority:
+		anonymous = anonymous.Where("aa.seniority <> ''")
+		anonymousName = "aa.seniority"
+	case model.SurveyAnalyticsGroupByProject:
+		anonymous = anonymous.Joins("CROSS JOIN LATERAL jsonb_array_elements_text(CASE WHEN jsonb_typeof(aa.projects) = 'array' THEN aa.projects ELSE '[]'::jsonb END) AS f(name)")
+		anonymousName = "f.name"
+	}
+
+	if filter.ProjectID != "" {
+		anonymous = anonymous.Where("jsonb_typeof(aa.projects) = 'array' AND aa.projects @> jsonb_build_array(?::text)", filter.ProjectName)
+	}
+
+	identified = identified.Select("fe.id AS event_id, fe.title, fe.start_date, fe.anonymity_threshold AS threshold, er.id::text AS respondent_key, " +
+		identifiedName + " AS group_name, eq.type, eq.domain, eq.answer")
+	anonymous = anonymous.Select("fe.id AS event_id, fe.title, fe.start_date, fe.anonymity_threshold AS threshold, aa.response_key AS respondent_key, " +
+		anonymousName + " AS group_name, aa.type, aa.domain, aa.answer")
+
+	return answers, db.Raw("? UNION ALL ?", identified, anonymous).Scan(&answers).Error
+}
+
+// GetSurveyAnalyticsResponseCounts returns the number of reviewers each survey was sent to and the number who answered,
+// per cohort when the analytics are grouped by a cohort
+func (s *store) GetSurveyAnalyticsResponseCounts(db *gorm.DB, filter model.SurveyAnalyticsFilter) ([]*model.SurveyAnalyticsResponseCount, error) {
+	var counts []*model.SurveyAnalyticsResponseCount
+
+	query := surveyAnalyticsEvents(db.Table("employee_event_reviewers er"), "er.event_id", filter).
+		Where("er.deleted_at IS NULL").
+		Where("er.reviewer_status <> ?", model.EventReviewerStatusNone)
+
+	query, name := surveyReviewerCohort(query, filter)
+
+	group := "fe.id"
+	if filter.GroupBy.IsCohort() {
+		group += ", " + name
+	}
+
+	query = query.
+		Select("fe.id AS event_id, "+name+" AS group_name, COUNT(*) AS total, COUNT(*) FILTER (WHERE er.reviewer_status = ? AND er.is_forced_done = FALSE) AS responded", model.EventReviewerStatusDone).
+		Group(group)
+
+	return counts, query.Scan(&counts).Error
+}
+
+// surveyAnalyticsEvents joins the surveys of the filter on the event id column
+func surveyAnalyticsEvents(query *gorm.DB, eventIDColumn string, filter model.SurveyAnalyticsFilter) *gorm.DB {
+	query = query.
+		Joins("JOIN feedback_events fe ON fe.id = "+eventIDColumn+" AND fe.deleted_at IS NULL").
+		Where("fe.type = ? AND fe.subtype = ?", model.EventTypeSurvey, filter.Subtype)
+
+	if filter.From != nil {
+		query = query.Where("fe.start_date >= ?", filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("fe.start_date <= ?", filter.To)
+	}
+
+	return query
+}
+
+// surveyReviewerCohort joins the cohort of reviewers, aliased er, and returns the column of the cohort name.
+// Work surveys are about one project so their project is the project of the topic,
+// other surveys use the projects the reviewer is currently an active member of
+func surveyReviewerCohort(query *gorm.DB, filter model.SurveyAnalyticsFilter) (*gorm.DB, string) {
+	isWork := filter.Subtype == model.EventSubtypeWork
+
+	if filter.ProjectID != "" {
+		if isWork {
+			query = query.Where("er.employee_event_topic_id IN (SELECT id FROM employee_event_topics WHERE project_id = ?)", filter.ProjectID)
+		} else {
+			query = query.Where("er.reviewer_id IN (SELECT employee_id FROM project_members WHERE project_id = ? AND status = ? AND deleted_at IS NULL)", filter.ProjectID, model.ProjectMemberStatusActive)
+		}
+	}
+
+	switch filter.GroupBy {
+	case model.SurveyAnalyticsGroupByChapter:
+		return query.
+			Joins("JOIN employee_chapters ec ON er.reviewer_id = ec.employee_id AND ec.deleted_at IS NULL").
+			Joins("JOIN chapters f ON ec.chapter_id = f.id"), "f.name"
+	case model.SurveyAnalyticsGroupBySeniority:
+		return query.
+			Joins("JOIN employees e ON er.reviewer_id = e.id").
+			Joins("JOIN seniorities f ON e.seniority_id = f.id"), "f.name"
+	case model.SurveyAnalyticsGroupByProject:
+		if isWork {
+			return query.
+				Joins("JOIN employee_event_topics t ON er.employee_event_topic_id = t.id").
+				Joins("JOIN projects f ON t.project_id = f.id"), "f.name"
+		}
+		return query.
+			Joins("JOIN project_members pm ON er.reviewer_id = pm.employee_id AND pm.status = ? AND pm.deleted_at IS NULL", model.ProjectMemberStatusActive).
+			Joins("JOIN projects f ON pm.project_id = f.id").
+			Where("f.status = ?", model.ProjectStatusActive), "f.name"
+	}
+
+	return query, "''"
+}
@@ -4,6 +4,7 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/utils/cryptoutils"
 )
 
 type store struct{}
@@ -59,7 +60,7 @@ func (s *store) One(db *gorm.DB, id string, preload bool) (*model.Employee, erro
 func (s *store) OneByEmail(db *gorm.DB, email string) (*model.Employee, error) {
 	var employee *model.Employee
 
-	return employee, db.Where("NULLIF(TRIM(team_email), '') = ? OR NULLIF(TRIM(personal_email), '') = ?", email, email).First(&employee).Error
+	return employee, db.Where(emailCondition(db, []string{email})).First(&employee).Error
 }
 
 // OneByNotionID get 1 employee by notion id
@@ -229,7 +230,22 @@ func (s *store) GetMenteesByID(db *gorm.DB, employeeID string) ([]*model.Employe
 func (s *store) GetByEmails(db *gorm.DB, emails []string) ([]*model.Employee, error) {
 	var employees []*model.Employee
 
-	return employees, db.Where("NULLIF(TRIM(team_email), '') IN ? OR NULLIF(TRIM(personal_email), '') IN ?", emails, emails).Find(&employees).Error
+	return employees, db.Where(emailCondition(db, emails)).Find(&employees).Error
+}
+
+// emailCondition matches the team email or the personal email, encrypted personal emails
+// are matched by their blind index while plain text rows are matched directly
+func emailCondition(db *gorm.DB, emails []string) *gorm.DB {
+	cond := db.Session(&gorm.Session{NewDB: true}).
+		Where("NULLIF(TRIM(team_email), '') IN ? OR NULLIF(TRIM(personal_email), '') IN ?", emails, emails)
+
+	if k := cryptoutils.DefaultKeyring(); k != nil {
+		for _, email := range emails {
+			cond = cond.Or("personal_email LIKE ?", k.BlindIndexPattern(email))
+		}
+	}
+
+	return cond
 }
 
 func (s *store) GetByBasecampIDs(db *gorm.DB, basecampIDs []int) ([]*model.Employee, error) {
@@ -255,3 +271,10 @@ func (s *store) SimpleList(db *gorm.DB) ([]*model.Employee, error) {
 
 	return employees, query.Find(&employees).Error
 }
+
+// GetRawColumns returns the stored values of the given columns for all employees,
+// encrypted columns are not decrypted
+func (s *store) GetRawColumns(db *gorm.DB, columns []string) ([]map[string]interface{}, error) {
+	var rows []map[string]interface{}
+	return rows, db.Table("employees").Select(append([]string{"id"}, columns...)).Order("created_at").Find(&rows).Error
+}
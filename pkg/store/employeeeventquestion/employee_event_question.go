@@ -17,7 +17,10 @@ func New() IStore {
 // GetByEventReviewerID return list EmployeeEventQuestion by eventReviewerID
 func (s *store) GetByEventReviewerID(db *gorm.DB, reviewID string) ([]*model.EmployeeEventQuestion, error) {
 	var eventQuestions []*model.EmployeeEventQuestion
-	return eventQuestions, db.Where("employee_event_reviewer_id = ?", reviewID).Order("\"order\"").Find(&eventQuestions).Error
+	return eventQuestions, db.Where("employee_event_reviewer_id = ?", reviewID).
+		Preload("TemplateQuestion").
+		Order("\"order\"").
+		Find(&eventQuestions).Error
 }
 
 // UpdateAnswers update answer and note by table id
@@ -106,14 +109,12 @@ func (s *store) GetAverageAnswerEngagementByTime(db *gorm.DB, times []time.Time)
 	var result []*model.StatisticEngagementDashboard
 
 	query := db.Table("feedback_events fe").
-		Select("DISTINCT q.content, eq.question_id, fe.title, fe.start_date, avg( CASE WHEN answer = '' THEN 0 ELSE cast(answer AS DECIMAL) END) AS point").
-		Joins("JOIN employee_event_questions eq ON fe.id = eq.event_id").
-		Joins("JOIN employee_event_reviewers er ON eq.employee_event_reviewer_id = er.id").
-		Joins("JOIN questions q ON eq.question_id = q.id").
-		Where("eq.domain = 'engagement'").
-		Where("er.reviewer_status = 'done' AND is_forced_done = FALSE").
+		Select("q.content, a.question_id, fe.title, fe.start_date, avg( CASE WHEN a.answer = '' THEN 0 ELSE cast(a.answer AS DECIMAL) END) AS point, count(*) AS respondents, fe.anonymity_threshold AS threshold").
+		Joins("JOIN (?) a ON fe.id = a.event_id", engagementAnswers(db, "")).
+		Joins("JOIN questions q ON a.question_id = q.id").
+		Where("a.domain = 'engagement'").
 		Where("fe.start_date IN ?", times).
-		Group("q.content, eq.question_id, fe.title, fe.start_date").
+		Group("q.content, a.question_id, fe.title, fe.start_date, fe.anonymity_threshold").
 		Order("q.content asc")
 
 	return result, query.Find(&result).Error
@@ -124,33 +125,59 @@ func (s *store) GetAverageAnswerEngagementByFilter(db *gorm.DB, filter model.Eng
 	var result []*model.StatisticEngagementDashboard
 
 	query := db.Table("feedback_events fe").
-		Select("DISTINCT f.name, eq.question_id, fe.title, fe.start_date, avg( CASE WHEN answer = '' THEN 0 ELSE cast(answer AS DECIMAL) END) AS point").
-		Joins("JOIN employee_event_questions eq ON fe.id = eq.event_id").
+		Select("a.name, a.question_id, fe.title, fe.start_date, avg( CASE WHEN a.answer = '' THEN 0 ELSE cast(a.answer AS DECIMAL) END) AS point, count(*) AS respondents, fe.anonymity_threshold AS threshold").
+		Joins("JOIN (?) a ON fe.id = a.event_id", engagementAnswers(db, filter)).
+		Where("a.domain = 'engagement'").
+		Where("fe.start_date = ?", time).
+		Group("a.name, a.question_id, fe.title, fe.start_date, fe.anonymity_threshold")
+
+	return result, query.Find(&result).Error
+}
+
+// engagementAnswers returns the answers of finished reviewers together with the detached answers of anonymous surveys,
+// name is the cohort of the filter the answers are grouped by
+func engagementAnswers(db *gorm.DB, filter model.EngagementDashboardFilter) *gorm.DB {
+	identified := db.Table("employee_event_questions eq").
 		Joins("JOIN employee_event_reviewers er ON eq.employee_event_reviewer_id = er.id").
-		Where("eq.domain = 'engagement'").
 		Where("er.reviewer_status = 'done' AND is_forced_done = FALSE").
-		Where("fe.start_date = ?", time).
-		Group("eq.question_id, fe.title, fe.start_date")
+		// answers of anonymous surveys are blanked once they are detached, they are counted from anonymous_event_answers
+		Where("eq.answer <> '' AND eq.deleted_at IS NULL")
+	anonymous := db.Table("anonymous_event_answers aa").
+		Where("aa.deleted_at IS NULL AND aa.answer <> ''")
 
-	if filter == model.EngagementDashboardFilterChapter {
-		query = query.
+	identifiedName, anonymousName := "''", "''"
+
+	switch filter {
+	case model.EngagementDashboardFilterChapter:
+		identified = identified.
 			Joins("JOIN employee_chapters ec ON er.reviewer_id = ec.employee_id").
-			Joins("JOIN chapters f ON ec.chapter_id = f.id").
-			Group("f.name")
-	}
-	if filter == model.EngagementDashboardFilterSeniority {
-		query = query.
+			Joins("JOIN chapters f ON ec.chapter_id = f.id")
+		anonymous = anonymous.Joins("CROSS JOIN LATERAL jsonb_array_elements_text(CASE WHEN jsonb_typeof(aa.chapters) = 'array' THEN aa.chapters ELSE '[]'::jsonb END) AS f(name)")
+		identifiedName, anonymousName = "f.name", "f.name"
+	case model.EngagementDashboardFilterSeniority:
+		identified = identified.
 			Joins("JOIN employees e ON er.reviewer_id = e.id").
-			Joins("JOIN seniorities f ON e.seniority_id = f.id").
-			Group("f.name")
-	}
-	if filter == model.EngagementDashboardFilterProject {
-		query = query.
+			Joins("JOIN seniorities f ON e.seniority_id = f.id")
+		anonymous = anonymous.Where("aa.seniority <> ''")
+		identifiedName, anonymousName = "f.name", "aa.seniority"
+	case model.EngagementDashboardFilterProject:
+		identified = identified.
 			Joins("JOIN project_members pm ON er.reviewer_id = pm.employee_id").
 			Joins("JOIN projects f ON pm.project_id = f.id").
-			Where("f.status = ?", model.ProjectStatusActive).
-			Group("f.name")
+			Where("f.status = ?", model.ProjectStatusActive)
+		anonymous = anonymous.Joins("CROSS JOIN LATERAL jsonb_array_elements_text(CASE WHEN jsonb_typeof(aa.projects) = 'array' THEN aa.projects ELSE '[]'::jsonb END) AS f(name)")
+		identifiedName, anonymousName = "f.name", "f.name"
 	}
 
-	return result, query.Find(&result).Error
+	identified = identified.Select("eq.event_id, eq.question_id, eq.answer, eq.domain, " + identifiedName + " AS name")
+	anonymous = anonymous.Select("aa.event_id, aa.question_id, aa.answer, aa.domain, " + anonymousName + " AS name")
+
+	return db.Raw("? UNION ALL ?", identified, anonymous)
+}
+
+// ClearAnswersByEventReviewerID clear answers and notes of an event reviewer
+func (s *store) ClearAnswersByEventReviewerID(db *gorm.DB, eventReviewerID string) error {
+	return db.Table("employee_event_questions").
+		Where("employee_event_reviewer_id = ?", eventReviewerID).
+		Updates(map[string]interface{}{"answer": "", "note": ""}).Error
 }
@@ -19,4 +19,5 @@ type IStore interface {
 	CountLikertScaleByEventIDAndDomain(db *gorm.DB, eventID string, domain string) (*model.LikertScaleCount, error)
 	GetAverageAnswerEngagementByTime(db *gorm.DB, times []time.Time) ([]*model.StatisticEngagementDashboard, error)
 	GetAverageAnswerEngagementByFilter(db *gorm.DB, filter model.EngagementDashboardFilter, time *time.Time) ([]*model.StatisticEngagementDashboard, error)
+	ClearAnswersByEventReviewerID(db *gorm.DB, eventReviewerID string) error
 }
@@ -32,6 +32,74 @@ func (s *store) GetByTopicID(db *gorm.DB, topicID string) ([]*model.EmployeeEven
 	return employeeeventreviewer, db.Where("employee_event_topic_id = ? ", topicID).Find(&employeeeventreviewer).Error
 }
 
+// GetPendingByEventID get reviewers of an event who have not finished their review
+func (s *store) GetPendingByEventID(db *gorm.DB, eventID string) ([]*model.EmployeeEventReviewer, error) {
+	var eers []*model.EmployeeEventReviewer
+	return eers, db.Where("event_id = ? AND reviewer_status IN ?", eventID,
+		[]model.EventReviewerStatus{model.EventReviewerStatusNew, model.EventReviewerStatusDraft}).
+		Preload("Reviewer", "deleted_at IS NULL").
+		Preload("Reviewer.DiscordAccount", "deleted_at IS NULL").
+		Preload("EmployeeEventTopic", "deleted_at IS NULL").
+		Find(&eers).Error
+}
+
+// GetAnswered get the finished reviews of a survey with their topic, reviewer and answers,
+// reviews forced to done were never answered and are left out
+func (s *store) GetAnswered(db *gorm.DB, input GetAnsweredInput) ([]*model.EmployeeEventReviewer, error) {
+	query := db.Where("employee_event_reviewers.event_id = ? AND employee_event_reviewers.reviewer_status = ? AND employee_event_reviewers.is_forced_done IS FALSE",
+		input.EventID, model.EventReviewerStatusDone)
+
+	if input.TopicID != "" {
+		query = query.Where("employee_event_reviewers.employee_event_topic_id = ?", input.TopicID)
+	}
+	if input.EmployeeID != "" {
+		query = query.Where("employee_event_reviewers.employee_event_topic_id IN (SELECT id FROM employee_event_topics WHERE employee_id = ? AND deleted_at IS NULL)", input.EmployeeID)
+	}
+	if input.SharedOnly {
+		query = query.Where("employee_event_reviewers.is_shared IS TRUE")
+	}
+
+	var eers []*model.EmployeeEventReviewer
+	return eers, query.
+		Preload("Reviewer", "deleted_at IS NULL").
+		Preload("EmployeeEventTopic", "deleted_at IS NULL").
+		Preload("EmployeeEventQuestions", func(db *gorm.DB) *gorm.DB {
+			return db.Where("deleted_at IS NULL").Order("\"order\"")
+		}).
+		Order("employee_event_reviewers.created_at").
+		Find(&eers).Error
+}
+
+// CountCompletionByProject count reviewers of an event and those who answered, grouped by their active projects.
+// Topics about a project are only counted for that project
+func (s *store) CountCompletionByProject(db *gorm.DB, eventID string) ([]*model.SurveyProjectCompletion, error) {
+	var res []*model.SurveyProjectCompletion
+
+	query := `
+		SELECT p.id AS project_id,
+			p.name AS project_name,
+			count(er.id) AS total,
+			count(er.id) FILTER (WHERE er.reviewer_status = ? AND er.is_forced_done IS FALSE) AS done
+		FROM employee_event_reviewers er
+			JOIN employee_event_topics t ON t.id = er.employee_event_topic_id
+			JOIN project_members pm ON pm.employee_id = er.reviewer_id AND pm.deleted_at IS NULL AND pm.status = ?
+			JOIN projects p ON p.id = pm.project_id AND p.deleted_at IS NULL AND p.status = ?
+		WHERE er.event_id = ? AND er.deleted_at IS NULL
+			AND er.reviewer_status <> ?
+			AND (t.project_id IS NULL OR t.project_id = p.id)
+		GROUP BY p.id, p.name
+		ORDER BY p.name
+	`
+
+	return res, db.Raw(query,
+		model.EventReviewerStatusDone,
+		model.ProjectMemberStatusActive,
+		model.ProjectStatusActive,
+		eventID,
+		model.EventReviewerStatusNone,
+	).Scan(&res).Error
+}
+
 // UpdateSelectedFieldsByID just update selected fields by id
 func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.EmployeeEventReviewer, updatedFields ...string) (*model.EmployeeEventReviewer, error) {
 	eventReviewer := model.EmployeeEventReviewer{}
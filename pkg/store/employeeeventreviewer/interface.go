@@ -10,6 +10,9 @@ type IStore interface {
 	One(db *gorm.DB, id string) (employeeeventreviewer *model.EmployeeEventReviewer, err error)
 	OneByReviewerID(db *gorm.DB, reviewerID string, topicID string) (*model.EmployeeEventReviewer, error)
 	GetByTopicID(db *gorm.DB, topicID string) ([]*model.EmployeeEventReviewer, error)
+	GetPendingByEventID(db *gorm.DB, eventID string) ([]*model.EmployeeEventReviewer, error)
+	GetAnswered(db *gorm.DB, input GetAnsweredInput) ([]*model.EmployeeEventReviewer, error)
+	CountCompletionByProject(db *gorm.DB, eventID string) ([]*model.SurveyProjectCompletion, error)
 	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.EmployeeEventReviewer, updatedFields ...string) (employeeEventReviewer *model.EmployeeEventReviewer, err error)
 	BatchCreate(db *gorm.DB, employeeEventReviewers []model.EmployeeEventReviewer) ([]model.EmployeeEventReviewer, error)
 	Create(tx *gorm.DB, eventReviewer *model.EmployeeEventReviewer) (employeeEventReviewer *model.EmployeeEventReviewer, err error)
@@ -0,0 +1,10 @@
+package employeeeventreviewer
+
+// GetAnsweredInput filters the answered reviews of a survey
+type GetAnsweredInput struct {
+	EventID    string
+	TopicID    string
+	EmployeeID string
+	// SharedOnly leaves out the reviews that were not shared with the reviewed employee
+	SharedOnly bool
+}
@@ -84,6 +84,41 @@ func (s *store) GetByEmployeeID(db *gorm.DB, employeeID string, input GetByEmplo
 		Find(&eTopics).Error
 }
 
+// GetContinuousFeedback return the feedback and kudos employees sent to each other outside of surveys, newest first
+func (s *store) GetContinuousFeedback(db *gorm.DB, input GetContinuousFeedbackInput, pagination model.Pagination) ([]*model.EmployeeEventTopic, int64, error) {
+	var eTopics []*model.EmployeeEventTopic
+	var total int64
+
+	query := db.
+		Table("employee_event_topics").
+		Joins("JOIN feedback_events fe ON employee_event_topics.event_id = fe.id AND fe.deleted_at IS NULL").
+		Where("employee_event_topics.deleted_at IS NULL AND fe.type = ? AND fe.visibility IN ?", model.EventTypeFeedback, input.Visibilities)
+
+	if input.EmployeeID != "" {
+		query = query.Where("employee_event_topics.employee_id = ?", input.EmployeeID)
+	}
+	if input.Subtype != "" {
+		query = query.Where("fe.subtype = ?", input.Subtype)
+	}
+
+	query = query.Count(&total).Order("employee_event_topics.created_at DESC")
+
+	limit, offset := pagination.ToLimitOffset()
+	if pagination.Page > 0 {
+		query = query.Limit(limit)
+	}
+
+	return eTopics, total, query.
+		Offset(offset).
+		Preload("Event", "deleted_at IS NULL").
+		Preload("Event.Employee", "deleted_at IS NULL").
+		Preload("Employee", "deleted_at IS NULL").
+		Preload("Project", "deleted_at IS NULL").
+		Preload("EmployeeEventReviewers", "deleted_at IS NULL").
+		Preload("EmployeeEventReviewers.EmployeeEventQuestions", "deleted_at IS NULL").
+		Find(&eTopics).Error
+}
+
 // CountUnreadFeedbackByEmployeeID return total of unread inbox of the employee
 func (s *store) CountUnreadFeedbackByEmployeeID(db *gorm.DB, employeeID string) (int64, error) {
 	var total int64
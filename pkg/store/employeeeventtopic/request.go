@@ -1,5 +1,7 @@
 package employeeeventtopic
 
+import "github.com/dwarvesf/fortress-api/pkg/model"
+
 type GetByEmployeeIDInput struct {
 	Status string
 }
@@ -12,3 +14,10 @@ type GetByEventIDInput struct {
 	Preload  bool
 	Paging   bool
 }
+
+// GetContinuousFeedbackInput filters the continuous feedback sent outside of surveys
+type GetContinuousFeedbackInput struct {
+	EmployeeID   string
+	Subtype      model.EventSubtype
+	Visibilities []model.FeedbackVisibility
+}
@@ -0,0 +1,49 @@
+package employeeidentity
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// One get identity by id
+func (s *store) One(db *gorm.DB, id string) (*model.EmployeeIdentity, error) {
+	var identity *model.EmployeeIdentity
+	return identity, db.Where("id = ?", id).First(&identity).Error
+}
+
+// OneByProviderSubject get identity by provider and the user id on provider side
+func (s *store) OneByProviderSubject(db *gorm.DB, provider string, subject string) (*model.EmployeeIdentity, error) {
+	var identity *model.EmployeeIdentity
+	return identity, db.Where("provider = ? AND subject = ?", provider, subject).
+		Preload("Employee", "deleted_at IS NULL").
+		First(&identity).Error
+}
+
+// GetByEmployeeID get all identities linked to an employee
+func (s *store) GetByEmployeeID(db *gorm.DB, employeeID string) ([]*model.EmployeeIdentity, error) {
+	var identities []*model.EmployeeIdentity
+	return identities, db.Where("employee_id = ?", employeeID).Order("created_at").Find(&identities).Error
+}
+
+// Create creates a new identity
+func (s *store) Create(db *gorm.DB, identity *model.EmployeeIdentity) (*model.EmployeeIdentity, error) {
+	return identity, db.Create(identity).Error
+}
+
+// UpdateSelectedFieldsByID just update selected fields by id
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.EmployeeIdentity, updatedFields ...string) (*model.EmployeeIdentity, error) {
+	identity := model.EmployeeIdentity{}
+	return &identity, db.Model(&identity).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+// Delete unlink an identity
+func (s *store) Delete(db *gorm.DB, id string) error {
+	return db.Where("id = ?", id).Delete(&model.EmployeeIdentity{}).Error
+}
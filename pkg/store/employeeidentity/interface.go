@@ -0,0 +1,16 @@
+package employeeidentity
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	One(db *gorm.DB, id string) (identity *model.EmployeeIdentity, err error)
+	OneByProviderSubject(db *gorm.DB, provider string, subject string) (identity *model.EmployeeIdentity, err error)
+	GetByEmployeeID(db *gorm.DB, employeeID string) (identities []*model.EmployeeIdentity, err error)
+	Create(db *gorm.DB, identity *model.EmployeeIdentity) (*model.EmployeeIdentity, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.EmployeeIdentity, updatedFields ...string) (*model.EmployeeIdentity, error)
+	Delete(db *gorm.DB, id string) error
+}
@@ -98,3 +98,11 @@ func (s *store) GetLatestEventByType(db *gorm.DB, eventType model.EventType, eve
 	var feedbackEvents []*model.FeedbackEvent
 	return feedbackEvents, db.Where("type = ? AND subtype = ?", eventType, eventSubtype).Order("start_date DESC").Limit(num).Find(&feedbackEvents).Error
 }
+
+// GetInProgressWithDeadline get in-progress events which have a deadline
+func (s *store) GetInProgressWithDeadline(db *gorm.DB) ([]*model.FeedbackEvent, error) {
+	var feedbackEvents []*model.FeedbackEvent
+	return feedbackEvents, db.Where("status = ? AND deadline IS NOT NULL", model.EventStatusInProgress).
+		Order("deadline").
+		Find(&feedbackEvents).Error
+}
@@ -1,6 +1,8 @@
 package invoice
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 
 	"github.com/dwarvesf/fortress-api/pkg/model"
@@ -10,8 +12,10 @@ type IStore interface {
 	All(db *gorm.DB, filter GetInvoicesFilter, pagination model.Pagination) (invoices []*model.Invoice, total int64, err error)
 	Create(db *gorm.DB, e *model.Invoice) (invoice *model.Invoice, err error)
 	Delete(db *gorm.DB, id string) (err error)
+	GetByProjectID(db *gorm.DB, projectID string, statuses []model.InvoiceStatus) (invoices []*model.Invoice, err error)
 	GetLatestInvoiceByProject(db *gorm.DB, projectID string) (invoice *model.Invoice, err error)
 	GetNextInvoiceNumber(db *gorm.DB, year int, projectCode string) (*string, error)
+	GetOverdueByProjectID(db *gorm.DB, projectID string, now time.Time) (invoices []*model.Invoice, err error)
 	IsExist(db *gorm.DB, id string) (exists bool, err error)
 	One(db *gorm.DB, query *Query) (invoice *model.Invoice, err error)
 	Save(db *gorm.DB, e *model.Invoice) (invoice *model.Invoice, err error)
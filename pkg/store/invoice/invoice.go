@@ -52,6 +52,25 @@ func (s *store) GetLatestInvoiceByProject(db *gorm.DB, projectID string) (*model
 	return &iv, db.Where("project_id = ? AND status != ? AND status != ?", projectID, model.InvoiceStatusDraft, model.InvoiceStatusError).Order("created_at DESC").First(&iv).Error
 }
 
+// GetOverdueByProjectID get the unpaid invoices of a project which are past due, the oldest due first
+func (s *store) GetOverdueByProjectID(db *gorm.DB, projectID string, now time.Time) ([]*model.Invoice, error) {
+	var invoices []*model.Invoice
+	return invoices, db.Where("deleted_at IS NULL AND project_id = ?", projectID).
+		Where("status = ? OR (status = ? AND due_at < ?)", model.InvoiceStatusOverdue, model.InvoiceStatusSent, now).
+		Order("due_at").
+		Find(&invoices).Error
+}
+
+// GetByProjectID get the invoices of a project in some statuses with their currency, newest first
+func (s *store) GetByProjectID(db *gorm.DB, projectID string, statuses []model.InvoiceStatus) ([]*model.Invoice, error) {
+	var invoices []*model.Invoice
+	return invoices, db.Where("deleted_at IS NULL AND project_id = ? AND status IN (?)", projectID, statuses).
+		Preload("Bank").
+		Preload("Bank.Currency").
+		Order("invoiced_at DESC NULLS LAST, created_at DESC").
+		Find(&invoices).Error
+}
+
 // All getNext all invoice
 func (s *store) All(db *gorm.DB, filter GetInvoicesFilter, pagination model.Pagination) ([]*model.Invoice, int64, error) {
 	var total int64
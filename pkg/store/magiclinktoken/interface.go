@@ -0,0 +1,15 @@
+package magiclinktoken
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	OneByTokenHash(db *gorm.DB, tokenHash string) (token *model.MagicLinkToken, err error)
+	Create(db *gorm.DB, token *model.MagicLinkToken) (*model.MagicLinkToken, error)
+	MarkUsed(db *gorm.DB, id string, usedAt time.Time) (ok bool, err error)
+}
@@ -0,0 +1,32 @@
+package magiclinktoken
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// OneByTokenHash get magic link token by its hash
+func (s *store) OneByTokenHash(db *gorm.DB, tokenHash string) (*model.MagicLinkToken, error) {
+	var token *model.MagicLinkToken
+	return token, db.Where("token_hash = ?", tokenHash).First(&token).Error
+}
+
+// Create creates a new magic link token
+func (s *store) Create(db *gorm.DB, token *model.MagicLinkToken) (*model.MagicLinkToken, error) {
+	return token, db.Create(token).Error
+}
+
+// MarkUsed marks the token as used, ok is false if it was already used by another request
+func (s *store) MarkUsed(db *gorm.DB, id string, usedAt time.Time) (bool, error) {
+	res := db.Model(&model.MagicLinkToken{}).Where("id = ? AND used_at IS NULL", id).Update("used_at", usedAt)
+	return res.RowsAffected == 1, res.Error
+}
@@ -0,0 +1,23 @@
+package objective
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	One(db *gorm.DB, id string, preload bool) (objective *model.Objective, err error)
+	All(db *gorm.DB, filter Filter, pagination model.Pagination) (objectives []*model.Objective, total int64, err error)
+	GetDescendants(db *gorm.DB, id string) (objectives []*model.Objective, err error)
+	Create(db *gorm.DB, objective *model.Objective) (*model.Objective, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.Objective, updatedFields ...string) (*model.Objective, error)
+
+	OneKeyResult(db *gorm.DB, objectiveID string, id string) (keyResult *model.KeyResult, err error)
+	GetCheckInDueKeyResults(db *gorm.DB) (keyResults []*model.KeyResult, err error)
+	CreateKeyResult(db *gorm.DB, keyResult *model.KeyResult) (*model.KeyResult, error)
+	UpdateKeyResultSelectedFieldsByID(db *gorm.DB, id string, updateModel model.KeyResult, updatedFields ...string) (*model.KeyResult, error)
+
+	GetCheckIns(db *gorm.DB, keyResultID string, pagination model.Pagination) (checkIns []*model.KeyResultCheckIn, total int64, err error)
+	CreateCheckIn(db *gorm.DB, checkIn *model.KeyResultCheckIn) (*model.KeyResultCheckIn, error)
+}
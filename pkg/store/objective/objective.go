@@ -0,0 +1,191 @@
+package objective
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+type Filter struct {
+	Level      string
+	Status     string
+	ParentID   string
+	OwnerID    string
+	ProjectID  string
+	ChapterID  string
+	EmployeeID string
+	// From and To keep the objectives whose period overlaps them
+	From *time.Time
+	To   *time.Time
+}
+
+// One get objective by id, preload loads its owner, what it belongs to and its key results
+func (s *store) One(db *gorm.DB, id string, preload bool) (*model.Objective, error) {
+	var objective *model.Objective
+
+	query := db.Where("id = ?", id)
+	if preload {
+		query = preloadObjective(query)
+	}
+
+	return objective, query.First(&objective).Error
+}
+
+// All get objectives by filter with pagination, with their key results
+func (s *store) All(db *gorm.DB, filter Filter, pagination model.Pagination) ([]*model.Objective, int64, error) {
+	var objectives []*model.Objective
+	var total int64
+
+	query := db.Table("objectives").Where("deleted_at IS NULL")
+
+	if filter.Level != "" {
+		query = query.Where("level = ?", filter.Level)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.ParentID != "" {
+		query = query.Where("parent_id = ?", filter.ParentID)
+	}
+	if filter.OwnerID != "" {
+		query = query.Where("owner_id = ?", filter.OwnerID)
+	}
+	if filter.ProjectID != "" {
+		query = query.Where("project_id = ?", filter.ProjectID)
+	}
+	if filter.ChapterID != "" {
+		query = query.Where("chapter_id = ?", filter.ChapterID)
+	}
+	if filter.EmployeeID != "" {
+		query = query.Where("employee_id = ?", filter.EmployeeID)
+	}
+	if filter.From != nil {
+		query = query.Where("end_date >= ?", filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("start_date <= ?", filter.To)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if pagination.Sort != "" {
+		query = query.Order(pagination.Sort)
+	} else {
+		query = query.Order("start_date DESC, created_at")
+	}
+
+	limit, offset := pagination.ToLimitOffset()
+	if pagination.Page > 0 {
+		query = query.Limit(limit)
+	}
+
+	return objectives, total, preloadObjective(query.Offset(offset)).Find(&objectives).Error
+}
+
+// GetDescendants get the objectives aligned to an objective, directly or through other objectives, with their key results
+func (s *store) GetDescendants(db *gorm.DB, id string) ([]*model.Objective, error) {
+	var objectives []*model.Objective
+	return objectives, db.Where(`id IN (
+		WITH RECURSIVE descendants AS (
+			SELECT o.id FROM objectives o WHERE o.parent_id = ? AND o.deleted_at IS NULL
+			UNION
+			SELECT o.id FROM objectives o JOIN descendants d ON o.parent_id = d.id WHERE o.deleted_at IS NULL
+		)
+		SELECT id FROM descendants
+	)`, id).
+		Preload("KeyResults", "deleted_at IS NULL").
+		Find(&objectives).Error
+}
+
+// Create creates a new objective
+func (s *store) Create(db *gorm.DB, objective *model.Objective) (*model.Objective, error) {
+	return objective, db.Omit(clause.Associations).Create(objective).Error
+}
+
+// UpdateSelectedFieldsByID just update selected fields by id
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.Objective, updatedFields ...string) (*model.Objective, error) {
+	objective := model.Objective{}
+	return &objective, db.Model(&objective).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+// OneKeyResult get a key result of an objective
+func (s *store) OneKeyResult(db *gorm.DB, objectiveID string, id string) (*model.KeyResult, error) {
+	var keyResult *model.KeyResult
+	return keyResult, db.Where("id = ? AND objective_id = ?", id, objectiveID).
+		Preload("Owner", "deleted_at IS NULL").
+		First(&keyResult).Error
+}
+
+// GetCheckInDueKeyResults get the key results of active objectives which are in their period,
+// with their objective and the Discord account of their owner
+func (s *store) GetCheckInDueKeyResults(db *gorm.DB) ([]*model.KeyResult, error) {
+	var keyResults []*model.KeyResult
+	return keyResults, db.Joins("JOIN objectives o ON o.id = key_results.objective_id AND o.deleted_at IS NULL").
+		Where("o.status = ? AND o.start_date <= now() AND o.end_date >= now()", model.ObjectiveStatusActive).
+		Preload("Objective", "deleted_at IS NULL").
+		Preload("Owner", "deleted_at IS NULL").
+		Preload("Owner.DiscordAccount", "deleted_at IS NULL").
+		Order("key_results.owner_id, key_results.created_at").
+		Find(&keyResults).Error
+}
+
+// CreateKeyResult creates a key result
+func (s *store) CreateKeyResult(db *gorm.DB, keyResult *model.KeyResult) (*model.KeyResult, error) {
+	return keyResult, db.Omit(clause.Associations).Create(keyResult).Error
+}
+
+// UpdateKeyResultSelectedFieldsByID just update selected fields of a key result by id
+func (s *store) UpdateKeyResultSelectedFieldsByID(db *gorm.DB, id string, updateModel model.KeyResult, updatedFields ...string) (*model.KeyResult, error) {
+	keyResult := model.KeyResult{}
+	return &keyResult, db.Model(&keyResult).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+// GetCheckIns get the check-ins of a key result, latest first
+func (s *store) GetCheckIns(db *gorm.DB, keyResultID string, pagination model.Pagination) ([]*model.KeyResultCheckIn, int64, error) {
+	var checkIns []*model.KeyResultCheckIn
+	var total int64
+
+	query := db.Model(&model.KeyResultCheckIn{}).Where("key_result_id = ?", keyResultID)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	limit, offset := pagination.ToLimitOffset()
+	if pagination.Page > 0 {
+		query = query.Limit(limit)
+	}
+
+	return checkIns, total, query.Offset(offset).
+		Order("created_at DESC").
+		Preload("Employee", "deleted_at IS NULL").
+		Find(&checkIns).Error
+}
+
+// CreateCheckIn creates a check-in of a key result
+func (s *store) CreateCheckIn(db *gorm.DB, checkIn *model.KeyResultCheckIn) (*model.KeyResultCheckIn, error) {
+	return checkIn, db.Omit(clause.Associations).Create(checkIn).Error
+}
+
+func preloadObjective(query *gorm.DB) *gorm.DB {
+	return query.
+		Preload("Owner", "deleted_at IS NULL").
+		Preload("Project", "deleted_at IS NULL").
+		Preload("Chapter", "deleted_at IS NULL").
+		Preload("Employee", "deleted_at IS NULL").
+		Preload("KeyResults", func(db *gorm.DB) *gorm.DB {
+			return db.Where("deleted_at IS NULL").Order("created_at")
+		}).
+		Preload("KeyResults.Owner", "deleted_at IS NULL")
+}
@@ -0,0 +1,26 @@
+package oneonone
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	One(db *gorm.DB, id string, preload bool) (meeting *model.OneOnOneMeeting, err error)
+	All(db *gorm.DB, filter Filter, pagination model.Pagination) (meetings []*model.OneOnOneMeeting, total int64, err error)
+	Create(db *gorm.DB, meeting *model.OneOnOneMeeting) (*model.OneOnOneMeeting, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.OneOnOneMeeting, updatedFields ...string) (*model.OneOnOneMeeting, error)
+
+	OneAgendaItem(db *gorm.DB, meetingID string, id string) (item *model.OneOnOneAgendaItem, err error)
+	GetOpenAgendaItems(db *gorm.DB, managerID string, reportID string, before time.Time, excludedMeetingID string) (items []*model.OneOnOneAgendaItem, err error)
+	CreateAgendaItem(db *gorm.DB, item *model.OneOnOneAgendaItem) (*model.OneOnOneAgendaItem, error)
+	UpdateAgendaItemSelectedFieldsByID(db *gorm.DB, id string, updateModel model.OneOnOneAgendaItem, updatedFields ...string) (*model.OneOnOneAgendaItem, error)
+
+	OneActionItem(db *gorm.DB, meetingID string, id string) (item *model.OneOnOneActionItem, err error)
+	GetOpenActionItems(db *gorm.DB, managerID string, reportID string, before time.Time, excludedMeetingID string) (items []*model.OneOnOneActionItem, err error)
+	CreateActionItem(db *gorm.DB, item *model.OneOnOneActionItem) (*model.OneOnOneActionItem, error)
+	UpdateActionItemSelectedFieldsByID(db *gorm.DB, id string, updateModel model.OneOnOneActionItem, updatedFields ...string) (*model.OneOnOneActionItem, error)
+}
@@ -0,0 +1,183 @@
+package oneonone
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+type Filter struct {
+	// ParticipantID keeps the meetings the employee is the manager or the report of
+	ParticipantID string
+	// CounterpartID keeps the meetings of the participant with this employee, whoever is the manager
+	CounterpartID string
+	ManagerID     string
+	ReportID      string
+	Status        string
+	// From and To keep the meetings starting between them
+	From *time.Time
+	To   *time.Time
+}
+
+// One get 1:1 meeting by id, preload loads its participants, schedule, agenda and action items
+func (s *store) One(db *gorm.DB, id string, preload bool) (*model.OneOnOneMeeting, error) {
+	var meeting *model.OneOnOneMeeting
+
+	query := db.Where("id = ?", id).Preload("Schedule").Preload("Schedule.GoogleCalendar")
+	if preload {
+		query = preloadMeeting(query).
+			Preload("AgendaItems", func(db *gorm.DB) *gorm.DB {
+				return db.Where("deleted_at IS NULL").Order("created_at")
+			}).
+			Preload("AgendaItems.Author", "deleted_at IS NULL").
+			Preload("ActionItems", func(db *gorm.DB) *gorm.DB {
+				return db.Where("deleted_at IS NULL").Order("created_at")
+			}).
+			Preload("ActionItems.Owner", "deleted_at IS NULL")
+	}
+
+	return meeting, query.First(&meeting).Error
+}
+
+// All get 1:1 meetings by filter with pagination, latest first
+func (s *store) All(db *gorm.DB, filter Filter, pagination model.Pagination) ([]*model.OneOnOneMeeting, int64, error) {
+	var meetings []*model.OneOnOneMeeting
+	var total int64
+
+	query := db.Table("one_on_one_meetings").
+		Joins("JOIN schedules ON schedules.id = one_on_one_meetings.schedule_id").
+		Where("one_on_one_meetings.deleted_at IS NULL")
+
+	if filter.ParticipantID != "" {
+		query = query.Where("(one_on_one_meetings.manager_id = ? OR one_on_one_meetings.report_id = ?)", filter.ParticipantID, filter.ParticipantID)
+	}
+	if filter.CounterpartID != "" {
+		query = query.Where("(one_on_one_meetings.manager_id = ? OR one_on_one_meetings.report_id = ?)", filter.CounterpartID, filter.CounterpartID)
+	}
+	if filter.ManagerID != "" {
+		query = query.Where("one_on_one_meetings.manager_id = ?", filter.ManagerID)
+	}
+	if filter.ReportID != "" {
+		query = query.Where("one_on_one_meetings.report_id = ?", filter.ReportID)
+	}
+	if filter.Status != "" {
+		query = query.Where("one_on_one_meetings.status = ?", filter.Status)
+	}
+	if filter.From != nil {
+		query = query.Where("schedules.start_time >= ?", filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("schedules.start_time <= ?", filter.To)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	limit, offset := pagination.ToLimitOffset()
+	if pagination.Page > 0 {
+		query = query.Limit(limit)
+	}
+
+	return meetings, total, preloadMeeting(query.Offset(offset)).
+		Select("one_on_one_meetings.*").
+		Preload("Schedule").
+		Order("schedules.start_time DESC").
+		Find(&meetings).Error
+}
+
+// Create creates a new 1:1 meeting
+func (s *store) Create(db *gorm.DB, meeting *model.OneOnOneMeeting) (*model.OneOnOneMeeting, error) {
+	return meeting, db.Omit(clause.Associations).Create(meeting).Error
+}
+
+// UpdateSelectedFieldsByID just update selected fields by id
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.OneOnOneMeeting, updatedFields ...string) (*model.OneOnOneMeeting, error) {
+	meeting := model.OneOnOneMeeting{}
+	return &meeting, db.Model(&meeting).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+// OneAgendaItem get an agenda item of a meeting
+func (s *store) OneAgendaItem(db *gorm.DB, meetingID string, id string) (*model.OneOnOneAgendaItem, error) {
+	var item *model.OneOnOneAgendaItem
+	return item, db.Where("id = ? AND meeting_id = ?", id, meetingID).
+		Preload("Author", "deleted_at IS NULL").
+		First(&item).Error
+}
+
+// GetOpenAgendaItems get the agenda items not discussed yet in the meetings of a manager and a report starting before a time
+func (s *store) GetOpenAgendaItems(db *gorm.DB, managerID string, reportID string, before time.Time, excludedMeetingID string) ([]*model.OneOnOneAgendaItem, error) {
+	var items []*model.OneOnOneAgendaItem
+	return items, pairItemsBefore(db.Table("one_on_one_agenda_items"), "one_on_one_agenda_items", managerID, reportID, before, excludedMeetingID).
+		Where("one_on_one_agenda_items.is_discussed IS FALSE").
+		Select("one_on_one_agenda_items.*").
+		Preload("Author", "deleted_at IS NULL").
+		Order("one_on_one_agenda_items.created_at").
+		Find(&items).Error
+}
+
+// CreateAgendaItem creates a new agenda item
+func (s *store) CreateAgendaItem(db *gorm.DB, item *model.OneOnOneAgendaItem) (*model.OneOnOneAgendaItem, error) {
+	return item, db.Omit(clause.Associations).Create(item).Error
+}
+
+// UpdateAgendaItemSelectedFieldsByID just update selected fields of an agenda item by id
+func (s *store) UpdateAgendaItemSelectedFieldsByID(db *gorm.DB, id string, updateModel model.OneOnOneAgendaItem, updatedFields ...string) (*model.OneOnOneAgendaItem, error) {
+	item := model.OneOnOneAgendaItem{}
+	return &item, db.Model(&item).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+// OneActionItem get an action item of a meeting
+func (s *store) OneActionItem(db *gorm.DB, meetingID string, id string) (*model.OneOnOneActionItem, error) {
+	var item *model.OneOnOneActionItem
+	return item, db.Where("id = ? AND meeting_id = ?", id, meetingID).
+		Preload("Owner", "deleted_at IS NULL").
+		First(&item).Error
+}
+
+// GetOpenActionItems get the open action items of the meetings of a manager and a report starting before a time
+func (s *store) GetOpenActionItems(db *gorm.DB, managerID string, reportID string, before time.Time, excludedMeetingID string) ([]*model.OneOnOneActionItem, error) {
+	var items []*model.OneOnOneActionItem
+	return items, pairItemsBefore(db.Table("one_on_one_action_items"), "one_on_one_action_items", managerID, reportID, before, excludedMeetingID).
+		Where("one_on_one_action_items.status = ?", model.OneOnOneActionItemStatusOpen).
+		Select("one_on_one_action_items.*").
+		Preload("Owner", "deleted_at IS NULL").
+		Order("one_on_one_action_items.due_date NULLS LAST, one_on_one_action_items.created_at").
+		Find(&items).Error
+}
+
+// CreateActionItem creates a new action item
+func (s *store) CreateActionItem(db *gorm.DB, item *model.OneOnOneActionItem) (*model.OneOnOneActionItem, error) {
+	return item, db.Omit(clause.Associations).Create(item).Error
+}
+
+// UpdateActionItemSelectedFieldsByID just update selected fields of an action item by id
+func (s *store) UpdateActionItemSelectedFieldsByID(db *gorm.DB, id string, updateModel model.OneOnOneActionItem, updatedFields ...string) (*model.OneOnOneActionItem, error) {
+	item := model.OneOnOneActionItem{}
+	return &item, db.Model(&item).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+func preloadMeeting(query *gorm.DB) *gorm.DB {
+	return query.
+		Preload("Manager", "deleted_at IS NULL").
+		Preload("Report", "deleted_at IS NULL")
+}
+
+// pairItemsBefore scopes the items of a table to the other meetings of a manager and a report starting before a time
+func pairItemsBefore(query *gorm.DB, table string, managerID string, reportID string, before time.Time, excludedMeetingID string) *gorm.DB {
+	return query.
+		Joins("JOIN one_on_one_meetings m ON m.id = "+table+".meeting_id").
+		Joins("JOIN schedules s ON s.id = m.schedule_id").
+		Where(table+".deleted_at IS NULL AND m.deleted_at IS NULL").
+		Where("m.manager_id = ? AND m.report_id = ?", managerID, reportID).
+		Where("m.id <> ? AND s.start_time < ?", excludedMeetingID, before)
+}
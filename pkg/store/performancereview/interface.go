@@ -0,0 +1,24 @@
+package performancereview
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	One(db *gorm.DB, id string, preload bool) (cycle *model.PerformanceReviewCycle, err error)
+	All(db *gorm.DB, filter Filter, pagination model.Pagination) (cycles []*model.PerformanceReviewCycle, total int64, err error)
+	Create(db *gorm.DB, cycle *model.PerformanceReviewCycle) (*model.PerformanceReviewCycle, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.PerformanceReviewCycle, updatedFields ...string) (*model.PerformanceReviewCycle, error)
+
+	OneReview(db *gorm.DB, id string) (review *model.PerformanceReview, err error)
+	GetReviews(db *gorm.DB, filter ReviewFilter, pagination model.Pagination) (reviews []*model.PerformanceReview, total int64, err error)
+	GetReviewsByCycleID(db *gorm.DB, cycleID string) (reviews []*model.PerformanceReview, err error)
+	BatchCreateReviews(db *gorm.DB, reviews []model.PerformanceReview) ([]model.PerformanceReview, error)
+	UpdateReviewSelectedFieldsByID(db *gorm.DB, id string, updateModel model.PerformanceReview, updatedFields ...string) (*model.PerformanceReview, error)
+
+	GetEngagementStats(db *gorm.DB, employeeID string, from, to time.Time) (*model.PerformanceEngagementStats, error)
+}
@@ -0,0 +1,166 @@
+package performancereview
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+type Filter struct {
+	Status  string
+	Keyword string
+}
+
+type ReviewFilter struct {
+	CycleID    string
+	EmployeeID string
+	ReviewerID string
+	Status     string
+}
+
+// One get performance review cycle by id, preload loads its creator and peer-review survey
+func (s *store) One(db *gorm.DB, id string, preload bool) (*model.PerformanceReviewCycle, error) {
+	var cycle *model.PerformanceReviewCycle
+
+	query := db.Where("id = ?", id)
+	if preload {
+		query = query.Preload("Creator", "deleted_at IS NULL").
+			Preload("PeerReviewEvent", "deleted_at IS NULL")
+	}
+
+	return cycle, query.First(&cycle).Error
+}
+
+// All get performance review cycles by filter with pagination
+func (s *store) All(db *gorm.DB, filter Filter, pagination model.Pagination) ([]*model.PerformanceReviewCycle, int64, error) {
+	var cycles []*model.PerformanceReviewCycle
+	var total int64
+
+	query := db.Table("performance_review_cycles").Where("deleted_at IS NULL")
+
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Keyword != "" {
+		query = query.Where("name ILIKE ?", "%"+filter.Keyword+"%")
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if pagination.Sort != "" {
+		query = query.Order(pagination.Sort)
+	} else {
+		query = query.Order("start_date DESC")
+	}
+
+	limit, offset := pagination.ToLimitOffset()
+	if pagination.Page > 0 {
+		query = query.Limit(limit)
+	}
+
+	return cycles, total, query.Offset(offset).
+		Preload("Creator", "deleted_at IS NULL").
+		Preload("PeerReviewEvent", "deleted_at IS NULL").
+		Find(&cycles).Error
+}
+
+// Create creates a new performance review cycle
+func (s *store) Create(db *gorm.DB, cycle *model.PerformanceReviewCycle) (*model.PerformanceReviewCycle, error) {
+	return cycle, db.Omit(clause.Associations).Create(cycle).Error
+}
+
+// UpdateSelectedFieldsByID just update selected fields by id
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.PerformanceReviewCycle, updatedFields ...string) (*model.PerformanceReviewCycle, error) {
+	cycle := model.PerformanceReviewCycle{}
+	return &cycle, db.Model(&cycle).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+// OneReview get performance review by id with its cycle, employee and reviewer
+func (s *store) OneReview(db *gorm.DB, id string) (*model.PerformanceReview, error) {
+	var review *model.PerformanceReview
+	return review, db.Where("id = ?", id).
+		Preload("Cycle", "deleted_at IS NULL").
+		Preload("Employee", "deleted_at IS NULL").
+		Preload("Reviewer", "deleted_at IS NULL").
+		First(&review).Error
+}
+
+// GetReviews get performance reviews by filter with pagination, latest cycle first
+func (s *store) GetReviews(db *gorm.DB, filter ReviewFilter, pagination model.Pagination) ([]*model.PerformanceReview, int64, error) {
+	var reviews []*model.PerformanceReview
+	var total int64
+
+	query := db.Model(&model.PerformanceReview{}).
+		Joins("JOIN performance_review_cycles c ON c.id = performance_reviews.cycle_id AND c.deleted_at IS NULL")
+
+	if filter.CycleID != "" {
+		query = query.Where("performance_reviews.cycle_id = ?", filter.CycleID)
+	}
+	if filter.EmployeeID != "" {
+		query = query.Where("performance_reviews.employee_id = ?", filter.EmployeeID)
+	}
+	if filter.ReviewerID != "" {
+		query = query.Where("performance_reviews.reviewer_id = ?", filter.ReviewerID)
+	}
+	if filter.Status != "" {
+		query = query.Where("performance_reviews.status = ?", filter.Status)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	limit, offset := pagination.ToLimitOffset()
+	if pagination.Page > 0 {
+		query = query.Limit(limit)
+	}
+
+	return reviews, total, query.Offset(offset).
+		Order("c.start_date DESC, performance_reviews.created_at").
+		Preload("Cycle", "deleted_at IS NULL").
+		Preload("Employee", "deleted_at IS NULL").
+		Preload("Reviewer", "deleted_at IS NULL").
+		Find(&reviews).Error
+}
+
+// GetReviewsByCycleID get all performance reviews of a cycle
+func (s *store) GetReviewsByCycleID(db *gorm.DB, cycleID string) ([]*model.PerformanceReview, error) {
+	var reviews []*model.PerformanceReview
+	return reviews, db.Where("cycle_id = ?", cycleID).Find(&reviews).Error
+}
+
+// BatchCreateReviews creates the performance reviews of a cycle
+func (s *store) BatchCreateReviews(db *gorm.DB, reviews []model.PerformanceReview) ([]model.PerformanceReview, error) {
+	return reviews, db.Omit(clause.Associations).Create(&reviews).Error
+}
+
+// UpdateReviewSelectedFieldsByID just update selected fields of a review by id
+func (s *store) UpdateReviewSelectedFieldsByID(db *gorm.DB, id string, updateModel model.PerformanceReview, updatedFields ...string) (*model.PerformanceReview, error) {
+	review := model.PerformanceReview{}
+	return &review, db.Model(&review).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+// GetEngagementStats counts the engagement surveys sent to an employee in a period and the ones they answered,
+// forced done reviews were never answered
+func (s *store) GetEngagementStats(db *gorm.DB, employeeID string, from, to time.Time) (*model.PerformanceEngagementStats, error) {
+	stats := model.PerformanceEngagementStats{}
+	return &stats, db.Table("employee_event_reviewers er").
+		Joins("JOIN feedback_events fe ON fe.id = er.event_id AND fe.deleted_at IS NULL").
+		Where("er.deleted_at IS NULL AND er.reviewer_id = ?", employeeID).
+		Where("er.reviewer_status <> ?", model.EventReviewerStatusNone).
+		Where("fe.type = ? AND fe.subtype = ?", model.EventTypeSurvey, model.EventSubtypeEngagement).
+		Where("fe.start_date BETWEEN ? AND ?", from, to).
+		Select("COUNT(*) AS surveys, COUNT(*) FILTER (WHERE er.reviewer_status = ? AND er.is_forced_done = FALSE) AS answered", model.EventReviewerStatusDone).
+		Scan(&stats).Error
+}
@@ -0,0 +1,18 @@
+package profitloss
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// IStore reads the revenue and the costs of projects by month, from and to are the first days of the first and last months
+type IStore interface {
+	GetInvoicedRevenues(db *gorm.DB, projectIDs []string, from, to time.Time) ([]*model.ProjectMonthAmount, error)
+	GetCollectedRevenues(db *gorm.DB, projectIDs []string, from, to time.Time) ([]*model.ProjectMonthAmount, error)
+	GetCommissions(db *gorm.DB, projectIDs []string, from, to time.Time) ([]*model.ProjectMonthAmount, error)
+	GetExpenses(db *gorm.DB, projectIDs []string, from, to time.Time) ([]*model.ProjectMonthAmount, error)
+	GetPayrollCosts(db *gorm.DB, employeeIDs []string, from, to time.Time) ([]*model.EmployeeMonthAmount, error)
+}
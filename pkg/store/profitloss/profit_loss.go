@@ -0,0 +1,110 @@
+package profitloss
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// GetInvoicedRevenues sums the converted amounts of the sent invoices by the month they bill
+func (s *store) GetInvoicedRevenues(db *gorm.DB, projectIDs []string, from, to time.Time) ([]*model.ProjectMonthAmount, error) {
+	var rs []*model.ProjectMonthAmount
+
+	query := `
+		SELECT project_id, year, month, SUM(conversion_amount) AS amount
+		FROM invoices
+		WHERE deleted_at IS NULL
+			AND project_id IN ?
+			AND status NOT IN ?
+			AND make_date(year, month, 1) BETWEEN ? AND ?
+		GROUP BY project_id, year, month
+	`
+
+	return rs, db.Raw(query, projectIDs, []string{model.InvoiceStatusDraft.String(), model.InvoiceStatusError.String()}, from, to).
+		Scan(&rs).Error
+}
+
+// GetCollectedRevenues sums the converted amounts of the paid invoices by the month they are paid
+func (s *store) GetCollectedRevenues(db *gorm.DB, projectIDs []string, from, to time.Time) ([]*model.ProjectMonthAmount, error) {
+	var rs []*model.ProjectMonthAmount
+
+	query := `
+		SELECT project_id,
+			date_part('year', paid_at)::INT AS year,
+			date_part('month', paid_at)::INT AS month,
+			SUM(conversion_amount) AS amount
+		FROM invoices
+		WHERE deleted_at IS NULL
+			AND project_id IN ?
+			AND status = ?
+			AND paid_at >= ? AND paid_at < ?
+		GROUP BY project_id, date_part('year', paid_at), date_part('month', paid_at)
+	`
+
+	return rs, db.Raw(query, projectIDs, model.InvoiceStatusPaid, from, to.AddDate(0, 1, 0)).
+		Scan(&rs).Error
+}
+
+// GetCommissions sums the commissions of the invoices by the month the invoices bill
+func (s *store) GetCommissions(db *gorm.DB, projectIDs []string, from, to time.Time) ([]*model.ProjectMonthAmount, error) {
+	var rs []*model.ProjectMonthAmount
+
+	query := `
+		SELECT invoices.project_id, invoices.year, invoices.month, SUM(employee_commissions.amount) AS amount
+		FROM employee_commissions
+			JOIN invoices ON invoices.id = employee_commissions.invoice_id
+		WHERE employee_commissions.deleted_at IS NULL
+			AND invoices.deleted_at IS NULL
+			AND invoices.project_id IN ?
+			AND make_date(invoices.year, invoices.month, 1) BETWEEN ? AND ?
+		GROUP BY invoices.project_id, invoices.year, invoices.month
+	`
+
+	return rs, db.Raw(query, projectIDs, from, to).Scan(&rs).Error
+}
+
+// GetExpenses sums the converted amounts of the project-tagged expenses by the month they are issued
+func (s *store) GetExpenses(db *gorm.DB, projectIDs []string, from, to time.Time) ([]*model.ProjectMonthAmount, error) {
+	var rs []*model.ProjectMonthAmount
+
+	query := `
+		SELECT expenses.project_id,
+			date_part('year', expenses.issued_date)::INT AS year,
+			date_part('month', expenses.issued_date)::INT AS month,
+			SUM(COALESCE(accounting_transactions.conversion_amount, expenses.amount)) AS amount
+		FROM expenses
+			LEFT JOIN accounting_transactions ON accounting_transactions.id = expenses.accounting_transaction_id
+				AND accounting_transactions.deleted_at IS NULL
+		WHERE expenses.deleted_at IS NULL
+			AND expenses.project_id IN ?
+			AND expenses.issued_date >= ? AND expenses.issued_date < ?
+		GROUP BY expenses.project_id, date_part('year', expenses.issued_date), date_part('month', expenses.issued_date)
+	`
+
+	return rs, db.Raw(query, projectIDs, from, to.AddDate(0, 1, 0)).Scan(&rs).Error
+}
+
+// GetPayrollCosts sums the payrolls of the employees by month, without the commissions and the bonuses
+// which are counted on their own
+func (s *store) GetPayrollCosts(db *gorm.DB, employeeIDs []string, from, to time.Time) ([]*model.EmployeeMonthAmount, error) {
+	var rs []*model.EmployeeMonthAmount
+
+	query := `
+		SELECT employee_id, year, month,
+			SUM(GREATEST(total - COALESCE(commission_amount, 0) - COALESCE(project_bonus_amount, 0), 0)) AS amount
+		FROM payrolls
+		WHERE employee_id IN ?
+			AND make_date(year, month, 1) BETWEEN ? AND ?
+		GROUP BY employee_id, year, month
+	`
+
+	return rs, db.Raw(query, employeeIDs, from, to).Scan(&rs).Error
+}
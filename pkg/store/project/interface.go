@@ -15,4 +15,7 @@ type IStore interface {
 	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.Project, updatedFields ...string) (*model.Project, error)
 	GetByEmployeeID(db *gorm.DB, employeeID string) ([]*model.Project, error)
 	GetProjectByAlias(db *gorm.DB, alias string) (*model.Project, error)
+	GetAllWithOrganization(db *gorm.DB) ([]*model.Project, error)
+	GetByClientID(db *gorm.DB, clientID string) ([]*model.Project, error)
+	OneByName(db *gorm.DB, name string) (*model.Project, error)
 }
@@ -230,6 +230,29 @@ func (s *store) GetProjectByAlias(db *gorm.DB, alias string) (*model.Project, er
 	return &res, db.Where("alias = ?", alias).Preload("ProjectInfo").Find(&res).Error
 }
 
+// GetAllWithOrganization get all projects with their organization
+func (s *store) GetAllWithOrganization(db *gorm.DB) ([]*model.Project, error) {
+	var projects []*model.Project
+	return projects, db.Where("deleted_at IS NULL").
+		Preload("Organization", "deleted_at IS NULL").
+		Order("name").
+		Find(&projects).Error
+}
+
+// GetByClientID get the projects of a client
+func (s *store) GetByClientID(db *gorm.DB, clientID string) ([]*model.Project, error) {
+	var projects []*model.Project
+	return projects, db.Where("deleted_at IS NULL AND client_id = ?", clientID).
+		Order("start_date DESC NULLS LAST, name").
+		Find(&projects).Error
+}
+
+// OneByName get a project by its name, case insensitive
+func (s *store) OneByName(db *gorm.DB, name string) (*model.Project, error) {
+	var project *model.Project
+	return project, db.Where("deleted_at IS NULL AND LOWER(name) = LOWER(?)", name).First(&project).Error
+}
+
 func (s *store) sortFieldMapping(fields string) string {
 	sortFields := strings.Split(fields, ",")
 
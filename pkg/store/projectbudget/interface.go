@@ -0,0 +1,19 @@
+package projectbudget
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	OneByProjectID(db *gorm.DB, projectID string) (budget *model.ProjectBudget, err error)
+	GetAlertable(db *gorm.DB) (budgets []*model.ProjectBudget, err error)
+	Create(db *gorm.DB, budget *model.ProjectBudget) (*model.ProjectBudget, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.ProjectBudget, updatedFields ...string) (*model.ProjectBudget, error)
+
+	OnePhase(db *gorm.DB, id string) (phase *model.ProjectBudgetPhase, err error)
+	CreatePhase(db *gorm.DB, phase *model.ProjectBudgetPhase) (*model.ProjectBudgetPhase, error)
+	UpdatePhaseSelectedFieldsByID(db *gorm.DB, id string, updateModel model.ProjectBudgetPhase, updatedFields ...string) (*model.ProjectBudgetPhase, error)
+	DeletePhase(db *gorm.DB, id string) error
+}
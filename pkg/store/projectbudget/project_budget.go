@@ -0,0 +1,73 @@
+package projectbudget
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// OneByProjectID get the budget of a project with its phases and their invoices
+func (s *store) OneByProjectID(db *gorm.DB, projectID string) (*model.ProjectBudget, error) {
+	var budget *model.ProjectBudget
+	return budget, db.Where("project_id = ?", projectID).
+		Preload("Phases", func(db *gorm.DB) *gorm.DB {
+			return db.Order("start_date")
+		}).
+		Preload("Phases.Invoices", "deleted_at IS NULL").
+		First(&budget).Error
+}
+
+// GetAlertable get the budgets of the running fixed-cost projects with the discord accounts of the project heads
+func (s *store) GetAlertable(db *gorm.DB) ([]*model.ProjectBudget, error) {
+	var budgets []*model.ProjectBudget
+	return budgets, db.Joins("JOIN projects ON projects.id = project_budgets.project_id").
+		Where("projects.deleted_at IS NULL AND projects.type = ?", model.ProjectTypeFixedCost).
+		Where("projects.status IN ?", []string{model.ProjectStatusOnBoarding.String(), model.ProjectStatusActive.String()}).
+		Preload("Project", "deleted_at IS NULL").
+		Preload("Project.Heads", "deleted_at IS NULL AND (end_date IS NULL OR end_date > now())").
+		Preload("Project.Heads.Employee", "deleted_at IS NULL").
+		Preload("Project.Heads.Employee.DiscordAccount", "deleted_at IS NULL").
+		Find(&budgets).Error
+}
+
+// Create creates a new budget
+func (s *store) Create(db *gorm.DB, budget *model.ProjectBudget) (*model.ProjectBudget, error) {
+	return budget, db.Omit(clause.Associations).Create(budget).Error
+}
+
+// UpdateSelectedFieldsByID just update selected fields by id
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.ProjectBudget, updatedFields ...string) (*model.ProjectBudget, error) {
+	budget := model.ProjectBudget{}
+	return &budget, db.Model(&budget).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+// OnePhase get budget phase by id with its invoices
+func (s *store) OnePhase(db *gorm.DB, id string) (*model.ProjectBudgetPhase, error) {
+	var phase *model.ProjectBudgetPhase
+	return phase, db.Where("id = ?", id).
+		Preload("Invoices", "deleted_at IS NULL").
+		First(&phase).Error
+}
+
+// CreatePhase creates a new budget phase
+func (s *store) CreatePhase(db *gorm.DB, phase *model.ProjectBudgetPhase) (*model.ProjectBudgetPhase, error) {
+	return phase, db.Omit(clause.Associations).Create(phase).Error
+}
+
+// UpdatePhaseSelectedFieldsByID just update selected fields by id
+func (s *store) UpdatePhaseSelectedFieldsByID(db *gorm.DB, id string, updateModel model.ProjectBudgetPhase, updatedFields ...string) (*model.ProjectBudgetPhase, error) {
+	phase := model.ProjectBudgetPhase{}
+	return &phase, db.Model(&phase).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+// DeletePhase soft deletes a budget phase by id
+func (s *store) DeletePhase(db *gorm.DB, id string) error {
+	return db.Where("id = ?", id).Delete(&model.ProjectBudgetPhase{}).Error
+}
@@ -0,0 +1,12 @@
+package projectchangelog
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	GetByProjectID(db *gorm.DB, projectID string) (changelogs []*model.ProjectChangelog, err error)
+	Create(db *gorm.DB, changelog *model.ProjectChangelog) (*model.ProjectChangelog, error)
+}
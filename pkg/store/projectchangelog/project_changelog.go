@@ -0,0 +1,26 @@
+package projectchangelog
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// GetByProjectID get the changelogs sent for a project, newest first
+func (s *store) GetByProjectID(db *gorm.DB, projectID string) ([]*model.ProjectChangelog, error) {
+	var changelogs []*model.ProjectChangelog
+	return changelogs, db.Where("project_id = ?", projectID).
+		Order("sent_at DESC").
+		Find(&changelogs).Error
+}
+
+// Create creates a new project changelog
+func (s *store) Create(db *gorm.DB, changelog *model.ProjectChangelog) (*model.ProjectChangelog, error) {
+	return changelog, db.Create(changelog).Error
+}
@@ -18,4 +18,5 @@ type IStore interface {
 	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.ProjectHead, updatedFields ...string) (*model.ProjectHead, error)
 	UpdateDateOfEmployee(db *gorm.DB, employeeID string, projectID string, position string, startDate *time.Time, endDate *time.Time) (*model.ProjectHead, error)
 	GetByProjectIDAndPosition(db *gorm.DB, projectID string, position model.HeadPosition) (heads []*model.ProjectHead, err error)
+	GetActiveWithDiscordAccountByProjectID(db *gorm.DB, projectID string) (projectHeads []*model.ProjectHead, err error)
 }
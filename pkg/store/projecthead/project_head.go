@@ -73,3 +73,12 @@ func (s *store) GetByProjectIDAndPosition(db *gorm.DB, projectID string, positio
 	var heads []*model.ProjectHead
 	return heads, db.Where("project_id = ? AND position = ?", projectID, position).Find(&heads).Error
 }
+
+// GetActiveWithDiscordAccountByProjectID get the active heads of a project with their discord account
+func (s *store) GetActiveWithDiscordAccountByProjectID(db *gorm.DB, projectID string) ([]*model.ProjectHead, error) {
+	var projectHeads []*model.ProjectHead
+	return projectHeads, db.Where("project_id = ? AND (end_date IS NULL OR end_date > ?)", projectID, time.Now()).
+		Preload("Employee", "deleted_at IS NULL").
+		Preload("Employee.DiscordAccount", "deleted_at IS NULL").
+		Find(&projectHeads).Error
+}
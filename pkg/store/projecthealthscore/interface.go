@@ -0,0 +1,16 @@
+package projecthealthscore
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	GetByWeek(db *gorm.DB, week time.Time) (scores []*model.ProjectHealthScore, err error)
+	GetByProjectID(db *gorm.DB, projectID string, limit int) (scores []*model.ProjectHealthScore, err error)
+	GetLatestWeek(db *gorm.DB) (week *time.Time, err error)
+	Upsert(db *gorm.DB, score *model.ProjectHealthScore) (*model.ProjectHealthScore, error)
+}
@@ -0,0 +1,51 @@
+package projecthealthscore
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// GetByWeek get the scores of a week with their projects, the least healthy first
+func (s *store) GetByWeek(db *gorm.DB, week time.Time) ([]*model.ProjectHealthScore, error) {
+	var scores []*model.ProjectHealthScore
+	return scores, db.Joins("JOIN projects ON projects.id = project_health_scores.project_id AND projects.deleted_at IS NULL").
+		Where("project_health_scores.deleted_at IS NULL AND project_health_scores.week = ?", week.Format("2006-01-02")).
+		Preload("Project", "deleted_at IS NULL").
+		Preload("Project.Heads", "deleted_at IS NULL AND (end_date IS NULL OR end_date > now())").
+		Preload("Project.Heads.Employee", "deleted_at IS NULL").
+		Order("project_health_scores.score, projects.name").
+		Find(&scores).Error
+}
+
+// GetByProjectID get the latest scores of a project, the most recent week first
+func (s *store) GetByProjectID(db *gorm.DB, projectID string, limit int) ([]*model.ProjectHealthScore, error) {
+	var scores []*model.ProjectHealthScore
+	return scores, db.Where("deleted_at IS NULL AND project_id = ?", projectID).
+		Order("week DESC").
+		Limit(limit).
+		Find(&scores).Error
+}
+
+// GetLatestWeek get the most recent scored week, nil when no week has been scored yet
+func (s *store) GetLatestWeek(db *gorm.DB) (*time.Time, error) {
+	var week *time.Time
+	return week, db.Raw("SELECT MAX(week) FROM project_health_scores WHERE deleted_at IS NULL").Scan(&week).Error
+}
+
+// Upsert creates the score of a project for a week or replaces it when the week has already been scored
+func (s *store) Upsert(db *gorm.DB, score *model.ProjectHealthScore) (*model.ProjectHealthScore, error) {
+	return score, db.Omit(clause.Associations).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "project_id"}, {Name: "week"}},
+		DoUpdates: clause.AssignmentColumns([]string{"score", "status", "signals", "updated_at"}),
+	}).Create(score).Error
+}
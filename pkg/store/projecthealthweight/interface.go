@@ -0,0 +1,12 @@
+package projecthealthweight
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	All(db *gorm.DB) (weights []*model.ProjectHealthWeight, err error)
+	Upsert(db *gorm.DB, weight *model.ProjectHealthWeight) (*model.ProjectHealthWeight, error)
+}
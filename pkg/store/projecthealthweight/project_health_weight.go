@@ -0,0 +1,28 @@
+package projecthealthweight
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// All get all configured weights
+func (s *store) All(db *gorm.DB) ([]*model.ProjectHealthWeight, error) {
+	var weights []*model.ProjectHealthWeight
+	return weights, db.Where("deleted_at IS NULL").Order("signal").Find(&weights).Error
+}
+
+// Upsert creates the weight of a signal or updates it when the signal already has one
+func (s *store) Upsert(db *gorm.DB, weight *model.ProjectHealthWeight) (*model.ProjectHealthWeight, error) {
+	return weight, db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "signal"}},
+		DoUpdates: clause.AssignmentColumns([]string{"weight", "updated_at"}),
+	}).Create(weight).Error
+}
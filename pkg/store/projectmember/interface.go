@@ -14,7 +14,11 @@ type IStore interface {
 	GetActiveByProjectIDs(db *gorm.DB, projectIDs []string) ([]*model.ProjectMember, error)
 	GetActiveMemberInProject(db *gorm.DB, projectID string, employeeID string) (*model.ProjectMember, error)
 	GetActiveMembersBySlotID(db *gorm.DB, slotID string) ([]*model.ProjectMember, error)
+	GetAllocatedByEmployeeIDs(db *gorm.DB, employeeIDs []string, from, to time.Time) ([]*model.ProjectMember, error)
 	GetAssignedMembers(db *gorm.DB, projectID string, status string, preload bool) ([]*model.ProjectMember, error)
+	GetByEmployeeIDInTimeRange(db *gorm.DB, employeeID string, from, to time.Time) ([]*model.ProjectMember, error)
+	GetByEmployeeIDsInPeriod(db *gorm.DB, employeeIDs []string, from, to time.Time) ([]*model.ProjectMember, error)
+	GetByProjectIDsInPeriod(db *gorm.DB, projectIDs []string, from, to time.Time) ([]*model.ProjectMember, error)
 	IsExist(db *gorm.DB, id string) (bool, error)
 	IsExistsByEmployeeID(db *gorm.DB, projectID string, employeeID string) (bool, error)
 	OneByID(db *gorm.DB, id string) (*model.ProjectMember, error)
@@ -26,4 +30,5 @@ type IStore interface {
 	UpdateMemberToInActiveByID(db *gorm.DB, id string, endDate *time.Time) error
 	UpdateSelectedFieldByProjectID(db *gorm.DB, projectID string, updateModel model.ProjectMember, updatedField string) error
 	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.ProjectMember, updatedFields ...string) (*model.ProjectMember, error)
+	UpdateToInactiveByProjectID(db *gorm.DB, projectID string, endDate time.Time) error
 }
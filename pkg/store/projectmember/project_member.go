@@ -199,3 +199,69 @@ func (s *store) UpdateMemberToInActiveByID(db *gorm.DB, id string, endDate *time
 	`
 	return db.Exec(sql, endDate, id).Error
 }
+
+// GetAllocatedByEmployeeIDs get the project assignments of employees overlapping a time range on projects
+// which are not closed, with their project and allocations
+func (s *store) GetAllocatedByEmployeeIDs(db *gorm.DB, employeeIDs []string, from, to time.Time) ([]*model.ProjectMember, error) {
+	var members []*model.ProjectMember
+	return members, db.Joins("JOIN projects ON projects.id = project_members.project_id").
+		Where("project_members.employee_id IN ?", employeeIDs).
+		Where("(project_members.start_date IS NULL OR project_members.start_date <= ?)", to).
+		Where("(project_members.end_date IS NULL OR project_members.end_date >= ?)", from).
+		Where("projects.deleted_at IS NULL AND projects.status <> ?", model.ProjectStatusClosed).
+		Order("project_members.start_date").
+		Preload("Project", "deleted_at IS NULL").
+		Preload("Allocations", func(db *gorm.DB) *gorm.DB {
+			return db.Order("start_date")
+		}).
+		Find(&members).Error
+}
+
+// GetByEmployeeIDsInPeriod get the project assignments of employees overlapping a period, with their allocations
+func (s *store) GetByEmployeeIDsInPeriod(db *gorm.DB, employeeIDs []string, from, to time.Time) ([]*model.ProjectMember, error) {
+	var members []*model.ProjectMember
+	return members, db.Where("employee_id IN ?", employeeIDs).
+		Where("(start_date IS NULL OR start_date <= ?)", to).
+		Where("(end_date IS NULL OR end_date >= ?)", from).
+		Preload("Allocations", func(db *gorm.DB) *gorm.DB {
+			return db.Order("start_date")
+		}).
+		Find(&members).Error
+}
+
+// GetByProjectIDsInPeriod get the members of projects overlapping a period, with their allocations
+func (s *store) GetByProjectIDsInPeriod(db *gorm.DB, projectIDs []string, from, to time.Time) ([]*model.ProjectMember, error) {
+	var members []*model.ProjectMember
+	return members, db.Where("project_id IN ?", projectIDs).
+		Where("(start_date IS NULL OR start_date <= ?)", to).
+		Where("(end_date IS NULL OR end_date >= ?)", from).
+		Preload("Allocations", func(db *gorm.DB) *gorm.DB {
+			return db.Order("start_date")
+		}).
+		Find(&members).Error
+}
+
+// GetByEmployeeIDInTimeRange get the project assignments of an employee overlapping a time range, with their project
+func (s *store) GetByEmployeeIDInTimeRange(db *gorm.DB, employeeID string, from, to time.Time) ([]*model.ProjectMember, error) {
+	var members []*model.ProjectMember
+	return members, db.Where("employee_id = ? AND start_date <= ?", employeeID, to).
+		Where("(end_date IS NULL OR end_date >= ?)", from).
+		Order("start_date").
+		Preload("Project", "deleted_at IS NULL").
+		Find(&members).Error
+}
+
+// UpdateToInactiveByProjectID inactivates the members of a project, their end date is capped at the end date
+func (s *store) UpdateToInactiveByProjectID(db *gorm.DB, projectID string, endDate time.Time) error {
+	sql := `
+		UPDATE project_members
+		SET
+			status = 'inactive',
+			end_date = LEAST(COALESCE(end_date, ?), ?)
+		WHERE
+			project_id = ?
+			AND deleted_at IS NULL
+			AND status <> 'inactive';
+	`
+	return db.Exec(sql, endDate, endDate, projectID).Error
+}
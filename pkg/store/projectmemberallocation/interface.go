@@ -0,0 +1,14 @@
+package projectmemberallocation
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	One(db *gorm.DB, id string) (allocation *model.ProjectMemberAllocation, err error)
+	GetByProjectMemberID(db *gorm.DB, projectMemberID string) (allocations []*model.ProjectMemberAllocation, err error)
+	Create(db *gorm.DB, allocation *model.ProjectMemberAllocation) (*model.ProjectMemberAllocation, error)
+	Delete(db *gorm.DB, id string) error
+}
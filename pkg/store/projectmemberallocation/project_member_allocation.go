@@ -0,0 +1,38 @@
+package projectmemberallocation
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// One get allocation by id
+func (s *store) One(db *gorm.DB, id string) (*model.ProjectMemberAllocation, error) {
+	var allocation *model.ProjectMemberAllocation
+	return allocation, db.Where("id = ?", id).First(&allocation).Error
+}
+
+// GetByProjectMemberID get the allocations of a project member ordered by start date
+func (s *store) GetByProjectMemberID(db *gorm.DB, projectMemberID string) ([]*model.ProjectMemberAllocation, error) {
+	var allocations []*model.ProjectMemberAllocation
+	return allocations, db.Where("project_member_id = ?", projectMemberID).
+		Order("start_date").
+		Find(&allocations).Error
+}
+
+// Create creates a new allocation
+func (s *store) Create(db *gorm.DB, allocation *model.ProjectMemberAllocation) (*model.ProjectMemberAllocation, error) {
+	return allocation, db.Omit(clause.Associations).Create(allocation).Error
+}
+
+// Delete soft deletes an allocation by id
+func (s *store) Delete(db *gorm.DB, id string) error {
+	return db.Where("id = ?", id).Delete(&model.ProjectMemberAllocation{}).Error
+}
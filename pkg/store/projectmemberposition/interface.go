@@ -8,4 +8,5 @@ import (
 type IStore interface {
 	Create(db *gorm.DB, pos ...model.ProjectMemberPosition) error
 	DeleteByProjectMemberID(db *gorm.DB, memberID string) error
+	GetByProjectMemberID(db *gorm.DB, memberID string) ([]*model.ProjectMemberPosition, error)
 }
@@ -21,3 +21,9 @@ func (s *store) Create(db *gorm.DB, pos ...model.ProjectMemberPosition) error {
 func (s *store) DeleteByProjectMemberID(db *gorm.DB, memberID string) error {
 	return db.Unscoped().Where("project_member_id = ?", memberID).Delete(&model.ProjectMemberPosition{}).Error
 }
+
+// GetByProjectMemberID get the positions of a project member
+func (s *store) GetByProjectMemberID(db *gorm.DB, memberID string) ([]*model.ProjectMemberPosition, error) {
+	var pos []*model.ProjectMemberPosition
+	return pos, db.Where("project_member_id = ?", memberID).Find(&pos).Error
+}
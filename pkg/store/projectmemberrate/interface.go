@@ -0,0 +1,18 @@
+package projectmemberrate
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	One(db *gorm.DB, id string) (rate *model.ProjectMemberRate, err error)
+	GetByProjectMemberID(db *gorm.DB, projectMemberID string) (rates []*model.ProjectMemberRate, err error)
+	GetByProjectMemberIDs(db *gorm.DB, projectMemberIDs []string) (rates []*model.ProjectMemberRate, err error)
+	GetUnapplied(db *gorm.DB, date time.Time) (rates []*model.ProjectMemberRate, err error)
+	Create(db *gorm.DB, rate *model.ProjectMemberRate) (*model.ProjectMemberRate, error)
+	Delete(db *gorm.DB, id string) error
+}
@@ -0,0 +1,71 @@
+package projectmemberrate
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// One get rate change by id
+func (s *store) One(db *gorm.DB, id string) (*model.ProjectMemberRate, error) {
+	var rate *model.ProjectMemberRate
+	return rate, db.Where("id = ?", id).First(&rate).Error
+}
+
+// GetByProjectMemberID get the rate changes of a project member ordered by effective date
+func (s *store) GetByProjectMemberID(db *gorm.DB, projectMemberID string) ([]*model.ProjectMemberRate, error) {
+	var rates []*model.ProjectMemberRate
+	return rates, db.Where("project_member_id = ?", projectMemberID).
+		Preload("RateCard", "deleted_at IS NULL").
+		Preload("Creator", "deleted_at IS NULL").
+		Order("effective_from, created_at").
+		Find(&rates).Error
+}
+
+// GetByProjectMemberIDs get the rate changes of project members ordered by effective date
+func (s *store) GetByProjectMemberIDs(db *gorm.DB, projectMemberIDs []string) ([]*model.ProjectMemberRate, error) {
+	var rates []*model.ProjectMemberRate
+	return rates, db.Where("project_member_id IN ?", projectMemberIDs).
+		Order("effective_from, created_at").
+		Find(&rates).Error
+}
+
+// GetUnapplied get the rate changes in effect at a date which are not the current rate of their project member yet
+func (s *store) GetUnapplied(db *gorm.DB, date time.Time) ([]*model.ProjectMemberRate, error) {
+	var rates []*model.ProjectMemberRate
+
+	query := `
+		WITH current_rates AS (
+			SELECT DISTINCT ON (project_member_id) *
+			FROM project_member_rates
+			WHERE deleted_at IS NULL AND effective_from <= ?
+			ORDER BY project_member_id, effective_from DESC, created_at DESC
+		)
+		SELECT current_rates.*
+		FROM current_rates
+			JOIN project_members pm ON pm.id = current_rates.project_member_id
+		WHERE pm.deleted_at IS NULL
+			AND (COALESCE(pm.rate, 0) <> current_rates.rate OR COALESCE(pm.discount, 0) <> current_rates.discount)
+	`
+
+	return rates, db.Raw(query, date.Format("2006-01-02")).Scan(&rates).Error
+}
+
+// Create creates a new rate change
+func (s *store) Create(db *gorm.DB, rate *model.ProjectMemberRate) (*model.ProjectMemberRate, error) {
+	return rate, db.Omit(clause.Associations).Create(rate).Error
+}
+
+// Delete soft deletes a rate change by id
+func (s *store) Delete(db *gorm.DB, id string) error {
+	return db.Where("id = ?", id).Delete(&model.ProjectMemberRate{}).Error
+}
@@ -6,6 +6,7 @@ import (
 )
 
 type IStore interface {
+	GetByProjectSlotID(db *gorm.DB, slotID string) ([]*model.ProjectSlotPosition, error)
 	Create(db *gorm.DB, pos ...model.ProjectSlotPosition) error
 	DeleteByProjectSlotID(db *gorm.DB, slotID string) error
 }
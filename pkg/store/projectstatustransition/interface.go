@@ -0,0 +1,12 @@
+package projectstatustransition
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	GetByProjectID(db *gorm.DB, projectID string) (transitions []*model.ProjectStatusTransition, err error)
+	Create(db *gorm.DB, transition *model.ProjectStatusTransition) (*model.ProjectStatusTransition, error)
+}
@@ -0,0 +1,28 @@
+package projectstatustransition
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// GetByProjectID get the status transitions of a project with their actor, newest first
+func (s *store) GetByProjectID(db *gorm.DB, projectID string) ([]*model.ProjectStatusTransition, error) {
+	var transitions []*model.ProjectStatusTransition
+	return transitions, db.Where("project_id = ?", projectID).
+		Preload("Actor", "deleted_at IS NULL").
+		Order("created_at DESC").
+		Find(&transitions).Error
+}
+
+// Create creates a new status transition
+func (s *store) Create(db *gorm.DB, transition *model.ProjectStatusTransition) (*model.ProjectStatusTransition, error) {
+	return transition, db.Omit(clause.Associations).Create(transition).Error
+}
@@ -0,0 +1,28 @@
+package ratecard
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	One(db *gorm.DB, id string) (card *model.RateCard, err error)
+	All(db *gorm.DB, filter GetListFilter) (cards []*model.RateCard, err error)
+	GetByProject(db *gorm.DB, projectID string, clientID string) (cards []*model.RateCard, err error)
+	GetRenewing(db *gorm.DB, from, to time.Time) (cards []*model.RateCard, err error)
+	Create(db *gorm.DB, card *model.RateCard) (*model.RateCard, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.RateCard, updatedFields ...string) (*model.RateCard, error)
+	Delete(db *gorm.DB, id string) error
+
+	CreateRates(db *gorm.DB, rates []*model.RateCardRate) error
+	DeleteRatesByRateCardID(db *gorm.DB, rateCardID string) error
+}
+
+// GetListFilter filters rate cards by their client or project
+type GetListFilter struct {
+	ClientID  string
+	ProjectID string
+}
@@ -0,0 +1,101 @@
+package ratecard
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+func preloadRates(db *gorm.DB) *gorm.DB {
+	return db.Preload("Rates", "deleted_at IS NULL").
+		Preload("Rates.Seniority", "deleted_at IS NULL").
+		Preload("Rates.Position", "deleted_at IS NULL")
+}
+
+// One get rate card by id with its rates
+func (s *store) One(db *gorm.DB, id string) (*model.RateCard, error) {
+	var card *model.RateCard
+	return card, preloadRates(db).Where("id = ?", id).
+		Preload("Client", "deleted_at IS NULL").
+		Preload("Project", "deleted_at IS NULL").
+		First(&card).Error
+}
+
+// All get the rate cards matching the filter ordered by start date, newest first
+func (s *store) All(db *gorm.DB, filter GetListFilter) ([]*model.RateCard, error) {
+	var cards []*model.RateCard
+
+	query := preloadRates(db).
+		Preload("Client", "deleted_at IS NULL").
+		Preload("Project", "deleted_at IS NULL")
+	if filter.ClientID != "" {
+		query = query.Where("client_id = ?", filter.ClientID)
+	}
+	if filter.ProjectID != "" {
+		query = query.Where("project_id = ?", filter.ProjectID)
+	}
+
+	return cards, query.Order("start_date DESC").Find(&cards).Error
+}
+
+// GetByProject get the rate cards of a project and of its client with their rates
+func (s *store) GetByProject(db *gorm.DB, projectID string, clientID string) ([]*model.RateCard, error) {
+	var cards []*model.RateCard
+
+	query := preloadRates(db)
+	if clientID != "" {
+		query = query.Where("project_id = ? OR (project_id IS NULL AND client_id = ?)", projectID, clientID)
+	} else {
+		query = query.Where("project_id = ?", projectID)
+	}
+
+	return cards, query.Order("start_date DESC").Find(&cards).Error
+}
+
+// GetRenewing get the rate cards ending between two dates with their client and project
+func (s *store) GetRenewing(db *gorm.DB, from, to time.Time) ([]*model.RateCard, error) {
+	var cards []*model.RateCard
+	return cards, preloadRates(db).Where("end_date BETWEEN ? AND ?", from, to).
+		Preload("Client", "deleted_at IS NULL").
+		Preload("Project", "deleted_at IS NULL").
+		Order("end_date").
+		Find(&cards).Error
+}
+
+// Create creates a new rate card
+func (s *store) Create(db *gorm.DB, card *model.RateCard) (*model.RateCard, error) {
+	return card, db.Omit(clause.Associations).Create(card).Error
+}
+
+// UpdateSelectedFieldsByID just update selected fields by id
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.RateCard, updatedFields ...string) (*model.RateCard, error) {
+	card := model.RateCard{}
+	return &card, db.Model(&card).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+// Delete soft deletes a rate card by id
+func (s *store) Delete(db *gorm.DB, id string) error {
+	return db.Where("id = ?", id).Delete(&model.RateCard{}).Error
+}
+
+// CreateRates creates the rates of a rate card
+func (s *store) CreateRates(db *gorm.DB, rates []*model.RateCardRate) error {
+	if len(rates) == 0 {
+		return nil
+	}
+	return db.Omit(clause.Associations).Create(rates).Error
+}
+
+// DeleteRatesByRateCardID soft deletes the rates of a rate card
+func (s *store) DeleteRatesByRateCardID(db *gorm.DB, rateCardID string) error {
+	return db.Where("rate_card_id = ?", rateCardID).Delete(&model.RateCardRate{}).Error
+}
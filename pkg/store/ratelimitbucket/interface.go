@@ -0,0 +1,14 @@
+package ratelimitbucket
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Take(db *gorm.DB, key string, limit int, ratePerSecond float64, now time.Time) (bucket *model.RateLimitBucket, err error)
+	DeleteIdle(db *gorm.DB, before time.Time) (err error)
+}
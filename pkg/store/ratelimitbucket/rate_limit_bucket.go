@@ -0,0 +1,46 @@
+package ratelimitbucket
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// takeQuery refills the bucket by the elapsed time and takes a token if there is one,
+// in a single statement so concurrent instances never take the same token
+const takeQuery = `
+INSERT INTO rate_limit_buckets AS b (key, tokens, allowed, updated_at)
+VALUES (@key, @limit - 1, TRUE, @now)
+ON CONFLICT (key) DO UPDATE SET
+	tokens = CASE
+		WHEN LEAST(@limit, b.tokens + GREATEST(EXTRACT(EPOCH FROM (@now - b.updated_at)), 0) * @rate) >= 1
+		THEN LEAST(@limit, b.tokens + GREATEST(EXTRACT(EPOCH FROM (@now - b.updated_at)), 0) * @rate) - 1
+		ELSE LEAST(@limit, b.tokens + GREATEST(EXTRACT(EPOCH FROM (@now - b.updated_at)), 0) * @rate)
+	END,
+	allowed = LEAST(@limit, b.tokens + GREATEST(EXTRACT(EPOCH FROM (@now - b.updated_at)), 0) * @rate) >= 1,
+	updated_at = @now
+RETURNING key, tokens, allowed, updated_at`
+
+// Take takes a token from the bucket of the key
+func (s *store) Take(db *gorm.DB, key string, limit int, ratePerSecond float64, now time.Time) (*model.RateLimitBucket, error) {
+	var bucket model.RateLimitBucket
+	return &bucket, db.Raw(takeQuery, map[string]interface{}{
+		"key":   key,
+		"limit": float64(limit),
+		"rate":  ratePerSecond,
+		"now":   now,
+	}).Scan(&bucket).Error
+}
+
+// DeleteIdle removes buckets which were not used since the given time
+func (s *store) DeleteIdle(db *gorm.DB, before time.Time) error {
+	return db.Where("updated_at < ?", before).Delete(&model.RateLimitBucket{}).Error
+}
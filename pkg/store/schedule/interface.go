@@ -7,9 +7,13 @@ import (
 )
 
 type IStore interface {
+	One(db *gorm.DB, id string) (*model.Schedule, error)
 	Create(db *gorm.DB, schedule *model.Schedule) (*model.Schedule, error)
 	CreateDiscord(db *gorm.DB, schedule *model.ScheduleDiscordEvent) (*model.ScheduleDiscordEvent, error)
+	CreateGoogleCalendar(db *gorm.DB, calendar *model.ScheduleGoogleCalendar) (*model.ScheduleGoogleCalendar, error)
 	GetOneByGcalID(db *gorm.DB, gcalID string) (*model.Schedule, error)
 
 	Update(db *gorm.DB, schedule *model.Schedule) (*model.Schedule, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.Schedule, updatedFields ...string) (*model.Schedule, error)
+	UpdateGoogleCalendarSelectedFieldsByID(db *gorm.DB, id string, updateModel model.ScheduleGoogleCalendar, updatedFields ...string) (*model.ScheduleGoogleCalendar, error)
 }
@@ -15,7 +15,16 @@ func (s *store) GetOneByGcalID(db *gorm.DB, gcalID string) (*model.Schedule, err
 	var sch *model.Schedule
 	return sch, db.
 		Table("schedules").
-		Joins("JOIN schedule_google_calendars sgc ON schedules.id = sgc.schedule_id").Where("sgc.google_calendar_id = ?", gcalID).First(&sch).Error
+		Joins("JOIN schedule_google_calendars sgc ON schedules.id = sgc.schedule_id").Where("sgc.google_calendar_id = ?", gcalID).
+		Preload("GoogleCalendar").
+		Preload("DiscordEvent").
+		First(&sch).Error
+}
+
+// One get schedule by id with its google calendar event
+func (s *store) One(db *gorm.DB, id string) (*model.Schedule, error) {
+	var sch *model.Schedule
+	return sch, db.Where("id = ?", id).Preload("GoogleCalendar").First(&sch).Error
 }
 
 func (s *store) Create(db *gorm.DB, schedule *model.Schedule) (*model.Schedule, error) {
@@ -26,6 +35,11 @@ func (s *store) CreateDiscord(db *gorm.DB, schedule *model.ScheduleDiscordEvent)
 	return schedule, db.Create(schedule).Error
 }
 
+// CreateGoogleCalendar links a schedule to a google calendar event
+func (s *store) CreateGoogleCalendar(db *gorm.DB, calendar *model.ScheduleGoogleCalendar) (*model.ScheduleGoogleCalendar, error) {
+	return calendar, db.Create(calendar).Error
+}
+
 func (s *store) Upsert(db *gorm.DB, schedule *model.Schedule) (*model.Schedule, error) {
 	return schedule, db.Save(schedule).Error
 }
@@ -33,3 +47,15 @@ func (s *store) Upsert(db *gorm.DB, schedule *model.Schedule) (*model.Schedule,
 func (s *store) Update(db *gorm.DB, schedule *model.Schedule) (*model.Schedule, error) {
 	return schedule, db.Updates(schedule).Error
 }
+
+// UpdateSelectedFieldsByID just update selected fields by id
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.Schedule, updatedFields ...string) (*model.Schedule, error) {
+	schedule := model.Schedule{}
+	return &schedule, db.Model(&schedule).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+// UpdateGoogleCalendarSelectedFieldsByID just update selected fields of a google calendar event link by id
+func (s *store) UpdateGoogleCalendarSelectedFieldsByID(db *gorm.DB, id string, updateModel model.ScheduleGoogleCalendar, updatedFields ...string) (*model.ScheduleGoogleCalendar, error) {
+	calendar := model.ScheduleGoogleCalendar{}
+	return &calendar, db.Model(&calendar).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
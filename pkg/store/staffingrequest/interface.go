@@ -0,0 +1,21 @@
+package staffingrequest
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	One(db *gorm.DB, id string, preload bool) (request *model.StaffingRequest, err error)
+	All(db *gorm.DB, filter Filter, pagination model.Pagination) (requests []*model.StaffingRequest, total int64, err error)
+	IsExistOpenBySlotID(db *gorm.DB, slotID string) (bool, error)
+	Create(db *gorm.DB, request *model.StaffingRequest) (*model.StaffingRequest, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.StaffingRequest, updatedFields ...string) (*model.StaffingRequest, error)
+	CreateStacks(db *gorm.DB, stacks []*model.StaffingRequestStack) error
+
+	OneCandidate(db *gorm.DB, requestID string, id string) (candidate *model.StaffingCandidate, err error)
+	CreateCandidates(db *gorm.DB, candidates []*model.StaffingCandidate) error
+	UpdateCandidateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.StaffingCandidate, updatedFields ...string) (*model.StaffingCandidate, error)
+	DeleteCandidatesByStatus(db *gorm.DB, requestID string, status model.StaffingCandidateStatus) error
+}
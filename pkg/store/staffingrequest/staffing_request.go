@@ -0,0 +1,138 @@
+package staffingrequest
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+type Filter struct {
+	ProjectID string
+	Statuses  []string
+}
+
+// One get staffing request by id, preload loads its slot, stacks and candidates
+func (s *store) One(db *gorm.DB, id string, preload bool) (*model.StaffingRequest, error) {
+	var request *model.StaffingRequest
+
+	query := db.Where("id = ?", id)
+	if preload {
+		query = preloadStaffingRequest(query).
+			Preload("ProjectSlot", "deleted_at IS NULL").
+			Preload("ProjectSlot.ProjectSlotPositions", "deleted_at IS NULL").
+			Preload("ProjectSlot.ProjectSlotPositions.Position", "deleted_at IS NULL").
+			Preload("Candidates", func(db *gorm.DB) *gorm.DB {
+				return db.Where("deleted_at IS NULL").Order("score DESC, created_at")
+			}).
+			Preload("Candidates.Employee", "deleted_at IS NULL")
+	}
+
+	return request, query.First(&request).Error
+}
+
+// All get staffing requests by filter with pagination, soonest start date first
+func (s *store) All(db *gorm.DB, filter Filter, pagination model.Pagination) ([]*model.StaffingRequest, int64, error) {
+	var requests []*model.StaffingRequest
+	var total int64
+
+	query := db.Table("staffing_requests").Where("deleted_at IS NULL")
+
+	if filter.ProjectID != "" {
+		query = query.Where("project_id = ?", filter.ProjectID)
+	}
+	if len(filter.Statuses) > 0 {
+		query = query.Where("status IN ?", filter.Statuses)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	limit, offset := pagination.ToLimitOffset()
+	if pagination.Page > 0 {
+		query = query.Limit(limit)
+	}
+
+	return requests, total, preloadStaffingRequest(query.Offset(offset)).
+		Order("start_date, created_at").
+		Find(&requests).Error
+}
+
+// IsExistOpenBySlotID check if a project slot has a staffing request which is not filled or cancelled
+func (s *store) IsExistOpenBySlotID(db *gorm.DB, slotID string) (bool, error) {
+	var record struct {
+		Result bool
+	}
+
+	query := db.Raw(`SELECT EXISTS (
+		SELECT * FROM staffing_requests
+		WHERE project_slot_id = ? AND status NOT IN ? AND deleted_at IS NULL
+	) AS result`, slotID, []string{
+		model.StaffingRequestStatusFilled.String(),
+		model.StaffingRequestStatusCancelled.String(),
+	})
+
+	return record.Result, query.Scan(&record).Error
+}
+
+// Create creates a new staffing request
+func (s *store) Create(db *gorm.DB, request *model.StaffingRequest) (*model.StaffingRequest, error) {
+	return request, db.Omit(clause.Associations).Create(request).Error
+}
+
+// UpdateSelectedFieldsByID just update selected fields by id
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.StaffingRequest, updatedFields ...string) (*model.StaffingRequest, error) {
+	request := model.StaffingRequest{}
+	return &request, db.Model(&request).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+// CreateStacks creates the required stacks of a staffing request
+func (s *store) CreateStacks(db *gorm.DB, stacks []*model.StaffingRequestStack) error {
+	if len(stacks) == 0 {
+		return nil
+	}
+	return db.Omit(clause.Associations).Create(&stacks).Error
+}
+
+// OneCandidate get a candidate of a staffing request
+func (s *store) OneCandidate(db *gorm.DB, requestID string, id string) (*model.StaffingCandidate, error) {
+	var candidate *model.StaffingCandidate
+	return candidate, db.Where("id = ? AND staffing_request_id = ?", id, requestID).
+		Preload("Employee", "deleted_at IS NULL").
+		First(&candidate).Error
+}
+
+// CreateCandidates creates candidates of a staffing request
+func (s *store) CreateCandidates(db *gorm.DB, candidates []*model.StaffingCandidate) error {
+	if len(candidates) == 0 {
+		return nil
+	}
+	return db.Omit(clause.Associations).Create(&candidates).Error
+}
+
+// UpdateCandidateSelectedFieldsByID just update selected fields of a candidate by id
+func (s *store) UpdateCandidateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.StaffingCandidate, updatedFields ...string) (*model.StaffingCandidate, error) {
+	candidate := model.StaffingCandidate{}
+	return &candidate, db.Model(&candidate).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+// DeleteCandidatesByStatus deletes the candidates of a staffing request in a status
+func (s *store) DeleteCandidatesByStatus(db *gorm.DB, requestID string, status model.StaffingCandidateStatus) error {
+	return db.Where("staffing_request_id = ? AND status = ?", requestID, status).Delete(&model.StaffingCandidate{}).Error
+}
+
+func preloadStaffingRequest(query *gorm.DB) *gorm.DB {
+	return query.
+		Preload("Project", "deleted_at IS NULL").
+		Preload("Seniority", "deleted_at IS NULL").
+		Preload("Requester", "deleted_at IS NULL").
+		Preload("Stacks", "deleted_at IS NULL").
+		Preload("Stacks.Stack", "deleted_at IS NULL")
+}
@@ -4,12 +4,15 @@ import (
 	"github.com/dwarvesf/fortress-api/pkg/store/accounting"
 	"github.com/dwarvesf/fortress-api/pkg/store/actionitem"
 	"github.com/dwarvesf/fortress-api/pkg/store/actionitemsnapshot"
+	"github.com/dwarvesf/fortress-api/pkg/store/anonymouseventanswer"
 	"github.com/dwarvesf/fortress-api/pkg/store/apikey"
 	"github.com/dwarvesf/fortress-api/pkg/store/apikeyrole"
+	"github.com/dwarvesf/fortress-api/pkg/store/approvalrequest"
 	"github.com/dwarvesf/fortress-api/pkg/store/audit"
 	"github.com/dwarvesf/fortress-api/pkg/store/auditactionitem"
 	"github.com/dwarvesf/fortress-api/pkg/store/auditcycle"
 	"github.com/dwarvesf/fortress-api/pkg/store/audititem"
+	"github.com/dwarvesf/fortress-api/pkg/store/auditlog"
 	"github.com/dwarvesf/fortress-api/pkg/store/auditparticipant"
 	"github.com/dwarvesf/fortress-api/pkg/store/bankaccount"
 	"github.com/dwarvesf/fortress-api/pkg/store/basesalary"
@@ -18,6 +21,7 @@ import (
 	"github.com/dwarvesf/fortress-api/pkg/store/chapter"
 	"github.com/dwarvesf/fortress-api/pkg/store/client"
 	"github.com/dwarvesf/fortress-api/pkg/store/clientcontact"
+	"github.com/dwarvesf/fortress-api/pkg/store/clientportalaccess"
 	"github.com/dwarvesf/fortress-api/pkg/store/content"
 	"github.com/dwarvesf/fortress-api/pkg/store/country"
 	"github.com/dwarvesf/fortress-api/pkg/store/currency"
@@ -31,6 +35,7 @@ import (
 	"github.com/dwarvesf/fortress-api/pkg/store/employeeeventquestion"
 	"github.com/dwarvesf/fortress-api/pkg/store/employeeeventreviewer"
 	"github.com/dwarvesf/fortress-api/pkg/store/employeeeventtopic"
+	"github.com/dwarvesf/fortress-api/pkg/store/employeeidentity"
 	"github.com/dwarvesf/fortress-api/pkg/store/employeeinvitation"
 	"github.com/dwarvesf/fortress-api/pkg/store/employeeorganization"
 	"github.com/dwarvesf/fortress-api/pkg/store/employeeposition"
@@ -43,28 +48,47 @@ import (
 	"github.com/dwarvesf/fortress-api/pkg/store/icytransaction"
 	"github.com/dwarvesf/fortress-api/pkg/store/invoice"
 	"github.com/dwarvesf/fortress-api/pkg/store/invoicenumbercaching"
+	"github.com/dwarvesf/fortress-api/pkg/store/magiclinktoken"
+	"github.com/dwarvesf/fortress-api/pkg/store/objective"
+	"github.com/dwarvesf/fortress-api/pkg/store/oneonone"
 	"github.com/dwarvesf/fortress-api/pkg/store/onleaverequest"
 	"github.com/dwarvesf/fortress-api/pkg/store/operationalservice"
 	"github.com/dwarvesf/fortress-api/pkg/store/organization"
 	"github.com/dwarvesf/fortress-api/pkg/store/payroll"
+	"github.com/dwarvesf/fortress-api/pkg/store/performancereview"
 	"github.com/dwarvesf/fortress-api/pkg/store/permission"
 	"github.com/dwarvesf/fortress-api/pkg/store/position"
+	"github.com/dwarvesf/fortress-api/pkg/store/profitloss"
 	"github.com/dwarvesf/fortress-api/pkg/store/project"
+	"github.com/dwarvesf/fortress-api/pkg/store/projectbudget"
+	"github.com/dwarvesf/fortress-api/pkg/store/projectchangelog"
 	"github.com/dwarvesf/fortress-api/pkg/store/projectcommissionconfig"
 	"github.com/dwarvesf/fortress-api/pkg/store/projecthead"
+	"github.com/dwarvesf/fortress-api/pkg/store/projecthealthscore"
+	"github.com/dwarvesf/fortress-api/pkg/store/projecthealthweight"
 	"github.com/dwarvesf/fortress-api/pkg/store/projectmember"
+	"github.com/dwarvesf/fortress-api/pkg/store/projectmemberallocation"
 	"github.com/dwarvesf/fortress-api/pkg/store/projectmemberposition"
+	"github.com/dwarvesf/fortress-api/pkg/store/projectmemberrate"
 	"github.com/dwarvesf/fortress-api/pkg/store/projectnotion"
 	"github.com/dwarvesf/fortress-api/pkg/store/projectslot"
 	"github.com/dwarvesf/fortress-api/pkg/store/projectslotposition"
 	"github.com/dwarvesf/fortress-api/pkg/store/projectstack"
+	"github.com/dwarvesf/fortress-api/pkg/store/projectstatustransition"
 	"github.com/dwarvesf/fortress-api/pkg/store/question"
+	"github.com/dwarvesf/fortress-api/pkg/store/ratecard"
+	"github.com/dwarvesf/fortress-api/pkg/store/ratelimitbucket"
 	"github.com/dwarvesf/fortress-api/pkg/store/recruitment"
 	"github.com/dwarvesf/fortress-api/pkg/store/role"
 	"github.com/dwarvesf/fortress-api/pkg/store/schedule"
 	"github.com/dwarvesf/fortress-api/pkg/store/seniority"
 	"github.com/dwarvesf/fortress-api/pkg/store/socialaccount"
 	"github.com/dwarvesf/fortress-api/pkg/store/stack"
+	"github.com/dwarvesf/fortress-api/pkg/store/staffingrequest"
+	"github.com/dwarvesf/fortress-api/pkg/store/surveycampaign"
+	"github.com/dwarvesf/fortress-api/pkg/store/surveyreminder"
+	"github.com/dwarvesf/fortress-api/pkg/store/surveytemplate"
+	"github.com/dwarvesf/fortress-api/pkg/store/timesheet"
 	"github.com/dwarvesf/fortress-api/pkg/store/valuation"
 	"github.com/dwarvesf/fortress-api/pkg/store/workunit"
 	"github.com/dwarvesf/fortress-api/pkg/store/workunitmember"
@@ -72,15 +96,18 @@ import (
 )
 
 type Store struct {
+	AnonymousEventAnswer    anonymouseventanswer.IStore
 	APIKey                  apikey.IStore
 	APIKeyRole              apikeyrole.IStore
 	Accounting              accounting.IStore
 	ActionItem              actionitem.IStore
 	ActionItemSnapshot      actionitemsnapshot.IStore
+	ApprovalRequest         approvalrequest.IStore
 	Audit                   audit.IStore
 	AuditActionItem         auditactionitem.IStore
 	AuditCycle              auditcycle.IStore
 	AuditItem               audititem.IStore
+	AuditLog                auditlog.IStore
 	AuditParticipant        auditparticipant.IStore
 	BankAccount             bankaccount.IStore
 	BaseSalary              basesalary.IStore
@@ -90,6 +117,7 @@ type Store struct {
 	Chapter                 chapter.IStore
 	Client                  client.IStore
 	ClientContact           clientcontact.IStore
+	ClientPortalAccess      clientportalaccess.IStore
 	Content                 content.IStore
 	Country                 country.IStore
 	Currency                currency.IStore
@@ -102,6 +130,7 @@ type Store struct {
 	EmployeeEventQuestion   employeeeventquestion.IStore
 	EmployeeEventReviewer   employeeeventreviewer.IStore
 	EmployeeEventTopic      employeeeventtopic.IStore
+	EmployeeIdentity        employeeidentity.IStore
 	EmployeeInvitation      employeeinvitation.IStore
 	EmployeeOrganization    employeeorganization.IStore
 	EmployeePosition        employeeposition.IStore
@@ -114,28 +143,47 @@ type Store struct {
 	IcyTransaction          icytransaction.IStore
 	Invoice                 invoice.IStore
 	InvoiceNumberCaching    invoicenumbercaching.IStore
+	MagicLinkToken          magiclinktoken.IStore
+	Objective               objective.IStore
+	OneOnOne                oneonone.IStore
 	OnLeaveRequest          onleaverequest.IStore
 	OperationalService      operationalservice.IStore
 	Organization            organization.IStore
 	Payroll                 payroll.IStore
+	PerformanceReview       performancereview.IStore
 	Permission              permission.IStore
 	Position                position.IStore
+	ProfitLoss              profitloss.IStore
 	Project                 project.IStore
+	ProjectBudget           projectbudget.IStore
+	ProjectChangelog        projectchangelog.IStore
 	ProjectCommissionConfig projectcommissionconfig.IStore
 	ProjectHead             projecthead.IStore
+	ProjectHealthScore      projecthealthscore.IStore
+	ProjectHealthWeight     projecthealthweight.IStore
 	ProjectMember           projectmember.IStore
+	ProjectMemberAllocation projectmemberallocation.IStore
 	ProjectMemberPosition   projectmemberposition.IStore
+	ProjectMemberRate       projectmemberrate.IStore
 	ProjectNotion           projectnotion.IStore
 	ProjectSlot             projectslot.IStore
 	ProjectSlotPosition     projectslotposition.IStore
 	ProjectStack            projectstack.IStore
+	ProjectStatusTransition projectstatustransition.IStore
 	Question                question.IStore
+	RateCard                ratecard.IStore
+	RateLimitBucket         ratelimitbucket.IStore
 	Recruitment             recruitment.IStore
 	Role                    role.IStore
 	Schedule                schedule.IStore
 	Seniority               seniority.IStore
 	SocialAccount           socialaccount.IStore
 	Stack                   stack.IStore
+	StaffingRequest         staffingrequest.IStore
+	SurveyCampaign          surveycampaign.IStore
+	SurveyReminder          surveyreminder.IStore
+	SurveyTemplate          surveytemplate.IStore
+	Timesheet               timesheet.IStore
 	Valuation               valuation.IStore
 	WorkUnit                workunit.IStore
 	WorkUnitMember          workunitmember.IStore
@@ -144,15 +192,18 @@ type Store struct {
 
 func New() *Store {
 	return &Store{
+		AnonymousEventAnswer:    anonymouseventanswer.New(),
 		APIKey:                  apikey.New(),
 		APIKeyRole:              apikeyrole.New(),
 		Accounting:              accounting.New(),
 		ActionItem:              actionitem.New(),
 		ActionItemSnapshot:      actionitemsnapshot.New(),
+		ApprovalRequest:         approvalrequest.New(),
 		Audit:                   audit.New(),
 		AuditActionItem:         auditactionitem.New(),
 		AuditCycle:              auditcycle.New(),
 		AuditItem:               audititem.New(),
+		AuditLog:                auditlog.New(),
 		AuditParticipant:        auditparticipant.New(),
 		BankAccount:             bankaccount.New(),
 		BaseSalary:              basesalary.New(),
@@ -162,6 +213,7 @@ func New() *Store {
 		Chapter:                 chapter.New(),
 		Client:                  client.New(),
 		ClientContact:           clientcontact.New(),
+		ClientPortalAccess:      clientportalaccess.New(),
 		Content:                 content.New(),
 		Country:                 country.New(),
 		Currency:                currency.New(),
@@ -174,6 +226,7 @@ func New() *Store {
 		EmployeeEventQuestion:   employeeeventquestion.New(),
 		EmployeeEventReviewer:   employeeeventreviewer.New(),
 		EmployeeEventTopic:      employeeeventtopic.New(),
+		EmployeeIdentity:        employeeidentity.New(),
 		EmployeeInvitation:      employeeinvitation.New(),
 		EmployeeOrganization:    employeeorganization.New(),
 		EmployeePosition:        employeeposition.New(),
@@ -186,28 +239,47 @@ func New() *Store {
 		IcyTransaction:          icytransaction.New(),
 		Invoice:                 invoice.New(),
 		InvoiceNumberCaching:    invoicenumbercaching.New(),
+		MagicLinkToken:          magiclinktoken.New(),
+		Objective:               objective.New(),
+		OneOnOne:                oneonone.New(),
 		OnLeaveRequest:          onleaverequest.New(),
 		OperationalService:      operationalservice.New(),
 		Organization:            organization.New(),
 		Payroll:                 payroll.New(),
+		PerformanceReview:       performancereview.New(),
 		Permission:              permission.New(),
 		Position:                position.New(),
+		ProfitLoss:              profitloss.New(),
 		Project:                 project.New(),
+		ProjectBudget:           projectbudget.New(),
+		ProjectChangelog:        projectchangelog.New(),
 		ProjectCommissionConfig: projectcommissionconfig.New(),
 		ProjectHead:             projecthead.New(),
+		ProjectHealthScore:      projecthealthscore.New(),
+		ProjectHealthWeight:     projecthealthweight.New(),
 		ProjectMember:           projectmember.New(),
+		ProjectMemberAllocation: projectmemberallocation.New(),
 		ProjectMemberPosition:   projectmemberposition.New(),
+		ProjectMemberRate:       projectmemberrate.New(),
 		ProjectNotion:           projectnotion.New(),
 		ProjectSlot:             projectslot.New(),
 		ProjectSlotPosition:     projectslotposition.New(),
 		ProjectStack:            projectstack.New(),
+		ProjectStatusTransition: projectstatustransition.New(),
 		Question:                question.New(),
+		RateCard:                ratecard.New(),
+		RateLimitBucket:         ratelimitbucket.New(),
 		Recruitment:             recruitment.New(),
 		Role:                    role.New(),
 		Schedule:                schedule.New(),
 		Seniority:               seniority.New(),
 		SocialAccount:           socialaccount.New(),
 		Stack:                   stack.New(),
+		StaffingRequest:         staffingrequest.New(),
+		SurveyCampaign:          surveycampaign.New(),
+		SurveyReminder:          surveyreminder.New(),
+		SurveyTemplate:          surveytemplate.New(),
+		Timesheet:               timesheet.New(),
 		Valuation:               valuation.New(),
 		WorkUnit:                workunit.New(),
 		WorkUnitMember:          workunitmember.New(),
@@ -0,0 +1,23 @@
+package surveycampaign
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	One(db *gorm.DB, id string, preload bool) (campaign *model.SurveyCampaign, err error)
+	All(db *gorm.DB, filter Filter, pagination model.Pagination) (campaigns []*model.SurveyCampaign, total int64, err error)
+	GetActive(db *gorm.DB) (campaigns []*model.SurveyCampaign, err error)
+	Create(db *gorm.DB, campaign *model.SurveyCampaign) (*model.SurveyCampaign, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.SurveyCampaign, updatedFields ...string) (*model.SurveyCampaign, error)
+
+	OneRunByPeriod(db *gorm.DB, campaignID string, period model.SurveyPeriod) (run *model.SurveyCampaignRun, err error)
+	GetRuns(db *gorm.DB, campaignID string, pagination model.Pagination) (runs []*model.SurveyCampaignRun, total int64, err error)
+	ClaimRun(db *gorm.DB, run *model.SurveyCampaignRun) (ok bool, err error)
+	ReclaimRun(db *gorm.DB, id string, staleBefore time.Time) (ok bool, err error)
+	UpdateRunSelectedFieldsByID(db *gorm.DB, id string, updateModel model.SurveyCampaignRun, updatedFields ...string) (*model.SurveyCampaignRun, error)
+}
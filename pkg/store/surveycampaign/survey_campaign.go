@@ -0,0 +1,146 @@
+package surveycampaign
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+type Filter struct {
+	Subtype  string
+	IsActive *bool
+	Keyword  string
+}
+
+// One get survey campaign by id, preload loads its creator and template
+func (s *store) One(db *gorm.DB, id string, preload bool) (*model.SurveyCampaign, error) {
+	var campaign *model.SurveyCampaign
+
+	query := db.Where("id = ?", id)
+	if preload {
+		query = query.Preload("Creator", "deleted_at IS NULL").
+			Preload("Template", "deleted_at IS NULL")
+	}
+
+	return campaign, query.First(&campaign).Error
+}
+
+// All get survey campaigns by filter with pagination
+func (s *store) All(db *gorm.DB, filter Filter, pagination model.Pagination) ([]*model.SurveyCampaign, int64, error) {
+	var campaigns []*model.SurveyCampaign
+	var total int64
+
+	query := db.Table("survey_campaigns").Where("deleted_at IS NULL")
+
+	if filter.Subtype != "" {
+		query = query.Where("subtype = ?", filter.Subtype)
+	}
+	if filter.IsActive != nil {
+		query = query.Where("is_active = ?", *filter.IsActive)
+	}
+	if filter.Keyword != "" {
+		query = query.Where("name ILIKE ?", "%"+filter.Keyword+"%")
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if pagination.Sort != "" {
+		query = query.Order(pagination.Sort)
+	} else {
+		query = query.Order("created_at DESC")
+	}
+
+	limit, offset := pagination.ToLimitOffset()
+	if pagination.Page > 0 {
+		query = query.Limit(limit)
+	}
+
+	return campaigns, total, query.Offset(offset).
+		Preload("Creator", "deleted_at IS NULL").
+		Preload("Template", "deleted_at IS NULL").
+		Find(&campaigns).Error
+}
+
+// GetActive get all active survey campaigns
+func (s *store) GetActive(db *gorm.DB) ([]*model.SurveyCampaign, error) {
+	var campaigns []*model.SurveyCampaign
+	return campaigns, db.Where("is_active IS TRUE").Order("created_at").Find(&campaigns).Error
+}
+
+// Create creates a new survey campaign
+func (s *store) Create(db *gorm.DB, campaign *model.SurveyCampaign) (*model.SurveyCampaign, error) {
+	return campaign, db.Omit(clause.Associations).Create(campaign).Error
+}
+
+// UpdateSelectedFieldsByID just update selected fields by id
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.SurveyCampaign, updatedFields ...string) (*model.SurveyCampaign, error) {
+	campaign := model.SurveyCampaign{}
+	return &campaign, db.Model(&campaign).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+// OneRunByPeriod get the run of a campaign for a period
+func (s *store) OneRunByPeriod(db *gorm.DB, campaignID string, period model.SurveyPeriod) (*model.SurveyCampaignRun, error) {
+	var run *model.SurveyCampaignRun
+	return run, db.Where("campaign_id = ? AND period_start = ?", campaignID, period.Start).First(&run).Error
+}
+
+// GetRuns get the runs of a campaign, latest period first
+func (s *store) GetRuns(db *gorm.DB, campaignID string, pagination model.Pagination) ([]*model.SurveyCampaignRun, int64, error) {
+	var runs []*model.SurveyCampaignRun
+	var total int64
+
+	query := db.Model(&model.SurveyCampaignRun{}).Where("campaign_id = ?", campaignID)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	limit, offset := pagination.ToLimitOffset()
+	if pagination.Page > 0 {
+		query = query.Limit(limit)
+	}
+
+	return runs, total, query.Offset(offset).
+		Order("period_start DESC").
+		Preload("Event", "deleted_at IS NULL").
+		Find(&runs).Error
+}
+
+// ClaimRun creates the run of a campaign for a period,
+// ok is false if another run of the period got there first
+func (s *store) ClaimRun(db *gorm.DB, run *model.SurveyCampaignRun) (bool, error) {
+	res := db.Omit(clause.Associations).Clauses(clause.OnConflict{
+		Columns:     []clause.Column{{Name: "campaign_id"}, {Name: "period_start"}},
+		TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "deleted_at IS NULL"}}},
+		DoNothing:   true,
+	}).Create(run)
+	return res.RowsAffected == 1, res.Error
+}
+
+// ReclaimRun marks a failed run, or a pending run claimed before staleBefore, as pending again to retry it,
+// ok is false if another run of the period got there first
+func (s *store) ReclaimRun(db *gorm.DB, id string, staleBefore time.Time) (bool, error) {
+	res := db.Model(&model.SurveyCampaignRun{}).
+		Where("id = ?", id).
+		Where("status = ? OR (status = ? AND COALESCE(updated_at, created_at) < ?)",
+			model.SurveyCampaignRunStatusFailed, model.SurveyCampaignRunStatusPending, staleBefore).
+		Updates(map[string]interface{}{"status": model.SurveyCampaignRunStatusPending, "error": "", "updated_at": time.Now()})
+	return res.RowsAffected == 1, res.Error
+}
+
+// UpdateRunSelectedFieldsByID just update selected fields of a run by id
+func (s *store) UpdateRunSelectedFieldsByID(db *gorm.DB, id string, updateModel model.SurveyCampaignRun, updatedFields ...string) (*model.SurveyCampaignRun, error) {
+	run := model.SurveyCampaignRun{}
+	return &run, db.Model(&run).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
@@ -0,0 +1,13 @@
+package surveyreminder
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Create(db *gorm.DB, reminder *model.SurveyReminder) (*model.SurveyReminder, error)
+	GetByEventID(db *gorm.DB, eventID string) (reminders []*model.SurveyReminder, err error)
+	DeleteByEventID(db *gorm.DB, eventID string) error
+}
@@ -0,0 +1,29 @@
+package surveyreminder
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// Create a survey reminder
+func (s *store) Create(db *gorm.DB, reminder *model.SurveyReminder) (*model.SurveyReminder, error) {
+	return reminder, db.Create(reminder).Error
+}
+
+// GetByEventID get reminders sent for an event
+func (s *store) GetByEventID(db *gorm.DB, eventID string) ([]*model.SurveyReminder, error) {
+	var reminders []*model.SurveyReminder
+	return reminders, db.Where("event_id = ?", eventID).Find(&reminders).Error
+}
+
+// DeleteByEventID delete reminders of an event
+func (s *store) DeleteByEventID(db *gorm.DB, eventID string) error {
+	return db.Where("event_id = ?", eventID).Delete(&model.SurveyReminder{}).Error
+}
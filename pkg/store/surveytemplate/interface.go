@@ -0,0 +1,18 @@
+package surveytemplate
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	One(db *gorm.DB, id string, preload bool) (template *model.SurveyTemplate, err error)
+	All(db *gorm.DB, filter Filter, pagination model.Pagination) (templates []*model.SurveyTemplate, total int64, err error)
+	Create(db *gorm.DB, template *model.SurveyTemplate) (*model.SurveyTemplate, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.SurveyTemplate, updatedFields ...string) (*model.SurveyTemplate, error)
+
+	OneVersion(db *gorm.DB, templateID string, version int) (templateVersion *model.SurveyTemplateVersion, err error)
+	OneVersionByID(db *gorm.DB, id string) (templateVersion *model.SurveyTemplateVersion, err error)
+	CreateVersion(db *gorm.DB, templateVersion *model.SurveyTemplateVersion) (*model.SurveyTemplateVersion, error)
+}
@@ -0,0 +1,106 @@
+package surveytemplate
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+type Filter struct {
+	Subtype string
+	Keyword string
+}
+
+// One get survey template by id, preload loads its versions without questions
+func (s *store) One(db *gorm.DB, id string, preload bool) (*model.SurveyTemplate, error) {
+	var template *model.SurveyTemplate
+
+	query := db.Where("id = ?", id)
+	if preload {
+		query = query.Preload("Creator", "deleted_at IS NULL").
+			Preload("Versions", func(db *gorm.DB) *gorm.DB {
+				return db.Where("deleted_at IS NULL").Order("version DESC")
+			})
+	}
+
+	return template, query.First(&template).Error
+}
+
+// All get survey templates by filter with pagination
+func (s *store) All(db *gorm.DB, filter Filter, pagination model.Pagination) ([]*model.SurveyTemplate, int64, error) {
+	var templates []*model.SurveyTemplate
+	var total int64
+
+	query := db.Table("survey_templates").Where("deleted_at IS NULL")
+
+	if filter.Subtype != "" {
+		query = query.Where("subtype = ? OR subtype IS NULL OR subtype = ''", filter.Subtype)
+	}
+	if filter.Keyword != "" {
+		query = query.Where("name ILIKE ?", "%"+filter.Keyword+"%")
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if pagination.Sort != "" {
+		query = query.Order(pagination.Sort)
+	} else {
+		query = query.Order("created_at DESC")
+	}
+
+	limit, offset := pagination.ToLimitOffset()
+	if pagination.Page > 0 {
+		query = query.Limit(limit)
+	}
+
+	return templates, total, query.Offset(offset).
+		Preload("Creator", "deleted_at IS NULL").
+		Find(&templates).Error
+}
+
+// Create creates a new survey template
+func (s *store) Create(db *gorm.DB, template *model.SurveyTemplate) (*model.SurveyTemplate, error) {
+	return template, db.Omit(clause.Associations).Create(template).Error
+}
+
+// UpdateSelectedFieldsByID just update selected fields by id
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.SurveyTemplate, updatedFields ...string) (*model.SurveyTemplate, error) {
+	template := model.SurveyTemplate{}
+	return &template, db.Model(&template).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+// OneVersion get a version of a survey template with its questions
+func (s *store) OneVersion(db *gorm.DB, templateID string, version int) (*model.SurveyTemplateVersion, error) {
+	var templateVersion *model.SurveyTemplateVersion
+	return templateVersion, db.Where("template_id = ? AND version = ?", templateID, version).
+		Preload("Template", "deleted_at IS NULL").
+		Preload("Questions", func(db *gorm.DB) *gorm.DB {
+			return db.Where("deleted_at IS NULL").Order("\"order\"")
+		}).
+		First(&templateVersion).Error
+}
+
+// OneVersionByID get a survey template version by id with its questions
+func (s *store) OneVersionByID(db *gorm.DB, id string) (*model.SurveyTemplateVersion, error) {
+	var templateVersion *model.SurveyTemplateVersion
+	return templateVersion, db.Where("id = ?", id).
+		Preload("Template", "deleted_at IS NULL").
+		Preload("Questions", func(db *gorm.DB) *gorm.DB {
+			return db.Where("deleted_at IS NULL").Order("\"order\"")
+		}).
+		First(&templateVersion).Error
+}
+
+// CreateVersion creates a survey template version together with its questions
+func (s *store) CreateVersion(db *gorm.DB, templateVersion *model.SurveyTemplateVersion) (*model.SurveyTemplateVersion, error) {
+	return templateVersion, db.Omit("Template").Create(templateVersion).Error
+}
@@ -0,0 +1,24 @@
+package timesheet
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	One(db *gorm.DB, id string, preload bool) (timesheet *model.Timesheet, err error)
+	OneByWeek(db *gorm.DB, employeeID string, projectID string, weekStart time.Time) (timesheet *model.Timesheet, err error)
+	All(db *gorm.DB, filter Filter, pagination model.Pagination) (timesheets []*model.Timesheet, total int64, err error)
+	Create(db *gorm.DB, timesheet *model.Timesheet) (*model.Timesheet, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.Timesheet, updatedFields ...string) (*model.Timesheet, error)
+
+	OneEntry(db *gorm.DB, timesheetID string, id string) (entry *model.TimesheetEntry, err error)
+	CreateEntry(db *gorm.DB, entry *model.TimesheetEntry) (*model.TimesheetEntry, error)
+	UpdateEntrySelectedFieldsByID(db *gorm.DB, id string, updateModel model.TimesheetEntry, updatedFields ...string) (*model.TimesheetEntry, error)
+	DeleteEntry(db *gorm.DB, id string) error
+	GetLoggedHoursOnDate(db *gorm.DB, employeeID string, date time.Time, excludedEntryID string) (hours float64, err error)
+	GetApprovedHoursByProjectID(db *gorm.DB, projectID string, from, to time.Time) (hours []*model.TimesheetHours, err error)
+}
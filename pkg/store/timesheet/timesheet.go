@@ -0,0 +1,164 @@
+package timesheet
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+type Filter struct {
+	EmployeeID string
+	ProjectIDs []string
+	// ReviewerID keeps the timesheets of the projects the employee is an active technical lead of
+	ReviewerID string
+	Status     string
+	// From and To keep the timesheets of the weeks starting between them
+	From *time.Time
+	To   *time.Time
+}
+
+// One get timesheet by id, preload loads its employee, project, reviewer and entries
+func (s *store) One(db *gorm.DB, id string, preload bool) (*model.Timesheet, error) {
+	var timesheet *model.Timesheet
+
+	query := db.Where("id = ?", id)
+	if preload {
+		query = preloadTimesheet(query)
+	}
+
+	return timesheet, query.First(&timesheet).Error
+}
+
+// OneByWeek get the timesheet of an employee on a project in a week
+func (s *store) OneByWeek(db *gorm.DB, employeeID string, projectID string, weekStart time.Time) (*model.Timesheet, error) {
+	var timesheet *model.Timesheet
+	return timesheet, db.Where("employee_id = ? AND project_id = ? AND week_start = ?", employeeID, projectID, weekStart).
+		First(&timesheet).Error
+}
+
+// All get timesheets by filter with pagination, latest week first
+func (s *store) All(db *gorm.DB, filter Filter, pagination model.Pagination) ([]*model.Timesheet, int64, error) {
+	var timesheets []*model.Timesheet
+	var total int64
+
+	query := db.Table("timesheets").Where("deleted_at IS NULL")
+
+	if filter.EmployeeID != "" {
+		query = query.Where("employee_id = ?", filter.EmployeeID)
+	}
+	if len(filter.ProjectIDs) > 0 {
+		query = query.Where("project_id IN ?", filter.ProjectIDs)
+	}
+	if filter.ReviewerID != "" {
+		query = query.Where(`project_id IN (
+			SELECT ph.project_id FROM project_heads ph
+			WHERE ph.employee_id = ? AND ph.position = ? AND ph.deleted_at IS NULL
+				AND (ph.end_date IS NULL OR ph.end_date > now())
+		)`, filter.ReviewerID, model.HeadPositionTechnicalLead)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.From != nil {
+		query = query.Where("week_start >= ?", filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("week_start <= ?", filter.To)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	limit, offset := pagination.ToLimitOffset()
+	if pagination.Page > 0 {
+		query = query.Limit(limit)
+	}
+
+	return timesheets, total, preloadTimesheet(query.Offset(offset)).
+		Order("week_start DESC, created_at").
+		Find(&timesheets).Error
+}
+
+// Create creates a new timesheet
+func (s *store) Create(db *gorm.DB, timesheet *model.Timesheet) (*model.Timesheet, error) {
+	return timesheet, db.Omit(clause.Associations).Create(timesheet).Error
+}
+
+// UpdateSelectedFieldsByID just update selected fields by id
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.Timesheet, updatedFields ...string) (*model.Timesheet, error) {
+	timesheet := model.Timesheet{}
+	return &timesheet, db.Model(&timesheet).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+// OneEntry get an entry of a timesheet
+func (s *store) OneEntry(db *gorm.DB, timesheetID string, id string) (*model.TimesheetEntry, error) {
+	var entry *model.TimesheetEntry
+	return entry, db.Where("id = ? AND timesheet_id = ?", id, timesheetID).
+		Preload("WorkUnit", "deleted_at IS NULL").
+		First(&entry).Error
+}
+
+// CreateEntry creates a new timesheet entry
+func (s *store) CreateEntry(db *gorm.DB, entry *model.TimesheetEntry) (*model.TimesheetEntry, error) {
+	return entry, db.Omit(clause.Associations).Create(entry).Error
+}
+
+// UpdateEntrySelectedFieldsByID just update selected fields of a timesheet entry by id
+func (s *store) UpdateEntrySelectedFieldsByID(db *gorm.DB, id string, updateModel model.TimesheetEntry, updatedFields ...string) (*model.TimesheetEntry, error) {
+	entry := model.TimesheetEntry{}
+	return &entry, db.Model(&entry).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+// DeleteEntry deletes a timesheet entry by id
+func (s *store) DeleteEntry(db *gorm.DB, id string) error {
+	return db.Where("id = ?", id).Delete(&model.TimesheetEntry{}).Error
+}
+
+// GetLoggedHoursOnDate get the hours an employee logged on a date across all projects
+func (s *store) GetLoggedHoursOnDate(db *gorm.DB, employeeID string, date time.Time, excludedEntryID string) (float64, error) {
+	var hours float64
+
+	query := db.Table("timesheet_entries te").
+		Joins("JOIN timesheets t ON t.id = te.timesheet_id").
+		Where("te.deleted_at IS NULL AND t.deleted_at IS NULL").
+		Where("t.employee_id = ? AND te.date = ?", employeeID, date)
+	if excludedEntryID != "" {
+		query = query.Where("te.id <> ?", excludedEntryID)
+	}
+
+	return hours, query.Select("COALESCE(SUM(te.hours), 0)").Scan(&hours).Error
+}
+
+// GetApprovedHoursByProjectID get the approved hours of each member of a project logged between two dates
+func (s *store) GetApprovedHoursByProjectID(db *gorm.DB, projectID string, from, to time.Time) ([]*model.TimesheetHours, error) {
+	var hours []*model.TimesheetHours
+	return hours, db.Table("timesheet_entries te").
+		Joins("JOIN timesheets t ON t.id = te.timesheet_id").
+		Where("te.deleted_at IS NULL AND t.deleted_at IS NULL").
+		Where("t.project_id = ? AND t.status = ?", projectID, model.TimesheetStatusApproved).
+		Where("te.date BETWEEN ? AND ?", from, to).
+		Group("t.employee_id").
+		Select("t.employee_id, SUM(te.hours) AS hours").
+		Scan(&hours).Error
+}
+
+func preloadTimesheet(query *gorm.DB) *gorm.DB {
+	return query.
+		Preload("Employee", "deleted_at IS NULL").
+		Preload("Project", "deleted_at IS NULL").
+		Preload("Reviewer", "deleted_at IS NULL").
+		Preload("Entries", func(db *gorm.DB) *gorm.DB {
+			return db.Where("deleted_at IS NULL").Order("date, created_at")
+		}).
+		Preload("Entries.WorkUnit", "deleted_at IS NULL")
+}
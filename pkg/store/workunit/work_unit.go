@@ -44,6 +44,17 @@ func (s *store) One(db *gorm.DB, id string) (*model.WorkUnit, error) {
 	return workUnit, db.Where("id = ?", id).First(&workUnit).Error
 }
 
+// GetDetailByID get a work unit with its active members and stacks
+func (s *store) GetDetailByID(db *gorm.DB, id string) (*model.WorkUnit, error) {
+	var workUnit *model.WorkUnit
+	return workUnit, db.Where("id = ?", id).
+		Preload("WorkUnitMembers", "deleted_at IS NULL and status = 'active'").
+		Preload("WorkUnitMembers.Employee", "deleted_at IS NULL").
+		Preload("WorkUnitStacks", "deleted_at IS NULL").
+		Preload("WorkUnitStacks.Stack", "deleted_at IS NULL").
+		First(&workUnit).Error
+}
+
 // UpdateSelectedFieldsByID just update selected fields by id
 func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.WorkUnit, updatedFields ...string) (*model.WorkUnit, error) {
 	workUnit := model.WorkUnit{}
@@ -74,3 +85,19 @@ func (s *store) GetAllWorkUnitByEmployeeID(db *gorm.DB, employeeID string) ([]*m
 		Preload("Project", "deleted_at IS NULL").
 		Find(&workUnits).Error
 }
+
+// ArchiveByProjectID archives the work units of a project
+func (s *store) ArchiveByProjectID(db *gorm.DB, projectID string) error {
+	return db.Model(&model.WorkUnit{}).
+		Where("project_id = ? AND status <> ?", projectID, model.WorkUnitStatusArchived).
+		Update("status", model.WorkUnitStatusArchived).Error
+}
+
+// GetGithubLinked get the active work units of the running projects which are linked to a GitHub repository
+func (s *store) GetGithubLinked(db *gorm.DB) ([]*model.WorkUnit, error) {
+	var workUnits []*model.WorkUnit
+	return workUnits, db.Joins("JOIN projects ON projects.id = work_units.project_id").
+		Where("work_units.status = ? AND work_units.source_metadata->>'provider' = ?", model.WorkUnitStatusActive, model.WorkUnitSourceGithub).
+		Where("projects.deleted_at IS NULL AND projects.status IN ?", []string{model.ProjectStatusOnBoarding.String(), model.ProjectStatusActive.String()}).
+		Find(&workUnits).Error
+}
@@ -11,6 +11,7 @@ import (
 type IStore interface {
 	Create(db *gorm.DB, wum *model.WorkUnitMember) error
 	GetByWorkUnitID(db *gorm.DB, wuID string) (wuMembers []*model.WorkUnitMember, err error)
+	GetByEmployeeIDInTimeRange(db *gorm.DB, employeeID string, from, to time.Time) (wuMembers []*model.WorkUnitMember, err error)
 	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.WorkUnitMember, updatedFields ...string) (*model.WorkUnitMember, error)
 	DeleteByWorkUnitID(db *gorm.DB, workUnitID string) error
 	All(db *gorm.DB, workUnitID string) (members []*model.WorkUnitMember, err error)
@@ -18,4 +19,5 @@ type IStore interface {
 	SoftDeleteByWorkUnitID(db *gorm.DB, workUnitID string, employeeID string) (err error)
 	GetPeerReviewerInTimeRange(db *gorm.DB, from *time.Time, to *time.Time) ([]model.WorkUnitPeer, error)
 	GetActivePeerReviewer(db *gorm.DB) ([]model.WorkUnitPeer, error)
+	UpdateToInactiveByProjectID(db *gorm.DB, projectID string, endDate time.Time) error
 }
@@ -33,6 +33,16 @@ func (s *store) GetByWorkUnitID(db *gorm.DB, wuID string) ([]*model.WorkUnitMemb
 	return members, db.Where("work_unit_id = ?", wuID).Find(&members).Error
 }
 
+// GetByEmployeeIDInTimeRange return the work units an employee worked on in a time range
+func (s *store) GetByEmployeeIDInTimeRange(db *gorm.DB, employeeID string, from, to time.Time) ([]*model.WorkUnitMember, error) {
+	var members []*model.WorkUnitMember
+	return members, db.Where("employee_id = ? AND start_date <= ?", employeeID, to).
+		Where("(end_date IS NULL OR end_date >= ?)", from).
+		Order("start_date").
+		Preload("WorkUnit", "deleted_at IS NULL").
+		Find(&members).Error
+}
+
 // UpdateSelectedFieldsByID just update selected fields by id
 func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.WorkUnitMember, updatedFields ...string) (*model.WorkUnitMember, error) {
 	member := model.WorkUnitMember{}
@@ -139,3 +149,13 @@ func (s *store) GetActivePeerReviewer(db *gorm.DB) ([]model.WorkUnitPeer, error)
 	`)
 	return peers, query.Scan(&peers).Error
 }
+
+// UpdateToInactiveByProjectID inactivates the members of the work units of a project
+func (s *store) UpdateToInactiveByProjectID(db *gorm.DB, projectID string, endDate time.Time) error {
+	return db.Model(&model.WorkUnitMember{}).
+		Where("project_id = ? AND status <> ?", projectID, model.ProjectMemberStatusInactive).
+		Updates(map[string]interface{}{
+			"status":   model.ProjectMemberStatusInactive,
+			"end_date": endDate,
+		}).Error
+}
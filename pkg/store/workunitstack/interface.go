@@ -9,4 +9,5 @@ import (
 type IStore interface {
 	Create(db *gorm.DB, wus *model.WorkUnitStack) error
 	DeleteByWorkUnitID(db *gorm.DB, workUnitID string) error
+	GetByWorkUnitID(db *gorm.DB, workUnitID string) (stacks []*model.WorkUnitStack, err error)
 }
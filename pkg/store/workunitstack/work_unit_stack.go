@@ -21,3 +21,9 @@ func (s *store) Create(db *gorm.DB, wus *model.WorkUnitStack) error {
 func (s *store) DeleteByWorkUnitID(db *gorm.DB, workUnitID string) error {
 	return db.Unscoped().Where("work_unit_id = ?", workUnitID).Delete(&model.WorkUnitStack{}).Error
 }
+
+// GetByWorkUnitID get the stacks of a work unit
+func (s *store) GetByWorkUnitID(db *gorm.DB, workUnitID string) ([]*model.WorkUnitStack, error) {
+	var stacks []*model.WorkUnitStack
+	return stacks, db.Where("work_unit_id = ?", workUnitID).Find(&stacks).Error
+}
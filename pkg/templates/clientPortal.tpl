@@ -0,0 +1,31 @@
+Mime-Version: 1.0
+From: "Team @ Dwarves Foundation" <team@dwarvesv.com>
+To: {{.Email}}
+Subject: {{if .IsInvitation}}Your access to the {{.ClientName}} portal{{else}}Your {{.ClientName}} portal login link{{end}}
+Content-Type: multipart/mixed; boundary=main
+
+--main
+Content-Type: text/html; charset="UTF-8"
+Content-Transfer-Encoding: quoted-printable
+
+<div dir=3D"ltr">
+	<div>Hi {{.Name}},<div>
+			<div dir=3D"ltr">
+				<div><br></div>
+				{{if .IsInvitation}}<div>You have been given access to the portal of {{.ClientName}}, where you can follow your projects with Dwarves Foundation: the team, the invoices, the changelogs and the milestones.<br><br></div>{{end}}
+				<div>Please use the link below to log in to the portal. The link can be used only once{{if .IsInvitation}} and expires in 7 days{{else}} and expires in 15 minutes{{end}}.<br><br>
+					<a href=3D"{{.Link}}">Log in to the portal</a>
+					<div><br></div>
+				</div>
+				<div>If you did not expect this email, you can safely ignore it.
+				</div>
+				<div><br></div>
+				<div>Best regards,</div>
+			</div>
+		</div>
+		<div><br></div>-- <br>
+	</div>
+	{{ template "signature.tpl" }}
+</div>
+
+--main--
@@ -0,0 +1,30 @@
+Mime-Version: 1.0
+From: "Team @ Dwarves Foundation" <team@dwarvesv.com>
+To: {{.Email}}
+Subject: Your Fortress login link
+Content-Type: multipart/mixed; boundary=main
+
+--main
+Content-Type: text/html; charset="UTF-8"
+Content-Transfer-Encoding: quoted-printable
+
+<div dir=3D"ltr">
+	<div>Hi,<div>
+			<div dir=3D"ltr">
+				<div><br></div>
+				<div>Please use the link below to log in to Fortress. The link can be used only once and expires in 15 minutes.<br><br>
+					<a href=3D"{{.Link}}">Log in to Fortress</a>
+					<div><br></div>
+				</div>
+				<div>If you did not request this email, you can safely ignore it.
+				</div>
+				<div><br></div>
+				<div>Best regards,</div>
+			</div>
+		</div>
+		<div><br></div>-- <br>
+	</div>
+	{{ template "signature.tpl" }}
+</div>
+
+--main--
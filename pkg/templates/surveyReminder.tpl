@@ -0,0 +1,30 @@
+Mime-Version: 1.0
+From: "Team @ Dwarves Foundation" <team@dwarvesv.com>
+To: {{.Email}}
+Subject: Reminder: {{.Survey}} is due on {{.Deadline}}
+Content-Type: multipart/mixed; boundary=main
+
+--main
+Content-Type: text/html; charset="UTF-8"
+Content-Transfer-Encoding: quoted-printable
+
+<div dir=3D"ltr">
+	<div>Hi {{.Name}},<div>
+			<div dir=3D"ltr">
+				<div><br></div>
+				<div>You have not finished <b>{{.Survey}}</b> yet. Please submit your answers before {{.Deadline}}.<br><br>
+					<a href=3D"{{.Link}}">Open the survey</a>
+					<div><br></div>
+				</div>
+				<div>Thank you for your time.
+				</div>
+				<div><br></div>
+				<div>Best regards,</div>
+			</div>
+		</div>
+		<div><br></div>-- <br>
+	</div>
+	{{ template "signature.tpl" }}
+</div>
+
+--main--
@@ -0,0 +1,153 @@
+package auditutils
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// RedactedValue replaces the value of sensitive fields in audit log snapshots
+const RedactedValue = "[REDACTED]"
+
+// sensitiveKeys are normalized field names (lower case, without separators)
+// which must never be stored in plain text in audit logs
+var sensitiveKeys = map[string]bool{
+	"password":               true,
+	"secretkey":              true,
+	"apikey":                 true,
+	"accesstoken":            true,
+	"refreshtoken":           true,
+	"token":                  true,
+	"accountnumber":          true,
+	"localbanknumber":        true,
+	"localbankrecipientname": true,
+	"wiseaccountnumber":      true,
+	"wiserecipientid":        true,
+	"wiserecipientemail":     true,
+	"identitycardphotofront": true,
+	"identitycardphotoback":  true,
+	"passportphotofront":     true,
+	"passportphotoback":      true,
+	"swiftcode":              true,
+	"iban":                   true,
+	"personalemail":          true,
+	"phonenumber":            true,
+	"dateofbirth":            true,
+	"address":                true,
+	"placeofresidence":       true,
+	// free text written by or about employees, e.g. survey answers, feedback and 1:1 notes
+	"answer":      true,
+	"answers":     true,
+	"comment":     true,
+	"message":     true,
+	"note":        true,
+	"notes":       true,
+	"managernote": true,
+	"reason":      true,
+}
+
+// ignoredDiffKeys are bookkeeping fields which change on every write
+var ignoredDiffKeys = map[string]bool{
+	"updatedat": true,
+}
+
+// DiffEntry describes how a single field changed
+type DiffEntry struct {
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
+func normalizeKey(key string) string {
+	return strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+}
+
+// IsSensitiveKey checks if the field name holds sensitive data
+func IsSensitiveKey(key string) bool {
+	return sensitiveKeys[normalizeKey(key)]
+}
+
+// ToMap converts any json-serializable value to a generic map,
+// non-object values are wrapped under the "data" key
+func ToMap(v interface{}) (map[string]interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var out interface{}
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+
+	switch o := out.(type) {
+	case nil:
+		return nil, nil
+	case map[string]interface{}:
+		return o, nil
+	default:
+		return map[string]interface{}{"data": o}, nil
+	}
+}
+
+// Redact returns a copy of the value with all sensitive fields replaced
+func Redact(v interface{}) interface{} {
+	switch o := v.(type) {
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(o))
+		for k, val := range o {
+			if IsSensitiveKey(k) && val != nil && val != "" {
+				res[k] = RedactedValue
+				continue
+			}
+			res[k] = Redact(val)
+		}
+		return res
+	case []interface{}:
+		res := make([]interface{}, len(o))
+		for i := range o {
+			res[i] = Redact(o[i])
+		}
+		return res
+	default:
+		return v
+	}
+}
+
+// RedactMap is the typed version of Redact for top level objects
+func RedactMap(m map[string]interface{}) map[string]interface{} {
+	if m == nil {
+		return nil
+	}
+	return Redact(m).(map[string]interface{})
+}
+
+// Diff compares top level fields of two snapshots,
+// a missing snapshot is treated as an empty object
+func Diff(before, after map[string]interface{}) map[string]DiffEntry {
+	res := map[string]DiffEntry{}
+
+	for k, from := range before {
+		if ignoredDiffKeys[normalizeKey(k)] {
+			continue
+		}
+		to, ok := after[k]
+		if !ok || !reflect.DeepEqual(from, to) {
+			res[k] = DiffEntry{From: from, To: to}
+		}
+	}
+
+	for k, to := range after {
+		if ignoredDiffKeys[normalizeKey(k)] {
+			continue
+		}
+		if _, ok := before[k]; !ok {
+			res[k] = DiffEntry{From: nil, To: to}
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,104 @@
+package auditutils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRedact(t *testing.T) {
+	testcases := []struct {
+		name  string
+		input interface{}
+		want  interface{}
+	}{
+		{
+			name: "redact top level and nested fields",
+			input: map[string]interface{}{
+				"FullName":        "John Doe",
+				"LocalBankNumber": "0123456789",
+				"bankAccount": map[string]interface{}{
+					"accountNumber": "987654321",
+					"bankName":      "ACB",
+				},
+			},
+			want: map[string]interface{}{
+				"FullName":        "John Doe",
+				"LocalBankNumber": RedactedValue,
+				"bankAccount": map[string]interface{}{
+					"accountNumber": RedactedValue,
+					"bankName":      "ACB",
+				},
+			},
+		},
+		{
+			name: "redact inside arrays and keep empty values",
+			input: []interface{}{
+				map[string]interface{}{"secret_key": "abc", "wiseAccountNumber": ""},
+			},
+			want: []interface{}{
+				map[string]interface{}{"secret_key": RedactedValue, "wiseAccountNumber": ""},
+			},
+		},
+		{
+			name: "redact personal data and free text",
+			input: map[string]interface{}{
+				"Address": "1 Main St",
+				"note":    "private note",
+				"answers": []interface{}{
+					map[string]interface{}{"eventQuestionID": "1", "answer": "yes"},
+				},
+				"status": "done",
+			},
+			want: map[string]interface{}{
+				"Address": RedactedValue,
+				"note":    RedactedValue,
+				"answers": RedactedValue,
+				"status":  "done",
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			out := Redact(tc.input)
+			if !reflect.DeepEqual(out, tc.want) {
+				t.Errorf("auditutils.Redact() want output: %v, got output: %v", tc.want, out)
+			}
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	testcases := []struct {
+		name   string
+		before map[string]interface{}
+		after  map[string]interface{}
+		want   map[string]DiffEntry
+	}{
+		{
+			name:   "created resource",
+			before: nil,
+			after:  map[string]interface{}{"name": "fortress"},
+			want:   map[string]DiffEntry{"name": {From: nil, To: "fortress"}},
+		},
+		{
+			name:   "deleted resource",
+			before: map[string]interface{}{"name": "fortress"},
+			after:  nil,
+			want:   map[string]DiffEntry{"name": {From: "fortress", To: nil}},
+		},
+		{
+			name:   "updated fields only, ignore updatedAt",
+			before: map[string]interface{}{"name": "fortress", "status": "active", "updatedAt": "2023-01-01"},
+			after:  map[string]interface{}{"name": "fortress", "status": "closed", "updatedAt": "2023-01-02"},
+			want:   map[string]DiffEntry{"status": {From: "active", To: "closed"}},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			out := Diff(tc.before, tc.after)
+			if !reflect.DeepEqual(out, tc.want) {
+				t.Errorf("auditutils.Diff() want output: %v, got output: %v", tc.want, out)
+			}
+		})
+	}
+}
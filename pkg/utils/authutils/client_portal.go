@@ -0,0 +1,85 @@
+package authutils
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/utils"
+)
+
+var ErrClientPortalNotConfigured = errors.New("client portal is not configured")
+
+// clientPortalSecretKey returns the key of the client portal tokens, it must be set and differ from the employee one
+func clientPortalSecretKey(cfg *config.Config) (string, error) {
+	key := cfg.ClientPortal.JWTSecretKey
+	if key == "" || key == cfg.JWTSecretKey {
+		return "", ErrClientPortalNotConfigured
+	}
+
+	return key, nil
+}
+
+// CheckClientPortalConfig checks if the client portal tokens can be signed
+func CheckClientPortalConfig(cfg *config.Config) error {
+	_, err := clientPortalSecretKey(cfg)
+	return err
+}
+
+// GenerateClientPortalToken signs the token of a client contact for the client portal
+func GenerateClientPortalToken(cfg *config.Config, claims *model.ClientPortalClaims, expiresAt time.Time) (string, error) {
+	key, err := clientPortalSecretKey(cfg)
+	if err != nil {
+		return "", err
+	}
+
+	claims.Audience = jwt.ClaimStrings{model.ClientPortalAudience}
+	claims.ExpiresAt = jwt.NewNumericDate(expiresAt)
+
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(key))
+}
+
+// GetClientPortalClaimsFromToken validates a client portal token, tokens of the employees are rejected
+func GetClientPortalClaimsFromToken(cfg *config.Config, tokenString string) (*model.ClientPortalClaims, error) {
+	key, err := clientPortalSecretKey(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := &model.ClientPortalClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, utils.ErrInvalidSignature
+		}
+		return []byte(key), nil
+	})
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, utils.ErrInvalidToken
+		}
+		return nil, utils.ErrBadToken
+	}
+	if !token.Valid || !claims.VerifyAudience(model.ClientPortalAudience, true) || claims.AccessID == "" {
+		return nil, utils.ErrBadToken
+	}
+
+	return claims, nil
+}
+
+// GetClientPortalClaimsFromContext validates the client portal token of a request
+func GetClientPortalClaimsFromContext(c *gin.Context, cfg *config.Config) (*model.ClientPortalClaims, error) {
+	if IsAPIKey(c) {
+		return nil, utils.ErrAuthenticationTypeHeaderInvalid
+	}
+
+	accessToken, err := GetTokenFromRequest(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetClientPortalClaimsFromToken(cfg, accessToken)
+}
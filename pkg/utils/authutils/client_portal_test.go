@@ -0,0 +1,96 @@
+package authutils
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/utils"
+)
+
+func TestGetClientPortalClaimsFromToken(t *testing.T) {
+	cfg := &config.Config{
+		JWTSecretKey: "JWTSecretKey",
+		ClientPortal: config.ClientPortal{JWTSecretKey: "ClientPortalJWTSecretKey"},
+	}
+	now := time.Now()
+
+	portalToken, err := GenerateClientPortalToken(cfg, &model.ClientPortalClaims{
+		AccessID:  "9a3a4f5c-9b1e-4a38-a3a4-3f5b1c2d4e6f",
+		ContactID: "596ae21d-570b-4852-92a6-117633629046",
+		ClientID:  "67f9f420-cdd5-4793-88c7-d2068bd17f61",
+	}, now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("GenerateClientPortalToken() error = %v", err)
+	}
+
+	expiredToken, err := GenerateClientPortalToken(cfg, &model.ClientPortalClaims{
+		AccessID: "9a3a4f5c-9b1e-4a38-a3a4-3f5b1c2d4e6f",
+	}, now.Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("GenerateClientPortalToken() error = %v", err)
+	}
+
+	employeeToken, err := GenerateJWTToken(&model.AuthenticationInfo{
+		UserID: "2655832e-f009-4b73-a535-64c3a22e558f",
+	}, now.Add(time.Hour).Unix(), cfg.JWTSecretKey)
+	if err != nil {
+		t.Fatalf("GenerateJWTToken() error = %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		cfg          *config.Config
+		token        string
+		wantAccessID string
+		wantErr      error
+	}{
+		{
+			name:         "happy case",
+			cfg:          cfg,
+			token:        portalToken,
+			wantAccessID: "9a3a4f5c-9b1e-4a38-a3a4-3f5b1c2d4e6f",
+		},
+		{
+			name:    "expired token",
+			cfg:     cfg,
+			token:   expiredToken,
+			wantErr: utils.ErrInvalidToken,
+		},
+		{
+			name:    "employee token",
+			cfg:     cfg,
+			token:   employeeToken,
+			wantErr: utils.ErrBadToken,
+		},
+		{
+			name:    "not configured",
+			cfg:     &config.Config{JWTSecretKey: "JWTSecretKey"},
+			token:   portalToken,
+			wantErr: ErrClientPortalNotConfigured,
+		},
+		{
+			name: "same secret key as the employees",
+			cfg: &config.Config{
+				JWTSecretKey: "JWTSecretKey",
+				ClientPortal: config.ClientPortal{JWTSecretKey: "JWTSecretKey"},
+			},
+			token:   employeeToken,
+			wantErr: ErrClientPortalNotConfigured,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetClientPortalClaimsFromToken(tt.cfg, tt.token)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("GetClientPortalClaimsFromToken() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr == nil && got.AccessID != tt.wantAccessID {
+				t.Errorf("GetClientPortalClaimsFromToken() AccessID = %v, want %v", got.AccessID, tt.wantAccessID)
+			}
+		})
+	}
+}
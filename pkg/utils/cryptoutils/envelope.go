@@ -0,0 +1,175 @@
+package cryptoutils
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+// prefix marks an encrypted value, the full format is
+// enc:v1:<blind index>:<key id>:<wrapped data key>:<ciphertext>
+// the blind index comes first so it stays a stable prefix across key rotations
+const (
+	prefix        = "enc:v1:"
+	segmentCount  = 4
+	blindIndexLen = 16
+)
+
+var ErrInvalidCiphertext = errors.New("invalid ciphertext")
+
+var encoding = base64.RawURLEncoding
+
+// IsEncrypted checks if the value was produced by Encrypt
+func IsEncrypted(s string) bool {
+	return strings.HasPrefix(s, prefix)
+}
+
+// Encrypt seals the plaintext with a random data key, which is wrapped by the current key.
+// If withIndex is true, a blind index of the plaintext is kept in front of the ciphertext.
+func (k *Keyring) Encrypt(plaintext string, withIndex bool) (string, error) {
+	dataKey := make([]byte, keySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", err
+	}
+
+	kek, err := k.key(k.currentKeyID)
+	if err != nil {
+		return "", err
+	}
+
+	wrappedKey, err := seal(kek, dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	ciphertext, err := seal(dataKey, []byte(plaintext))
+	if err != nil {
+		return "", err
+	}
+
+	index := ""
+	if withIndex {
+		index = k.BlindIndex(plaintext)
+	}
+
+	return prefix + strings.Join([]string{
+		index,
+		k.currentKeyID,
+		encoding.EncodeToString(wrappedKey),
+		encoding.EncodeToString(ciphertext),
+	}, ":"), nil
+}
+
+// Decrypt opens a value produced by Encrypt, values without the prefix are
+// returned as is so rows written before encryption was enabled stay readable
+func (k *Keyring) Decrypt(s string) (string, error) {
+	if !IsEncrypted(s) {
+		return s, nil
+	}
+
+	segments := strings.Split(strings.TrimPrefix(s, prefix), ":")
+	if len(segments) != segmentCount {
+		return "", ErrInvalidCiphertext
+	}
+
+	kek, err := k.key(segments[1])
+	if err != nil {
+		return "", err
+	}
+
+	wrappedKey, err := encoding.DecodeString(segments[2])
+	if err != nil {
+		return "", ErrInvalidCiphertext
+	}
+
+	dataKey, err := open(kek, wrappedKey)
+	if err != nil {
+		return "", err
+	}
+
+	ciphertext, err := encoding.DecodeString(segments[3])
+	if err != nil {
+		return "", ErrInvalidCiphertext
+	}
+
+	plaintext, err := open(dataKey, ciphertext)
+	if err != nil {
+		return "", err
+	}
+
+	return string(plaintext), nil
+}
+
+// NeedsRotation checks if the stored value is plaintext or was encrypted with an old key
+func (k *Keyring) NeedsRotation(s string) bool {
+	if s == "" {
+		return false
+	}
+	if !IsEncrypted(s) {
+		return true
+	}
+
+	segments := strings.Split(strings.TrimPrefix(s, prefix), ":")
+	return len(segments) != segmentCount || segments[1] != k.currentKeyID
+}
+
+// BlindIndex returns a keyed hash of the normalized value, equal values
+// always have the same index so it can be used for exact lookups
+func (k *Keyring) BlindIndex(value string) string {
+	mac := hmac.New(sha256.New, k.blindIndexKey)
+	mac.Write([]byte(strings.ToLower(strings.TrimSpace(value))))
+	return hex.EncodeToString(mac.Sum(nil)[:blindIndexLen])
+}
+
+// BlindIndexPattern returns a LIKE pattern matching encrypted values of the given plaintext
+func (k *Keyring) BlindIndexPattern(value string) string {
+	return prefix + k.BlindIndex(value) + ":%"
+}
+
+func seal(key []byte, plaintext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func open(key []byte, ciphertext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, ErrInvalidCiphertext
+	}
+
+	nonce, ciphertext := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, ErrInvalidCiphertext
+	}
+
+	return plaintext, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,129 @@
+package cryptoutils
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+func testKey(b byte) string {
+	return base64.StdEncoding.EncodeToString([]byte(strings.Repeat(string(b), keySize)))
+}
+
+func newTestKeyring(t *testing.T, currentKeyID string) *Keyring {
+	k, err := NewKeyring(currentKeyID, map[string]string{
+		"k1": testKey('a'),
+		"k2": testKey('b'),
+	}, testKey('c'))
+	if err != nil {
+		t.Fatalf("cryptoutils.NewKeyring() unexpected error: %v", err)
+	}
+	return k
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	k := newTestKeyring(t, "k1")
+
+	testcases := []struct {
+		name      string
+		plaintext string
+		withIndex bool
+	}{
+		{name: "without blind index", plaintext: "0123456789"},
+		{name: "with blind index", plaintext: "john@gmail.com", withIndex: true},
+		{name: "unicode", plaintext: "số 1 Nguyễn Huệ, Quận 1", withIndex: true},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ciphertext, err := k.Encrypt(tc.plaintext, tc.withIndex)
+			if err != nil {
+				t.Fatalf("Keyring.Encrypt() unexpected error: %v", err)
+			}
+			if !IsEncrypted(ciphertext) || strings.Contains(ciphertext, tc.plaintext) {
+				t.Errorf("Keyring.Encrypt() got unexpected ciphertext: %v", ciphertext)
+			}
+
+			out, err := k.Decrypt(ciphertext)
+			if err != nil {
+				t.Fatalf("Keyring.Decrypt() unexpected error: %v", err)
+			}
+			if out != tc.plaintext {
+				t.Errorf("Keyring.Decrypt() want output: %v, got output: %v", tc.plaintext, out)
+			}
+		})
+	}
+}
+
+func TestDecryptPlaintext(t *testing.T) {
+	k := newTestKeyring(t, "k1")
+
+	out, err := k.Decrypt("0123456789")
+	if err != nil || out != "0123456789" {
+		t.Errorf("Keyring.Decrypt() want plaintext to be returned as is, got output: %v, err: %v", out, err)
+	}
+
+	if _, err := k.Decrypt("enc:v1:::broken"); err != ErrInvalidCiphertext {
+		t.Errorf("Keyring.Decrypt() want error: %v, got: %v", ErrInvalidCiphertext, err)
+	}
+}
+
+func TestRotation(t *testing.T) {
+	oldKeyring := newTestKeyring(t, "k1")
+	newKeyring := newTestKeyring(t, "k2")
+
+	ciphertext, err := oldKeyring.Encrypt("john@gmail.com", true)
+	if err != nil {
+		t.Fatalf("Keyring.Encrypt() unexpected error: %v", err)
+	}
+
+	if oldKeyring.NeedsRotation(ciphertext) {
+		t.Errorf("Keyring.NeedsRotation() want false for the current key")
+	}
+	if !newKeyring.NeedsRotation(ciphertext) {
+		t.Errorf("Keyring.NeedsRotation() want true for an old key")
+	}
+	if !newKeyring.NeedsRotation("john@gmail.com") || newKeyring.NeedsRotation("") {
+		t.Errorf("Keyring.NeedsRotation() want true for plaintext and false for empty values")
+	}
+
+	// old values stay readable after the current key changes
+	out, err := newKeyring.Decrypt(ciphertext)
+	if err != nil || out != "john@gmail.com" {
+		t.Errorf("Keyring.Decrypt() want output: john@gmail.com, got output: %v, err: %v", out, err)
+	}
+
+	rotated, err := newKeyring.Encrypt(out, true)
+	if err != nil {
+		t.Fatalf("Keyring.Encrypt() unexpected error: %v", err)
+	}
+
+	// the blind index is kept across rotations so lookups still match
+	pattern := strings.TrimSuffix(newKeyring.BlindIndexPattern("John@Gmail.com "), "%")
+	if !strings.HasPrefix(ciphertext, pattern) || !strings.HasPrefix(rotated, pattern) {
+		t.Errorf("Keyring.BlindIndexPattern() want %v to prefix both %v and %v", pattern, ciphertext, rotated)
+	}
+}
+
+func TestNewKeyring(t *testing.T) {
+	testcases := []struct {
+		name         string
+		currentKeyID string
+		keys         map[string]string
+		indexKey     string
+		wantErr      bool
+	}{
+		{name: "valid", currentKeyID: "k1", keys: map[string]string{"k1": testKey('a')}, indexKey: testKey('c')},
+		{name: "missing current key", currentKeyID: "k2", keys: map[string]string{"k1": testKey('a')}, indexKey: testKey('c'), wantErr: true},
+		{name: "short key", currentKeyID: "k1", keys: map[string]string{"k1": "c2hvcnQ="}, indexKey: testKey('c'), wantErr: true},
+		{name: "invalid key id", currentKeyID: "k:1", keys: map[string]string{"k:1": testKey('a')}, indexKey: testKey('c'), wantErr: true},
+		{name: "missing blind index key", currentKeyID: "k1", keys: map[string]string{"k1": testKey('a')}, wantErr: true},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewKeyring(tc.currentKeyID, tc.keys, tc.indexKey)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("cryptoutils.NewKeyring() want error: %v, got: %v", tc.wantErr, err)
+			}
+		})
+	}
+}
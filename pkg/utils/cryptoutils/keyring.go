@@ -0,0 +1,172 @@
+package cryptoutils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+)
+
+const keySize = 32
+
+var (
+	ErrKeyringNotConfigured = errors.New("encryption keyring is not configured")
+	ErrInvalidKey           = errors.New("encryption key must be 32 bytes encoded in base64")
+	ErrInvalidKeyID         = errors.New("encryption key id must only contain letters, digits, - or _")
+	ErrCurrentKeyNotFound   = errors.New("current encryption key is not found in keyring")
+	ErrKeyNotFound          = errors.New("encryption key is not found in keyring")
+	ErrEmptyBlindIndexKey   = errors.New("blind index key is required")
+)
+
+var keyIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// Keyring holds the key encryption keys by id, new values are always
+// encrypted with the current key while older keys are kept for decryption
+type Keyring struct {
+	currentKeyID  string
+	keys          map[string][]byte
+	blindIndexKey []byte
+}
+
+type keyFile struct {
+	CurrentKeyID  string            `json:"currentKeyID"`
+	Keys          map[string]string `json:"keys"`
+	BlindIndexKey string            `json:"blindIndexKey"`
+}
+
+// NewKeyring builds a keyring from base64 encoded keys
+func NewKeyring(currentKeyID string, keys map[string]string, blindIndexKey string) (*Keyring, error) {
+	k := &Keyring{
+		currentKeyID: currentKeyID,
+		keys:         map[string][]byte{},
+	}
+
+	for id, encoded := range keys {
+		if !keyIDPattern.MatchString(id) {
+			return nil, ErrInvalidKeyID
+		}
+
+		key, err := decodeKey(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", id, err)
+		}
+		k.keys[id] = key
+	}
+
+	if _, ok := k.keys[currentKeyID]; !ok {
+		return nil, ErrCurrentKeyNotFound
+	}
+
+	if blindIndexKey == "" {
+		return nil, ErrEmptyBlindIndexKey
+	}
+	indexKey, err := decodeKey(blindIndexKey)
+	if err != nil {
+		return nil, fmt.Errorf("blind index key: %w", err)
+	}
+	k.blindIndexKey = indexKey
+
+	return k, nil
+}
+
+// NewKeyringFromConfig builds the keyring from the local keyfile if any,
+// otherwise from the keys in config which are sourced from vault in deployed environments.
+// It returns nil without error if encryption is not configured.
+func NewKeyringFromConfig(cfg config.Encryption) (*Keyring, error) {
+	if cfg.KeyFile != "" {
+		return LoadKeyFile(cfg.KeyFile)
+	}
+
+	if cfg.Keys == "" {
+		return nil, nil
+	}
+
+	keys, err := ParseKeys(cfg.Keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewKeyring(cfg.CurrentKeyID, keys, cfg.BlindIndexKey)
+}
+
+// LoadKeyFile reads a json keyfile, it is meant for local development
+func LoadKeyFile(path string) (*Keyring, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var f keyFile
+	if err := json.Unmarshal(b, &f); err != nil {
+		return nil, err
+	}
+
+	return NewKeyring(f.CurrentKeyID, f.Keys, f.BlindIndexKey)
+}
+
+// ParseKeys parses keys in the format `id1:base64key,id2:base64key`
+func ParseKeys(s string) (map[string]string, error) {
+	keys := map[string]string{}
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		id, key, ok := strings.Cut(pair, ":")
+		if !ok || id == "" || key == "" {
+			return nil, ErrInvalidKey
+		}
+		keys[strings.TrimSpace(id)] = strings.TrimSpace(key)
+	}
+
+	return keys, nil
+}
+
+// CurrentKeyID returns the id of the key used for new values
+func (k *Keyring) CurrentKeyID() string {
+	return k.currentKeyID
+}
+
+func (k *Keyring) key(id string) ([]byte, error) {
+	key, ok := k.keys[id]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+	return key, nil
+}
+
+func decodeKey(s string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(s)
+	if err != nil || len(key) != keySize {
+		return nil, ErrInvalidKey
+	}
+	return key, nil
+}
+
+var (
+	defaultMu      sync.RWMutex
+	defaultKeyring *Keyring
+)
+
+// SetDefaultKeyring sets the keyring used by the gorm serializer
+func SetDefaultKeyring(k *Keyring) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+
+	defaultKeyring = k
+}
+
+// DefaultKeyring returns the keyring used by the gorm serializer, nil if encryption is disabled
+func DefaultKeyring() *Keyring {
+	defaultMu.RLock()
+	defer defaultMu.RUnlock()
+
+	return defaultKeyring
+}
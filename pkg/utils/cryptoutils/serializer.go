@@ -0,0 +1,109 @@
+package cryptoutils
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"gorm.io/gorm/schema"
+)
+
+// SerializerName is used in model tags, e.g. `gorm:"serializer:encrypted;blindindex"`
+const SerializerName = "encrypted"
+
+// blindIndexTag enables the blind index on a field
+const blindIndexTag = "BLINDINDEX"
+
+func init() {
+	schema.RegisterSerializer(SerializerName, EncryptedSerializer{})
+}
+
+// EncryptedSerializer encrypts string and *time.Time fields with the default keyring,
+// values are stored as is when encryption is disabled
+type EncryptedSerializer struct{}
+
+// Scan implements schema.SerializerInterface
+func (EncryptedSerializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue interface{}) error {
+	var raw string
+	switch v := dbValue.(type) {
+	case nil:
+	case string:
+		raw = v
+	case []byte:
+		raw = string(v)
+	case time.Time:
+		// columns which are not migrated to text yet
+		raw = v.Format(time.RFC3339)
+	default:
+		return fmt.Errorf("failed to scan %T into encrypted field %s", dbValue, field.Name)
+	}
+
+	plaintext := raw
+	if IsEncrypted(raw) {
+		k := DefaultKeyring()
+		if k == nil {
+			return ErrKeyringNotConfigured
+		}
+
+		var err error
+		plaintext, err = k.Decrypt(raw)
+		if err != nil {
+			return err
+		}
+	}
+
+	fieldValue := reflect.New(field.FieldType).Elem()
+	switch field.FieldType {
+	case reflect.TypeOf(""):
+		fieldValue.SetString(plaintext)
+	case reflect.TypeOf(&time.Time{}):
+		if plaintext != "" {
+			t, err := parseTime(plaintext)
+			if err != nil {
+				return err
+			}
+			fieldValue.Set(reflect.ValueOf(&t))
+		}
+	default:
+		return fmt.Errorf("unsupported type %s for encrypted field %s", field.FieldType, field.Name)
+	}
+
+	field.ReflectValueOf(ctx, dst).Set(fieldValue)
+	return nil
+}
+
+// Value implements schema.SerializerValuerInterface
+func (EncryptedSerializer) Value(ctx context.Context, field *schema.Field, dst reflect.Value, fieldValue interface{}) (interface{}, error) {
+	var plaintext string
+	switch v := fieldValue.(type) {
+	case string:
+		plaintext = v
+	case *time.Time:
+		if v == nil {
+			return nil, nil
+		}
+		plaintext = v.Format(time.RFC3339)
+	case nil:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %T for encrypted field %s", fieldValue, field.Name)
+	}
+
+	k := DefaultKeyring()
+	if plaintext == "" || k == nil {
+		return plaintext, nil
+	}
+
+	_, withIndex := field.TagSettings[blindIndexTag]
+	return k.Encrypt(plaintext, withIndex)
+}
+
+func parseTime(s string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("failed to parse encrypted time value")
+}
@@ -0,0 +1,71 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often idle buckets are removed from memory
+const sweepInterval = 10 * time.Minute
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+	rule      Rule
+}
+
+type memoryBackend struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewMemoryBackend returns a backend keeping buckets in memory,
+// limits are per instance when the api runs on multiple instances
+func NewMemoryBackend() Backend {
+	return &memoryBackend{
+		buckets:   map[string]*bucket{},
+		lastSweep: time.Now(),
+		now:       time.Now,
+	}
+}
+
+func (m *memoryBackend) Take(key string, rule Rule) (*Result, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	m.sweep(now)
+
+	b, ok := m.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(rule.Limit), updatedAt: now}
+		m.buckets[key] = b
+	}
+
+	b.rule = rule
+	b.tokens = refill(rule, b.tokens, now.Sub(b.updatedAt))
+	b.updatedAt = now
+
+	allowed := b.tokens >= 1
+	if allowed {
+		b.tokens--
+	}
+
+	return newResult(rule, b.tokens, allowed), nil
+}
+
+// sweep removes buckets which are full again, they behave the same as missing buckets
+func (m *memoryBackend) sweep(now time.Time) {
+	if now.Sub(m.lastSweep) < sweepInterval {
+		return
+	}
+	m.lastSweep = now
+
+	for key, b := range m.buckets {
+		if refill(b.rule, b.tokens, now.Sub(b.updatedAt)) >= float64(b.rule.Limit) {
+			delete(m.buckets, key)
+		}
+	}
+}
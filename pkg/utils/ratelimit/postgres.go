@@ -0,0 +1,53 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+// idleTTL is how long an unused bucket is kept in the shared table
+const idleTTL = 24 * time.Hour
+
+type postgresBackend struct {
+	store *store.Store
+	repo  store.DBRepo
+
+	mu        sync.Mutex
+	lastSweep time.Time
+}
+
+// NewPostgresBackend returns a backend sharing buckets between instances through postgres
+func NewPostgresBackend(s *store.Store, repo store.DBRepo) Backend {
+	return &postgresBackend{
+		store:     s,
+		repo:      repo,
+		lastSweep: time.Now(),
+	}
+}
+
+func (p *postgresBackend) Take(key string, rule Rule) (*Result, error) {
+	now := time.Now()
+	p.sweep(now)
+
+	b, err := p.store.RateLimitBucket.Take(p.repo.DB(), key, rule.Limit, rule.rate(), now)
+	if err != nil {
+		return nil, err
+	}
+
+	return newResult(rule, b.Tokens, b.Allowed), nil
+}
+
+func (p *postgresBackend) sweep(now time.Time) {
+	p.mu.Lock()
+	if now.Sub(p.lastSweep) < sweepInterval {
+		p.mu.Unlock()
+		return
+	}
+	p.lastSweep = now
+	p.mu.Unlock()
+
+	// best effort, stale buckets only cost storage
+	_ = p.store.RateLimitBucket.DeleteIdle(p.repo.DB(), now.Add(-idleTTL))
+}
@@ -0,0 +1,97 @@
+package ratelimit
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidRule = errors.New("rate limit rule must be in the format <limit>/<period>, e.g. 60/1m")
+
+// Rule allows Limit requests per Period, bursts up to Limit are allowed
+type Rule struct {
+	Limit  int
+	Period time.Duration
+}
+
+// ParseRule parses rules like `60/1m` or `1000/1h`, `off` disables the limit
+func ParseRule(s string) (*Rule, error) {
+	s = strings.TrimSpace(s)
+	if s == "off" || s == "0" {
+		return nil, nil
+	}
+
+	limit, period, ok := strings.Cut(s, "/")
+	if !ok {
+		return nil, ErrInvalidRule
+	}
+
+	l, err := strconv.Atoi(strings.TrimSpace(limit))
+	if err != nil || l <= 0 {
+		return nil, ErrInvalidRule
+	}
+
+	p, err := time.ParseDuration(strings.TrimSpace(period))
+	if err != nil || p <= 0 {
+		return nil, ErrInvalidRule
+	}
+
+	return &Rule{Limit: l, Period: p}, nil
+}
+
+// rate returns the number of tokens refilled per second
+func (r Rule) rate() float64 {
+	return float64(r.Limit) / r.Period.Seconds()
+}
+
+// Result is the state of a bucket after taking a token
+type Result struct {
+	Allowed bool
+	Limit   int
+	// Remaining is the number of requests which can be made right now
+	Remaining int
+	// ResetAfter is the time until the bucket is full again
+	ResetAfter time.Duration
+	// RetryAfter is the time until the next request is allowed, zero if allowed
+	RetryAfter time.Duration
+}
+
+// Backend stores token buckets
+type Backend interface {
+	// Take takes a token from the bucket of the key
+	Take(key string, rule Rule) (*Result, error)
+}
+
+// newResult builds the result from the tokens left in a bucket
+func newResult(rule Rule, tokens float64, allowed bool) *Result {
+	rate := rule.rate()
+	rs := &Result{
+		Allowed:    allowed,
+		Limit:      rule.Limit,
+		Remaining:  int(math.Max(0, math.Floor(tokens))),
+		ResetAfter: toDuration((float64(rule.Limit) - tokens) / rate),
+	}
+
+	if !allowed {
+		rs.RetryAfter = toDuration((1 - tokens) / rate)
+	}
+
+	return rs
+}
+
+// refill returns the tokens of a bucket after the elapsed time
+func refill(rule Rule, tokens float64, elapsed time.Duration) float64 {
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	return math.Min(float64(rule.Limit), tokens+elapsed.Seconds()*rule.rate())
+}
+
+func toDuration(seconds float64) time.Duration {
+	if seconds <= 0 {
+		return 0
+	}
+	return time.Duration(math.Ceil(seconds * float64(time.Second)))
+}
@@ -0,0 +1,78 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRule(t *testing.T) {
+	testcases := []struct {
+		name    string
+		input   string
+		want    *Rule
+		wantErr bool
+	}{
+		{name: "per minute", input: "60/1m", want: &Rule{Limit: 60, Period: time.Minute}},
+		{name: "with spaces", input: " 1000 / 1h ", want: &Rule{Limit: 1000, Period: time.Hour}},
+		{name: "off", input: "off", want: nil},
+		{name: "zero", input: "0", want: nil},
+		{name: "missing period", input: "60", wantErr: true},
+		{name: "negative limit", input: "-1/1m", wantErr: true},
+		{name: "invalid period", input: "60/minute", wantErr: true},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRule(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.want == nil {
+				if got != nil {
+					t.Errorf("ParseRule() = %v, want nil", got)
+				}
+				return
+			}
+			if got == nil || *got != *tt.want {
+				t.Errorf("ParseRule() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMemoryBackend_Take(t *testing.T) {
+	now := time.Date(2023, 7, 11, 0, 0, 0, 0, time.UTC)
+	backend := NewMemoryBackend().(*memoryBackend)
+	backend.now = func() time.Time { return now }
+
+	rule := Rule{Limit: 2, Period: time.Minute}
+
+	for i := 0; i < 2; i++ {
+		rs, err := backend.Take("ip:1", rule)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !rs.Allowed || rs.Remaining != 1-i {
+			t.Fatalf("request %d: got allowed %v remaining %d", i, rs.Allowed, rs.Remaining)
+		}
+	}
+
+	rs, _ := backend.Take("ip:1", rule)
+	if rs.Allowed {
+		t.Fatal("expected the third request to be limited")
+	}
+	if rs.RetryAfter != 30*time.Second {
+		t.Errorf("RetryAfter = %v, want 30s", rs.RetryAfter)
+	}
+
+	// other keys have their own bucket
+	if rs, _ := backend.Take("ip:2", rule); !rs.Allowed {
+		t.Error("expected another key to be allowed")
+	}
+
+	// one token is refilled every 30s
+	now = now.Add(30 * time.Second)
+	if rs, _ := backend.Take("ip:1", rule); !rs.Allowed {
+		t.Error("expected the request to be allowed after refill")
+	}
+}
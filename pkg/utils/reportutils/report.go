@@ -0,0 +1,153 @@
+// Package reportutils renders survey reports to CSV, XLSX and PDF files
+package reportutils
+
+import (
+	"bytes"
+	"encoding/csv"
+	"html/template"
+	"path/filepath"
+
+	toPdf "github.com/SebastiaanKlippert/go-wkhtmltopdf"
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	sheetName        = "Report"
+	pdfTemplateName  = "surveyReport.html"
+	defaultSheetName = "Sheet1"
+)
+
+// Header is the first row of CSV and XLSX reports
+var Header = []string{"Section", "Topic", "Reviewer", "Relationship", "Status", "Question", "Answer", "Note"}
+
+// Report is a survey, a topic or a review packet ready to be rendered
+type Report struct {
+	Title    string
+	Subtitle string
+	Sections []Section
+}
+
+// Section groups the reviews of a report, i.e. a topic or a relationship
+type Section struct {
+	Title   string
+	Reviews []Review
+}
+
+// Review is the answers of one reviewer
+type Review struct {
+	Topic        string
+	Reviewer     string
+	Relationship string
+	Status       string
+	Answers      []Answer
+}
+
+// Answer is the answer to one question
+type Answer struct {
+	Question string
+	Answer   string
+	Note     string
+}
+
+// Rows flattens the report to one row per answer, starting with the header
+func (r Report) Rows() [][]string {
+	rows := [][]string{Header}
+	for _, s := range r.Sections {
+		for _, rv := range s.Reviews {
+			for _, a := range rv.Answers {
+				rows = append(rows, []string{s.Title, rv.Topic, rv.Reviewer, rv.Relationship, rv.Status, a.Question, a.Answer, a.Note})
+			}
+		}
+	}
+
+	return rows
+}
+
+// ToCSV renders the report to a CSV file
+func ToCSV(r Report) ([]byte, error) {
+	var buf bytes.Buffer
+
+	w := csv.NewWriter(&buf)
+	if err := w.WriteAll(r.Rows()); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// ToXLSX renders the report to a spreadsheet with a single sheet
+func ToXLSX(r Report) ([]byte, error) {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	if err := f.SetSheetName(defaultSheetName, sheetName); err != nil {
+		return nil, err
+	}
+
+	for i, row := range r.Rows() {
+		cell, err := excelize.CoordinatesToCellName(1, i+1)
+		if err != nil {
+			return nil, err
+		}
+
+		values := make([]interface{}, len(row))
+		for j, v := range row {
+			values[j] = v
+		}
+		if err := f.SetSheetRow(sheetName, cell, &values); err != nil {
+			return nil, err
+		}
+	}
+
+	bold, err := f.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+	if err != nil {
+		return nil, err
+	}
+	if err := f.SetRowStyle(sheetName, 1, 1, bold); err != nil {
+		return nil, err
+	}
+
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// ToPDF renders the report with the surveyReport.html template of templatePath
+func ToPDF(r Report, templatePath string) ([]byte, error) {
+	tmpl, err := template.ParseFiles(filepath.Join(templatePath, pdfTemplateName))
+	if err != nil {
+		return nil, err
+	}
+
+	data := struct {
+		Path string
+		Report
+	}{
+		Path:   templatePath,
+		Report: r,
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, pdfTemplateName, data); err != nil {
+		return nil, err
+	}
+
+	pdfg, err := toPdf.NewPDFGenerator()
+	if err != nil {
+		return nil, err
+	}
+
+	t := toPdf.NewPageReader(&buf)
+	t.EnableLocalFileAccess.Set(true)
+	pdfg.AddPage(t)
+	pdfg.PageSize.Set("A4")
+
+	if err := pdfg.Create(); err != nil {
+		return nil, err
+	}
+
+	return pdfg.Buffer().Bytes(), nil
+}
@@ -0,0 +1,81 @@
+package reportutils
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/xuri/excelize/v2"
+)
+
+var testReport = Report{
+	Title: "Q2/2023 peer review",
+	Sections: []Section{
+		{
+			Title: "Self review",
+			Reviews: []Review{
+				{
+					Topic:        "Review of Nam",
+					Reviewer:     "Nam Nguyen",
+					Relationship: "self",
+					Status:       "done",
+					Answers: []Answer{
+						{Question: "Strengths", Answer: "Go, \"clean\" code", Note: ""},
+						{Question: "Growth", Answer: "Public speaking", Note: "talk at the next meetup"},
+					},
+				},
+			},
+		},
+	},
+}
+
+func TestReport_Rows(t *testing.T) {
+	want := [][]string{
+		Header,
+		{"Self review", "Review of Nam", "Nam Nguyen", "self", "done", "Strengths", "Go, \"clean\" code", ""},
+		{"Self review", "Review of Nam", "Nam Nguyen", "self", "done", "Growth", "Public speaking", "talk at the next meetup"},
+	}
+
+	if got := testReport.Rows(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Rows() = %v, want %v", got, want)
+	}
+}
+
+func TestToCSV(t *testing.T) {
+	got, err := ToCSV(testReport)
+	if err != nil {
+		t.Fatalf("ToCSV() error = %v", err)
+	}
+
+	want := "Section,Topic,Reviewer,Relationship,Status,Question,Answer,Note\n" +
+		"Self review,Review of Nam,Nam Nguyen,self,done,Strengths,\"Go, \"\"clean\"\" code\",\n" +
+		"Self review,Review of Nam,Nam Nguyen,self,done,Growth,Public speaking,talk at the next meetup\n"
+	if string(got) != want {
+		t.Errorf("ToCSV() = %q, want %q", got, want)
+	}
+}
+
+func TestToXLSX(t *testing.T) {
+	got, err := ToXLSX(testReport)
+	if err != nil {
+		t.Fatalf("ToXLSX() error = %v", err)
+	}
+
+	f, err := excelize.OpenReader(bytes.NewReader(got))
+	if err != nil {
+		t.Fatalf("failed to open spreadsheet: %v", err)
+	}
+	defer f.Close()
+
+	rows, err := f.GetRows(sheetName)
+	if err != nil {
+		t.Fatalf("failed to read rows: %v", err)
+	}
+
+	// trailing empty cells are not stored
+	want := testReport.Rows()
+	want[1] = want[1][:len(want[1])-1]
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("ToXLSX() rows = %v, want %v", rows, want)
+	}
+}
@@ -0,0 +1,141 @@
+// Package textutils analyzes free-text answers locally, answers never leave the server
+package textutils
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// negationWindow is the number of words a negation flips the sentiment of, i.e. "not really happy"
+const negationWindow = 3
+
+var positiveWords = toSet(
+	"good", "great", "happy", "enjoy", "enjoyed", "enjoying", "love", "loved", "liked",
+	"helpful", "help", "support", "supportive", "supported", "clear", "smooth", "learn", "learned", "learning",
+	"improve", "improved", "improving", "nice", "fun", "excellent", "awesome", "amazing", "productive",
+	"balanced", "fine", "well", "thanks", "thank", "appreciate", "appreciated", "motivated", "motivating",
+	"interesting", "comfortable", "proud", "growth", "friendly", "easy", "calm", "flexible", "efficient",
+	"better", "best", "satisfied", "positive", "stable", "on-track", "confident", "recognized",
+)
+
+var negativeWords = toSet(
+	"bad", "worse", "worst", "hard", "difficult", "problem", "problems", "issue", "issues", "bug", "bugs",
+	"overload", "overloaded", "overtime", "ot", "stress", "stressed", "stressful", "burnout", "burned", "burnt",
+	"exhausted", "tired", "pressure", "late", "delay", "delayed", "blocked", "blocker", "unclear", "confusing",
+	"confused", "rush", "rushed", "busy", "toxic", "frustrating", "frustrated", "boring", "bored", "slow",
+	"quit", "quitting", "unhappy", "sad", "angry", "annoying", "annoyed", "messy", "chaos", "chaotic",
+	"overwhelmed", "overwhelming", "unfair", "ignored", "lonely", "worried", "worry", "anxious", "negative",
+	"demotivated", "crunch", "underpaid", "micromanage",
+)
+
+var negations = toSet("not", "no", "never", "none", "nothing", "hardly", "barely", "without", "dont", "doesnt", "didnt", "isnt", "wasnt", "arent", "cant", "cannot", "wont")
+
+var stopWords = toSet(
+	"the", "and", "for", "are", "but", "not", "you", "all", "any", "can", "had", "her", "was", "one", "our",
+	"out", "has", "have", "his", "how", "its", "may", "new", "now", "see", "who", "did", "get", "got", "him",
+	"she", "too", "use", "this", "that", "with", "from", "they", "them", "then", "than", "there", "their",
+	"what", "when", "where", "which", "while", "will", "would", "could", "should", "about", "into", "just",
+	"more", "most", "some", "such", "only", "also", "very", "much", "been", "being", "were", "your", "yours",
+	"mine", "myself", "over", "under", "again", "other", "each", "same", "because", "does", "doing", "here",
+	"dont", "doesnt", "didnt", "isnt", "wasnt", "arent", "cant", "cannot", "wont", "really", "still", "even",
+	"like", "well", "lot", "lots", "thing", "things", "team", "project", "work", "working", "think",
+)
+
+func toSet(words ...string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, w := range words {
+		set[w] = true
+	}
+	return set
+}
+
+// Tokenize splits the text into lowercase words, apostrophes are dropped so "don't" becomes "dont"
+func Tokenize(text string) []string {
+	text = strings.NewReplacer("'", "", "’", "").Replace(strings.ToLower(text))
+
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r) && r != '-'
+	})
+}
+
+// Sentiment is the number of positive and negative words of a text
+type Sentiment struct {
+	Positive int
+	Negative int
+}
+
+// Score returns the sentiment from -1, only negative words, to 1, only positive words, 0 when there are none
+func (s Sentiment) Score() float64 {
+	if s.Positive+s.Negative == 0 {
+		return 0
+	}
+	return float64(s.Positive-s.Negative) / float64(s.Positive+s.Negative)
+}
+
+// AnalyzeSentiment scores the text with the word lists, a negation flips the words following it
+func AnalyzeSentiment(text string) Sentiment {
+	var s Sentiment
+
+	negated := 0
+	for _, w := range Tokenize(text) {
+		if negations[w] {
+			negated = negationWindow
+			continue
+		}
+
+		positive, negative := positiveWords[w], negativeWords[w]
+		if negated > 0 {
+			positive, negative = negative, positive
+			negated--
+		}
+
+		if positive {
+			s.Positive++
+		}
+		if negative {
+			s.Negative++
+		}
+	}
+
+	return s
+}
+
+// Keyword is a word and the number of texts it appears in
+type Keyword struct {
+	Word  string
+	Count int
+}
+
+// ExtractKeywords returns the most frequent words of the texts, each text counts a word once
+func ExtractKeywords(texts []string, limit int) []Keyword {
+	counts := map[string]int{}
+	for _, text := range texts {
+		seen := map[string]bool{}
+		for _, w := range Tokenize(text) {
+			if len([]rune(w)) < 3 || stopWords[w] || seen[w] {
+				continue
+			}
+			seen[w] = true
+			counts[w]++
+		}
+	}
+
+	keywords := make([]Keyword, 0, len(counts))
+	for w, c := range counts {
+		keywords = append(keywords, Keyword{Word: w, Count: c})
+	}
+
+	sort.Slice(keywords, func(i, j int) bool {
+		if keywords[i].Count != keywords[j].Count {
+			return keywords[i].Count > keywords[j].Count
+		}
+		return keywords[i].Word < keywords[j].Word
+	})
+
+	if limit > 0 && len(keywords) > limit {
+		keywords = keywords[:limit]
+	}
+
+	return keywords
+}
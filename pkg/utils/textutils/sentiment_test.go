@@ -0,0 +1,73 @@
+package textutils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAnalyzeSentiment(t *testing.T) {
+	testcases := []struct {
+		name string
+		text string
+		want Sentiment
+	}{
+		{
+			name: "positive",
+			text: "Great team, I learned a lot and enjoyed it",
+			want: Sentiment{Positive: 3},
+		},
+		{
+			name: "negative",
+			text: "Too much overtime, I'm exhausted and stressed",
+			want: Sentiment{Negative: 3},
+		},
+		{
+			name: "negation flips the following words",
+			text: "I'm not happy with the workload",
+			want: Sentiment{Negative: 1},
+		},
+		{
+			name: "apostrophes are dropped",
+			text: "Don't feel supported",
+			want: Sentiment{Negative: 1},
+		},
+		{
+			name: "neutral",
+			text: "Nothing to report",
+			want: Sentiment{},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := AnalyzeSentiment(tc.text); got != tc.want {
+				t.Errorf("AnalyzeSentiment() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSentiment_Score(t *testing.T) {
+	if got := (Sentiment{Positive: 3, Negative: 1}).Score(); got != 0.5 {
+		t.Errorf("Score() = %v, want 0.5", got)
+	}
+	if got := (Sentiment{}).Score(); got != 0 {
+		t.Errorf("Score() = %v, want 0", got)
+	}
+}
+
+func TestExtractKeywords(t *testing.T) {
+	texts := []string{
+		"Overtime again, overtime every sprint",
+		"The deadline forces overtime",
+		"Deadline was fine",
+	}
+
+	want := []Keyword{
+		{Word: "deadline", Count: 2},
+		{Word: "overtime", Count: 2},
+	}
+	if got := ExtractKeywords(texts, 2); !reflect.DeepEqual(got, want) {
+		t.Errorf("ExtractKeywords() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,136 @@
+package view
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type ProjectMemberAllocation struct {
+	ID              string     `json:"id"`
+	ProjectMemberID string     `json:"projectMemberID"`
+	Percentage      float64    `json:"percentage"`
+	StartDate       time.Time  `json:"startDate"`
+	EndDate         *time.Time `json:"endDate"`
+	Note            string     `json:"note"`
+}
+
+func ToProjectMemberAllocation(a *model.ProjectMemberAllocation) ProjectMemberAllocation {
+	return ProjectMemberAllocation{
+		ID:              a.ID.String(),
+		ProjectMemberID: a.ProjectMemberID.String(),
+		Percentage:      a.Percentage,
+		StartDate:       a.StartDate,
+		EndDate:         a.EndDate,
+		Note:            a.Note,
+	}
+}
+
+func ToProjectMemberAllocations(allocations []*model.ProjectMemberAllocation) []ProjectMemberAllocation {
+	rs := make([]ProjectMemberAllocation, 0, len(allocations))
+	for _, a := range allocations {
+		rs = append(rs, ToProjectMemberAllocation(a))
+	}
+
+	return rs
+}
+
+type CapacityPlan struct {
+	Weeks     []time.Time        `json:"weeks"`
+	Employees []EmployeeCapacity `json:"employees"`
+	FreeingUp []FreeingUpMember  `json:"freeingUp"`
+}
+
+type EmployeeCapacity struct {
+	Employee        *BasicEmployeeInfo   `json:"employee"`
+	IsOverAllocated bool                 `json:"isOverAllocated"`
+	Weeks           []CapacityWeek       `json:"weeks"`
+	Projects        []CapacityMembership `json:"projects"`
+}
+
+type CapacityWeek struct {
+	WeekStart       time.Time `json:"weekStart"`
+	Allocation      float64   `json:"allocation"`
+	IsOverAllocated bool      `json:"isOverAllocated"`
+}
+
+type CapacityMembership struct {
+	ProjectMemberID string                    `json:"projectMemberID"`
+	Project         *BasicProjectInfo         `json:"project"`
+	DeploymentType  string                    `json:"deploymentType"`
+	StartDate       *time.Time                `json:"startDate"`
+	EndDate         *time.Time                `json:"endDate"`
+	Allocations     []ProjectMemberAllocation `json:"allocations"`
+}
+
+type FreeingUpMember struct {
+	ProjectMemberID string             `json:"projectMemberID"`
+	Employee        *BasicEmployeeInfo `json:"employee"`
+	Project         *BasicProjectInfo  `json:"project"`
+	EndDate         *time.Time         `json:"endDate"`
+	Allocation      float64            `json:"allocation"`
+	Remaining       float64            `json:"remaining"`
+}
+
+func ToCapacityPlan(weeks []time.Time, employees []*model.EmployeeCapacity, freeingUp []*model.FreeingUpMember) CapacityPlan {
+	rs := CapacityPlan{
+		Weeks:     weeks,
+		Employees: make([]EmployeeCapacity, 0, len(employees)),
+		FreeingUp: make([]FreeingUpMember, 0, len(freeingUp)),
+	}
+
+	for _, e := range employees {
+		c := EmployeeCapacity{
+			Employee:        toBasicEmployeeInfo(*e.Employee),
+			IsOverAllocated: e.IsOverAllocated(),
+			Weeks:           make([]CapacityWeek, 0, len(e.Weeks)),
+			Projects:        make([]CapacityMembership, 0, len(e.Members)),
+		}
+
+		for _, w := range e.Weeks {
+			c.Weeks = append(c.Weeks, CapacityWeek{
+				WeekStart:       w.WeekStart,
+				Allocation:      w.Allocation,
+				IsOverAllocated: w.IsOverAllocated(),
+			})
+		}
+
+		for _, m := range e.Members {
+			c.Projects = append(c.Projects, CapacityMembership{
+				ProjectMemberID: m.ID.String(),
+				Project:         toBasicProjectInfo(m.Project),
+				DeploymentType:  m.DeploymentType.String(),
+				StartDate:       m.StartDate,
+				EndDate:         m.EndDate,
+				Allocations:     ToProjectMemberAllocations(m.Allocations),
+			})
+		}
+
+		rs.Employees = append(rs.Employees, c)
+	}
+
+	for _, f := range freeingUp {
+		rs.FreeingUp = append(rs.FreeingUp, FreeingUpMember{
+			ProjectMemberID: f.Member.ID.String(),
+			Employee:        toBasicEmployeeInfo(*f.Employee),
+			Project:         toBasicProjectInfo(f.Member.Project),
+			EndDate:         f.Member.EndDate,
+			Allocation:      f.Allocation,
+			Remaining:       f.Remaining,
+		})
+	}
+
+	return rs
+}
+
+type ProjectMemberAllocationResponse struct {
+	Data ProjectMemberAllocation `json:"data"`
+}
+
+type ListProjectMemberAllocationResponse struct {
+	Data []ProjectMemberAllocation `json:"data"`
+}
+
+type CapacityPlanResponse struct {
+	Data CapacityPlan `json:"data"`
+}
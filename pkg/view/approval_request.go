@@ -0,0 +1,76 @@
+package view
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type ApprovalRequest struct {
+	ID           string             `json:"id"`
+	CreatedAt    time.Time          `json:"createdAt"`
+	Action       string             `json:"action"`
+	Status       string             `json:"status"`
+	Permission   string             `json:"permission"`
+	Summary      string             `json:"summary"`
+	Payload      model.JSON         `json:"payload"`
+	ExpiredAt    time.Time          `json:"expiredAt"`
+	ReviewedAt   *time.Time         `json:"reviewedAt"`
+	ExecutedAt   *time.Time         `json:"executedAt"`
+	RejectReason string             `json:"rejectReason"`
+	ErrorMessage string             `json:"errorMessage"`
+	Requester    *BasicEmployeeInfo `json:"requester"`
+	// RequesterAPIKey is the client id of the api key which requested the approval, when it was not an employee
+	RequesterAPIKey string             `json:"requesterAPIKey"`
+	Approver        *BasicEmployeeInfo `json:"approver"`
+}
+
+func ToApprovalRequest(request *model.ApprovalRequest) *ApprovalRequest {
+	if request == nil {
+		return nil
+	}
+
+	rs := &ApprovalRequest{
+		ID:           request.ID.String(),
+		CreatedAt:    request.CreatedAt,
+		Action:       request.Action.String(),
+		Status:       request.Status.String(),
+		Permission:   request.Permission,
+		Summary:      request.Summary,
+		Payload:      request.Payload,
+		ExpiredAt:    request.ExpiredAt,
+		ReviewedAt:   request.ReviewedAt,
+		ExecutedAt:   request.ExecutedAt,
+		RejectReason: request.RejectReason,
+		ErrorMessage: request.ErrorMessage,
+	}
+
+	if request.Requester != nil {
+		rs.Requester = toBasicEmployeeInfo(*request.Requester)
+	}
+	if request.RequesterAPIKey != nil {
+		rs.RequesterAPIKey = request.RequesterAPIKey.ClientID
+	}
+	if request.Approver != nil {
+		rs.Approver = toBasicEmployeeInfo(*request.Approver)
+	}
+
+	return rs
+}
+
+func ToApprovalRequests(requests []*model.ApprovalRequest) []ApprovalRequest {
+	rs := make([]ApprovalRequest, 0, len(requests))
+	for _, r := range requests {
+		rs = append(rs, *ToApprovalRequest(r))
+	}
+
+	return rs
+}
+
+type ApprovalRequestResponse struct {
+	Data ApprovalRequest `json:"data"`
+}
+
+type ListApprovalRequestResponse struct {
+	Data []ApprovalRequest `json:"data"`
+}
@@ -0,0 +1,54 @@
+package view
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type AuditLog struct {
+	ID           string     `json:"id"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	ActorType    string     `json:"actorType"`
+	ActorID      string     `json:"actorID"`
+	Action       string     `json:"action"`
+	ResourceType string     `json:"resourceType"`
+	ResourceID   string     `json:"resourceID"`
+	Method       string     `json:"method"`
+	Path         string     `json:"path"`
+	StatusCode   int        `json:"statusCode"`
+	Before       model.JSON `json:"before"`
+	After        model.JSON `json:"after"`
+	Diff         model.JSON `json:"diff"`
+	RequestID    string     `json:"requestID"`
+	IPAddress    string     `json:"ipAddress"`
+}
+
+func ToAuditLogs(logs []*model.AuditLog) []AuditLog {
+	rs := make([]AuditLog, 0, len(logs))
+	for _, l := range logs {
+		rs = append(rs, AuditLog{
+			ID:           l.ID.String(),
+			CreatedAt:    l.CreatedAt,
+			ActorType:    l.ActorType.String(),
+			ActorID:      l.ActorID,
+			Action:       l.Action.String(),
+			ResourceType: l.ResourceType,
+			ResourceID:   l.ResourceID,
+			Method:       l.Method,
+			Path:         l.Path,
+			StatusCode:   l.StatusCode,
+			Before:       l.Before,
+			After:        l.After,
+			Diff:         l.Diff,
+			RequestID:    l.RequestID,
+			IPAddress:    l.IPAddress,
+		})
+	}
+
+	return rs
+}
+
+type ListAuditLogResponse struct {
+	Data []AuditLog `json:"data"`
+}
@@ -1,6 +1,8 @@
 package view
 
 import (
+	"time"
+
 	"github.com/dwarvesf/fortress-api/pkg/model"
 )
 
@@ -54,3 +56,42 @@ type AuthUserResponse struct {
 type APIKeyResponse struct {
 	Data APIKeyData `json:"data"`
 }
+
+type EmployeeIdentity struct {
+	ID          string     `json:"id"`
+	Provider    string     `json:"provider"`
+	Email       string     `json:"email"`
+	LastLoginAt *time.Time `json:"lastLoginAt"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
+func ToEmployeeIdentity(identity *model.EmployeeIdentity) *EmployeeIdentity {
+	return &EmployeeIdentity{
+		ID:          identity.ID.String(),
+		Provider:    identity.Provider,
+		Email:       identity.Email,
+		LastLoginAt: identity.LastLoginAt,
+		CreatedAt:   identity.CreatedAt,
+	}
+}
+
+func ToEmployeeIdentities(identities []*model.EmployeeIdentity) []*EmployeeIdentity {
+	rs := make([]*EmployeeIdentity, 0, len(identities))
+	for _, identity := range identities {
+		rs = append(rs, ToEmployeeIdentity(identity))
+	}
+
+	return rs
+}
+
+type EmployeeIdentityResponse struct {
+	Data EmployeeIdentity `json:"data"`
+}
+
+type EmployeeIdentityListResponse struct {
+	Data []EmployeeIdentity `json:"data"`
+}
+
+type AuthProvidersResponse struct {
+	Data []string `json:"data"`
+}
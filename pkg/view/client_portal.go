@@ -0,0 +1,226 @@
+package view
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type ClientPortalAccess struct {
+	ID          string     `json:"id"`
+	ContactID   string     `json:"contactID"`
+	Email       string     `json:"email"`
+	Status      string     `json:"status"`
+	LastLoginAt *time.Time `json:"lastLoginAt"`
+	RevokedAt   *time.Time `json:"revokedAt"`
+}
+
+type ClientPortalLogin struct {
+	AccessToken string `json:"accessToken"`
+}
+
+type ClientPortalMe struct {
+	ContactID  string `json:"contactID"`
+	Name       string `json:"name"`
+	Role       string `json:"role"`
+	Email      string `json:"email"`
+	ClientID   string `json:"clientID"`
+	ClientName string `json:"clientName"`
+}
+
+type ClientPortalProject struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	Code      string     `json:"code"`
+	Type      string     `json:"type"`
+	Status    string     `json:"status"`
+	Avatar    string     `json:"avatar"`
+	StartDate *time.Time `json:"startDate"`
+	EndDate   *time.Time `json:"endDate"`
+}
+
+type ClientPortalProjectDetail struct {
+	ClientPortalProject
+	Members    []ClientPortalMember    `json:"members"`
+	Milestones []ClientPortalMilestone `json:"milestones"`
+}
+
+// ClientPortalMember only has the public fields of a project member
+type ClientPortalMember struct {
+	DisplayName string   `json:"displayName"`
+	Avatar      string   `json:"avatar"`
+	Positions   []string `json:"positions"`
+	IsLead      bool     `json:"isLead"`
+}
+
+type ClientPortalMilestone struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	StartDate time.Time  `json:"startDate"`
+	EndDate   *time.Time `json:"endDate"`
+	Status    string     `json:"status"`
+}
+
+type ClientPortalInvoice struct {
+	ID             string     `json:"id"`
+	Number         string     `json:"number"`
+	Month          int        `json:"month"`
+	Year           int        `json:"year"`
+	InvoicedAt     *time.Time `json:"invoicedAt"`
+	DueAt          *time.Time `json:"dueAt"`
+	PaidAt         *time.Time `json:"paidAt"`
+	Status         string     `json:"status"`
+	Total          float64    `json:"total"`
+	Currency       string     `json:"currency"`
+	InvoiceFileURL string     `json:"invoiceFileURL"`
+}
+
+type ClientPortalChangelog struct {
+	ID      string    `json:"id"`
+	Title   string    `json:"title"`
+	Content string    `json:"content"`
+	SentAt  time.Time `json:"sentAt"`
+}
+
+func ToClientPortalAccess(access *model.ClientPortalAccess) *ClientPortalAccess {
+	return &ClientPortalAccess{
+		ID:          access.ID.String(),
+		ContactID:   access.ClientContactID.String(),
+		Email:       access.Email,
+		Status:      access.Status.String(),
+		LastLoginAt: access.LastLoginAt,
+		RevokedAt:   access.RevokedAt,
+	}
+}
+
+func ToClientPortalMe(access *model.ClientPortalAccess, client *model.Client, contact *model.ClientContact) *ClientPortalMe {
+	return &ClientPortalMe{
+		ContactID:  contact.ID.String(),
+		Name:       contact.Name,
+		Role:       contact.Role,
+		Email:      access.Email,
+		ClientID:   client.ID.String(),
+		ClientName: client.Name,
+	}
+}
+
+func ToClientPortalProjects(projects []*model.Project) []ClientPortalProject {
+	rs := make([]ClientPortalProject, 0, len(projects))
+	for _, p := range projects {
+		rs = append(rs, toClientPortalProject(p))
+	}
+
+	return rs
+}
+
+func ToClientPortalProjectDetail(project *model.Project, members []*model.ProjectMember, milestones []*model.ProjectBudgetPhase, now time.Time) *ClientPortalProjectDetail {
+	rs := &ClientPortalProjectDetail{
+		ClientPortalProject: toClientPortalProject(project),
+		Members:             make([]ClientPortalMember, 0, len(members)),
+		Milestones:          make([]ClientPortalMilestone, 0, len(milestones)),
+	}
+
+	for _, m := range members {
+		member := ClientPortalMember{
+			DisplayName: m.Employee.DisplayName,
+			Avatar:      m.Employee.Avatar,
+			Positions:   make([]string, 0, len(m.ProjectMemberPositions)),
+			IsLead:      m.IsLead,
+		}
+		for _, p := range m.ProjectMemberPositions {
+			member.Positions = append(member.Positions, p.Position.Name)
+		}
+		rs.Members = append(rs.Members, member)
+	}
+
+	for _, p := range milestones {
+		rs.Milestones = append(rs.Milestones, ClientPortalMilestone{
+			ID:        p.ID.String(),
+			Name:      p.Name,
+			StartDate: p.StartDate,
+			EndDate:   p.EndDate,
+			Status:    p.MilestoneStatus(now).String(),
+		})
+	}
+
+	return rs
+}
+
+func ToClientPortalInvoices(invoices []*model.Invoice) []ClientPortalInvoice {
+	rs := make([]ClientPortalInvoice, 0, len(invoices))
+	for _, iv := range invoices {
+		invoice := ClientPortalInvoice{
+			ID:             iv.ID.String(),
+			Number:         iv.Number,
+			Month:          iv.Month,
+			Year:           iv.Year,
+			InvoicedAt:     iv.InvoicedAt,
+			DueAt:          iv.DueAt,
+			PaidAt:         iv.PaidAt,
+			Status:         iv.Status.String(),
+			Total:          iv.Total,
+			InvoiceFileURL: iv.InvoiceFileURL,
+		}
+		if iv.Bank != nil && iv.Bank.Currency != nil {
+			invoice.Currency = iv.Bank.Currency.Name
+		}
+		rs = append(rs, invoice)
+	}
+
+	return rs
+}
+
+func ToClientPortalChangelogs(changelogs []*model.ProjectChangelog) []ClientPortalChangelog {
+	rs := make([]ClientPortalChangelog, 0, len(changelogs))
+	for _, c := range changelogs {
+		rs = append(rs, ClientPortalChangelog{
+			ID:      c.ID.String(),
+			Title:   c.Title,
+			Content: c.Content,
+			SentAt:  c.SentAt,
+		})
+	}
+
+	return rs
+}
+
+func toClientPortalProject(p *model.Project) ClientPortalProject {
+	return ClientPortalProject{
+		ID:        p.ID.String(),
+		Name:      p.Name,
+		Code:      p.Code,
+		Type:      p.Type.String(),
+		Status:    p.Status.String(),
+		Avatar:    p.Avatar,
+		StartDate: p.StartDate,
+		EndDate:   p.EndDate,
+	}
+}
+
+type ClientPortalAccessResponse struct {
+	Data ClientPortalAccess `json:"data"`
+}
+
+type ClientPortalLoginResponse struct {
+	Data ClientPortalLogin `json:"data"`
+}
+
+type ClientPortalMeResponse struct {
+	Data ClientPortalMe `json:"data"`
+}
+
+type ClientPortalProjectsResponse struct {
+	Data []ClientPortalProject `json:"data"`
+}
+
+type ClientPortalProjectDetailResponse struct {
+	Data ClientPortalProjectDetail `json:"data"`
+}
+
+type ClientPortalInvoicesResponse struct {
+	Data []ClientPortalInvoice `json:"data"`
+}
+
+type ClientPortalChangelogsResponse struct {
+	Data []ClientPortalChangelog `json:"data"`
+}
@@ -0,0 +1,70 @@
+package view
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// ContinuousFeedback is a kudos or a comment an employee sent to a colleague outside of surveys
+type ContinuousFeedback struct {
+	EventID    string             `json:"eventID"`
+	TopicID    string             `json:"topicID"`
+	Subtype    string             `json:"subtype"`
+	Visibility string             `json:"visibility"`
+	Title      string             `json:"title"`
+	Message    string             `json:"message"`
+	IsRead     bool               `json:"isRead"`
+	CreatedAt  *time.Time         `json:"createdAt"`
+	Author     *BasicEmployeeInfo `json:"author"`
+	Recipient  *BasicEmployeeInfo `json:"recipient"`
+	Project    *BasicProjectInfo  `json:"project"`
+}
+
+type ContinuousFeedbackResponse struct {
+	Data ContinuousFeedback `json:"data"`
+}
+
+type ListContinuousFeedbackResponse struct {
+	Data []ContinuousFeedback `json:"data"`
+}
+
+// ToContinuousFeedback converts a feedback topic to ContinuousFeedback,
+// the message is the answer of the only question of the sender
+func ToContinuousFeedback(topic *model.EmployeeEventTopic) ContinuousFeedback {
+	feedback := ContinuousFeedback{
+		EventID:    topic.EventID.String(),
+		TopicID:    topic.ID.String(),
+		Subtype:    topic.Event.Subtype.String(),
+		Visibility: topic.Event.Visibility.String(),
+		Title:      topic.Title,
+		CreatedAt:  &topic.CreatedAt,
+		Author:     toBasicEmployeeInfo(topic.Event.Employee),
+	}
+
+	if topic.Employee != nil {
+		feedback.Recipient = toBasicEmployeeInfo(*topic.Employee)
+	}
+	if topic.Project != nil {
+		feedback.Project = toBasicProjectInfo(*topic.Project)
+	}
+
+	if len(topic.EmployeeEventReviewers) > 0 {
+		reviewer := topic.EmployeeEventReviewers[0]
+		feedback.IsRead = reviewer.IsRead
+		if len(reviewer.EmployeeEventQuestions) > 0 {
+			feedback.Message = reviewer.EmployeeEventQuestions[0].Answer
+		}
+	}
+
+	return feedback
+}
+
+func ToListContinuousFeedback(topics []*model.EmployeeEventTopic) []ContinuousFeedback {
+	results := make([]ContinuousFeedback, 0, len(topics))
+	for _, t := range topics {
+		results = append(results, ToContinuousFeedback(t))
+	}
+
+	return results
+}
@@ -14,6 +14,8 @@ type EngagementDashboardQuestionStat struct {
 	Title     string     `json:"title"`
 	StartDate *time.Time `json:"startDate"`
 	Point     float64    `json:"point"`
+	// IsHidden is true when there are not enough respondents to show the point of an anonymous survey
+	IsHidden bool `json:"isHidden"`
 }
 type EngagementDashboard struct {
 	Content    string                            `json:"content"`
@@ -25,6 +27,8 @@ type EngagementDashboardQuestionDetailStat struct {
 	Field     string     `json:"field"`
 	StartDate *time.Time `json:"startDate"`
 	Point     float64    `json:"point"`
+	// IsHidden is true when there are not enough respondents to show the point of an anonymous survey
+	IsHidden bool `json:"isHidden"`
 }
 
 type EngagementDashboardDetail struct {
@@ -669,7 +673,8 @@ func ToEngagementDashboard(statistic []*model.StatisticEngagementDashboard) []En
 		questionMapper[s.Content] = append(questionMapper[s.Content], EngagementDashboardQuestionStat{
 			Title:     strings.Replace(s.Title, ", ", "/", -1),
 			StartDate: &s.StartDate,
-			Point:     math.Floor(s.Point*100) / 100,
+			Point:     engagementPoint(s),
+			IsHidden:  s.IsHidden(),
 		})
 		questionIDMapper[s.Content] = s.QuestionID.String()
 	}
@@ -699,7 +704,8 @@ func ToEngagementDashboardDetails(statistic []*model.StatisticEngagementDashboar
 		questionMapper[s.QuestionID.String()] = append(questionMapper[s.QuestionID.String()], EngagementDashboardQuestionDetailStat{
 			Field:     s.Name,
 			StartDate: &s.StartDate,
-			Point:     math.Floor(s.Point*100) / 100,
+			Point:     engagementPoint(s),
+			IsHidden:  s.IsHidden(),
 		})
 	}
 
@@ -722,6 +728,14 @@ func ToEngagementDashboardDetails(statistic []*model.StatisticEngagementDashboar
 	return dashboard
 }
 
+// engagementPoint hides the point of groups smaller than the anonymity threshold
+func engagementPoint(s *model.StatisticEngagementDashboard) float64 {
+	if s.IsHidden() {
+		return 0
+	}
+	return math.Floor(s.Point*100) / 100
+}
+
 type GetEngagementDashboardResponse struct {
 	Data []EngagementDashboard `json:"data"`
 }
@@ -71,6 +71,8 @@ type QuestionAnswer struct {
 	Type            string               `json:"type"`
 	Order           int64                `json:"order"`
 	Domain          model.QuestionDomain `json:"domain"`
+	// TemplateQuestion holds the options, limits and conditions of questions from a survey template
+	TemplateQuestion *SurveyTemplateQuestion `json:"templateQuestion,omitempty"`
 }
 
 type FeedBackReviewDetail struct {
@@ -92,6 +94,8 @@ type FeedbackDetail struct {
 	Title        string            `json:"title"`
 	Relationship string            `json:"relationship"`
 	Project      *BasicProjectInfo `json:"project"`
+	// Goals are the individual goals of the reviewee of a peer-review
+	Goals []Objective `json:"goals,omitempty"`
 }
 
 type FeedbackDetailResponse struct {
@@ -107,6 +111,7 @@ type FeedbackDetailInfo struct {
 	Title        string
 	Relationship model.Relationship
 	Project      *model.Project
+	Goals        []*model.Objective
 }
 
 func ToListFeedbackDetails(questions []*model.EmployeeEventQuestion, detailInfo FeedbackDetailInfo) FeedbackDetail {
@@ -125,6 +130,8 @@ func ToListFeedbackDetails(questions []*model.EmployeeEventQuestion, detailInfo
 			Type:            q.Type,
 			Order:           q.Order,
 			Domain:          q.Domain,
+
+			TemplateQuestion: ToSurveyTemplateQuestion(q.TemplateQuestion),
 		})
 	}
 
@@ -141,6 +148,10 @@ func ToListFeedbackDetails(questions []*model.EmployeeEventQuestion, detailInfo
 	rs.Title = detailInfo.Title
 	rs.Relationship = detailInfo.Relationship.String()
 
+	if len(detailInfo.Goals) > 0 {
+		rs.Goals = ToObjectives(detailInfo.Goals)
+	}
+
 	return rs
 }
 
@@ -174,6 +185,8 @@ func ToListSubmitFeedback(questions []*model.EmployeeEventQuestion, detailInfo F
 			Type:            q.Type,
 			Order:           q.Order,
 			Domain:          q.Domain,
+
+			TemplateQuestion: ToSurveyTemplateQuestion(q.TemplateQuestion),
 		})
 	}
 
@@ -210,6 +223,8 @@ func ToFeedbackReviewDetail(questions []*model.EmployeeEventQuestion, topic *mod
 			Type:            q.Type,
 			Order:           q.Order,
 			Domain:          q.Domain,
+
+			TemplateQuestion: ToSurveyTemplateQuestion(q.TemplateQuestion),
 		})
 	}
 
@@ -105,6 +105,8 @@ type ProjectInvoiceTemplate struct {
 	Client        ClientInfo  `json:"client"`
 	BankAccount   BankAccount `json:"bankAccount"`
 	CompanyInfo   CompanyInfo `json:"companyInfo"`
+	// TimesheetItems are the approved hours of the members of a time-and-material project in the invoiced period
+	TimesheetItems []InvoiceItem `json:"timesheetItems,omitempty"`
 }
 
 type InvoiceTemplateResponse struct {
@@ -167,6 +169,22 @@ func ToInvoiceInfo(invoice *model.Invoice) (*Invoice, error) {
 	return nil, nil
 }
 
+func ToTimesheetInvoiceItems(lineItems []model.InvoiceItem) []InvoiceItem {
+	items := make([]InvoiceItem, 0, len(lineItems))
+	for _, item := range lineItems {
+		items = append(items, InvoiceItem{
+			Quantity:    item.Quantity,
+			UnitCost:    item.UnitCost,
+			Discount:    item.Discount,
+			Cost:        item.Cost,
+			Description: item.Description,
+			IsExternal:  item.IsExternal,
+		})
+	}
+
+	return items
+}
+
 func ToInvoiceTemplateResponse(p *model.Project, lastInvoice *model.Invoice, nextInvoiceNUmber string) (*ProjectInvoiceTemplate, error) {
 	companyInfo := CompanyInfo{}
 	if p.CompanyInfo != nil {
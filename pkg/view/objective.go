@@ -0,0 +1,169 @@
+package view
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type Objective struct {
+	ID                  string             `json:"id"`
+	CreatedAt           time.Time          `json:"createdAt"`
+	Title               string             `json:"title"`
+	Description         string             `json:"description"`
+	Level               string             `json:"level"`
+	Status              string             `json:"status"`
+	ParentID            string             `json:"parentID"`
+	StartDate           time.Time          `json:"startDate"`
+	EndDate             time.Time          `json:"endDate"`
+	CheckInIntervalDays int                `json:"checkInIntervalDays"`
+	Progress            float64            `json:"progress"`
+	Owner               *BasicEmployeeInfo `json:"owner"`
+	Employee            *BasicEmployeeInfo `json:"employee"`
+	Project             *BasicProjectInfo  `json:"project"`
+	Chapter             *Chapter           `json:"chapter"`
+	KeyResults          []KeyResult        `json:"keyResults"`
+}
+
+type KeyResult struct {
+	ID            string             `json:"id"`
+	ObjectiveID   string             `json:"objectiveID"`
+	Title         string             `json:"title"`
+	Unit          string             `json:"unit"`
+	StartValue    float64            `json:"startValue"`
+	TargetValue   float64            `json:"targetValue"`
+	CurrentValue  float64            `json:"currentValue"`
+	Progress      float64            `json:"progress"`
+	LastCheckInAt *time.Time         `json:"lastCheckInAt"`
+	Owner         *BasicEmployeeInfo `json:"owner"`
+}
+
+type KeyResultCheckIn struct {
+	ID          string             `json:"id"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	KeyResultID string             `json:"keyResultID"`
+	Value       float64            `json:"value"`
+	Progress    float64            `json:"progress"`
+	Confidence  int                `json:"confidence"`
+	Note        string             `json:"note"`
+	Employee    *BasicEmployeeInfo `json:"employee"`
+}
+
+func ToObjective(o *model.Objective) Objective {
+	rs := Objective{
+		ID:                  o.ID.String(),
+		CreatedAt:           o.CreatedAt,
+		Title:               o.Title,
+		Description:         o.Description,
+		Level:               o.Level.String(),
+		Status:              o.Status.String(),
+		ParentID:            o.ParentID.String(),
+		StartDate:           o.StartDate,
+		EndDate:             o.EndDate,
+		CheckInIntervalDays: o.CheckInIntervalDays,
+		Progress:            o.Progress,
+		KeyResults:          make([]KeyResult, 0, len(o.KeyResults)),
+	}
+
+	if o.Owner != nil {
+		rs.Owner = toBasicEmployeeInfo(*o.Owner)
+	}
+	if o.Employee != nil {
+		rs.Employee = toBasicEmployeeInfo(*o.Employee)
+	}
+	if o.Project != nil {
+		rs.Project = toBasicProjectInfo(*o.Project)
+	}
+	if o.Chapter != nil {
+		rs.Chapter = &Chapter{
+			ID:   o.Chapter.ID.String(),
+			Code: o.Chapter.Code,
+			Name: o.Chapter.Name,
+		}
+		if o.Chapter.LeadID != nil {
+			rs.Chapter.LeadID = o.Chapter.LeadID.String()
+		}
+	}
+
+	for _, kr := range o.KeyResults {
+		rs.KeyResults = append(rs.KeyResults, ToKeyResult(kr))
+	}
+
+	return rs
+}
+
+func ToObjectives(objectives []*model.Objective) []Objective {
+	rs := make([]Objective, 0, len(objectives))
+	for _, o := range objectives {
+		rs = append(rs, ToObjective(o))
+	}
+
+	return rs
+}
+
+func ToKeyResult(kr *model.KeyResult) KeyResult {
+	rs := KeyResult{
+		ID:            kr.ID.String(),
+		ObjectiveID:   kr.ObjectiveID.String(),
+		Title:         kr.Title,
+		Unit:          kr.Unit,
+		StartValue:    kr.StartValue,
+		TargetValue:   kr.TargetValue,
+		CurrentValue:  kr.CurrentValue,
+		Progress:      kr.Progress(),
+		LastCheckInAt: kr.LastCheckInAt,
+	}
+
+	if kr.Owner != nil {
+		rs.Owner = toBasicEmployeeInfo(*kr.Owner)
+	}
+
+	return rs
+}
+
+func ToKeyResultCheckIn(c *model.KeyResultCheckIn) KeyResultCheckIn {
+	rs := KeyResultCheckIn{
+		ID:          c.ID.String(),
+		CreatedAt:   c.CreatedAt,
+		KeyResultID: c.KeyResultID.String(),
+		Value:       c.Value,
+		Progress:    c.Progress,
+		Confidence:  c.Confidence,
+		Note:        c.Note,
+	}
+
+	if c.Employee != nil {
+		rs.Employee = toBasicEmployeeInfo(*c.Employee)
+	}
+
+	return rs
+}
+
+func ToKeyResultCheckIns(checkIns []*model.KeyResultCheckIn) []KeyResultCheckIn {
+	rs := make([]KeyResultCheckIn, 0, len(checkIns))
+	for _, c := range checkIns {
+		rs = append(rs, ToKeyResultCheckIn(c))
+	}
+
+	return rs
+}
+
+type ObjectiveResponse struct {
+	Data Objective `json:"data"`
+}
+
+type ListObjectiveResponse struct {
+	Data []Objective `json:"data"`
+}
+
+type KeyResultResponse struct {
+	Data KeyResult `json:"data"`
+}
+
+type KeyResultCheckInResponse struct {
+	Data KeyResultCheckIn `json:"data"`
+}
+
+type ListKeyResultCheckInResponse struct {
+	Data []KeyResultCheckIn `json:"data"`
+}
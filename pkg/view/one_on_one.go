@@ -0,0 +1,158 @@
+package view
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type OneOnOneMeeting struct {
+	ID               string             `json:"id"`
+	CreatedAt        time.Time          `json:"createdAt"`
+	Title            string             `json:"title"`
+	Description      string             `json:"description"`
+	Status           string             `json:"status"`
+	StartTime        *time.Time         `json:"startTime"`
+	EndTime          *time.Time         `json:"endTime"`
+	GoogleCalendarID string             `json:"googleCalendarID"`
+	HangoutLink      string             `json:"hangoutLink"`
+	ManagerNote      string             `json:"managerNote,omitempty"`
+	Manager          *BasicEmployeeInfo `json:"manager"`
+	Report           *BasicEmployeeInfo `json:"report"`
+
+	AgendaItems            []OneOnOneAgendaItem `json:"agendaItems,omitempty"`
+	ActionItems            []OneOnOneActionItem `json:"actionItems,omitempty"`
+	CarriedOverAgendaItems []OneOnOneAgendaItem `json:"carriedOverAgendaItems,omitempty"`
+	CarriedOverActionItems []OneOnOneActionItem `json:"carriedOverActionItems,omitempty"`
+}
+
+type OneOnOneAgendaItem struct {
+	ID          string             `json:"id"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	MeetingID   string             `json:"meetingID"`
+	Content     string             `json:"content"`
+	IsDiscussed bool               `json:"isDiscussed"`
+	Author      *BasicEmployeeInfo `json:"author"`
+}
+
+type OneOnOneActionItem struct {
+	ID          string             `json:"id"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	MeetingID   string             `json:"meetingID"`
+	Content     string             `json:"content"`
+	DueDate     *time.Time         `json:"dueDate"`
+	Status      string             `json:"status"`
+	CompletedAt *time.Time         `json:"completedAt"`
+	Owner       *BasicEmployeeInfo `json:"owner"`
+}
+
+func ToOneOnOneMeeting(m *model.OneOnOneMeeting) OneOnOneMeeting {
+	rs := OneOnOneMeeting{
+		ID:          m.ID.String(),
+		CreatedAt:   m.CreatedAt,
+		Status:      m.Status.String(),
+		ManagerNote: m.ManagerNote,
+	}
+
+	if m.Schedule != nil {
+		rs.Title = m.Schedule.Name
+		rs.Description = m.Schedule.Description
+		rs.StartTime = m.Schedule.StartTime
+		rs.EndTime = m.Schedule.EndTime
+
+		if m.Schedule.GoogleCalendar != nil {
+			rs.GoogleCalendarID = m.Schedule.GoogleCalendar.GoogleCalendarID
+			rs.HangoutLink = m.Schedule.GoogleCalendar.HangoutLink
+		}
+	}
+	if m.Manager != nil {
+		rs.Manager = toBasicEmployeeInfo(*m.Manager)
+	}
+	if m.Report != nil {
+		rs.Report = toBasicEmployeeInfo(*m.Report)
+	}
+
+	rs.AgendaItems = ToOneOnOneAgendaItems(m.AgendaItems)
+	rs.ActionItems = ToOneOnOneActionItems(m.ActionItems)
+	rs.CarriedOverAgendaItems = ToOneOnOneAgendaItems(m.CarriedOverAgendaItems)
+	rs.CarriedOverActionItems = ToOneOnOneActionItems(m.CarriedOverActionItems)
+
+	return rs
+}
+
+func ToOneOnOneMeetings(meetings []*model.OneOnOneMeeting) []OneOnOneMeeting {
+	rs := make([]OneOnOneMeeting, 0, len(meetings))
+	for _, m := range meetings {
+		rs = append(rs, ToOneOnOneMeeting(m))
+	}
+
+	return rs
+}
+
+func ToOneOnOneAgendaItem(item *model.OneOnOneAgendaItem) OneOnOneAgendaItem {
+	rs := OneOnOneAgendaItem{
+		ID:          item.ID.String(),
+		CreatedAt:   item.CreatedAt,
+		MeetingID:   item.MeetingID.String(),
+		Content:     item.Content,
+		IsDiscussed: item.IsDiscussed,
+	}
+
+	if item.Author != nil {
+		rs.Author = toBasicEmployeeInfo(*item.Author)
+	}
+
+	return rs
+}
+
+func ToOneOnOneAgendaItems(items []*model.OneOnOneAgendaItem) []OneOnOneAgendaItem {
+	var rs []OneOnOneAgendaItem
+	for _, item := range items {
+		rs = append(rs, ToOneOnOneAgendaItem(item))
+	}
+
+	return rs
+}
+
+func ToOneOnOneActionItem(item *model.OneOnOneActionItem) OneOnOneActionItem {
+	rs := OneOnOneActionItem{
+		ID:          item.ID.String(),
+		CreatedAt:   item.CreatedAt,
+		MeetingID:   item.MeetingID.String(),
+		Content:     item.Content,
+		DueDate:     item.DueDate,
+		Status:      item.Status.String(),
+		CompletedAt: item.CompletedAt,
+	}
+
+	if item.Owner != nil {
+		rs.Owner = toBasicEmployeeInfo(*item.Owner)
+	}
+
+	return rs
+}
+
+func ToOneOnOneActionItems(items []*model.OneOnOneActionItem) []OneOnOneActionItem {
+	var rs []OneOnOneActionItem
+	for _, item := range items {
+		rs = append(rs, ToOneOnOneActionItem(item))
+	}
+
+	return rs
+}
+
+type OneOnOneMeetingResponse struct {
+	Data OneOnOneMeeting `json:"data"`
+}
+
+type ListOneOnOneMeetingResponse struct {
+	Data []OneOnOneMeeting `json:"data"`
+}
+
+type OneOnOneAgendaItemResponse struct {
+	Data OneOnOneAgendaItem `json:"data"`
+}
+
+type OneOnOneActionItemResponse struct {
+	Data OneOnOneActionItem `json:"data"`
+}
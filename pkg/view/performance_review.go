@@ -0,0 +1,257 @@
+package view
+
+import (
+	"sort"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type PerformanceReviewCycle struct {
+	ID              string             `json:"id"`
+	CreatedAt       time.Time          `json:"createdAt"`
+	Name            string             `json:"name"`
+	StartDate       time.Time          `json:"startDate"`
+	EndDate         time.Time          `json:"endDate"`
+	Status          string             `json:"status"`
+	PeerReviewEvent *Survey            `json:"peerReviewEvent"`
+	Creator         *BasicEmployeeInfo `json:"creator"`
+}
+
+type PerformanceReview struct {
+	ID               string                            `json:"id"`
+	CycleID          string                            `json:"cycleID"`
+	CycleName        string                            `json:"cycleName"`
+	Status           string                            `json:"status"`
+	Employee         *BasicEmployeeInfo                `json:"employee"`
+	Reviewer         *BasicEmployeeInfo                `json:"reviewer"`
+	Assessment       []model.PerformanceAssessmentItem `json:"assessment"`
+	ProposedRating   string                            `json:"proposedRating"`
+	CalibratedRating string                            `json:"calibratedRating"`
+	CalibrationNote  string                            `json:"calibrationNote"`
+	FinalRating      string                            `json:"finalRating"`
+	FinalizedAt      *time.Time                        `json:"finalizedAt"`
+}
+
+type PerformanceReviewPacket struct {
+	Review PerformanceReview `json:"review"`
+	// Criteria are the criteria of the line manager assessment form
+	Criteria     []string                    `json:"criteria"`
+	SelfReview   *PerformanceReviewFeedback  `json:"selfReview"`
+	PeerReviews  []PerformanceReviewFeedback `json:"peerReviews"`
+	Projects     []PerformanceReviewProject  `json:"projects"`
+	WorkUnits    []PerformanceReviewWorkUnit `json:"workUnits"`
+	BraineryLogs []PerformanceReviewBrainery `json:"braineryLogs"`
+	Engagement   PerformanceReviewEngagement `json:"engagement"`
+}
+
+type PerformanceReviewFeedback struct {
+	Reviewer     *BasicEmployeeInfo        `json:"reviewer"`
+	Relationship string                    `json:"relationship"`
+	Answers      []PerformanceReviewAnswer `json:"answers"`
+}
+
+type PerformanceReviewAnswer struct {
+	Question string `json:"question"`
+	Answer   string `json:"answer"`
+	Note     string `json:"note"`
+}
+
+type PerformanceReviewProject struct {
+	Project   *BasicProjectInfo `json:"project"`
+	Status    string            `json:"status"`
+	StartDate *time.Time        `json:"startDate"`
+	EndDate   *time.Time        `json:"endDate"`
+}
+
+type PerformanceReviewWorkUnit struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	Type      string     `json:"type"`
+	StartDate time.Time  `json:"startDate"`
+	EndDate   *time.Time `json:"endDate"`
+}
+
+type PerformanceReviewBrainery struct {
+	Title       string     `json:"title"`
+	URL         string     `json:"url"`
+	PublishedAt *time.Time `json:"publishedAt"`
+}
+
+type PerformanceReviewEngagement struct {
+	Surveys  int `json:"surveys"`
+	Answered int `json:"answered"`
+}
+
+func ToPerformanceReviewCycle(cycle *model.PerformanceReviewCycle) PerformanceReviewCycle {
+	rs := PerformanceReviewCycle{
+		ID:        cycle.ID.String(),
+		CreatedAt: cycle.CreatedAt,
+		Name:      cycle.Name,
+		StartDate: cycle.StartDate,
+		EndDate:   cycle.EndDate,
+		Status:    cycle.Status.String(),
+	}
+
+	if cycle.PeerReviewEvent != nil {
+		rs.PeerReviewEvent = &Survey{
+			ID:        cycle.PeerReviewEvent.ID.String(),
+			Title:     cycle.PeerReviewEvent.Title,
+			Type:      cycle.PeerReviewEvent.Type.String(),
+			Subtype:   cycle.PeerReviewEvent.Subtype.String(),
+			Status:    cycle.PeerReviewEvent.Status.String(),
+			StartDate: cycle.PeerReviewEvent.StartDate,
+			EndDate:   cycle.PeerReviewEvent.EndDate,
+			Deadline:  cycle.PeerReviewEvent.Deadline,
+		}
+	}
+
+	if cycle.Creator != nil {
+		rs.Creator = toBasicEmployeeInfo(*cycle.Creator)
+	}
+
+	return rs
+}
+
+func ToPerformanceReviewCycles(cycles []*model.PerformanceReviewCycle) []PerformanceReviewCycle {
+	rs := make([]PerformanceReviewCycle, 0, len(cycles))
+	for _, c := range cycles {
+		rs = append(rs, ToPerformanceReviewCycle(c))
+	}
+
+	return rs
+}
+
+func ToPerformanceReview(review *model.PerformanceReview) PerformanceReview {
+	rs := PerformanceReview{
+		ID:               review.ID.String(),
+		CycleID:          review.CycleID.String(),
+		Status:           review.Status.String(),
+		Assessment:       review.Assessment,
+		ProposedRating:   review.ProposedRating.String(),
+		CalibratedRating: review.CalibratedRating.String(),
+		CalibrationNote:  review.CalibrationNote,
+		FinalRating:      review.FinalRating.String(),
+		FinalizedAt:      review.FinalizedAt,
+	}
+
+	if review.Cycle != nil {
+		rs.CycleName = review.Cycle.Name
+	}
+	if review.Employee != nil {
+		rs.Employee = toBasicEmployeeInfo(*review.Employee)
+	}
+	if review.Reviewer != nil {
+		rs.Reviewer = toBasicEmployeeInfo(*review.Reviewer)
+	}
+
+	return rs
+}
+
+func ToPerformanceReviews(reviews []*model.PerformanceReview) []PerformanceReview {
+	rs := make([]PerformanceReview, 0, len(reviews))
+	for _, r := range reviews {
+		rs = append(rs, ToPerformanceReview(r))
+	}
+
+	return rs
+}
+
+// ToPerformanceReviewPacket splits the self review from the peer reviews of the packet
+func ToPerformanceReviewPacket(packet *model.PerformanceReviewPacket) PerformanceReviewPacket {
+	rs := PerformanceReviewPacket{
+		Review:       ToPerformanceReview(packet.Review),
+		Criteria:     make([]string, 0, len(model.PerformanceCriteria)),
+		PeerReviews:  make([]PerformanceReviewFeedback, 0, len(packet.Reviews)),
+		Projects:     make([]PerformanceReviewProject, 0, len(packet.ProjectMembers)),
+		WorkUnits:    make([]PerformanceReviewWorkUnit, 0, len(packet.WorkUnitMembers)),
+		BraineryLogs: make([]PerformanceReviewBrainery, 0, len(packet.BraineryLogs)),
+		Engagement: PerformanceReviewEngagement{
+			Surveys:  packet.Engagement.Surveys,
+			Answered: packet.Engagement.Answered,
+		},
+	}
+
+	for _, c := range model.PerformanceCriteria {
+		rs.Criteria = append(rs.Criteria, string(c))
+	}
+
+	for _, r := range packet.Reviews {
+		feedback := toPerformanceReviewFeedback(r)
+		if r.Relationship == model.RelationshipSelf {
+			rs.SelfReview = &feedback
+			continue
+		}
+		rs.PeerReviews = append(rs.PeerReviews, feedback)
+	}
+
+	for _, m := range packet.ProjectMembers {
+		rs.Projects = append(rs.Projects, PerformanceReviewProject{
+			Project:   toBasicProjectInfo(m.Project),
+			Status:    m.Status.String(),
+			StartDate: m.StartDate,
+			EndDate:   m.EndDate,
+		})
+	}
+
+	for _, m := range packet.WorkUnitMembers {
+		rs.WorkUnits = append(rs.WorkUnits, PerformanceReviewWorkUnit{
+			ID:        m.WorkUnitID.String(),
+			Name:      m.WorkUnit.Name,
+			Type:      m.WorkUnit.Type.String(),
+			StartDate: m.StartDate,
+			EndDate:   m.EndDate,
+		})
+	}
+
+	for _, l := range packet.BraineryLogs {
+		rs.BraineryLogs = append(rs.BraineryLogs, PerformanceReviewBrainery{
+			Title:       l.Title,
+			URL:         l.URL,
+			PublishedAt: l.PublishedAt,
+		})
+	}
+
+	return rs
+}
+
+func toPerformanceReviewFeedback(r *model.EmployeeEventReviewer) PerformanceReviewFeedback {
+	rs := PerformanceReviewFeedback{
+		Relationship: r.Relationship.String(),
+	}
+	if r.Reviewer != nil {
+		rs.Reviewer = toBasicEmployeeInfo(*r.Reviewer)
+	}
+
+	questions := make([]model.EmployeeEventQuestion, len(r.EmployeeEventQuestions))
+	copy(questions, r.EmployeeEventQuestions)
+	sort.SliceStable(questions, func(i, j int) bool {
+		return questions[i].Order < questions[j].Order
+	})
+
+	for _, q := range questions {
+		rs.Answers = append(rs.Answers, PerformanceReviewAnswer{Question: q.Content, Answer: q.Answer, Note: q.Note})
+	}
+
+	return rs
+}
+
+type PerformanceReviewCycleResponse struct {
+	Data PerformanceReviewCycle `json:"data"`
+}
+
+type ListPerformanceReviewCycleResponse struct {
+	Data []PerformanceReviewCycle `json:"data"`
+}
+
+type PerformanceReviewResponse struct {
+	Data PerformanceReview `json:"data"`
+}
+
+type ListPerformanceReviewResponse struct {
+	Data []PerformanceReview `json:"data"`
+}
+
+type PerformanceReviewPacketResponse struct {
+	Data PerformanceReviewPacket `json:"data"`
+}
@@ -0,0 +1,127 @@
+package view
+
+import (
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// ProfitLoss amounts are in VND
+type ProfitLoss struct {
+	InvoicedRevenue  float64 `json:"invoicedRevenue"`
+	CollectedRevenue float64 `json:"collectedRevenue"`
+	PayrollCost      float64 `json:"payrollCost"`
+	CommissionCost   float64 `json:"commissionCost"`
+	ExpenseCost      float64 `json:"expenseCost"`
+	TotalCost        float64 `json:"totalCost"`
+	GrossMargin      float64 `json:"grossMargin"`
+	MarginPercentage float64 `json:"marginPercentage"`
+}
+
+func toProfitLoss(p model.ProfitLoss) ProfitLoss {
+	return ProfitLoss{
+		InvoicedRevenue:  p.InvoicedRevenue,
+		CollectedRevenue: p.CollectedRevenue,
+		PayrollCost:      p.PayrollCost,
+		CommissionCost:   p.CommissionCost,
+		ExpenseCost:      p.ExpenseCost,
+		TotalCost:        p.TotalCost(),
+		GrossMargin:      p.GrossMargin(),
+		MarginPercentage: p.MarginPercentage(),
+	}
+}
+
+type ProjectProfitLoss struct {
+	Project *BasicProjectInfo        `json:"project"`
+	Total   ProfitLoss               `json:"total"`
+	Months  []ProjectProfitLossMonth `json:"months"`
+}
+
+type ProjectProfitLossMonth struct {
+	ProfitLoss
+
+	// Month is in YYYY-MM format
+	Month string `json:"month"`
+}
+
+func ToProjectProfitLoss(project *model.Project, months []*model.ProjectProfitLoss) ProjectProfitLoss {
+	rs := ProjectProfitLoss{
+		Project: toBasicProjectInfo(*project),
+		Months:  make([]ProjectProfitLossMonth, 0, len(months)),
+	}
+
+	var total model.ProfitLoss
+	for _, m := range months {
+		total.Add(m.ProfitLoss)
+		rs.Months = append(rs.Months, ProjectProfitLossMonth{
+			ProfitLoss: toProfitLoss(m.ProfitLoss),
+			Month:      m.Month.Format("2006-01"),
+		})
+	}
+	rs.Total = toProfitLoss(total)
+
+	return rs
+}
+
+type CompanyProfitLoss struct {
+	Total  ProfitLoss               `json:"total"`
+	Months []CompanyProfitLossMonth `json:"months"`
+}
+
+type CompanyProfitLossMonth struct {
+	// Month is in YYYY-MM format
+	Month  string            `json:"month"`
+	Total  ProfitLoss        `json:"total"`
+	Groups []ProfitLossGroup `json:"groups"`
+}
+
+type ProfitLossGroup struct {
+	ProfitLoss
+
+	Organization *Organization `json:"organization"`
+	ProjectType  string        `json:"projectType"`
+	Projects     int           `json:"projects"`
+}
+
+func ToCompanyProfitLoss(months []*model.ProfitLossMonth) CompanyProfitLoss {
+	rs := CompanyProfitLoss{
+		Months: make([]CompanyProfitLossMonth, 0, len(months)),
+	}
+
+	var total model.ProfitLoss
+	for _, m := range months {
+		total.Add(m.Total)
+
+		month := CompanyProfitLossMonth{
+			Month:  m.Month.Format("2006-01"),
+			Total:  toProfitLoss(m.Total),
+			Groups: make([]ProfitLossGroup, 0, len(m.Groups)),
+		}
+		for _, g := range m.Groups {
+			group := ProfitLossGroup{
+				ProfitLoss:  toProfitLoss(g.ProfitLoss),
+				ProjectType: g.ProjectType.String(),
+				Projects:    g.Projects,
+			}
+			if g.Organization != nil {
+				group.Organization = &Organization{
+					ID:     g.Organization.ID.String(),
+					Code:   g.Organization.Code,
+					Name:   g.Organization.Name,
+					Avatar: g.Organization.Avatar,
+				}
+			}
+			month.Groups = append(month.Groups, group)
+		}
+		rs.Months = append(rs.Months, month)
+	}
+	rs.Total = toProfitLoss(total)
+
+	return rs
+}
+
+type ProjectProfitLossResponse struct {
+	Data ProjectProfitLoss `json:"data"`
+}
+
+type CompanyProfitLossResponse struct {
+	Data CompanyProfitLoss `json:"data"`
+}
@@ -43,6 +43,7 @@ type ProjectData struct {
 	Organization        *Organization         `json:"organization"`
 	MonthlyChargeRate   decimal.Decimal       `json:"monthlyChargeRate"`
 	Currency            *Currency             `json:"currency"`
+	Budget              *ProjectBudget        `json:"budget,omitempty"`
 }
 
 type BasicClientInfo struct {
@@ -742,3 +743,35 @@ func ToProjectContentData(url string) *ProjectContentData {
 		Url: url,
 	}
 }
+
+type ProjectStatusTransition struct {
+	ID         string             `json:"id"`
+	FromStatus string             `json:"fromStatus"`
+	ToStatus   string             `json:"toStatus"`
+	Reason     string             `json:"reason"`
+	Actor      *BasicEmployeeInfo `json:"actor"`
+	CreatedAt  time.Time          `json:"createdAt"`
+}
+
+func ToProjectStatusTransitions(transitions []*model.ProjectStatusTransition) []ProjectStatusTransition {
+	rs := make([]ProjectStatusTransition, 0, len(transitions))
+	for _, t := range transitions {
+		transition := ProjectStatusTransition{
+			ID:         t.ID.String(),
+			FromStatus: t.FromStatus.String(),
+			ToStatus:   t.ToStatus.String(),
+			Reason:     t.Reason,
+			CreatedAt:  t.CreatedAt,
+		}
+		if t.Actor != nil {
+			transition.Actor = toBasicEmployeeInfo(*t.Actor)
+		}
+		rs = append(rs, transition)
+	}
+
+	return rs
+}
+
+type ListProjectStatusTransitionResponse struct {
+	Data []ProjectStatusTransition `json:"data"`
+}
@@ -0,0 +1,106 @@
+package view
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// ProjectBudget amounts are in VND
+type ProjectBudget struct {
+	ID                string               `json:"id"`
+	ProjectID         string               `json:"projectID"`
+	TotalAmount       float64              `json:"totalAmount"`
+	StartDate         time.Time            `json:"startDate"`
+	EndDate           *time.Time           `json:"endDate"`
+	AlertThresholds   []float64            `json:"alertThresholds"`
+	Note              string               `json:"note"`
+	Status            string               `json:"status"`
+	BurnedAmount      float64              `json:"burnedAmount"`
+	BurnPercentage    float64              `json:"burnPercentage"`
+	RemainingAmount   float64              `json:"remainingAmount"`
+	ElapsedPercentage float64              `json:"elapsedPercentage"`
+	ApprovedHours     float64              `json:"approvedHours"`
+	InvoicedAmount    float64              `json:"invoicedAmount"`
+	Phases            []ProjectBudgetPhase `json:"phases"`
+}
+
+type ProjectBudgetPhase struct {
+	ID             string                      `json:"id"`
+	Name           string                      `json:"name"`
+	Amount         float64                     `json:"amount"`
+	StartDate      time.Time                   `json:"startDate"`
+	EndDate        *time.Time                  `json:"endDate"`
+	Note           string                      `json:"note"`
+	InvoicedAmount float64                     `json:"invoicedAmount"`
+	IsPaid         bool                        `json:"isPaid"`
+	Invoices       []ProjectBudgetPhaseInvoice `json:"invoices"`
+}
+
+type ProjectBudgetPhaseInvoice struct {
+	ID               string  `json:"id"`
+	Number           string  `json:"number"`
+	Status           string  `json:"status"`
+	Total            float64 `json:"total"`
+	ConversionAmount float64 `json:"conversionAmount"`
+}
+
+func ToProjectBudget(health *model.ProjectBudgetHealth, now time.Time) *ProjectBudget {
+	b := health.Budget
+
+	rs := &ProjectBudget{
+		ID:                b.ID.String(),
+		ProjectID:         b.ProjectID.String(),
+		TotalAmount:       b.TotalAmount,
+		StartDate:         b.StartDate,
+		EndDate:           b.EndDate,
+		AlertThresholds:   b.GetAlertThresholds(),
+		Note:              b.Note,
+		Status:            health.Status(now).String(),
+		BurnedAmount:      health.BurnedAmount,
+		BurnPercentage:    health.BurnPercentage(),
+		RemainingAmount:   b.TotalAmount - health.BurnedAmount,
+		ElapsedPercentage: health.ElapsedPercentage(now),
+		ApprovedHours:     health.ApprovedHours,
+		InvoicedAmount:    health.InvoicedAmount(),
+		Phases:            make([]ProjectBudgetPhase, 0, len(b.Phases)),
+	}
+	for _, p := range b.Phases {
+		rs.Phases = append(rs.Phases, ToProjectBudgetPhase(p))
+	}
+
+	return rs
+}
+
+func ToProjectBudgetPhase(p *model.ProjectBudgetPhase) ProjectBudgetPhase {
+	rs := ProjectBudgetPhase{
+		ID:             p.ID.String(),
+		Name:           p.Name,
+		Amount:         p.Amount,
+		StartDate:      p.StartDate,
+		EndDate:        p.EndDate,
+		Note:           p.Note,
+		InvoicedAmount: p.InvoicedAmount(),
+		IsPaid:         p.IsPaid(),
+		Invoices:       make([]ProjectBudgetPhaseInvoice, 0, len(p.Invoices)),
+	}
+	for _, iv := range p.Invoices {
+		rs.Invoices = append(rs.Invoices, ProjectBudgetPhaseInvoice{
+			ID:               iv.ID.String(),
+			Number:           iv.Number,
+			Status:           iv.Status.String(),
+			Total:            iv.Total,
+			ConversionAmount: iv.ConversionAmount,
+		})
+	}
+
+	return rs
+}
+
+type ProjectBudgetResponse struct {
+	Data ProjectBudget `json:"data"`
+}
+
+type ProjectBudgetPhaseResponse struct {
+	Data ProjectBudgetPhase `json:"data"`
+}
@@ -0,0 +1,140 @@
+package view
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type ProjectHealthPortfolio struct {
+	Week     time.Time                     `json:"week"`
+	Projects []ProjectHealthPortfolioEntry `json:"projects"`
+}
+
+type ProjectHealthPortfolioEntry struct {
+	Project *BasicProjectInfo `json:"project"`
+	Score   float64           `json:"score"`
+	Status  string            `json:"status"`
+	// PreviousScore and PreviousStatus are the score of the week before, nil when the project was not scored
+	PreviousScore  *float64                   `json:"previousScore"`
+	PreviousStatus string                     `json:"previousStatus"`
+	TurnedRed      bool                       `json:"turnedRed"`
+	Signals        []ProjectHealthSignalScore `json:"signals"`
+}
+
+type ProjectHealth struct {
+	Project *BasicProjectInfo    `json:"project"`
+	Weeks   []ProjectHealthScore `json:"weeks"`
+}
+
+type ProjectHealthScore struct {
+	Week    time.Time                  `json:"week"`
+	Score   float64                    `json:"score"`
+	Status  string                     `json:"status"`
+	Signals []ProjectHealthSignalScore `json:"signals"`
+}
+
+type ProjectHealthSignalScore struct {
+	Signal       string  `json:"signal"`
+	Available    bool    `json:"available"`
+	Value        float64 `json:"value"`
+	Score        float64 `json:"score"`
+	Weight       float64 `json:"weight"`
+	Contribution float64 `json:"contribution"`
+	Detail       string  `json:"detail"`
+}
+
+type ProjectHealthWeight struct {
+	Signal string  `json:"signal"`
+	Weight float64 `json:"weight"`
+}
+
+func ToProjectHealthPortfolio(portfolio *model.ProjectHealthPortfolio) *ProjectHealthPortfolio {
+	rs := &ProjectHealthPortfolio{
+		Week:     portfolio.Week,
+		Projects: make([]ProjectHealthPortfolioEntry, 0, len(portfolio.Scores)),
+	}
+
+	for _, s := range portfolio.Scores {
+		entry := ProjectHealthPortfolioEntry{
+			Score:   s.Score,
+			Status:  s.Status.String(),
+			Signals: toProjectHealthSignalScores(s),
+		}
+		if s.Project != nil {
+			entry.Project = toBasicProjectInfo(*s.Project)
+		}
+
+		previous := portfolio.PreviousScores[s.ProjectID]
+		if previous != nil {
+			entry.PreviousScore = &previous.Score
+			entry.PreviousStatus = previous.Status.String()
+		}
+		entry.TurnedRed = s.TurnedRed(previous)
+
+		rs.Projects = append(rs.Projects, entry)
+	}
+
+	return rs
+}
+
+func ToProjectHealth(project *model.Project, scores []*model.ProjectHealthScore) *ProjectHealth {
+	rs := &ProjectHealth{
+		Project: toBasicProjectInfo(*project),
+		Weeks:   make([]ProjectHealthScore, 0, len(scores)),
+	}
+
+	for _, s := range scores {
+		rs.Weeks = append(rs.Weeks, ProjectHealthScore{
+			Week:    s.Week,
+			Score:   s.Score,
+			Status:  s.Status.String(),
+			Signals: toProjectHealthSignalScores(s),
+		})
+	}
+
+	return rs
+}
+
+func ToProjectHealthWeights(weights []*model.ProjectHealthWeight) []ProjectHealthWeight {
+	rs := make([]ProjectHealthWeight, 0, len(weights))
+	for _, w := range weights {
+		rs = append(rs, ProjectHealthWeight{
+			Signal: w.Signal.String(),
+			Weight: w.Weight,
+		})
+	}
+
+	return rs
+}
+
+func toProjectHealthSignalScores(s *model.ProjectHealthScore) []ProjectHealthSignalScore {
+	signals := s.GetSignals()
+
+	rs := make([]ProjectHealthSignalScore, 0, len(signals))
+	for _, sig := range signals {
+		rs = append(rs, ProjectHealthSignalScore{
+			Signal:       sig.Signal.String(),
+			Available:    sig.Available,
+			Value:        sig.Value,
+			Score:        sig.Score,
+			Weight:       sig.Weight,
+			Contribution: sig.Contribution,
+			Detail:       sig.Detail,
+		})
+	}
+
+	return rs
+}
+
+type ProjectHealthPortfolioResponse struct {
+	Data ProjectHealthPortfolio `json:"data"`
+}
+
+type ProjectHealthResponse struct {
+	Data ProjectHealth `json:"data"`
+}
+
+type ProjectHealthWeightsResponse struct {
+	Data []ProjectHealthWeight `json:"data"`
+}
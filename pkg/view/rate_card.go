@@ -0,0 +1,159 @@
+package view
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type RateCard struct {
+	ID        string            `json:"id"`
+	Name      string            `json:"name"`
+	Client    *BasicClientInfo  `json:"client"`
+	Project   *BasicProjectInfo `json:"project"`
+	StartDate time.Time         `json:"startDate"`
+	EndDate   *time.Time        `json:"endDate"`
+	Note      string            `json:"note"`
+	Rates     []RateCardRate    `json:"rates"`
+}
+
+type RateCardRate struct {
+	ID        string          `json:"id"`
+	Seniority *Seniority      `json:"seniority"`
+	Position  *Position       `json:"position"`
+	Rate      decimal.Decimal `json:"rate"`
+	Discount  decimal.Decimal `json:"discount"`
+}
+
+func ToRateCard(card *model.RateCard) RateCard {
+	rs := RateCard{
+		ID:        card.ID.String(),
+		Name:      card.Name,
+		StartDate: card.StartDate,
+		EndDate:   card.EndDate,
+		Note:      card.Note,
+		Rates:     make([]RateCardRate, 0, len(card.Rates)),
+	}
+
+	if card.Client != nil {
+		rs.Client = ToBasicClientInfo(card.Client)
+	}
+	if card.Project != nil {
+		rs.Project = toBasicProjectInfo(*card.Project)
+	}
+
+	for _, r := range card.Rates {
+		rate := RateCardRate{
+			ID:       r.ID.String(),
+			Rate:     r.Rate,
+			Discount: r.Discount,
+		}
+		if r.Seniority != nil {
+			seniority := ToSeniority(*r.Seniority)
+			rate.Seniority = &seniority
+		}
+		if r.Position != nil {
+			rate.Position = &Position{
+				ID:   r.Position.ID.String(),
+				Code: r.Position.Code,
+				Name: r.Position.Name,
+			}
+		}
+		rs.Rates = append(rs.Rates, rate)
+	}
+
+	return rs
+}
+
+func ToRateCards(cards []*model.RateCard) []RateCard {
+	rs := make([]RateCard, 0, len(cards))
+	for _, c := range cards {
+		rs = append(rs, ToRateCard(c))
+	}
+
+	return rs
+}
+
+type RateCardRenewal struct {
+	RateCard
+
+	// DaysUntilRenewal is the number of days left before the rate card ends
+	DaysUntilRenewal int `json:"daysUntilRenewal"`
+}
+
+func ToRateCardRenewals(cards []*model.RateCard, now time.Time) []RateCardRenewal {
+	rs := make([]RateCardRenewal, 0, len(cards))
+	for _, c := range cards {
+		rs = append(rs, RateCardRenewal{
+			RateCard:         ToRateCard(c),
+			DaysUntilRenewal: c.DaysUntilRenewal(now),
+		})
+	}
+
+	return rs
+}
+
+type ProjectMemberRate struct {
+	ID              string             `json:"id"`
+	ProjectMemberID string             `json:"projectMemberID"`
+	RateCardID      *string            `json:"rateCardID"`
+	Rate            decimal.Decimal    `json:"rate"`
+	Discount        decimal.Decimal    `json:"discount"`
+	EffectiveFrom   time.Time          `json:"effectiveFrom"`
+	Note            string             `json:"note"`
+	CreatedBy       *BasicEmployeeInfo `json:"createdBy"`
+	CreatedAt       time.Time          `json:"createdAt"`
+}
+
+func ToProjectMemberRate(r *model.ProjectMemberRate) ProjectMemberRate {
+	rs := ProjectMemberRate{
+		ID:              r.ID.String(),
+		ProjectMemberID: r.ProjectMemberID.String(),
+		Rate:            r.Rate,
+		Discount:        r.Discount,
+		EffectiveFrom:   r.EffectiveFrom,
+		Note:            r.Note,
+		CreatedAt:       r.CreatedAt,
+	}
+
+	if r.RateCardID != nil {
+		id := r.RateCardID.String()
+		rs.RateCardID = &id
+	}
+	if r.Creator != nil {
+		rs.CreatedBy = toBasicEmployeeInfo(*r.Creator)
+	}
+
+	return rs
+}
+
+func ToProjectMemberRates(rates []*model.ProjectMemberRate) []ProjectMemberRate {
+	rs := make([]ProjectMemberRate, 0, len(rates))
+	for _, r := range rates {
+		rs = append(rs, ToProjectMemberRate(r))
+	}
+
+	return rs
+}
+
+type RateCardResponse struct {
+	Data RateCard `json:"data"`
+}
+
+type ListRateCardResponse struct {
+	Data []RateCard `json:"data"`
+}
+
+type ListRateCardRenewalResponse struct {
+	Data []RateCardRenewal `json:"data"`
+}
+
+type ProjectMemberRateResponse struct {
+	Data ProjectMemberRate `json:"data"`
+}
+
+type ListProjectMemberRateResponse struct {
+	Data []ProjectMemberRate `json:"data"`
+}
@@ -0,0 +1,117 @@
+package view
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type StaffingRequest struct {
+	ID                   string              `json:"id"`
+	ProjectSlotID        string              `json:"projectSlotID"`
+	ProjectMemberID      string              `json:"projectMemberID,omitempty"`
+	StartDate            time.Time           `json:"startDate"`
+	AllocationPercentage float64             `json:"allocationPercentage"`
+	Status               string              `json:"status"`
+	Note                 string              `json:"note"`
+	FilledAt             *time.Time          `json:"filledAt"`
+	Project              *BasicProjectInfo   `json:"project"`
+	Seniority            *Seniority          `json:"seniority"`
+	Positions            []Position          `json:"positions"`
+	Stacks               []Stack             `json:"stacks"`
+	Requester            *BasicEmployeeInfo  `json:"requester"`
+	Candidates           []StaffingCandidate `json:"candidates"`
+}
+
+type StaffingCandidate struct {
+	ID            string             `json:"id"`
+	Employee      *BasicEmployeeInfo `json:"employee"`
+	Score         float64            `json:"score"`
+	Allocation    float64            `json:"allocation"`
+	AvailableFrom time.Time          `json:"availableFrom"`
+	Status        string             `json:"status"`
+	Note          string             `json:"note"`
+}
+
+func ToStaffingRequest(r *model.StaffingRequest) StaffingRequest {
+	rs := StaffingRequest{
+		ID:                   r.ID.String(),
+		ProjectSlotID:        r.ProjectSlotID.String(),
+		StartDate:            r.StartDate,
+		AllocationPercentage: r.AllocationPercentage,
+		Status:               r.Status.String(),
+		Note:                 r.Note,
+		FilledAt:             r.FilledAt,
+		Positions:            make([]Position, 0),
+		Stacks:               make([]Stack, 0, len(r.Stacks)),
+		Candidates:           make([]StaffingCandidate, 0, len(r.Candidates)),
+	}
+
+	if !r.ProjectMemberID.IsZero() {
+		rs.ProjectMemberID = r.ProjectMemberID.String()
+	}
+	if r.Project != nil {
+		rs.Project = toBasicProjectInfo(*r.Project)
+	}
+	if r.Seniority != nil {
+		seniority := ToSeniority(*r.Seniority)
+		rs.Seniority = &seniority
+	}
+	if r.Requester != nil {
+		rs.Requester = toBasicEmployeeInfo(*r.Requester)
+	}
+
+	if r.ProjectSlot != nil {
+		positions := make([]model.Position, 0, len(r.ProjectSlot.ProjectSlotPositions))
+		for _, p := range r.ProjectSlot.ProjectSlotPositions {
+			positions = append(positions, p.Position)
+		}
+		rs.Positions = ToPositions(positions)
+	}
+
+	for _, s := range r.Stacks {
+		if s.Stack == nil {
+			continue
+		}
+		rs.Stacks = append(rs.Stacks, Stack{
+			ID:     s.Stack.ID.String(),
+			Name:   s.Stack.Name,
+			Code:   s.Stack.Code,
+			Avatar: s.Stack.Avatar,
+		})
+	}
+
+	for _, c := range r.Candidates {
+		candidate := StaffingCandidate{
+			ID:            c.ID.String(),
+			Score:         c.Score,
+			Allocation:    c.Allocation,
+			AvailableFrom: c.AvailableFrom,
+			Status:        c.Status.String(),
+			Note:          c.Note,
+		}
+		if c.Employee != nil {
+			candidate.Employee = toBasicEmployeeInfo(*c.Employee)
+		}
+		rs.Candidates = append(rs.Candidates, candidate)
+	}
+
+	return rs
+}
+
+func ToStaffingRequests(requests []*model.StaffingRequest) []StaffingRequest {
+	rs := make([]StaffingRequest, 0, len(requests))
+	for _, r := range requests {
+		rs = append(rs, ToStaffingRequest(r))
+	}
+
+	return rs
+}
+
+type StaffingRequestResponse struct {
+	Data StaffingRequest `json:"data"`
+}
+
+type ListStaffingRequestResponse struct {
+	Data []StaffingRequest `json:"data"`
+}
@@ -14,6 +14,7 @@ type Survey struct {
 	Status    string        `json:"status"`
 	StartDate *time.Time    `json:"startDate"`
 	EndDate   *time.Time    `json:"endDate"`
+	Deadline  *time.Time    `json:"deadline"`
 	Count     FeedbackCount `json:"count"`
 	Domains   []Domain      `json:"domains"`
 }
@@ -95,6 +96,7 @@ func ToListSurvey(events []*model.FeedbackEvent) []Survey {
 			Status:    e.Status.String(),
 			StartDate: e.StartDate,
 			EndDate:   e.EndDate,
+			Deadline:  e.Deadline,
 			Count: FeedbackCount{
 				Total: len(e.Topics),
 				Sent:  sent,
@@ -121,23 +123,29 @@ type SurveyDetail struct {
 	EndDate   *time.Time         `json:"endDate"`
 	Author    *BasicEmployeeInfo `json:"author"`
 	Topics    []Topic            `json:"topics"`
+
+	Deadline     *time.Time `json:"deadline"`
+	ReminderDays []int      `json:"reminderDays"`
+	AutoClose    bool       `json:"autoClose"`
+	ClosedAt     *time.Time `json:"closedAt"`
 }
 
 type Topic struct {
-	ID           string              `json:"id"`
-	EventID      string              `json:"eventID"`
-	ReviewID     string              `json:"reviewID,omitempty"`
-	Title        string              `json:"title"`
-	Type         string              `json:"type"`
-	Subtype      string              `json:"subtype"`
-	Status       string              `json:"status,omitempty"`
-	IsForcedDone bool                `json:"isForcedDone"`
-	Employee     BasicEmployeeInfo   `json:"employee"`
-	Participants []BasicEmployeeInfo `json:"participants"`
-	Count        *FeedbackCount      `json:"count"`
-	Domains      []Domain            `json:"domains"`
-	Comments     int                 `json:"comments"`
-	Project      *BasicProjectInfo   `json:"project"`
+	ID            string              `json:"id"`
+	EventID       string              `json:"eventID"`
+	ReviewID      string              `json:"reviewID,omitempty"`
+	Title         string              `json:"title"`
+	Type          string              `json:"type"`
+	Subtype       string              `json:"subtype"`
+	Status        string              `json:"status,omitempty"`
+	IsForcedDone  bool                `json:"isForcedDone"`
+	IsNonResponse bool                `json:"isNonResponse"`
+	Employee      BasicEmployeeInfo   `json:"employee"`
+	Participants  []BasicEmployeeInfo `json:"participants"`
+	Count         *FeedbackCount      `json:"count"`
+	Domains       []Domain            `json:"domains"`
+	Comments      int                 `json:"comments"`
+	Project       *BasicProjectInfo   `json:"project"`
 }
 
 type SurveyResult struct {
@@ -158,6 +166,11 @@ func ToSurveyDetail(event *model.FeedbackEvent) SurveyDetail {
 		StartDate: event.StartDate,
 		EndDate:   event.EndDate,
 		Author:    toBasicEmployeeInfo(event.Employee),
+
+		Deadline:     event.Deadline,
+		ReminderDays: event.ReminderDays,
+		AutoClose:    event.AutoClose,
+		ClosedAt:     event.ClosedAt,
 	}
 
 	var topics = make([]Topic, 0, len(event.Topics))
@@ -205,6 +218,7 @@ func ToSurveyDetail(event *model.FeedbackEvent) SurveyDetail {
 					newTopic.ReviewID = topic.EmployeeEventReviewers[0].ID.String()
 					newTopic.Status = topic.EmployeeEventReviewers[0].AuthorStatus.String()
 					newTopic.IsForcedDone = topic.EmployeeEventReviewers[0].IsForcedDone
+					newTopic.IsNonResponse = topic.EmployeeEventReviewers[0].IsNonResponse
 
 					if topic.Project != nil {
 						newTopic.Project = toBasicProjectInfo(*topic.Project)
@@ -232,6 +246,7 @@ func ToSurveyDetail(event *model.FeedbackEvent) SurveyDetail {
 					newTopic.ReviewID = topic.EmployeeEventReviewers[0].ID.String()
 					newTopic.Status = topic.EmployeeEventReviewers[0].AuthorStatus.String()
 					newTopic.IsForcedDone = topic.EmployeeEventReviewers[0].IsForcedDone
+					newTopic.IsNonResponse = topic.EmployeeEventReviewers[0].IsNonResponse
 				}
 			}
 		}
@@ -249,6 +264,7 @@ type PeerReviewer struct {
 	Status          model.EventReviewerStatus `json:"status"`
 	Relationship    model.Relationship        `json:"relationship"`
 	IsForcedDone    bool                      `json:"isForcedDone"`
+	IsNonResponse   bool                      `json:"isNonResponse"`
 }
 
 type SurveyTopicDetail struct {
@@ -275,6 +291,7 @@ func ToPeerReviewDetail(topic *model.EmployeeEventTopic) SurveyTopicDetail {
 			Status:          model.EventReviewerStatus(eventReviewer.AuthorStatus),
 			Relationship:    eventReviewer.Relationship,
 			IsForcedDone:    eventReviewer.IsForcedDone,
+			IsNonResponse:   eventReviewer.IsNonResponse,
 		}
 
 		if eventReviewer.Reviewer != nil {
@@ -360,3 +377,80 @@ func countLikertScaleAverage(count model.LikertScaleCount) float32 {
 	}
 	return average
 }
+
+type AnonymousSurveyResponses struct {
+	EventID     string                    `json:"eventID"`
+	Anonymity   string                    `json:"anonymity"`
+	Respondents int64                     `json:"respondents"`
+	Threshold   int                       `json:"threshold"`
+	IsHidden    bool                      `json:"isHidden"`
+	Responses   []AnonymousSurveyResponse `json:"responses"`
+}
+
+type AnonymousSurveyResponse struct {
+	ResponseKey string                  `json:"responseKey"`
+	Answers     []AnonymousSurveyAnswer `json:"answers"`
+}
+
+type AnonymousSurveyAnswer struct {
+	QuestionID         string `json:"questionID"`
+	TemplateQuestionID string `json:"templateQuestionID,omitempty"`
+	Content            string `json:"content"`
+	Type               string `json:"type"`
+	Answer             string `json:"answer"`
+	Note               string `json:"note"`
+	Order              int64  `json:"order"`
+	Domain             string `json:"domain"`
+}
+
+type AnonymousSurveyResponsesResponse struct {
+	Data AnonymousSurveyResponses `json:"data"`
+}
+
+// ToAnonymousSurveyResponses groups the answers by response, answers are only returned when the event reaches its threshold
+func ToAnonymousSurveyResponses(event *model.FeedbackEvent, respondents int64, answers []*model.AnonymousEventAnswer) AnonymousSurveyResponses {
+	result := AnonymousSurveyResponses{
+		EventID:     event.ID.String(),
+		Anonymity:   event.Anonymity.String(),
+		Respondents: respondents,
+		Threshold:   event.AnonymityThreshold,
+		IsHidden:    respondents < int64(event.AnonymityThreshold),
+		Responses:   make([]AnonymousSurveyResponse, 0),
+	}
+	if result.IsHidden {
+		return result
+	}
+
+	index := map[string]int{}
+	for _, a := range answers {
+		i, ok := index[a.ResponseKey]
+		if !ok {
+			i = len(result.Responses)
+			index[a.ResponseKey] = i
+			result.Responses = append(result.Responses, AnonymousSurveyResponse{ResponseKey: a.ResponseKey})
+		}
+
+		answer := a.Answer
+		if level, ok := model.AgreementLevelValueMap[answer]; ok && a.Type == model.QuestionTypeScale.String() {
+			answer = level.String()
+		}
+
+		var templateQuestionID string
+		if !a.TemplateQuestionID.IsZero() {
+			templateQuestionID = a.TemplateQuestionID.String()
+		}
+
+		result.Responses[i].Answers = append(result.Responses[i].Answers, AnonymousSurveyAnswer{
+			QuestionID:         a.QuestionID.String(),
+			TemplateQuestionID: templateQuestionID,
+			Content:            a.Content,
+			Type:               a.Type,
+			Answer:             answer,
+			Note:               a.Note,
+			Order:              a.Order,
+			Domain:             a.Domain.String(),
+		})
+	}
+
+	return result
+}
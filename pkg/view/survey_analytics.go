@@ -0,0 +1,311 @@
+package view
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/utils/textutils"
+)
+
+// surveyAnalyticsKeywordLimit is the number of keywords returned per group and period
+const surveyAnalyticsKeywordLimit = 10
+
+// SurveyAnalytics compares survey results across periods
+type SurveyAnalytics struct {
+	Subtype string                  `json:"subtype"`
+	GroupBy string                  `json:"groupBy"`
+	Periods []SurveyAnalyticsPeriod `json:"periods"`
+	Groups  []SurveyAnalyticsGroup  `json:"groups"`
+}
+
+// SurveyAnalyticsPeriod is a survey of the compared periods, oldest first
+type SurveyAnalyticsPeriod struct {
+	EventID      string             `json:"eventID"`
+	Title        string             `json:"title"`
+	StartDate    *time.Time         `json:"startDate"`
+	ResponseRate SurveyResponseRate `json:"responseRate"`
+}
+
+// SurveyResponseRate is the share of reviewers who answered a survey
+type SurveyResponseRate struct {
+	Total     int     `json:"total"`
+	Responded int     `json:"responded"`
+	Rate      float64 `json:"rate"`
+}
+
+// SurveyAnalyticsGroup is the results of a question, domain or cohort over the periods
+type SurveyAnalyticsGroup struct {
+	Name string `json:"name"`
+	// Trend is up or down when the latest change of the mean score is significant, stable otherwise
+	Trend  string                 `json:"trend"`
+	Points []SurveyAnalyticsPoint `json:"points"`
+}
+
+// SurveyAnalyticsPoint is the results of a group in a period, results are hidden below the anonymity threshold
+type SurveyAnalyticsPoint struct {
+	EventID      string                  `json:"eventID"`
+	Title        string                  `json:"title"`
+	StartDate    *time.Time              `json:"startDate"`
+	Respondents  int                     `json:"respondents"`
+	IsHidden     bool                    `json:"isHidden"`
+	ResponseRate *SurveyResponseRate     `json:"responseRate,omitempty"`
+	Likert       *SurveyLikertStats      `json:"likert,omitempty"`
+	Sentiment    *SurveySentimentSummary `json:"sentiment,omitempty"`
+}
+
+// SurveyLikertStats summarizes likert-scale answers, scores go from 1 for strongly disagree to 5 for strongly agree
+type SurveyLikertStats struct {
+	Count       int                   `json:"count"`
+	Mean        float64               `json:"mean"`
+	StdDev      float64               `json:"stdDev"`
+	Favorable   float64               `json:"favorable"`
+	Unfavorable float64               `json:"unfavorable"`
+	Delta       *SurveyAnalyticsDelta `json:"delta,omitempty"`
+}
+
+// SurveyAnalyticsDelta is the change of the mean score since the previous shown period
+type SurveyAnalyticsDelta struct {
+	Value         float64 `json:"value"`
+	TScore        float64 `json:"tScore"`
+	IsSignificant bool    `json:"isSignificant"`
+}
+
+// SurveySentimentSummary scores free-text answers with a word list, from -1 for negative to 1 for positive
+type SurveySentimentSummary struct {
+	Answers  int             `json:"answers"`
+	Score    float64         `json:"score"`
+	Positive int             `json:"positive"`
+	Negative int             `json:"negative"`
+	Neutral  int             `json:"neutral"`
+	Keywords []SurveyKeyword `json:"keywords"`
+}
+
+// SurveyKeyword is a word and the number of answers it appears in
+type SurveyKeyword struct {
+	Word  string `json:"word"`
+	Count int    `json:"count"`
+}
+
+type SurveyAnalyticsResponse struct {
+	Data SurveyAnalytics `json:"data"`
+}
+
+// surveyAnalyticsBucket collects the answers of a group in a period
+type surveyAnalyticsBucket struct {
+	respondents map[string]bool
+	scores      []float64
+	texts       []string
+}
+
+// ToSurveyAnalytics groups the answers by group and period and compares each period with the previous one
+func ToSurveyAnalytics(filter model.SurveyAnalyticsFilter, answers []*model.SurveyAnalyticsAnswer, counts []*model.SurveyAnalyticsResponseCount) SurveyAnalytics {
+	periodMap := map[model.UUID]*SurveyAnalyticsPeriod{}
+	thresholds := map[model.UUID]int{}
+	buckets := map[string]map[model.UUID]*surveyAnalyticsBucket{}
+
+	for _, a := range answers {
+		if _, ok := periodMap[a.EventID]; !ok {
+			startDate := a.StartDate
+			periodMap[a.EventID] = &SurveyAnalyticsPeriod{
+				EventID:   a.EventID.String(),
+				Title:     a.Title,
+				StartDate: &startDate,
+			}
+			thresholds[a.EventID] = a.Threshold
+		}
+
+		if buckets[a.GroupName] == nil {
+			buckets[a.GroupName] = map[model.UUID]*surveyAnalyticsBucket{}
+		}
+		b := buckets[a.GroupName][a.EventID]
+		if b == nil {
+			b = &surveyAnalyticsBucket{respondents: map[string]bool{}}
+			buckets[a.GroupName][a.EventID] = b
+		}
+
+		b.respondents[a.RespondentKey] = true
+		switch model.QuestionType(a.Type) {
+		case model.QuestionTypeScale:
+			if score, ok := model.LikertScore(a.Answer); ok {
+				b.scores = append(b.scores, score)
+			}
+		case model.QuestionTypeGeneral, model.QuestionTypeText:
+			b.texts = append(b.texts, a.Answer)
+		}
+	}
+
+	groupRates := map[string]map[model.UUID]*SurveyResponseRate{}
+	for _, c := range counts {
+		p, ok := periodMap[c.EventID]
+		if !ok {
+			// nobody answered the survey yet
+			continue
+		}
+
+		p.ResponseRate.Total += c.Total
+		p.ResponseRate.Responded += c.Responded
+
+		if filter.GroupBy.IsCohort() {
+			if groupRates[c.GroupName] == nil {
+				groupRates[c.GroupName] = map[model.UUID]*SurveyResponseRate{}
+			}
+			groupRates[c.GroupName][c.EventID] = newSurveyResponseRate(c.Total, c.Responded)
+		}
+	}
+
+	eventIDs := make([]model.UUID, 0, len(periodMap))
+	for id := range periodMap {
+		eventIDs = append(eventIDs, id)
+	}
+	sort.Slice(eventIDs, func(i, j int) bool {
+		return periodMap[eventIDs[i]].StartDate.Before(*periodMap[eventIDs[j]].StartDate)
+	})
+
+	periods := make([]SurveyAnalyticsPeriod, 0, len(eventIDs))
+	for _, id := range eventIDs {
+		p := periodMap[id]
+		p.ResponseRate = *newSurveyResponseRate(p.ResponseRate.Total, p.ResponseRate.Responded)
+		periods = append(periods, *p)
+	}
+
+	groups := make([]SurveyAnalyticsGroup, 0, len(buckets))
+	for name, byEvent := range buckets {
+		group := SurveyAnalyticsGroup{Name: name}
+
+		var previous *model.SurveyStats
+		for _, eventID := range eventIDs {
+			p := periodMap[eventID]
+			b, ok := byEvent[eventID]
+			if !ok {
+				continue
+			}
+
+			point := SurveyAnalyticsPoint{
+				EventID:      p.EventID,
+				Title:        p.Title,
+				StartDate:    p.StartDate,
+				Respondents:  len(b.respondents),
+				ResponseRate: groupRates[name][eventID],
+			}
+
+			// a group smaller than the threshold could reveal who answered what
+			if threshold := thresholds[eventID]; threshold > 0 && point.Respondents < threshold {
+				point.IsHidden = true
+				group.Points = append(group.Points, point)
+				continue
+			}
+
+			if len(b.scores) > 0 {
+				stats := model.NewSurveyStats(b.scores)
+				point.Likert = toSurveyLikertStats(stats)
+				if previous != nil {
+					point.Likert.Delta = toSurveyAnalyticsDelta(model.CompareSurveyStats(*previous, stats))
+				}
+				previous = &stats
+			}
+
+			if len(b.texts) > 0 {
+				point.Sentiment = toSurveySentimentSummary(b.texts)
+			}
+
+			group.Points = append(group.Points, point)
+		}
+
+		group.Trend = surveyAnalyticsTrend(group.Points)
+		groups = append(groups, group)
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Name < groups[j].Name
+	})
+
+	return SurveyAnalytics{
+		Subtype: filter.Subtype.String(),
+		GroupBy: filter.GroupBy.String(),
+		Periods: periods,
+		Groups:  groups,
+	}
+}
+
+func newSurveyResponseRate(total, responded int) *SurveyResponseRate {
+	rate := &SurveyResponseRate{Total: total, Responded: responded}
+	if total > 0 {
+		rate.Rate = roundSurveyAnalytics(float64(responded) / float64(total))
+	}
+	return rate
+}
+
+func toSurveyLikertStats(stats model.SurveyStats) *SurveyLikertStats {
+	return &SurveyLikertStats{
+		Count:       stats.Count,
+		Mean:        roundSurveyAnalytics(stats.Mean),
+		StdDev:      roundSurveyAnalytics(stats.StdDev),
+		Favorable:   roundSurveyAnalytics(stats.Favorable),
+		Unfavorable: roundSurveyAnalytics(stats.Unfavorable),
+	}
+}
+
+func toSurveyAnalyticsDelta(delta *model.SurveyDelta) *SurveyAnalyticsDelta {
+	if delta == nil {
+		return nil
+	}
+
+	return &SurveyAnalyticsDelta{
+		Value:         roundSurveyAnalytics(delta.Value),
+		TScore:        roundSurveyAnalytics(delta.TScore),
+		IsSignificant: delta.IsSignificant,
+	}
+}
+
+func toSurveySentimentSummary(texts []string) *SurveySentimentSummary {
+	summary := &SurveySentimentSummary{Answers: len(texts)}
+
+	var total float64
+	for _, text := range texts {
+		score := textutils.AnalyzeSentiment(text).Score()
+		total += score
+
+		switch {
+		case score > 0:
+			summary.Positive++
+		case score < 0:
+			summary.Negative++
+		default:
+			summary.Neutral++
+		}
+	}
+	summary.Score = roundSurveyAnalytics(total / float64(len(texts)))
+
+	summary.Keywords = make([]SurveyKeyword, 0)
+	for _, k := range textutils.ExtractKeywords(texts, surveyAnalyticsKeywordLimit) {
+		summary.Keywords = append(summary.Keywords, SurveyKeyword{Word: k.Word, Count: k.Count})
+	}
+
+	return summary
+}
+
+// surveyAnalyticsTrend returns the direction of the latest likert-scale change if it is significant
+func surveyAnalyticsTrend(points []SurveyAnalyticsPoint) string {
+	for i := len(points) - 1; i >= 0; i-- {
+		if points[i].Likert == nil {
+			continue
+		}
+
+		delta := points[i].Likert.Delta
+		if delta == nil || !delta.IsSignificant {
+			return "stable"
+		}
+		if delta.Value > 0 {
+			return "up"
+		}
+		return "down"
+	}
+
+	return "stable"
+}
+
+func roundSurveyAnalytics(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,132 @@
+package view
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type SurveyCampaign struct {
+	ID                 string                      `json:"id"`
+	CreatedAt          time.Time                   `json:"createdAt"`
+	Name               string                      `json:"name"`
+	Subtype            string                      `json:"subtype"`
+	Recurrence         string                      `json:"recurrence"`
+	StartDate          time.Time                   `json:"startDate"`
+	LeadDays           int                         `json:"leadDays"`
+	DeadlineDays       int                         `json:"deadlineDays"`
+	ReminderDays       []int                       `json:"reminderDays"`
+	AutoClose          bool                        `json:"autoClose"`
+	Anonymity          string                      `json:"anonymity"`
+	AnonymityThreshold int                         `json:"anonymityThreshold"`
+	ParticipantRule    model.SurveyParticipantRule `json:"participantRule"`
+	IsActive           bool                        `json:"isActive"`
+	Template           *SurveyTemplate             `json:"template"`
+	Creator            *BasicEmployeeInfo          `json:"creator"`
+	NextRunDate        *time.Time                  `json:"nextRunDate"`
+}
+
+type SurveyCampaignRun struct {
+	ID          string    `json:"id"`
+	CreatedAt   time.Time `json:"createdAt"`
+	CampaignID  string    `json:"campaignID"`
+	PeriodStart time.Time `json:"periodStart"`
+	PeriodEnd   time.Time `json:"periodEnd"`
+	Status      string    `json:"status"`
+	Error       string    `json:"error,omitempty"`
+	Survey      *Survey   `json:"survey"`
+}
+
+func ToSurveyCampaign(campaign *model.SurveyCampaign) *SurveyCampaign {
+	if campaign == nil {
+		return nil
+	}
+
+	rs := &SurveyCampaign{
+		ID:                 campaign.ID.String(),
+		CreatedAt:          campaign.CreatedAt,
+		Name:               campaign.Name,
+		Subtype:            campaign.Subtype.String(),
+		Recurrence:         campaign.Recurrence.String(),
+		StartDate:          campaign.StartDate,
+		LeadDays:           campaign.LeadDays,
+		DeadlineDays:       campaign.DeadlineDays,
+		ReminderDays:       campaign.ReminderDays,
+		AutoClose:          campaign.AutoClose,
+		Anonymity:          campaign.Anonymity.String(),
+		AnonymityThreshold: campaign.AnonymityThreshold,
+		ParticipantRule:    campaign.ParticipantRule,
+		IsActive:           campaign.IsActive,
+		Template:           ToSurveyTemplate(campaign.Template),
+	}
+
+	if campaign.Creator != nil {
+		rs.Creator = toBasicEmployeeInfo(*campaign.Creator)
+	}
+
+	if campaign.IsActive {
+		// the run date of the current period can be in the past when it already ran
+		now := time.Now()
+		period := campaign.PeriodAt(now)
+		runDate := campaign.RunDate(period)
+		if runDate.Before(now) || period.End.Before(campaign.StartDate) {
+			runDate = campaign.RunDate(campaign.PeriodAt(period.End.AddDate(0, 0, 1)))
+		}
+		rs.NextRunDate = &runDate
+	}
+
+	return rs
+}
+
+func ToSurveyCampaigns(campaigns []*model.SurveyCampaign) []SurveyCampaign {
+	rs := make([]SurveyCampaign, 0, len(campaigns))
+	for _, c := range campaigns {
+		rs = append(rs, *ToSurveyCampaign(c))
+	}
+
+	return rs
+}
+
+func ToSurveyCampaignRuns(runs []*model.SurveyCampaignRun) []SurveyCampaignRun {
+	rs := make([]SurveyCampaignRun, 0, len(runs))
+	for _, r := range runs {
+		run := SurveyCampaignRun{
+			ID:          r.ID.String(),
+			CreatedAt:   r.CreatedAt,
+			CampaignID:  r.CampaignID.String(),
+			PeriodStart: r.PeriodStart,
+			PeriodEnd:   r.PeriodEnd,
+			Status:      r.Status.String(),
+			Error:       r.Error,
+		}
+
+		if r.Event != nil {
+			run.Survey = &Survey{
+				ID:        r.Event.ID.String(),
+				Title:     r.Event.Title,
+				Type:      r.Event.Type.String(),
+				Subtype:   r.Event.Subtype.String(),
+				Status:    r.Event.Status.String(),
+				StartDate: r.Event.StartDate,
+				EndDate:   r.Event.EndDate,
+				Deadline:  r.Event.Deadline,
+			}
+		}
+
+		rs = append(rs, run)
+	}
+
+	return rs
+}
+
+type SurveyCampaignResponse struct {
+	Data SurveyCampaign `json:"data"`
+}
+
+type ListSurveyCampaignResponse struct {
+	Data []SurveyCampaign `json:"data"`
+}
+
+type ListSurveyCampaignRunResponse struct {
+	Data []SurveyCampaignRun `json:"data"`
+}
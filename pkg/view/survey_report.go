@@ -0,0 +1,163 @@
+package view
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/utils/reportutils"
+)
+
+// reviewPacketRelationships is the order of the sections of a peer-review packet
+var reviewPacketRelationships = []model.Relationship{
+	model.RelationshipSelf,
+	model.RelationshipPeer,
+	model.RelationshipLineManager,
+	model.RelationshipChapterLead,
+}
+
+var relationshipTitles = map[model.Relationship]string{
+	model.RelationshipSelf:        "Self review",
+	model.RelationshipPeer:        "Peer reviews",
+	model.RelationshipLineManager: "Line manager review",
+	model.RelationshipChapterLead: "Chapter lead review",
+}
+
+// ToSurveyReport renders the reviews of a survey with one section per topic
+func ToSurveyReport(event *model.FeedbackEvent, reviews []*model.EmployeeEventReviewer) reportutils.Report {
+	sections := map[model.UUID]*reportutils.Section{}
+	var topicIDs []model.UUID
+
+	for _, r := range reviews {
+		s, ok := sections[r.EmployeeEventTopicID]
+		if !ok {
+			s = &reportutils.Section{Title: r.EmployeeEventTopic.Title}
+			sections[r.EmployeeEventTopicID] = s
+			topicIDs = append(topicIDs, r.EmployeeEventTopicID)
+		}
+		s.Reviews = append(s.Reviews, toReportReview(r))
+	}
+
+	sort.SliceStable(topicIDs, func(i, j int) bool {
+		return sections[topicIDs[i]].Title < sections[topicIDs[j]].Title
+	})
+
+	report := reportutils.Report{
+		Title:    event.Title,
+		Subtitle: surveyReportSubtitle(event),
+	}
+	for _, id := range topicIDs {
+		report.Sections = append(report.Sections, *sections[id])
+	}
+
+	return report
+}
+
+// ToSurveyTopicReport renders the reviews of a topic, the reviews of a peer-review topic
+// are grouped by the relationship of the reviewer to the employee
+func ToSurveyTopicReport(topic *model.EmployeeEventTopic, reviews []*model.EmployeeEventReviewer) reportutils.Report {
+	report := reportutils.Report{
+		Title:    topic.Title,
+		Subtitle: surveyReportSubtitle(&topic.Event),
+	}
+
+	if topic.Event.Subtype != model.EventSubtypePeerReview {
+		section := reportutils.Section{Title: topic.Title}
+		for _, r := range reviews {
+			section.Reviews = append(section.Reviews, toReportReview(r))
+		}
+		report.Sections = []reportutils.Section{section}
+		return report
+	}
+
+	report.Sections = toRelationshipSections(reviews)
+	return report
+}
+
+// ToReviewPacket renders the reviews an employee received in a survey, grouped by relationship
+func ToReviewPacket(event *model.FeedbackEvent, employee *model.Employee, reviews []*model.EmployeeEventReviewer) reportutils.Report {
+	return reportutils.Report{
+		Title:    fmt.Sprintf("%s - %s", event.Title, employee.FullName),
+		Subtitle: surveyReportSubtitle(event),
+		Sections: toRelationshipSections(reviews),
+	}
+}
+
+// ToAnonymousSurveyReport renders the detached answers of an anonymous survey, one review per response
+func ToAnonymousSurveyReport(event *model.FeedbackEvent, answers []*model.AnonymousEventAnswer) reportutils.Report {
+	responses := map[string]*reportutils.Review{}
+	var keys []string
+
+	for _, a := range answers {
+		rv, ok := responses[a.ResponseKey]
+		if !ok {
+			rv = &reportutils.Review{Status: model.EventReviewerStatusDone.String()}
+			responses[a.ResponseKey] = rv
+			keys = append(keys, a.ResponseKey)
+		}
+		rv.Answers = append(rv.Answers, reportutils.Answer{Question: a.Content, Answer: a.Answer, Note: a.Note})
+	}
+
+	sort.Strings(keys)
+
+	section := reportutils.Section{Title: "Responses"}
+	for i, k := range keys {
+		rv := responses[k]
+		rv.Reviewer = fmt.Sprintf("Respondent %d", i+1)
+		section.Reviews = append(section.Reviews, *rv)
+	}
+
+	return reportutils.Report{
+		Title:    event.Title,
+		Subtitle: surveyReportSubtitle(event),
+		Sections: []reportutils.Section{section},
+	}
+}
+
+func toRelationshipSections(reviews []*model.EmployeeEventReviewer) []reportutils.Section {
+	var sections []reportutils.Section
+	for _, rel := range reviewPacketRelationships {
+		section := reportutils.Section{Title: relationshipTitles[rel]}
+		for _, r := range reviews {
+			if r.Relationship == rel {
+				section.Reviews = append(section.Reviews, toReportReview(r))
+			}
+		}
+
+		if len(section.Reviews) > 0 {
+			sections = append(sections, section)
+		}
+	}
+
+	return sections
+}
+
+func toReportReview(r *model.EmployeeEventReviewer) reportutils.Review {
+	review := reportutils.Review{
+		Topic:        r.EmployeeEventTopic.Title,
+		Relationship: r.Relationship.String(),
+		Status:       r.ReviewerStatus.String(),
+	}
+	if r.Reviewer != nil {
+		review.Reviewer = r.Reviewer.FullName
+	}
+
+	questions := make([]model.EmployeeEventQuestion, len(r.EmployeeEventQuestions))
+	copy(questions, r.EmployeeEventQuestions)
+	sort.SliceStable(questions, func(i, j int) bool {
+		return questions[i].Order < questions[j].Order
+	})
+
+	for _, q := range questions {
+		review.Answers = append(review.Answers, reportutils.Answer{Question: q.Content, Answer: q.Answer, Note: q.Note})
+	}
+
+	return review
+}
+
+func surveyReportSubtitle(event *model.FeedbackEvent) string {
+	if event.StartDate == nil {
+		return event.Subtype.String()
+	}
+	return fmt.Sprintf("%s · %s", event.Subtype.String(), event.StartDate.Format("02 Jan 2006"))
+}
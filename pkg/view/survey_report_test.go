@@ -0,0 +1,58 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+func TestToSurveyTopicReport(t *testing.T) {
+	review := func(name string, rel model.Relationship) *model.EmployeeEventReviewer {
+		return &model.EmployeeEventReviewer{
+			Relationship:   rel,
+			ReviewerStatus: model.EventReviewerStatusDone,
+			Reviewer:       &model.Employee{FullName: name},
+			EmployeeEventQuestions: []model.EmployeeEventQuestion{
+				{Content: "Growth", Answer: "b", Order: 2},
+				{Content: "Strengths", Answer: "a", Order: 1},
+			},
+		}
+	}
+	reviews := []*model.EmployeeEventReviewer{
+		review("Lead", model.RelationshipChapterLead),
+		review("Peer 1", model.RelationshipPeer),
+		review("Nam", model.RelationshipSelf),
+		review("Peer 2", model.RelationshipPeer),
+	}
+
+	t.Run("peer reviews are grouped by relationship", func(t *testing.T) {
+		topic := &model.EmployeeEventTopic{Title: "Review of Nam", Event: model.FeedbackEvent{Subtype: model.EventSubtypePeerReview}}
+
+		got := ToSurveyTopicReport(topic, reviews)
+
+		wantSections := []string{"Self review", "Peer reviews", "Chapter lead review"}
+		if len(got.Sections) != len(wantSections) {
+			t.Fatalf("ToSurveyTopicReport() sections = %+v, want %v", got.Sections, wantSections)
+		}
+		for i, s := range got.Sections {
+			if s.Title != wantSections[i] {
+				t.Errorf("section %d = %v, want %v", i, s.Title, wantSections[i])
+			}
+		}
+		if n := len(got.Sections[1].Reviews); n != 2 {
+			t.Errorf("peer reviews = %v, want 2", n)
+		}
+		if q := got.Sections[0].Reviews[0].Answers[0].Question; q != "Strengths" {
+			t.Errorf("first answer = %v, want answers sorted by order", q)
+		}
+	})
+
+	t.Run("other surveys have a single section", func(t *testing.T) {
+		topic := &model.EmployeeEventTopic{Title: "Work survey", Event: model.FeedbackEvent{Subtype: model.EventSubtypeWork}}
+
+		got := ToSurveyTopicReport(topic, reviews)
+		if len(got.Sections) != 1 || len(got.Sections[0].Reviews) != 4 {
+			t.Errorf("ToSurveyTopicReport() = %+v, want one section with every review", got.Sections)
+		}
+	})
+}
@@ -0,0 +1,127 @@
+package view
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type SurveyTemplate struct {
+	ID            string                  `json:"id"`
+	CreatedAt     time.Time               `json:"createdAt"`
+	Name          string                  `json:"name"`
+	Description   string                  `json:"description"`
+	Subtype       string                  `json:"subtype"`
+	LatestVersion int                     `json:"latestVersion"`
+	Creator       *BasicEmployeeInfo      `json:"creator"`
+	Versions      []SurveyTemplateVersion `json:"versions,omitempty"`
+}
+
+type SurveyTemplateVersion struct {
+	ID         string                   `json:"id"`
+	CreatedAt  time.Time                `json:"createdAt"`
+	TemplateID string                   `json:"templateID"`
+	Version    int                      `json:"version"`
+	Questions  []SurveyTemplateQuestion `json:"questions,omitempty"`
+}
+
+type SurveyTemplateQuestion struct {
+	ID        string               `json:"id"`
+	Key       string               `json:"key"`
+	Type      string               `json:"type"`
+	Content   string               `json:"content"`
+	Order     int64                `json:"order"`
+	Domain    model.QuestionDomain `json:"domain"`
+	Required  bool                 `json:"required"`
+	Options   []string             `json:"options,omitempty"`
+	MinValue  *int                 `json:"minValue,omitempty"`
+	MaxValue  *int                 `json:"maxValue,omitempty"`
+	ParentKey string               `json:"parentKey,omitempty"`
+	ShowIf    []string             `json:"showIf,omitempty"`
+}
+
+func ToSurveyTemplate(template *model.SurveyTemplate) *SurveyTemplate {
+	if template == nil {
+		return nil
+	}
+
+	rs := &SurveyTemplate{
+		ID:            template.ID.String(),
+		CreatedAt:     template.CreatedAt,
+		Name:          template.Name,
+		Description:   template.Description,
+		Subtype:       template.Subtype.String(),
+		LatestVersion: template.LatestVersion,
+	}
+
+	if template.Creator != nil {
+		rs.Creator = toBasicEmployeeInfo(*template.Creator)
+	}
+
+	for _, v := range template.Versions {
+		rs.Versions = append(rs.Versions, *ToSurveyTemplateVersion(v))
+	}
+
+	return rs
+}
+
+func ToSurveyTemplates(templates []*model.SurveyTemplate) []SurveyTemplate {
+	rs := make([]SurveyTemplate, 0, len(templates))
+	for _, t := range templates {
+		rs = append(rs, *ToSurveyTemplate(t))
+	}
+
+	return rs
+}
+
+func ToSurveyTemplateVersion(templateVersion *model.SurveyTemplateVersion) *SurveyTemplateVersion {
+	if templateVersion == nil {
+		return nil
+	}
+
+	rs := &SurveyTemplateVersion{
+		ID:         templateVersion.ID.String(),
+		CreatedAt:  templateVersion.CreatedAt,
+		TemplateID: templateVersion.TemplateID.String(),
+		Version:    templateVersion.Version,
+	}
+
+	for _, q := range templateVersion.Questions {
+		rs.Questions = append(rs.Questions, *ToSurveyTemplateQuestion(q))
+	}
+
+	return rs
+}
+
+func ToSurveyTemplateQuestion(question *model.SurveyTemplateQuestion) *SurveyTemplateQuestion {
+	if question == nil {
+		return nil
+	}
+
+	return &SurveyTemplateQuestion{
+		ID:        question.ID.String(),
+		Key:       question.Key,
+		Type:      question.Type.String(),
+		Content:   question.Content,
+		Order:     question.Order,
+		Domain:    question.Domain,
+		Required:  question.Required,
+		Options:   question.Options,
+		MinValue:  question.MinValue,
+		MaxValue:  question.MaxValue,
+		ParentKey: question.ParentKey,
+		ShowIf:    question.ShowIf,
+	}
+}
+
+type SurveyTemplateResponse struct {
+	Data SurveyTemplate `json:"data"`
+}
+
+type ListSurveyTemplateResponse struct {
+	Data []SurveyTemplate `json:"data"`
+}
+
+type SurveyTemplateVersionResponse struct {
+	Data SurveyTemplateVersion `json:"data"`
+}
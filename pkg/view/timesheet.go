@@ -0,0 +1,112 @@
+package view
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type Timesheet struct {
+	ID          string             `json:"id"`
+	WeekStart   time.Time          `json:"weekStart"`
+	Status      string             `json:"status"`
+	TotalHours  float64            `json:"totalHours"`
+	SubmittedAt *time.Time         `json:"submittedAt"`
+	ReviewedAt  *time.Time         `json:"reviewedAt"`
+	ReviewNote  string             `json:"reviewNote"`
+	Employee    *BasicEmployeeInfo `json:"employee"`
+	Project     *BasicProjectInfo  `json:"project"`
+	Reviewer    *BasicEmployeeInfo `json:"reviewer"`
+	Entries     []TimesheetEntry   `json:"entries"`
+}
+
+type TimesheetEntry struct {
+	ID          string             `json:"id"`
+	TimesheetID string             `json:"timesheetID"`
+	Date        time.Time          `json:"date"`
+	Hours       float64            `json:"hours"`
+	Note        string             `json:"note"`
+	WorkUnit    *TimesheetWorkUnit `json:"workUnit"`
+}
+
+type TimesheetWorkUnit struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func ToTimesheet(t *model.Timesheet) Timesheet {
+	rs := Timesheet{
+		ID:          t.ID.String(),
+		WeekStart:   t.WeekStart,
+		Status:      t.Status.String(),
+		TotalHours:  t.TotalHours(),
+		SubmittedAt: t.SubmittedAt,
+		ReviewedAt:  t.ReviewedAt,
+		ReviewNote:  t.ReviewNote,
+		Entries:     make([]TimesheetEntry, 0, len(t.Entries)),
+	}
+
+	if t.Employee != nil {
+		rs.Employee = toBasicEmployeeInfo(*t.Employee)
+	}
+	if t.Project != nil {
+		rs.Project = toBasicProjectInfo(*t.Project)
+	}
+	if t.Reviewer != nil {
+		rs.Reviewer = toBasicEmployeeInfo(*t.Reviewer)
+	}
+
+	for _, e := range t.Entries {
+		rs.Entries = append(rs.Entries, ToTimesheetEntry(e))
+	}
+
+	return rs
+}
+
+func ToTimesheets(timesheets []*model.Timesheet) []Timesheet {
+	rs := make([]Timesheet, 0, len(timesheets))
+	for _, t := range timesheets {
+		rs = append(rs, ToTimesheet(t))
+	}
+
+	return rs
+}
+
+func ToTimesheetEntry(e *model.TimesheetEntry) TimesheetEntry {
+	rs := TimesheetEntry{
+		ID:          e.ID.String(),
+		TimesheetID: e.TimesheetID.String(),
+		Date:        e.Date,
+		Hours:       e.Hours,
+		Note:        e.Note,
+	}
+
+	if e.WorkUnit != nil {
+		rs.WorkUnit = &TimesheetWorkUnit{
+			ID:   e.WorkUnit.ID.String(),
+			Name: e.WorkUnit.Name,
+		}
+	}
+
+	return rs
+}
+
+type TimesheetResponse struct {
+	Data Timesheet `json:"data"`
+}
+
+type ListTimesheetResponse struct {
+	Data []Timesheet `json:"data"`
+}
+
+type TimesheetEntryResponse struct {
+	Data TimesheetEntry `json:"data"`
+}
+
+type ImportTimesheetResponse struct {
+	Data ImportTimesheetResult `json:"data"`
+}
+
+type ImportTimesheetResult struct {
+	Imported int `json:"imported"`
+}
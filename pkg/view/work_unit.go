@@ -1,6 +1,8 @@
 package view
 
 import (
+	"time"
+
 	"github.com/dwarvesf/fortress-api/pkg/model"
 )
 
@@ -32,6 +34,39 @@ type WorkUnit struct {
 	Status    string        `json:"status"`
 	ProjectID string        `json:"projectID"`
 	Code      string        `json:"code"`
+	// Github is the synced GitHub repository, nil when the work unit is not linked to a repository
+	Github *WorkUnitGithub `json:"github"`
+}
+
+type WorkUnitGithub struct {
+	Owner         string                    `json:"owner"`
+	Name          string                    `json:"name"`
+	DefaultBranch string                    `json:"defaultBranch"`
+	PushedAt      *time.Time                `json:"pushedAt"`
+	SyncedAt      *time.Time                `json:"syncedAt"`
+	SyncError     string                    `json:"syncError"`
+	Languages     map[string]int            `json:"languages"`
+	Contributors  []model.GithubContributor `json:"contributors"`
+	Activity      model.GithubActivity      `json:"activity"`
+}
+
+func toWorkUnitGithub(workUnit *model.WorkUnit) *WorkUnitGithub {
+	metadata, ok := workUnit.GetGithubMetadata()
+	if !ok {
+		return nil
+	}
+
+	return &WorkUnitGithub{
+		Owner:         metadata.Owner,
+		Name:          metadata.Name,
+		DefaultBranch: metadata.DefaultBranch,
+		PushedAt:      metadata.PushedAt,
+		SyncedAt:      metadata.SyncedAt,
+		SyncError:     metadata.SyncError,
+		Languages:     metadata.Languages,
+		Contributors:  metadata.Contributors,
+		Activity:      metadata.Activity,
+	}
 }
 
 func ToWorkUnit(workUnit *model.WorkUnit, projectCode string) WorkUnit {
@@ -43,6 +78,7 @@ func ToWorkUnit(workUnit *model.WorkUnit, projectCode string) WorkUnit {
 		URL:       workUnit.SourceURL,
 		ProjectID: workUnit.ProjectID.String(),
 		Code:      projectCode,
+		Github:    toWorkUnitGithub(workUnit),
 	}
 
 	members := make([]BasicMember, 0, len(workUnit.WorkUnitMembers))
@@ -86,6 +122,7 @@ func ToWorkUnitList(workUnits []*model.WorkUnit, projectID string, projectCode s
 			Status:    wu.Status.String(),
 			ProjectID: projectID,
 			Code:      projectCode,
+			Github:    toWorkUnitGithub(wu),
 		}
 
 		for _, member := range wu.WorkUnitMembers {